@@ -19,6 +19,10 @@ type APIController struct {
 	controller.RepositoryController
 	controller.BranchController
 	controller.MergeRequestController
+	controller.MergeRequestReviewController
+	controller.BranchProtectionController
+	controller.CommitStatusController
+	controller.WebhookController
 	controller.AkSkController
 
 	controller.GroupController
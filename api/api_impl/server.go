@@ -20,6 +20,7 @@ import (
 	"github.com/GitDataAI/jiaozifs/auth"
 	"github.com/GitDataAI/jiaozifs/auth/crypt"
 	"github.com/GitDataAI/jiaozifs/config"
+	"github.com/GitDataAI/jiaozifs/dav"
 	"github.com/GitDataAI/jiaozifs/models"
 	"github.com/MadAppGang/httplog"
 	"github.com/flowchartsman/swaggerui"
@@ -46,12 +47,17 @@ func SetupAPI(lc fx.Lifecycle,
 	sessionStore sessions.Store,
 	repo models.IRepo,
 	verifier aksk.Verifier,
+	davHandler *dav.Handler,
 	controller APIController) error {
 	swagger, err := api.GetSwagger()
 	if err != nil {
 		return err
 	}
 
+	for _, method := range dav.Methods {
+		chi.RegisterMethod(method)
+	}
+
 	// This is how you set up a basic chi router
 	r := chi.NewRouter()
 	r.Use(httplog.LoggerWithName("http"),
@@ -90,6 +96,9 @@ func SetupAPI(lc fx.Lifecycle,
 		Version: "v1.0",
 	}))
 	r.Get("/status", h.HandlerFunc)
+	r.With(
+		auth.RequirementsMiddleware(swagger.Security, authenticator, secretStore, repo.UserRepo(), repo.AkskRepo(), sessionStore, verifier),
+	).Handle(dav.Prefix+"*", davHandler)
 
 	url, err := url.Parse(apiConfig.Listen)
 	if err != nil {
@@ -39,11 +39,42 @@ const (
 	Zip ArchiveType = "zip"
 )
 
+// Defines values for BranchReflogOperation.
+const (
+	BranchReflogOperationCherryPick  BranchReflogOperation = "cherry_pick"
+	BranchReflogOperationCommit      BranchReflogOperation = "commit"
+	BranchReflogOperationCreate      BranchReflogOperation = "create"
+	BranchReflogOperationDelete      BranchReflogOperation = "delete"
+	BranchReflogOperationFastForward BranchReflogOperation = "fast_forward"
+	BranchReflogOperationMerge       BranchReflogOperation = "merge"
+	BranchReflogOperationRestore     BranchReflogOperation = "restore"
+	BranchReflogOperationRevert      BranchReflogOperation = "revert"
+	BranchReflogOperationSquash      BranchReflogOperation = "squash"
+	BranchReflogOperationUndelete    BranchReflogOperation = "undelete"
+)
+
 // Defines values for ChangeAction.
 const (
 	N1 ChangeAction = 1
 	N2 ChangeAction = 2
 	N3 ChangeAction = 3
+	N4 ChangeAction = 4
+	N5 ChangeAction = 5
+)
+
+// Defines values for CommitStatusState.
+const (
+	CommitStatusStateError   CommitStatusState = "error"
+	CommitStatusStateFailure CommitStatusState = "failure"
+	CommitStatusStatePending CommitStatusState = "pending"
+	CommitStatusStateSuccess CommitStatusState = "success"
+)
+
+// Defines values for DeliveryState.
+const (
+	DeliveryStateFailed  DeliveryState = "failed"
+	DeliveryStatePending DeliveryState = "pending"
+	DeliveryStateSuccess DeliveryState = "success"
 )
 
 // Defines values for LoginConfigRBAC.
@@ -52,6 +83,13 @@ const (
 	Simplified LoginConfigRBAC = "simplified"
 )
 
+// Defines values for MergeMethod.
+const (
+	MergeMethodFastForwardOnly MergeMethod = "fast-forward-only"
+	MergeMethodMerge           MergeMethod = "merge"
+	MergeMethodSquash          MergeMethod = "squash"
+)
+
 // Defines values for RefType.
 const (
 	RefTypeBranch RefType = "branch"
@@ -60,12 +98,25 @@ const (
 	RefTypeWip    RefType = "wip"
 )
 
+// Defines values for ReviewState.
+const (
+	Approved         ReviewState = "approved"
+	ChangesRequested ReviewState = "changes_requested"
+)
+
 // Defines values for SetupStateState.
 const (
 	Initialized    SetupStateState = "initialized"
 	NotInitialized SetupStateState = "not_initialized"
 )
 
+// Defines values for TableRowChangeKind.
+const (
+	Added    TableRowChangeKind = "added"
+	Modified TableRowChangeKind = "modified"
+	Removed  TableRowChangeKind = "removed"
+)
+
 // Aksk defines model for Aksk.
 type Aksk struct {
 	AccessKey   string             `json:"access_key"`
@@ -82,6 +133,13 @@ type AkskList struct {
 	Results    []SafeAksk `json:"results"`
 }
 
+// ArchiveImportResult defines model for ArchiveImportResult.
+type ArchiveImportResult struct {
+	FileCount int    `json:"file_count"`
+	RootCid   string `json:"root_cid"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
 // ArchiveType defines model for ArchiveType.
 type ArchiveType string
 
@@ -94,6 +152,19 @@ type AuthenticationToken struct {
 	TokenExpiration *int64 `json:"token_expiration,omitempty"`
 }
 
+// BlameHunk consecutive lines of file introduced by the same commit
+type BlameHunk struct {
+	Author Signature `json:"author"`
+
+	// Commit hash of commit introduced these lines
+	Commit    string `json:"commit"`
+	LineCount int    `json:"line_count"`
+	Message   string `json:"message"`
+
+	// StartLine first line of hunk, start from 1
+	StartLine int `json:"start_line"`
+}
+
 // Branch defines model for Branch.
 type Branch struct {
 	CommitHash   string             `json:"commit_hash"`
@@ -118,39 +189,164 @@ type BranchList struct {
 	Results    []Branch   `json:"results"`
 }
 
+// BranchProtectionRule defines model for BranchProtectionRule.
+type BranchProtectionRule struct {
+	CreatedAt           int64              `json:"created_at"`
+	ForbidDeletion      bool               `json:"forbid_deletion"`
+	ForbidForceUpdate   bool               `json:"forbid_force_update"`
+	Id                  openapi_types.UUID `json:"id"`
+	Pattern             string             `json:"pattern"`
+	RequireMergeRequest bool               `json:"require_merge_request"`
+	RequiredChecks      []string           `json:"required_checks"`
+	UpdatedAt           int64              `json:"updated_at"`
+}
+
+// BranchProtectionRuleCreation defines model for BranchProtectionRuleCreation.
+type BranchProtectionRuleCreation struct {
+	ForbidDeletion *bool `json:"forbid_deletion,omitempty"`
+
+	// ForbidForceUpdate forbid to move branch to commit which is not descendant of current head
+	ForbidForceUpdate *bool `json:"forbid_force_update,omitempty"`
+
+	// Pattern glob of branch name, eg. main, release/*
+	Pattern string `json:"pattern"`
+
+	// RequireMergeRequest forbid commit to branch directly, changes can only be merged by merge request
+	RequireMergeRequest *bool `json:"require_merge_request,omitempty"`
+
+	// RequiredChecks checks must success before merge request can be merged into branch
+	RequiredChecks *[]string `json:"required_checks,omitempty"`
+}
+
+// BranchReflog defines model for BranchReflog.
+type BranchReflog struct {
+	// BranchId id of branch when head moved, branch recreated with the same name has a new id
+	BranchId   openapi_types.UUID `json:"branch_id"`
+	BranchName string             `json:"branch_name"`
+	CreatedAt  int64              `json:"created_at"`
+	Id         openapi_types.UUID `json:"id"`
+	Message    string             `json:"message"`
+
+	// NewHash head after movement, empty for deleted branch
+	NewHash string `json:"new_hash"`
+
+	// OldHash head before movement, empty for created branch
+	OldHash    string                `json:"old_hash"`
+	Operation  BranchReflogOperation `json:"operation"`
+	OperatorId openapi_types.UUID    `json:"operator_id"`
+}
+
+// BranchReflogOperation defines model for BranchReflog.Operation.
+type BranchReflogOperation string
+
+// BranchReflogList defines model for BranchReflogList.
+type BranchReflogList struct {
+	Pagination Pagination     `json:"pagination"`
+	Results    []BranchReflog `json:"results"`
+}
+
+// BranchRestore defines model for BranchRestore.
+type BranchRestore struct {
+	// Force move branch head even if current head is not ancestor of restored head, forbidden on branch protected from force update
+	Force *bool `json:"force,omitempty"`
+
+	// ReflogId reflog entry of the branch, branch head is moved to new_hash of the entry
+	ReflogId openapi_types.UUID `json:"reflog_id"`
+}
+
 // Change defines model for Change.
 type Change struct {
+	// Action 1 insert, 2 delete, 3 modify, 4 rename, 5 copy
 	Action   ChangeAction `json:"action"`
 	BaseHash *string      `json:"base_hash,omitempty"`
-	Path     string       `json:"path"`
-	ToHash   *string      `json:"to_hash,omitempty"`
+
+	// FromPath source path of renamed or copied file
+	FromPath *string `json:"from_path,omitempty"`
+	Path     string  `json:"path"`
+	ToHash   *string `json:"to_hash,omitempty"`
 }
 
-// ChangeAction defines model for Change.Action.
+// ChangeAction 1 insert, 2 delete, 3 modify, 4 rename, 5 copy
 type ChangeAction int
 
 // ChangePair defines model for ChangePair.
 type ChangePair struct {
 	IsConflict bool    `json:"is_conflict"`
 	Left       *Change `json:"left,omitempty"`
-	Path       string  `json:"path"`
-	Right      *Change `json:"right,omitempty"`
+
+	// MergeStrategy merge strategy of this path config in .jzattributes, one of ours, theirs, union, binary, text3way
+	MergeStrategy *string `json:"merge_strategy,omitempty"`
+	Path          string  `json:"path"`
+	Right         *Change `json:"right,omitempty"`
+}
+
+// CombinedCommitStatus defines model for CombinedCommitStatus.
+type CombinedCommitStatus struct {
+	Commit string `json:"commit"`
+
+	// State failure if any status failed or errored, pending if any status is pending or no status reported, otherwise success
+	State    CommitStatusState `json:"state"`
+	Statuses []CommitStatus    `json:"statuses"`
 }
 
 // Commit defines model for Commit.
 type Commit struct {
-	Author       Signature          `json:"author"`
-	Committer    Signature          `json:"committer"`
-	CreatedAt    int64              `json:"created_at"`
-	Hash         string             `json:"hash"`
-	MergeTag     string             `json:"merge_tag"`
-	Message      string             `json:"message"`
+	Author    Signature `json:"author"`
+	Committer Signature `json:"committer"`
+	CreatedAt int64     `json:"created_at"`
+	Hash      string    `json:"hash"`
+	MergeTag  string    `json:"merge_tag"`
+	Message   string    `json:"message"`
+
+	// Metadata key values attached to commit for lineage, included in commit hash
+	Metadata     *map[string]string `json:"metadata,omitempty"`
 	ParentHashes []string           `json:"parent_hashes"`
 	RepositoryId openapi_types.UUID `json:"repository_id"`
 	TreeHash     string             `json:"tree_hash"`
 	UpdatedAt    int64              `json:"updated_at"`
 }
 
+// CommitApply defines model for CommitApply.
+type CommitApply struct {
+	// Commit hash of commit to apply, changes against its first parent are applied
+	Commit string `json:"commit"`
+
+	// ConflictResolve use to record the resolution of the conflict, left is change of the applied commit, example({"b/a.txt":"left"})
+	ConflictResolve *map[string]string `json:"conflict_resolve,omitempty"`
+}
+
+// CommitCreation defines model for CommitCreation.
+type CommitCreation struct {
+	// Metadata key values attached to commit for lineage, included in commit hash
+	Metadata *map[string]string `json:"metadata,omitempty"`
+}
+
+// CommitStatus defines model for CommitStatus.
+type CommitStatus struct {
+	Context     string             `json:"context"`
+	CreatedAt   int64              `json:"created_at"`
+	Creator     string             `json:"creator"`
+	Description *string            `json:"description,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+	State       CommitStatusState  `json:"state"`
+	TargetUrl   *string            `json:"target_url,omitempty"`
+	UpdatedAt   int64              `json:"updated_at"`
+}
+
+// CommitStatusCreation defines model for CommitStatusCreation.
+type CommitStatusCreation struct {
+	// Context identify the system reported the status, eg. ci/build, data/schema-check
+	Context     string            `json:"context"`
+	Description *string           `json:"description,omitempty"`
+	State       CommitStatusState `json:"state"`
+
+	// TargetUrl link to detail of the status
+	TargetUrl *string `json:"target_url,omitempty"`
+}
+
+// CommitStatusState defines model for CommitStatusState.
+type CommitStatusState string
+
 // CreateMergeRequest defines model for CreateMergeRequest.
 type CreateMergeRequest struct {
 	Description      *string `json:"description,omitempty"`
@@ -163,19 +359,44 @@ type CreateMergeRequest struct {
 type CreateRepository struct {
 	// BlockstoreConfig block storage config url encoded json
 	BlockstoreConfig *string `json:"blockstore_config,omitempty"`
-	Description      *string `json:"description,omitempty"`
-	Name             string  `json:"name"`
-	Visible          *bool   `json:"visible,omitempty"`
+
+	// ChunkedStorage split content of files into content defined chunks, so unchanged chunks are shared between versions
+	ChunkedStorage *bool   `json:"chunked_storage,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Name           string  `json:"name"`
+	Visible        *bool   `json:"visible,omitempty"`
+}
+
+// CreateReview defines model for CreateReview.
+type CreateReview struct {
+	Message *string     `json:"message,omitempty"`
+	State   ReviewState `json:"state"`
+}
+
+// CreateReviewComment defines model for CreateReviewComment.
+type CreateReviewComment struct {
+	Content string `json:"content"`
+
+	// ParentId comment to reply
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+
+	// Path path in diff of merge request the comment anchored to
+	Path *string `json:"path,omitempty"`
 }
 
+// DeliveryState defines model for DeliveryState.
+type DeliveryState string
+
 // FullTreeEntry defines model for FullTreeEntry.
 type FullTreeEntry struct {
-	CreatedAt int64  `json:"created_at"`
-	Hash      string `json:"hash"`
-	IsDir     bool   `json:"is_dir"`
-	Name      string `json:"name"`
-	Size      int64  `json:"size"`
-	UpdatedAt int64  `json:"updated_at"`
+	// Checksum md5 of file content, empty for directory
+	Checksum  *string `json:"checksum,omitempty"`
+	CreatedAt int64   `json:"created_at"`
+	Hash      string  `json:"hash"`
+	IsDir     bool    `json:"is_dir"`
+	Name      string  `json:"name"`
+	Size      int64   `json:"size"`
+	UpdatedAt int64   `json:"updated_at"`
 }
 
 // Group defines model for Group.
@@ -187,6 +408,14 @@ type Group struct {
 	UpdatedAt int64                `json:"updated_at"`
 }
 
+// LinkPhysicalAddress defines model for LinkPhysicalAddress.
+type LinkPhysicalAddress struct {
+	// Checksum hex md5 of uploaded content
+	Checksum        string `json:"checksum"`
+	PhysicalAddress string `json:"physical_address"`
+	SizeBytes       int64  `json:"size_bytes"`
+}
+
 // LoginConfig defines model for LoginConfig.
 type LoginConfig struct {
 	// RBAC RBAC will remain enabled on GUI if "external".  That only works
@@ -227,19 +456,40 @@ type Member struct {
 	UserId    openapi_types.UUID `json:"user_id"`
 }
 
+// MergeApprovalRule defines model for MergeApprovalRule.
+type MergeApprovalRule struct {
+	// GroupName only approvals from members of the group are counted, eg. RepoAdmin
+	GroupName *string `json:"group_name,omitempty"`
+
+	// RequiredApprovals number of approvals required before merge request can be merged
+	RequiredApprovals int `json:"required_approvals"`
+}
+
 // MergeMergeRequest defines model for MergeMergeRequest.
 type MergeMergeRequest struct {
 	// ConflictResolve use to record the resolution of the conflict, example({"b/a.txt":"left"})
 	ConflictResolve *map[string]string `json:"conflict_resolve,omitempty"`
-	Msg             string             `json:"msg"`
+
+	// MergeMethod merge creates merge commit, squash creates one commit with target branch as the only parent, fast-forward-only refuses unless target branch is ancestor of source
+	MergeMethod *MergeMethod `json:"merge_method,omitempty"`
+
+	// Metadata key values attached to merge commit for lineage, included in commit hash
+	Metadata *map[string]string `json:"metadata,omitempty"`
+	Msg      string             `json:"msg"`
 }
 
+// MergeMethod merge creates merge commit, squash creates one commit with target branch as the only parent, fast-forward-only refuses unless target branch is ancestor of source
+type MergeMethod string
+
 // MergeRequest defines model for MergeRequest.
 type MergeRequest struct {
-	AuthorId     openapi_types.UUID `json:"author_id"`
-	CreatedAt    int64              `json:"created_at"`
-	Description  *string            `json:"description,omitempty"`
-	Id           openapi_types.UUID `json:"id"`
+	AuthorId    openapi_types.UUID `json:"author_id"`
+	CreatedAt   int64              `json:"created_at"`
+	Description *string            `json:"description,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// MergeMethod merge creates merge commit, squash creates one commit with target branch as the only parent, fast-forward-only refuses unless target branch is ancestor of source
+	MergeMethod  *MergeMethod       `json:"merge_method,omitempty"`
 	MergeStatus  int                `json:"merge_status"`
 	Sequence     uint64             `json:"sequence"`
 	SourceBranch openapi_types.UUID `json:"source_branch"`
@@ -252,11 +502,15 @@ type MergeRequest struct {
 
 // MergeRequestFullState defines model for MergeRequestFullState.
 type MergeRequestFullState struct {
-	AuthorId     openapi_types.UUID `json:"author_id"`
-	Changes      []ChangePair       `json:"changes"`
-	CreatedAt    int64              `json:"created_at"`
-	Description  *string            `json:"description,omitempty"`
-	Id           openapi_types.UUID `json:"id"`
+	AuthorId    openapi_types.UUID    `json:"author_id"`
+	Changes     []ChangePair          `json:"changes"`
+	Checks      *CombinedCommitStatus `json:"checks,omitempty"`
+	CreatedAt   int64                 `json:"created_at"`
+	Description *string               `json:"description,omitempty"`
+	Id          openapi_types.UUID    `json:"id"`
+
+	// MergeMethod merge creates merge commit, squash creates one commit with target branch as the only parent, fast-forward-only refuses unless target branch is ancestor of source
+	MergeMethod  *MergeMethod       `json:"merge_method,omitempty"`
 	MergeStatus  int                `json:"merge_status"`
 	Sequence     uint64             `json:"sequence"`
 	SourceBranch openapi_types.UUID `json:"source_branch"`
@@ -273,6 +527,25 @@ type MergeRequestList struct {
 	Results    []MergeRequest `json:"results"`
 }
 
+// MultipartUpload defines model for MultipartUpload.
+type MultipartUpload struct {
+	CreatedAt int64                 `json:"created_at"`
+	Id        openapi_types.UUID    `json:"id"`
+	Parts     []MultipartUploadPart `json:"parts"`
+	Path      string                `json:"path"`
+	RefName   string                `json:"ref_name"`
+}
+
+// MultipartUploadPart defines model for MultipartUploadPart.
+type MultipartUploadPart struct {
+	// Checksum hex md5 of part content
+	Checksum   string `json:"checksum"`
+	CreatedAt  int64  `json:"created_at"`
+	Etag       string `json:"etag"`
+	PartNumber int    `json:"part_number"`
+	Size       int64  `json:"size"`
+}
+
 // ObjectStats defines model for ObjectStats.
 type ObjectStats struct {
 	Checksum string `json:"checksum"`
@@ -306,11 +579,24 @@ type Pagination struct {
 	Results int `json:"results"`
 }
 
+// PhysicalAddress defines model for PhysicalAddress.
+type PhysicalAddress struct {
+	// ExpiresAt Unix Epoch in seconds, absent if storage does not report expiry
+	ExpiresAt *int64 `json:"expires_at,omitempty"`
+
+	// PhysicalAddress staging key of uploaded content, used to link content to wip
+	PhysicalAddress string `json:"physical_address"`
+
+	// PresignedUrl pre-signed url to upload content by PUT
+	PresignedUrl string `json:"presigned_url"`
+}
+
 // RefType defines model for RefType.
 type RefType string
 
 // Repository defines model for Repository.
 type Repository struct {
+	ChunkedStorage       *bool              `json:"chunked_storage,omitempty"`
 	CreatedAt            int64              `json:"created_at"`
 	CreatorId            openapi_types.UUID `json:"creator_id"`
 	Description          *string            `json:"description,omitempty"`
@@ -331,6 +617,43 @@ type RepositoryList struct {
 	Results    []Repository `json:"results"`
 }
 
+// Review defines model for Review.
+type Review struct {
+	// CommitHash head of source branch when reviewed, review is dismissed once source branch moved
+	CommitHash string             `json:"commit_hash"`
+	CreatedAt  int64              `json:"created_at"`
+	Id         openapi_types.UUID `json:"id"`
+	Message    *string            `json:"message,omitempty"`
+	Reviewer   string             `json:"reviewer"`
+	State      ReviewState        `json:"state"`
+	UpdatedAt  int64              `json:"updated_at"`
+}
+
+// ReviewComment defines model for ReviewComment.
+type ReviewComment struct {
+	Author    string              `json:"author"`
+	Content   string              `json:"content"`
+	CreatedAt int64               `json:"created_at"`
+	Id        openapi_types.UUID  `json:"id"`
+	ParentId  *openapi_types.UUID `json:"parent_id,omitempty"`
+	Path      *string             `json:"path,omitempty"`
+	UpdatedAt int64               `json:"updated_at"`
+}
+
+// ReviewState defines model for ReviewState.
+type ReviewState string
+
+// Reviewer defines model for Reviewer.
+type Reviewer struct {
+	CreatedAt int64  `json:"created_at"`
+	Reviewer  string `json:"reviewer"`
+}
+
+// ReviewerRequest defines model for ReviewerRequest.
+type ReviewerRequest struct {
+	Reviewers []string `json:"reviewers"`
+}
+
 // SafeAksk defines model for SafeAksk.
 type SafeAksk struct {
 	AccessKey   string             `json:"access_key"`
@@ -358,6 +681,48 @@ type Signature struct {
 	When  int64               `json:"when"`
 }
 
+// TableColumn defines model for TableColumn.
+type TableColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TableColumnChange defines model for TableColumnChange.
+type TableColumnChange struct {
+	FromType string `json:"from_type"`
+	Name     string `json:"name"`
+	ToType   string `json:"to_type"`
+}
+
+// TableDiff defines model for TableDiff.
+type TableDiff struct {
+	AddedColumns   []TableColumn       `json:"added_columns"`
+	BaseRows       int                 `json:"base_rows"`
+	Format         string              `json:"format"`
+	Path           string              `json:"path"`
+	PrimaryKey     *string             `json:"primary_key,omitempty"`
+	RemovedColumns []TableColumn       `json:"removed_columns"`
+	RetypedColumns []TableColumnChange `json:"retyped_columns"`
+	RowsAdded      int                 `json:"rows_added"`
+	RowsModified   int                 `json:"rows_modified"`
+	RowsRemoved    int                 `json:"rows_removed"`
+	Samples        []TableRowChange    `json:"samples"`
+	ToRows         int                 `json:"to_rows"`
+}
+
+// TableRowChange defines model for TableRowChange.
+type TableRowChange struct {
+	Base *map[string]string `json:"base,omitempty"`
+
+	// Key value of primary key column
+	Key  *string            `json:"key,omitempty"`
+	Kind TableRowChangeKind `json:"kind"`
+	To   *map[string]string `json:"to,omitempty"`
+}
+
+// TableRowChangeKind defines model for TableRowChange.Kind.
+type TableRowChangeKind string
+
 // Tag defines model for Tag.
 type Tag struct {
 	CreatedAt    int64              `json:"created_at"`
@@ -394,8 +759,10 @@ type UpdateMergeRequest struct {
 
 // UpdateRepository defines model for UpdateRepository.
 type UpdateRepository struct {
-	Description *string `json:"description,omitempty"`
-	Head        *string `json:"head,omitempty"`
+	// ChunkedStorage only affect files uploaded after change
+	ChunkedStorage *bool   `json:"chunked_storage,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Head           *string `json:"head,omitempty"`
 }
 
 // UpdateWip defines model for UpdateWip.
@@ -434,6 +801,50 @@ type VersionResult struct {
 	Version string `json:"version"`
 }
 
+// Webhook defines model for Webhook.
+type Webhook struct {
+	Active    bool               `json:"active"`
+	CreatedAt int64              `json:"created_at"`
+	Creator   string             `json:"creator"`
+	Events    []string           `json:"events"`
+	Id        openapi_types.UUID `json:"id"`
+	UpdatedAt int64              `json:"updated_at"`
+	Url       string             `json:"url"`
+}
+
+// WebhookCreation defines model for WebhookCreation.
+type WebhookCreation struct {
+	// Active default to true
+	Active *bool    `json:"active,omitempty"`
+	Events []string `json:"events"`
+
+	// Secret key to sign request body, signature is in header X-Jiaozifs-Signature-256
+	Secret string `json:"secret"`
+	Url    string `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts      int                `json:"attempts"`
+	CreatedAt     int64              `json:"created_at"`
+	Error         *string            `json:"error,omitempty"`
+	Event         string             `json:"event"`
+	Id            openapi_types.UUID `json:"id"`
+	NextAttemptAt int64              `json:"next_attempt_at"`
+	Payload       string             `json:"payload"`
+	ResponseCode  *int               `json:"response_code,omitempty"`
+	State         DeliveryState      `json:"state"`
+	UpdatedAt     int64              `json:"updated_at"`
+}
+
+// WebhookUpdate defines model for WebhookUpdate.
+type WebhookUpdate struct {
+	Active *bool     `json:"active,omitempty"`
+	Events *[]string `json:"events,omitempty"`
+	Secret *string   `json:"secret,omitempty"`
+	Url    *string   `json:"url,omitempty"`
+}
+
 // Wip defines model for Wip.
 type Wip struct {
 	BaseCommit   string             `json:"base_commit"`
@@ -467,7 +878,7 @@ type LoginJSONBody struct {
 
 // DeleteObjectParams defines parameters for DeleteObject.
 type DeleteObjectParams struct {
-	// RefName branch/tag to the ref
+	// RefName branch/tag/commit to the ref, revision expression is accepted, e.g. main~3, v1.0^2, main@{2024-05-01}, abbreviated commit hash
 	RefName string `form:"refName" json:"refName"`
 
 	// Path relative to the ref
@@ -479,7 +890,10 @@ type GetObjectParams struct {
 	// Type type indicate to retrieve from wip/branch/tag, default branch
 	Type RefType `form:"type" json:"type"`
 
-	// RefName branch/tag to the ref
+	// Presign redirect to a short-lived pre-signed url of the underlying storage instead of returning content
+	Presign *bool `form:"presign,omitempty" json:"presign,omitempty"`
+
+	// RefName branch/tag/commit to the ref, revision expression is accepted, e.g. main~3, v1.0^2, main@{2024-05-01}, abbreviated commit hash
 	RefName string `form:"refName" json:"refName"`
 
 	// Path relative to the ref
@@ -494,7 +908,7 @@ type HeadObjectParams struct {
 	// Type type indicate to retrieve from wip/branch/tag, default branch
 	Type RefType `form:"type" json:"type"`
 
-	// RefName branch/tag to the ref
+	// RefName branch/tag/commit to the ref, revision expression is accepted, e.g. main~3, v1.0^2, main@{2024-05-01}, abbreviated commit hash
 	RefName string `form:"refName" json:"refName"`
 
 	// Path relative to the ref
@@ -515,7 +929,7 @@ type UploadObjectParams struct {
 	// IsReplace indicate to replace existing object or not
 	IsReplace *bool `form:"isReplace,omitempty" json:"isReplace,omitempty"`
 
-	// RefName branch/tag to the ref
+	// RefName branch/tag/commit to the ref, revision expression is accepted, e.g. main~3, v1.0^2, main@{2024-05-01}, abbreviated commit hash
 	RefName string `form:"refName" json:"refName"`
 
 	// Path relative to the ref
@@ -530,8 +944,53 @@ type GetFilesParams struct {
 	// Type files to retrieve from wip/branch/tag/commit, default branch
 	Type RefType `form:"type" json:"type"`
 
-	// RefName branch/tag to the ref
+	// RefName branch/tag/commit to the ref, revision expression is accepted, e.g. main~3, v1.0^2, main@{2024-05-01}, abbreviated commit hash
+	RefName string `form:"refName" json:"refName"`
+}
+
+// ListMultipartUploadsParams defines parameters for ListMultipartUploads.
+type ListMultipartUploadsParams struct {
+	// RefName branch of upload
+	RefName *string `form:"refName,omitempty" json:"refName,omitempty"`
+
+	// Path path of upload
+	Path *string `form:"path,omitempty" json:"path,omitempty"`
+}
+
+// CreateMultipartUploadParams defines parameters for CreateMultipartUpload.
+type CreateMultipartUploadParams struct {
+	// RefName branch to add object to
+	RefName string `form:"refName" json:"refName"`
+
+	// Path relative to the ref
+	Path string `form:"path" json:"path"`
+}
+
+// CompleteMultipartUploadParams defines parameters for CompleteMultipartUpload.
+type CompleteMultipartUploadParams struct {
+	// IsReplace indicate to replace existing object or not
+	IsReplace *bool `form:"isReplace,omitempty" json:"isReplace,omitempty"`
+}
+
+// GetPhysicalAddressParams defines parameters for GetPhysicalAddress.
+type GetPhysicalAddressParams struct {
+	// RefName branch to the ref
+	RefName string `form:"refName" json:"refName"`
+
+	// Path relative to the ref
+	Path string `form:"path" json:"path"`
+}
+
+// LinkPhysicalAddressParams defines parameters for LinkPhysicalAddress.
+type LinkPhysicalAddressParams struct {
+	// IsReplace indicate to replace existing object or not
+	IsReplace *bool `form:"isReplace,omitempty" json:"isReplace,omitempty"`
+
+	// RefName branch to the ref
 	RefName string `form:"refName" json:"refName"`
+
+	// Path relative to the ref
+	Path string `form:"path" json:"path"`
 }
 
 // ListPublicRepositoryParams defines parameters for ListPublicRepository.
@@ -556,11 +1015,35 @@ type GetArchiveParams struct {
 	// ArchiveType download zip or car files
 	ArchiveType ArchiveType `form:"archive_type" json:"archive_type"`
 
-	// RefType ref type only allow branch or tag
+	// RefType ref type only allow branch, tag or commit
 	RefType RefType `form:"refType" json:"refType"`
 
-	// RefName ref(branch/tag) name
+	// RefName ref(branch/tag/commit) name, revision expression is accepted, e.g. main~3, v1.0^2, main@{2024-05-01}, abbreviated commit hash
+	RefName string `form:"refName" json:"refName"`
+}
+
+// ImportArchiveParams defines parameters for ImportArchive.
+type ImportArchiveParams struct {
+	// RefName branch name
 	RefName string `form:"refName" json:"refName"`
+
+	// Path directory to place files, root if not specific, required if root of car is a file
+	Path *string `form:"path,omitempty" json:"path,omitempty"`
+
+	// IsReplace indicate to replace existing object or not
+	IsReplace *bool `form:"isReplace,omitempty" json:"isReplace,omitempty"`
+}
+
+// GetBlameParams defines parameters for GetBlame.
+type GetBlameParams struct {
+	// Path path of text file
+	Path string `form:"path" json:"path"`
+
+	// Ref specific( ref name, tag name, commit hash), branch name default to repository default branch(HEAD), revision expression is accepted, e.g. main~3, v1.0^2, main@{2024-05-01}, abbreviated commit hash
+	Ref *string `form:"ref,omitempty" json:"ref,omitempty"`
+
+	// Type type indicate to retrieve from branch/tag/commit, wip is not supported
+	Type RefType `form:"type" json:"type"`
 }
 
 // DeleteBranchParams defines parameters for DeleteBranch.
@@ -573,6 +1056,39 @@ type GetBranchParams struct {
 	RefName string `form:"refName" json:"refName"`
 }
 
+// CherryPickParams defines parameters for CherryPick.
+type CherryPickParams struct {
+	// RefName branch to apply commit
+	RefName string `form:"refName" json:"refName"`
+}
+
+// ListBranchReflogParams defines parameters for ListBranchReflog.
+type ListBranchReflogParams struct {
+	RefName string `form:"refName" json:"refName"`
+
+	// After return items after this value
+	After *PaginationInt64After `form:"after,omitempty" json:"after,omitempty"`
+
+	// Amount how many items to return
+	Amount *PaginationAmount `form:"amount,omitempty" json:"amount,omitempty"`
+}
+
+// RestoreBranchParams defines parameters for RestoreBranch.
+type RestoreBranchParams struct {
+	RefName string `form:"refName" json:"refName"`
+}
+
+// RevertCommitParams defines parameters for RevertCommit.
+type RevertCommitParams struct {
+	// RefName branch to apply commit
+	RefName string `form:"refName" json:"refName"`
+}
+
+// UndeleteBranchParams defines parameters for UndeleteBranch.
+type UndeleteBranchParams struct {
+	RefName string `form:"refName" json:"refName"`
+}
+
 // ListBranchesParams defines parameters for ListBranches.
 type ListBranchesParams struct {
 	// Prefix return items prefixed with this value
@@ -601,6 +1117,15 @@ type GetCommitsInRefParams struct {
 
 	// RefName ref(branch/tag) name
 	RefName *string `form:"refName,omitempty" json:"refName,omitempty"`
+
+	// Path only return commits which changed the file or directory of this path
+	Path *string `form:"path,omitempty" json:"path,omitempty"`
+
+	// Follow continue listing the history of a file beyond renames, only used with path
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+
+	// Metadata only return commits having all the metadata, each item is key=value
+	Metadata *[]string `form:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // CompareCommitParams defines parameters for CompareCommit.
@@ -609,18 +1134,36 @@ type CompareCommitParams struct {
 	Path *string `form:"path,omitempty" json:"path,omitempty"`
 }
 
+// CompareTableParams defines parameters for CompareTable.
+type CompareTableParams struct {
+	// Path path of table file
+	Path string `form:"path" json:"path"`
+
+	// PrimaryKey column used to match rows, if not specific rows are compared by content
+	PrimaryKey *string `form:"primaryKey,omitempty" json:"primaryKey,omitempty"`
+
+	// SampleSize max number of changed rows to return
+	SampleSize *int32 `form:"sampleSize,omitempty" json:"sampleSize,omitempty"`
+}
+
 // GetEntriesInRefParams defines parameters for GetEntriesInRef.
 type GetEntriesInRefParams struct {
 	// Path specific path, if not specific return entries in root
 	Path *string `form:"path,omitempty" json:"path,omitempty"`
 
-	// Ref specific( ref name, tag name, commit hash), for wip and branchm, branch name default to repository default branch(HEAD),
+	// Ref specific( ref name, tag name, commit hash), for wip and branchm, branch name default to repository default branch(HEAD), revision expression is accepted, e.g. main~3, v1.0^2, main@{2024-05-01}, abbreviated commit hash
 	Ref *string `form:"ref,omitempty" json:"ref,omitempty"`
 
 	// Type type indicate to retrieve from wip/branch/tag/commit, default branch
 	Type RefType `form:"type" json:"type"`
 }
 
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Amount number of deliveries to return, default to 50
+	Amount *int `form:"amount,omitempty" json:"amount,omitempty"`
+}
+
 // RevokeMemberParams defines parameters for RevokeMember.
 type RevokeMemberParams struct {
 	UserId openapi_types.UUID `form:"user_id" json:"user_id"`
@@ -648,6 +1191,11 @@ type ListMergeRequestsParams struct {
 	State  *int              `form:"state,omitempty" json:"state,omitempty"`
 }
 
+// RemoveReviewerParams defines parameters for RemoveReviewer.
+type RemoveReviewerParams struct {
+	Reviewer string `form:"reviewer" json:"reviewer"`
+}
+
 // DeleteTagParams defines parameters for DeleteTag.
 type DeleteTagParams struct {
 	RefName string `form:"refName" json:"refName"`
@@ -776,21 +1324,57 @@ type LoginJSONRequestBody LoginJSONBody
 // UploadObjectMultipartRequestBody defines body for UploadObject for multipart/form-data ContentType.
 type UploadObjectMultipartRequestBody UploadObjectMultipartBody
 
+// LinkPhysicalAddressJSONRequestBody defines body for LinkPhysicalAddress for application/json ContentType.
+type LinkPhysicalAddressJSONRequestBody = LinkPhysicalAddress
+
 // UpdateRepositoryJSONRequestBody defines body for UpdateRepository for application/json ContentType.
 type UpdateRepositoryJSONRequestBody = UpdateRepository
 
+// SetMergeApprovalRuleJSONRequestBody defines body for SetMergeApprovalRule for application/json ContentType.
+type SetMergeApprovalRuleJSONRequestBody = MergeApprovalRule
+
 // CreateBranchJSONRequestBody defines body for CreateBranch for application/json ContentType.
 type CreateBranchJSONRequestBody = BranchCreation
 
+// CherryPickJSONRequestBody defines body for CherryPick for application/json ContentType.
+type CherryPickJSONRequestBody = CommitApply
+
+// RestoreBranchJSONRequestBody defines body for RestoreBranch for application/json ContentType.
+type RestoreBranchJSONRequestBody = BranchRestore
+
+// RevertCommitJSONRequestBody defines body for RevertCommit for application/json ContentType.
+type RevertCommitJSONRequestBody = CommitApply
+
+// CreateBranchProtectionRuleJSONRequestBody defines body for CreateBranchProtectionRule for application/json ContentType.
+type CreateBranchProtectionRuleJSONRequestBody = BranchProtectionRuleCreation
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = WebhookCreation
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = WebhookUpdate
+
 // CreateMergeRequestJSONRequestBody defines body for CreateMergeRequest for application/json ContentType.
 type CreateMergeRequestJSONRequestBody = CreateMergeRequest
 
 // UpdateMergeRequestJSONRequestBody defines body for UpdateMergeRequest for application/json ContentType.
 type UpdateMergeRequestJSONRequestBody = UpdateMergeRequest
 
+// CreateMergeRequestCommentJSONRequestBody defines body for CreateMergeRequestComment for application/json ContentType.
+type CreateMergeRequestCommentJSONRequestBody = CreateReviewComment
+
 // MergeJSONRequestBody defines body for Merge for application/json ContentType.
 type MergeJSONRequestBody = MergeMergeRequest
 
+// AddReviewersJSONRequestBody defines body for AddReviewers for application/json ContentType.
+type AddReviewersJSONRequestBody = ReviewerRequest
+
+// ReviewMergeRequestJSONRequestBody defines body for ReviewMergeRequest for application/json ContentType.
+type ReviewMergeRequestJSONRequestBody = CreateReview
+
+// CreateCommitStatusJSONRequestBody defines body for CreateCommitStatus for application/json ContentType.
+type CreateCommitStatusJSONRequestBody = CommitStatusCreation
+
 // CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
 type CreateTagJSONRequestBody = TagCreation
 
@@ -803,6 +1387,9 @@ type CreateRepositoryJSONRequestBody = CreateRepository
 // UpdateWipJSONRequestBody defines body for UpdateWip for application/json ContentType.
 type UpdateWipJSONRequestBody = UpdateWip
 
+// CommitWipJSONRequestBody defines body for CommitWip for application/json ContentType.
+type CommitWipJSONRequestBody = CommitCreation
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -902,6 +1489,32 @@ type ClientInterface interface {
 	// GetFiles request
 	GetFiles(ctx context.Context, owner string, repository string, params *GetFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListMultipartUploads request
+	ListMultipartUploads(ctx context.Context, owner string, repository string, params *ListMultipartUploadsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateMultipartUpload request
+	CreateMultipartUpload(ctx context.Context, owner string, repository string, params *CreateMultipartUploadParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AbortMultipartUpload request
+	AbortMultipartUpload(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMultipartUpload request
+	GetMultipartUpload(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteMultipartUpload request
+	CompleteMultipartUpload(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, params *CompleteMultipartUploadParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadMultipartPartWithBody request with any body
+	UploadMultipartPartWithBody(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, partNumber int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPhysicalAddress request
+	GetPhysicalAddress(ctx context.Context, owner string, repository string, params *GetPhysicalAddressParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LinkPhysicalAddressWithBody request with any body
+	LinkPhysicalAddressWithBody(ctx context.Context, owner string, repository string, params *LinkPhysicalAddressParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LinkPhysicalAddress(ctx context.Context, owner string, repository string, params *LinkPhysicalAddressParams, body LinkPhysicalAddressJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListPublicRepository request
 	ListPublicRepository(ctx context.Context, params *ListPublicRepositoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -916,9 +1529,26 @@ type ClientInterface interface {
 
 	UpdateRepository(ctx context.Context, owner string, repository string, body UpdateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteMergeApprovalRule request
+	DeleteMergeApprovalRule(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMergeApprovalRule request
+	GetMergeApprovalRule(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetMergeApprovalRuleWithBody request with any body
+	SetMergeApprovalRuleWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetMergeApprovalRule(ctx context.Context, owner string, repository string, body SetMergeApprovalRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetArchive request
 	GetArchive(ctx context.Context, owner string, repository string, params *GetArchiveParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ImportArchiveWithBody request with any body
+	ImportArchiveWithBody(ctx context.Context, owner string, repository string, params *ImportArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBlame request
+	GetBlame(ctx context.Context, owner string, repository string, params *GetBlameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteBranch request
 	DeleteBranch(ctx context.Context, owner string, repository string, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -930,6 +1560,38 @@ type ClientInterface interface {
 
 	CreateBranch(ctx context.Context, owner string, repository string, body CreateBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CherryPickWithBody request with any body
+	CherryPickWithBody(ctx context.Context, owner string, repository string, params *CherryPickParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CherryPick(ctx context.Context, owner string, repository string, params *CherryPickParams, body CherryPickJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBranchReflog request
+	ListBranchReflog(ctx context.Context, owner string, repository string, params *ListBranchReflogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreBranchWithBody request with any body
+	RestoreBranchWithBody(ctx context.Context, owner string, repository string, params *RestoreBranchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RestoreBranch(ctx context.Context, owner string, repository string, params *RestoreBranchParams, body RestoreBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevertCommitWithBody request with any body
+	RevertCommitWithBody(ctx context.Context, owner string, repository string, params *RevertCommitParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RevertCommit(ctx context.Context, owner string, repository string, params *RevertCommitParams, body RevertCommitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UndeleteBranch request
+	UndeleteBranch(ctx context.Context, owner string, repository string, params *UndeleteBranchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBranchProtectionRules request
+	ListBranchProtectionRules(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBranchProtectionRuleWithBody request with any body
+	CreateBranchProtectionRuleWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBranchProtectionRule(ctx context.Context, owner string, repository string, body CreateBranchProtectionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBranchProtectionRule request
+	DeleteBranchProtectionRule(ctx context.Context, owner string, repository string, ruleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListBranches request
 	ListBranches(ctx context.Context, owner string, repository string, params *ListBranchesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -942,9 +1604,34 @@ type ClientInterface interface {
 	// CompareCommit request
 	CompareCommit(ctx context.Context, owner string, repository string, basehead string, params *CompareCommitParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CompareTable request
+	CompareTable(ctx context.Context, owner string, repository string, basehead string, params *CompareTableParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetEntriesInRef request
 	GetEntriesInRef(ctx context.Context, owner string, repository string, params *GetEntriesInRefParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListWebhooks request
+	ListWebhooks(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhookWithBody request with any body
+	CreateWebhookWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhook(ctx context.Context, owner string, repository string, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhook request
+	DeleteWebhook(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhook request
+	GetWebhook(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWebhookWithBody request with any body
+	UpdateWebhookWithBody(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWebhook(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhookDeliveries request
+	ListWebhookDeliveries(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RevokeMember request
 	RevokeMember(ctx context.Context, owner string, repository string, params *RevokeMemberParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -973,11 +1660,52 @@ type ClientInterface interface {
 
 	UpdateMergeRequest(ctx context.Context, owner string, repository string, mrSeq uint64, body UpdateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListMergeRequestComments request
+	ListMergeRequestComments(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateMergeRequestCommentWithBody request with any body
+	CreateMergeRequestCommentWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateMergeRequestComment(ctx context.Context, owner string, repository string, mrSeq uint64, body CreateMergeRequestCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMergeRequestComment request
+	DeleteMergeRequestComment(ctx context.Context, owner string, repository string, mrSeq uint64, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// MergeWithBody request with any body
 	MergeWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	Merge(ctx context.Context, owner string, repository string, mrSeq uint64, body MergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RemoveReviewer request
+	RemoveReviewer(ctx context.Context, owner string, repository string, mrSeq uint64, params *RemoveReviewerParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListReviewers request
+	ListReviewers(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddReviewersWithBody request with any body
+	AddReviewersWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddReviewers(ctx context.Context, owner string, repository string, mrSeq uint64, body AddReviewersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListReviews request
+	ListReviews(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReviewMergeRequestWithBody request with any body
+	ReviewMergeRequestWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReviewMergeRequest(ctx context.Context, owner string, repository string, mrSeq uint64, body ReviewMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCombinedCommitStatus request
+	GetCombinedCommitStatus(ctx context.Context, owner string, repository string, commitId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCommitStatuses request
+	ListCommitStatuses(ctx context.Context, owner string, repository string, commitId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCommitStatusWithBody request with any body
+	CreateCommitStatusWithBody(ctx context.Context, owner string, repository string, commitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCommitStatus(ctx context.Context, owner string, repository string, commitId string, body CreateCommitStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteTag request
 	DeleteTag(ctx context.Context, owner string, repository string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1049,8 +1777,10 @@ type ClientInterface interface {
 	// GetWipChanges request
 	GetWipChanges(ctx context.Context, owner string, repository string, params *GetWipChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// CommitWip request
-	CommitWip(ctx context.Context, owner string, repository string, params *CommitWipParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CommitWipWithBody request with any body
+	CommitWipWithBody(ctx context.Context, owner string, repository string, params *CommitWipParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CommitWip(ctx context.Context, owner string, repository string, params *CommitWipParams, body CommitWipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListWip request
 	ListWip(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -1167,8 +1897,8 @@ func (c *Client) GetFiles(ctx context.Context, owner string, repository string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListPublicRepository(ctx context.Context, params *ListPublicRepositoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListPublicRepositoryRequest(c.Server, params)
+func (c *Client) ListMultipartUploads(ctx context.Context, owner string, repository string, params *ListMultipartUploadsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMultipartUploadsRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1179,8 +1909,8 @@ func (c *Client) ListPublicRepository(ctx context.Context, params *ListPublicRep
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteRepository(ctx context.Context, owner string, repository string, params *DeleteRepositoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteRepositoryRequest(c.Server, owner, repository, params)
+func (c *Client) CreateMultipartUpload(ctx context.Context, owner string, repository string, params *CreateMultipartUploadParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMultipartUploadRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1191,8 +1921,8 @@ func (c *Client) DeleteRepository(ctx context.Context, owner string, repository
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetRepository(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRepositoryRequest(c.Server, owner, repository)
+func (c *Client) AbortMultipartUpload(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAbortMultipartUploadRequest(c.Server, owner, repository, uploadId)
 	if err != nil {
 		return nil, err
 	}
@@ -1203,8 +1933,8 @@ func (c *Client) GetRepository(ctx context.Context, owner string, repository str
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateRepositoryWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateRepositoryRequestWithBody(c.Server, owner, repository, contentType, body)
+func (c *Client) GetMultipartUpload(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMultipartUploadRequest(c.Server, owner, repository, uploadId)
 	if err != nil {
 		return nil, err
 	}
@@ -1215,8 +1945,8 @@ func (c *Client) UpdateRepositoryWithBody(ctx context.Context, owner string, rep
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateRepository(ctx context.Context, owner string, repository string, body UpdateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateRepositoryRequest(c.Server, owner, repository, body)
+func (c *Client) CompleteMultipartUpload(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, params *CompleteMultipartUploadParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteMultipartUploadRequest(c.Server, owner, repository, uploadId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1227,8 +1957,8 @@ func (c *Client) UpdateRepository(ctx context.Context, owner string, repository
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetArchive(ctx context.Context, owner string, repository string, params *GetArchiveParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetArchiveRequest(c.Server, owner, repository, params)
+func (c *Client) UploadMultipartPartWithBody(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, partNumber int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadMultipartPartRequestWithBody(c.Server, owner, repository, uploadId, partNumber, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1239,8 +1969,8 @@ func (c *Client) GetArchive(ctx context.Context, owner string, repository string
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteBranch(ctx context.Context, owner string, repository string, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteBranchRequest(c.Server, owner, repository, params)
+func (c *Client) GetPhysicalAddress(ctx context.Context, owner string, repository string, params *GetPhysicalAddressParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPhysicalAddressRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1251,8 +1981,8 @@ func (c *Client) DeleteBranch(ctx context.Context, owner string, repository stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetBranch(ctx context.Context, owner string, repository string, params *GetBranchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetBranchRequest(c.Server, owner, repository, params)
+func (c *Client) LinkPhysicalAddressWithBody(ctx context.Context, owner string, repository string, params *LinkPhysicalAddressParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLinkPhysicalAddressRequestWithBody(c.Server, owner, repository, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1263,8 +1993,8 @@ func (c *Client) GetBranch(ctx context.Context, owner string, repository string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateBranchWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateBranchRequestWithBody(c.Server, owner, repository, contentType, body)
+func (c *Client) LinkPhysicalAddress(ctx context.Context, owner string, repository string, params *LinkPhysicalAddressParams, body LinkPhysicalAddressJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLinkPhysicalAddressRequest(c.Server, owner, repository, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1275,8 +2005,8 @@ func (c *Client) CreateBranchWithBody(ctx context.Context, owner string, reposit
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateBranch(ctx context.Context, owner string, repository string, body CreateBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateBranchRequest(c.Server, owner, repository, body)
+func (c *Client) ListPublicRepository(ctx context.Context, params *ListPublicRepositoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPublicRepositoryRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1287,8 +2017,8 @@ func (c *Client) CreateBranch(ctx context.Context, owner string, repository stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListBranches(ctx context.Context, owner string, repository string, params *ListBranchesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListBranchesRequest(c.Server, owner, repository, params)
+func (c *Client) DeleteRepository(ctx context.Context, owner string, repository string, params *DeleteRepositoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRepositoryRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1299,8 +2029,8 @@ func (c *Client) ListBranches(ctx context.Context, owner string, repository stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCommitChanges(ctx context.Context, owner string, repository string, commitId string, params *GetCommitChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCommitChangesRequest(c.Server, owner, repository, commitId, params)
+func (c *Client) GetRepository(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRepositoryRequest(c.Server, owner, repository)
 	if err != nil {
 		return nil, err
 	}
@@ -1311,8 +2041,8 @@ func (c *Client) GetCommitChanges(ctx context.Context, owner string, repository
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCommitsInRef(ctx context.Context, owner string, repository string, params *GetCommitsInRefParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCommitsInRefRequest(c.Server, owner, repository, params)
+func (c *Client) UpdateRepositoryWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRepositoryRequestWithBody(c.Server, owner, repository, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1323,8 +2053,8 @@ func (c *Client) GetCommitsInRef(ctx context.Context, owner string, repository s
 	return c.Client.Do(req)
 }
 
-func (c *Client) CompareCommit(ctx context.Context, owner string, repository string, basehead string, params *CompareCommitParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCompareCommitRequest(c.Server, owner, repository, basehead, params)
+func (c *Client) UpdateRepository(ctx context.Context, owner string, repository string, body UpdateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRepositoryRequest(c.Server, owner, repository, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1335,8 +2065,8 @@ func (c *Client) CompareCommit(ctx context.Context, owner string, repository str
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetEntriesInRef(ctx context.Context, owner string, repository string, params *GetEntriesInRefParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetEntriesInRefRequest(c.Server, owner, repository, params)
+func (c *Client) DeleteMergeApprovalRule(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMergeApprovalRuleRequest(c.Server, owner, repository)
 	if err != nil {
 		return nil, err
 	}
@@ -1347,8 +2077,8 @@ func (c *Client) GetEntriesInRef(ctx context.Context, owner string, repository s
 	return c.Client.Do(req)
 }
 
-func (c *Client) RevokeMember(ctx context.Context, owner string, repository string, params *RevokeMemberParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRevokeMemberRequest(c.Server, owner, repository, params)
+func (c *Client) GetMergeApprovalRule(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMergeApprovalRuleRequest(c.Server, owner, repository)
 	if err != nil {
 		return nil, err
 	}
@@ -1359,8 +2089,8 @@ func (c *Client) RevokeMember(ctx context.Context, owner string, repository stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMemberGroup(ctx context.Context, owner string, repository string, params *UpdateMemberGroupParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMemberGroupRequest(c.Server, owner, repository, params)
+func (c *Client) SetMergeApprovalRuleWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetMergeApprovalRuleRequestWithBody(c.Server, owner, repository, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1371,8 +2101,8 @@ func (c *Client) UpdateMemberGroup(ctx context.Context, owner string, repository
 	return c.Client.Do(req)
 }
 
-func (c *Client) InviteMember(ctx context.Context, owner string, repository string, params *InviteMemberParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInviteMemberRequest(c.Server, owner, repository, params)
+func (c *Client) SetMergeApprovalRule(ctx context.Context, owner string, repository string, body SetMergeApprovalRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetMergeApprovalRuleRequest(c.Server, owner, repository, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1383,8 +2113,8 @@ func (c *Client) InviteMember(ctx context.Context, owner string, repository stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMembers(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMembersRequest(c.Server, owner, repository)
+func (c *Client) GetArchive(ctx context.Context, owner string, repository string, params *GetArchiveParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetArchiveRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1395,8 +2125,8 @@ func (c *Client) ListMembers(ctx context.Context, owner string, repository strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMergeRequests(ctx context.Context, owner string, repository string, params *ListMergeRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMergeRequestsRequest(c.Server, owner, repository, params)
+func (c *Client) ImportArchiveWithBody(ctx context.Context, owner string, repository string, params *ImportArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportArchiveRequestWithBody(c.Server, owner, repository, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1407,8 +2137,8 @@ func (c *Client) ListMergeRequests(ctx context.Context, owner string, repository
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMergeRequestWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMergeRequestRequestWithBody(c.Server, owner, repository, contentType, body)
+func (c *Client) GetBlame(ctx context.Context, owner string, repository string, params *GetBlameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBlameRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1419,8 +2149,8 @@ func (c *Client) CreateMergeRequestWithBody(ctx context.Context, owner string, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMergeRequest(ctx context.Context, owner string, repository string, body CreateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMergeRequestRequest(c.Server, owner, repository, body)
+func (c *Client) DeleteBranch(ctx context.Context, owner string, repository string, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBranchRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1431,8 +2161,8 @@ func (c *Client) CreateMergeRequest(ctx context.Context, owner string, repositor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMergeRequest(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMergeRequestRequest(c.Server, owner, repository, mrSeq)
+func (c *Client) GetBranch(ctx context.Context, owner string, repository string, params *GetBranchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBranchRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1443,8 +2173,8 @@ func (c *Client) GetMergeRequest(ctx context.Context, owner string, repository s
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMergeRequestWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMergeRequestRequestWithBody(c.Server, owner, repository, mrSeq, contentType, body)
+func (c *Client) CreateBranchWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBranchRequestWithBody(c.Server, owner, repository, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1455,8 +2185,8 @@ func (c *Client) UpdateMergeRequestWithBody(ctx context.Context, owner string, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMergeRequest(ctx context.Context, owner string, repository string, mrSeq uint64, body UpdateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMergeRequestRequest(c.Server, owner, repository, mrSeq, body)
+func (c *Client) CreateBranch(ctx context.Context, owner string, repository string, body CreateBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBranchRequest(c.Server, owner, repository, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1467,8 +2197,8 @@ func (c *Client) UpdateMergeRequest(ctx context.Context, owner string, repositor
 	return c.Client.Do(req)
 }
 
-func (c *Client) MergeWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMergeRequestWithBody(c.Server, owner, repository, mrSeq, contentType, body)
+func (c *Client) CherryPickWithBody(ctx context.Context, owner string, repository string, params *CherryPickParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCherryPickRequestWithBody(c.Server, owner, repository, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1479,8 +2209,8 @@ func (c *Client) MergeWithBody(ctx context.Context, owner string, repository str
 	return c.Client.Do(req)
 }
 
-func (c *Client) Merge(ctx context.Context, owner string, repository string, mrSeq uint64, body MergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMergeRequest(c.Server, owner, repository, mrSeq, body)
+func (c *Client) CherryPick(ctx context.Context, owner string, repository string, params *CherryPickParams, body CherryPickJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCherryPickRequest(c.Server, owner, repository, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1491,8 +2221,8 @@ func (c *Client) Merge(ctx context.Context, owner string, repository string, mrS
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTag(ctx context.Context, owner string, repository string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTagRequest(c.Server, owner, repository, params)
+func (c *Client) ListBranchReflog(ctx context.Context, owner string, repository string, params *ListBranchReflogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBranchReflogRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1503,8 +2233,8 @@ func (c *Client) DeleteTag(ctx context.Context, owner string, repository string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTag(ctx context.Context, owner string, repository string, params *GetTagParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTagRequest(c.Server, owner, repository, params)
+func (c *Client) RestoreBranchWithBody(ctx context.Context, owner string, repository string, params *RestoreBranchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreBranchRequestWithBody(c.Server, owner, repository, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1515,8 +2245,8 @@ func (c *Client) GetTag(ctx context.Context, owner string, repository string, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTagWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTagRequestWithBody(c.Server, owner, repository, contentType, body)
+func (c *Client) RestoreBranch(ctx context.Context, owner string, repository string, params *RestoreBranchParams, body RestoreBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreBranchRequest(c.Server, owner, repository, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1527,8 +2257,8 @@ func (c *Client) CreateTagWithBody(ctx context.Context, owner string, repository
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTag(ctx context.Context, owner string, repository string, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTagRequest(c.Server, owner, repository, body)
+func (c *Client) RevertCommitWithBody(ctx context.Context, owner string, repository string, params *RevertCommitParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevertCommitRequestWithBody(c.Server, owner, repository, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1539,8 +2269,8 @@ func (c *Client) CreateTag(ctx context.Context, owner string, repository string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTags(ctx context.Context, owner string, repository string, params *ListTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTagsRequest(c.Server, owner, repository, params)
+func (c *Client) RevertCommit(ctx context.Context, owner string, repository string, params *RevertCommitParams, body RevertCommitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevertCommitRequest(c.Server, owner, repository, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1551,8 +2281,8 @@ func (c *Client) ListTags(ctx context.Context, owner string, repository string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ChangeVisible(ctx context.Context, owner string, repository string, params *ChangeVisibleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewChangeVisibleRequest(c.Server, owner, repository, params)
+func (c *Client) UndeleteBranch(ctx context.Context, owner string, repository string, params *UndeleteBranchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUndeleteBranchRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1563,8 +2293,8 @@ func (c *Client) ChangeVisible(ctx context.Context, owner string, repository str
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSetupState(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSetupStateRequest(c.Server)
+func (c *Client) ListBranchProtectionRules(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBranchProtectionRulesRequest(c.Server, owner, repository)
 	if err != nil {
 		return nil, err
 	}
@@ -1575,8 +2305,8 @@ func (c *Client) GetSetupState(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteAksk(ctx context.Context, params *DeleteAkskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAkskRequest(c.Server, params)
+func (c *Client) CreateBranchProtectionRuleWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBranchProtectionRuleRequestWithBody(c.Server, owner, repository, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1587,8 +2317,8 @@ func (c *Client) DeleteAksk(ctx context.Context, params *DeleteAkskParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAksk(ctx context.Context, params *GetAkskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAkskRequest(c.Server, params)
+func (c *Client) CreateBranchProtectionRule(ctx context.Context, owner string, repository string, body CreateBranchProtectionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBranchProtectionRuleRequest(c.Server, owner, repository, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1599,8 +2329,8 @@ func (c *Client) GetAksk(ctx context.Context, params *GetAkskParams, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateAksk(ctx context.Context, params *CreateAkskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateAkskRequest(c.Server, params)
+func (c *Client) DeleteBranchProtectionRule(ctx context.Context, owner string, repository string, ruleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBranchProtectionRuleRequest(c.Server, owner, repository, ruleId)
 	if err != nil {
 		return nil, err
 	}
@@ -1611,8 +2341,8 @@ func (c *Client) CreateAksk(ctx context.Context, params *CreateAkskParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListAksks(ctx context.Context, params *ListAksksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListAksksRequest(c.Server, params)
+func (c *Client) ListBranches(ctx context.Context, owner string, repository string, params *ListBranchesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBranchesRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1623,8 +2353,8 @@ func (c *Client) ListAksks(ctx context.Context, params *ListAksksParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) RefreshToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRefreshTokenRequest(c.Server)
+func (c *Client) GetCommitChanges(ctx context.Context, owner string, repository string, commitId string, params *GetCommitChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCommitChangesRequest(c.Server, owner, repository, commitId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1635,8 +2365,8 @@ func (c *Client) RefreshToken(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetCommitsInRef(ctx context.Context, owner string, repository string, params *GetCommitsInRefParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCommitsInRefRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1647,8 +2377,8 @@ func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) Register(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterRequest(c.Server, body)
+func (c *Client) CompareCommit(ctx context.Context, owner string, repository string, basehead string, params *CompareCommitParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompareCommitRequest(c.Server, owner, repository, basehead, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1659,8 +2389,8 @@ func (c *Client) Register(ctx context.Context, body RegisterJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListRepositoryOfAuthenticatedUser(ctx context.Context, params *ListRepositoryOfAuthenticatedUserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListRepositoryOfAuthenticatedUserRequest(c.Server, params)
+func (c *Client) CompareTable(ctx context.Context, owner string, repository string, basehead string, params *CompareTableParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompareTableRequest(c.Server, owner, repository, basehead, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1671,8 +2401,8 @@ func (c *Client) ListRepositoryOfAuthenticatedUser(ctx context.Context, params *
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateRepositoryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRepositoryRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetEntriesInRef(ctx context.Context, owner string, repository string, params *GetEntriesInRefParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEntriesInRefRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1683,8 +2413,8 @@ func (c *Client) CreateRepositoryWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateRepository(ctx context.Context, body CreateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRepositoryRequest(c.Server, body)
+func (c *Client) ListWebhooks(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhooksRequest(c.Server, owner, repository)
 	if err != nil {
 		return nil, err
 	}
@@ -1695,8 +2425,8 @@ func (c *Client) CreateRepository(ctx context.Context, body CreateRepositoryJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUserInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserInfoRequest(c.Server)
+func (c *Client) CreateWebhookWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequestWithBody(c.Server, owner, repository, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1707,8 +2437,8 @@ func (c *Client) GetUserInfo(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListRepository(ctx context.Context, owner string, params *ListRepositoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListRepositoryRequest(c.Server, owner, params)
+func (c *Client) CreateWebhook(ctx context.Context, owner string, repository string, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequest(c.Server, owner, repository, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1719,8 +2449,8 @@ func (c *Client) ListRepository(ctx context.Context, owner string, params *ListR
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetVersionRequest(c.Server)
+func (c *Client) DeleteWebhook(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookRequest(c.Server, owner, repository, hookId)
 	if err != nil {
 		return nil, err
 	}
@@ -1731,8 +2461,8 @@ func (c *Client) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteWip(ctx context.Context, owner string, repository string, params *DeleteWipParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteWipRequest(c.Server, owner, repository, params)
+func (c *Client) GetWebhook(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookRequest(c.Server, owner, repository, hookId)
 	if err != nil {
 		return nil, err
 	}
@@ -1743,8 +2473,8 @@ func (c *Client) DeleteWip(ctx context.Context, owner string, repository string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetWip(ctx context.Context, owner string, repository string, params *GetWipParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetWipRequest(c.Server, owner, repository, params)
+func (c *Client) UpdateWebhookWithBody(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequestWithBody(c.Server, owner, repository, hookId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1755,8 +2485,8 @@ func (c *Client) GetWip(ctx context.Context, owner string, repository string, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateWipWithBody(ctx context.Context, owner string, repository string, params *UpdateWipParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateWipRequestWithBody(c.Server, owner, repository, params, contentType, body)
+func (c *Client) UpdateWebhook(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequest(c.Server, owner, repository, hookId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1767,8 +2497,8 @@ func (c *Client) UpdateWipWithBody(ctx context.Context, owner string, repository
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateWip(ctx context.Context, owner string, repository string, params *UpdateWipParams, body UpdateWipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateWipRequest(c.Server, owner, repository, params, body)
+func (c *Client) ListWebhookDeliveries(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookDeliveriesRequest(c.Server, owner, repository, hookId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1779,8 +2509,8 @@ func (c *Client) UpdateWip(ctx context.Context, owner string, repository string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetWipChanges(ctx context.Context, owner string, repository string, params *GetWipChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetWipChangesRequest(c.Server, owner, repository, params)
+func (c *Client) RevokeMember(ctx context.Context, owner string, repository string, params *RevokeMemberParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeMemberRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1791,8 +2521,8 @@ func (c *Client) GetWipChanges(ctx context.Context, owner string, repository str
 	return c.Client.Do(req)
 }
 
-func (c *Client) CommitWip(ctx context.Context, owner string, repository string, params *CommitWipParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCommitWipRequest(c.Server, owner, repository, params)
+func (c *Client) UpdateMemberGroup(ctx context.Context, owner string, repository string, params *UpdateMemberGroupParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMemberGroupRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1803,8 +2533,8 @@ func (c *Client) CommitWip(ctx context.Context, owner string, repository string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListWip(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListWipRequest(c.Server, owner, repository)
+func (c *Client) InviteMember(ctx context.Context, owner string, repository string, params *InviteMemberParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInviteMemberRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1815,8 +2545,8 @@ func (c *Client) ListWip(ctx context.Context, owner string, repository string, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) RevertWipChanges(ctx context.Context, owner string, repository string, params *RevertWipChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRevertWipChangesRequest(c.Server, owner, repository, params)
+func (c *Client) ListMembers(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMembersRequest(c.Server, owner, repository)
 	if err != nil {
 		return nil, err
 	}
@@ -1827,806 +2557,680 @@ func (c *Client) RevertWipChanges(ctx context.Context, owner string, repository
 	return c.Client.Do(req)
 }
 
-// NewLoginRequest calls the generic Login builder with application/json body
-func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListMergeRequests(ctx context.Context, owner string, repository string, params *ListMergeRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMergeRequestsRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewLoginRequestWithBody generates requests for Login with any type of body
-func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateMergeRequestWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMergeRequestRequestWithBody(c.Server, owner, repository, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateMergeRequest(ctx context.Context, owner string, repository string, body CreateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMergeRequestRequest(c.Server, owner, repository, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewLogoutRequest generates requests for Logout
-func NewLogoutRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetMergeRequest(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMergeRequestRequest(c.Server, owner, repository, mrSeq)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/logout")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateMergeRequestWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMergeRequestRequestWithBody(c.Server, owner, repository, mrSeq, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListRepoGroupRequest generates requests for ListRepoGroup
-func NewListRepoGroupRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateMergeRequest(ctx context.Context, owner string, repository string, mrSeq uint64, body UpdateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMergeRequestRequest(c.Server, owner, repository, mrSeq, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/groups/repo")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListMergeRequestComments(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMergeRequestCommentsRequest(c.Server, owner, repository, mrSeq)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) CreateMergeRequestCommentWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMergeRequestCommentRequestWithBody(c.Server, owner, repository, mrSeq, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteObjectRequest generates requests for DeleteObject
-func NewDeleteObjectRequest(server string, owner string, repository string, params *DeleteObjectParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+func (c *Client) CreateMergeRequestComment(ctx context.Context, owner string, repository string, mrSeq uint64, body CreateMergeRequestCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMergeRequestCommentRequest(c.Server, owner, repository, mrSeq, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+func (c *Client) DeleteMergeRequestComment(ctx context.Context, owner string, repository string, mrSeq uint64, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMergeRequestCommentRequest(c.Server, owner, repository, mrSeq, commentId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) MergeWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeRequestWithBody(c.Server, owner, repository, mrSeq, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/object/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) Merge(ctx context.Context, owner string, repository string, mrSeq uint64, body MergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeRequest(c.Server, owner, repository, mrSeq, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) RemoveReviewer(ctx context.Context, owner string, repository string, mrSeq uint64, params *RemoveReviewerParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveReviewerRequest(c.Server, owner, repository, mrSeq, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetObjectRequest generates requests for GetObject
-func NewGetObjectRequest(server string, owner string, repository string, params *GetObjectParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+func (c *Client) ListReviewers(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListReviewersRequest(c.Server, owner, repository, mrSeq)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+func (c *Client) AddReviewersWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddReviewersRequestWithBody(c.Server, owner, repository, mrSeq, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AddReviewers(ctx context.Context, owner string, repository string, mrSeq uint64, body AddReviewersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddReviewersRequest(c.Server, owner, repository, mrSeq, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/object/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListReviews(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListReviewsRequest(c.Server, owner, repository, mrSeq)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) ReviewMergeRequestWithBody(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewMergeRequestRequestWithBody(c.Server, owner, repository, mrSeq, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		if params.Range != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("Range", headerParam0)
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewHeadObjectRequest generates requests for HeadObject
-func NewHeadObjectRequest(server string, owner string, repository string, params *HeadObjectParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+func (c *Client) ReviewMergeRequest(ctx context.Context, owner string, repository string, mrSeq uint64, body ReviewMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewMergeRequestRequest(c.Server, owner, repository, mrSeq, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+func (c *Client) GetCombinedCommitStatus(ctx context.Context, owner string, repository string, commitId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCombinedCommitStatusRequest(c.Server, owner, repository, commitId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListCommitStatuses(ctx context.Context, owner string, repository string, commitId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCommitStatusesRequest(c.Server, owner, repository, commitId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/object/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) CreateCommitStatusWithBody(ctx context.Context, owner string, repository string, commitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCommitStatusRequestWithBody(c.Server, owner, repository, commitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateCommitStatus(ctx context.Context, owner string, repository string, commitId string, body CreateCommitStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCommitStatusRequest(c.Server, owner, repository, commitId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) DeleteTag(ctx context.Context, owner string, repository string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagRequest(c.Server, owner, repository, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
+func (c *Client) GetTag(ctx context.Context, owner string, repository string, params *GetTagParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTagRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		if params.Range != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("Range", headerParam0)
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUploadObjectRequestWithBody generates requests for UploadObject with any type of body
-func NewUploadObjectRequestWithBody(server string, owner string, repository string, params *UploadObjectParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+func (c *Client) CreateTagWithBody(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequestWithBody(c.Server, owner, repository, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateTag(ctx context.Context, owner string, repository string, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequest(c.Server, owner, repository, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/object/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListTags(ctx context.Context, owner string, repository string, params *ListTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTagsRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.IsReplace != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "isReplace", runtime.ParamLocationQuery, *params.IsReplace); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) ChangeVisible(ctx context.Context, owner string, repository string, params *ChangeVisibleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangeVisibleRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetFilesRequest generates requests for GetFiles
-func NewGetFilesRequest(server string, owner string, repository string, params *GetFilesParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+func (c *Client) GetSetupState(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSetupStateRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteAksk(ctx context.Context, params *DeleteAkskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAkskRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/object/%s/%s/files", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetAksk(ctx context.Context, params *GetAkskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAkskRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Pattern != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pattern", runtime.ParamLocationQuery, *params.Pattern); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) CreateAksk(ctx context.Context, params *CreateAkskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAkskRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListPublicRepositoryRequest generates requests for ListPublicRepository
-func NewListPublicRepositoryRequest(server string, params *ListPublicRepositoryParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListAksks(ctx context.Context, params *ListAksksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAksksRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/repos/public")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RefreshToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTokenRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Prefix != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.After != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Amount != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteRepositoryRequest generates requests for DeleteRepository
-func NewDeleteRepositoryRequest(server string, owner string, repository string, params *DeleteRepositoryParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+func (c *Client) Register(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListRepositoryOfAuthenticatedUser(ctx context.Context, params *ListRepositoryOfAuthenticatedUserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRepositoryOfAuthenticatedUserRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/repos/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateRepositoryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRepositoryRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.IsCleanData != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "is_clean_data", runtime.ParamLocationQuery, *params.IsCleanData); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) CreateRepository(ctx context.Context, body CreateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRepositoryRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetRepositoryRequest generates requests for GetRepository
-func NewGetRepositoryRequest(server string, owner string, repository string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+func (c *Client) GetUserInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserInfoRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListRepository(ctx context.Context, owner string, params *ListRepositoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRepositoryRequest(c.Server, owner, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/repos/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVersionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DeleteWip(ctx context.Context, owner string, repository string, params *DeleteWipParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWipRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateRepositoryRequest calls the generic UpdateRepository builder with application/json body
-func NewUpdateRepositoryRequest(server string, owner string, repository string, body UpdateRepositoryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetWip(ctx context.Context, owner string, repository string, params *GetWipParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWipRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateRepositoryRequestWithBody(server, owner, repository, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateRepositoryRequestWithBody generates requests for UpdateRepository with any type of body
-func NewUpdateRepositoryRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+func (c *Client) UpdateWipWithBody(ctx context.Context, owner string, repository string, params *UpdateWipParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWipRequestWithBody(c.Server, owner, repository, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+func (c *Client) UpdateWip(ctx context.Context, owner string, repository string, params *UpdateWipParams, body UpdateWipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWipRequest(c.Server, owner, repository, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetWipChanges(ctx context.Context, owner string, repository string, params *GetWipChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWipChangesRequest(c.Server, owner, repository, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/repos/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CommitWipWithBody(ctx context.Context, owner string, repository string, params *CommitWipParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommitWipRequestWithBody(c.Server, owner, repository, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) CommitWip(ctx context.Context, owner string, repository string, params *CommitWipParams, body CommitWipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommitWipRequest(c.Server, owner, repository, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) ListWip(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWipRequest(c.Server, owner, repository)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) RevertWipChanges(ctx context.Context, owner string, repository string, params *RevertWipChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevertWipChangesRequest(c.Server, owner, repository, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetArchiveRequest generates requests for GetArchive
-func NewGetArchiveRequest(server string, owner string, repository string, params *GetArchiveParams) (*http.Request, error) {
-	var err error
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+}
 
-	var pathParam0 string
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLogoutRequest generates requests for Logout
+func NewLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/archive", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/auth/logout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2636,46 +3240,31 @@ func NewGetArchiveRequest(server string, owner string, repository string, params
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archive_type", runtime.ParamLocationQuery, params.ArchiveType); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return req, nil
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refType", runtime.ParamLocationQuery, params.RefType); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// NewListRepoGroupRequest generates requests for ListRepoGroup
+func NewListRepoGroupRequest(server string) (*http.Request, error) {
+	var err error
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	operationPath := fmt.Sprintf("/groups/repo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -2686,8 +3275,8 @@ func NewGetArchiveRequest(server string, owner string, repository string, params
 	return req, nil
 }
 
-// NewDeleteBranchRequest generates requests for DeleteBranch
-func NewDeleteBranchRequest(server string, owner string, repository string, params *DeleteBranchParams) (*http.Request, error) {
+// NewDeleteObjectRequest generates requests for DeleteObject
+func NewDeleteObjectRequest(server string, owner string, repository string, params *DeleteObjectParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2709,7 +3298,7 @@ func NewDeleteBranchRequest(server string, owner string, repository string, para
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/branch", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/object/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2734,6 +3323,18 @@ func NewDeleteBranchRequest(server string, owner string, repository string, para
 			}
 		}
 
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -2745,8 +3346,8 @@ func NewDeleteBranchRequest(server string, owner string, repository string, para
 	return req, nil
 }
 
-// NewGetBranchRequest generates requests for GetBranch
-func NewGetBranchRequest(server string, owner string, repository string, params *GetBranchParams) (*http.Request, error) {
+// NewGetObjectRequest generates requests for GetObject
+func NewGetObjectRequest(server string, owner string, repository string, params *GetObjectParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2768,7 +3369,7 @@ func NewGetBranchRequest(server string, owner string, repository string, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/branch", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/object/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2781,7 +3382,7 @@ func NewGetBranchRequest(server string, owner string, repository string, params
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2793,73 +3394,74 @@ func NewGetBranchRequest(server string, owner string, repository string, params
 			}
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.Presign != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "presign", runtime.ParamLocationQuery, *params.Presign); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewCreateBranchRequest calls the generic CreateBranch builder with application/json body
-func NewCreateBranchRequest(server string, owner string, repository string, body CreateBranchJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateBranchRequestWithBody(server, owner, repository, "application/json", bodyReader)
-}
+		}
 
-// NewCreateBranchRequestWithBody generates requests for CreateBranch with any type of body
-func NewCreateBranchRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	var pathParam0 string
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/branch", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.Range != nil {
+			var headerParam0 string
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
+			if err != nil {
+				return nil, err
+			}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+			req.Header.Set("Range", headerParam0)
+		}
 
-	req.Header.Add("Content-Type", contentType)
+	}
 
 	return req, nil
 }
 
-// NewListBranchesRequest generates requests for ListBranches
-func NewListBranchesRequest(server string, owner string, repository string, params *ListBranchesParams) (*http.Request, error) {
+// NewHeadObjectRequest generates requests for HeadObject
+func NewHeadObjectRequest(server string, owner string, repository string, params *HeadObjectParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2881,7 +3483,7 @@ func NewListBranchesRequest(server string, owner string, repository string, para
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/branches", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/object/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2894,67 +3496,70 @@ func NewListBranchesRequest(server string, owner string, repository string, para
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Prefix != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
-		if params.After != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
-		if params.Amount != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if params != nil {
+
+		if params.Range != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range", headerParam0)
+		}
+
+	}
+
 	return req, nil
 }
 
-// NewGetCommitChangesRequest generates requests for GetCommitChanges
-func NewGetCommitChangesRequest(server string, owner string, repository string, commitId string, params *GetCommitChangesParams) (*http.Request, error) {
+// NewUploadObjectRequestWithBody generates requests for UploadObject with any type of body
+func NewUploadObjectRequestWithBody(server string, owner string, repository string, params *UploadObjectParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2971,19 +3576,12 @@ func NewGetCommitChangesRequest(server string, owner string, repository string,
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "commit_id", runtime.ParamLocationPath, commitId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/changes/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/object/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2996,9 +3594,9 @@ func NewGetCommitChangesRequest(server string, owner string, repository string,
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Path != nil {
+		if params.IsReplace != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "isReplace", runtime.ParamLocationQuery, *params.IsReplace); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3012,19 +3610,45 @@ func NewGetCommitChangesRequest(server string, owner string, repository string,
 
 		}
 
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetCommitsInRefRequest generates requests for GetCommitsInRef
-func NewGetCommitsInRefRequest(server string, owner string, repository string, params *GetCommitsInRefParams) (*http.Request, error) {
+// NewGetFilesRequest generates requests for GetFiles
+func NewGetFilesRequest(server string, owner string, repository string, params *GetFilesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3046,7 +3670,7 @@ func NewGetCommitsInRefRequest(server string, owner string, repository string, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/commits", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/object/%s/%s/files", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3059,9 +3683,9 @@ func NewGetCommitsInRefRequest(server string, owner string, repository string, p
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.After != nil {
+		if params.Pattern != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pattern", runtime.ParamLocationQuery, *params.Pattern); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3075,36 +3699,28 @@ func NewGetCommitsInRefRequest(server string, owner string, repository string, p
 
 		}
 
-		if params.Amount != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
-		if params.RefName != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, *params.RefName); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
@@ -3118,8 +3734,8 @@ func NewGetCommitsInRefRequest(server string, owner string, repository string, p
 	return req, nil
 }
 
-// NewCompareCommitRequest generates requests for CompareCommit
-func NewCompareCommitRequest(server string, owner string, repository string, basehead string, params *CompareCommitParams) (*http.Request, error) {
+// NewListMultipartUploadsRequest generates requests for ListMultipartUploads
+func NewListMultipartUploadsRequest(server string, owner string, repository string, params *ListMultipartUploadsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3136,19 +3752,12 @@ func NewCompareCommitRequest(server string, owner string, repository string, bas
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "basehead", runtime.ParamLocationPath, basehead)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/compare/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/object/%s/%s/multipart", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3161,6 +3770,22 @@ func NewCompareCommitRequest(server string, owner string, repository string, bas
 	if params != nil {
 		queryValues := queryURL.Query()
 
+		if params.RefName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, *params.RefName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		if params.Path != nil {
 
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
@@ -3188,8 +3813,8 @@ func NewCompareCommitRequest(server string, owner string, repository string, bas
 	return req, nil
 }
 
-// NewGetEntriesInRefRequest generates requests for GetEntriesInRef
-func NewGetEntriesInRefRequest(server string, owner string, repository string, params *GetEntriesInRefParams) (*http.Request, error) {
+// NewCreateMultipartUploadRequest generates requests for CreateMultipartUpload
+func NewCreateMultipartUploadRequest(server string, owner string, repository string, params *CreateMultipartUploadParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3211,7 +3836,7 @@ func NewGetEntriesInRefRequest(server string, owner string, repository string, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/contents", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/object/%s/%s/multipart", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3224,39 +3849,19 @@ func NewGetEntriesInRefRequest(server string, owner string, repository string, p
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Path != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Ref != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ref", runtime.ParamLocationQuery, *params.Ref); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -3271,7 +3876,7 @@ func NewGetEntriesInRefRequest(server string, owner string, repository string, p
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3279,8 +3884,8 @@ func NewGetEntriesInRefRequest(server string, owner string, repository string, p
 	return req, nil
 }
 
-// NewRevokeMemberRequest generates requests for RevokeMember
-func NewRevokeMemberRequest(server string, owner string, repository string, params *RevokeMemberParams) (*http.Request, error) {
+// NewAbortMultipartUploadRequest generates requests for AbortMultipartUpload
+func NewAbortMultipartUploadRequest(server string, owner string, repository string, uploadId openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3297,12 +3902,19 @@ func NewRevokeMemberRequest(server string, owner string, repository string, para
 		return nil, err
 	}
 
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/member", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/object/%s/%s/multipart/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3312,25 +3924,55 @@ func NewRevokeMemberRequest(server string, owner string, repository string, para
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return req, nil
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+// NewGetMultipartUploadRequest generates requests for GetMultipartUpload
+func NewGetMultipartUploadRequest(server string, owner string, repository string, uploadId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/object/%s/%s/multipart/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3338,8 +3980,8 @@ func NewRevokeMemberRequest(server string, owner string, repository string, para
 	return req, nil
 }
 
-// NewUpdateMemberGroupRequest generates requests for UpdateMemberGroup
-func NewUpdateMemberGroupRequest(server string, owner string, repository string, params *UpdateMemberGroupParams) (*http.Request, error) {
+// NewCompleteMultipartUploadRequest generates requests for CompleteMultipartUpload
+func NewCompleteMultipartUploadRequest(server string, owner string, repository string, uploadId openapi_types.UUID, params *CompleteMultipartUploadParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3356,12 +3998,19 @@ func NewUpdateMemberGroupRequest(server string, owner string, repository string,
 		return nil, err
 	}
 
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/member", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/object/%s/%s/multipart/%s/complete", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3374,28 +4023,20 @@ func NewUpdateMemberGroupRequest(server string, owner string, repository string,
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+		if params.IsReplace != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_id", runtime.ParamLocationQuery, params.GroupId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "isReplace", runtime.ParamLocationQuery, *params.IsReplace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
@@ -3409,8 +4050,8 @@ func NewUpdateMemberGroupRequest(server string, owner string, repository string,
 	return req, nil
 }
 
-// NewInviteMemberRequest generates requests for InviteMember
-func NewInviteMemberRequest(server string, owner string, repository string, params *InviteMemberParams) (*http.Request, error) {
+// NewUploadMultipartPartRequestWithBody generates requests for UploadMultipartPart with any type of body
+func NewUploadMultipartPartRequestWithBody(server string, owner string, repository string, uploadId openapi_types.UUID, partNumber int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3427,12 +4068,69 @@ func NewInviteMemberRequest(server string, owner string, repository string, para
 		return nil, err
 	}
 
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "partNumber", runtime.ParamLocationPath, partNumber)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/member/invite", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/object/%s/%s/multipart/%s/parts/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPhysicalAddressRequest generates requests for GetPhysicalAddress
+func NewGetPhysicalAddressRequest(server string, owner string, repository string, params *GetPhysicalAddressParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/object/%s/%s/physical_address", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3445,7 +4143,7 @@ func NewInviteMemberRequest(server string, owner string, repository string, para
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -3457,7 +4155,7 @@ func NewInviteMemberRequest(server string, owner string, repository string, para
 			}
 		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_id", runtime.ParamLocationQuery, params.GroupId); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -3472,7 +4170,7 @@ func NewInviteMemberRequest(server string, owner string, repository string, para
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3480,8 +4178,19 @@ func NewInviteMemberRequest(server string, owner string, repository string, para
 	return req, nil
 }
 
-// NewListMembersRequest generates requests for ListMembers
-func NewListMembersRequest(server string, owner string, repository string) (*http.Request, error) {
+// NewLinkPhysicalAddressRequest calls the generic LinkPhysicalAddress builder with application/json body
+func NewLinkPhysicalAddressRequest(server string, owner string, repository string, params *LinkPhysicalAddressParams, body LinkPhysicalAddressJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLinkPhysicalAddressRequestWithBody(server, owner, repository, params, "application/json", bodyReader)
+}
+
+// NewLinkPhysicalAddressRequestWithBody generates requests for LinkPhysicalAddress with any type of body
+func NewLinkPhysicalAddressRequestWithBody(server string, owner string, repository string, params *LinkPhysicalAddressParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3503,7 +4212,7 @@ func NewListMembersRequest(server string, owner string, repository string) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/members", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/object/%s/%s/physical_address", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3513,38 +4222,72 @@ func NewListMembersRequest(server string, owner string, repository string) (*htt
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.IsReplace != nil {
 
-// NewListMergeRequestsRequest generates requests for ListMergeRequests
-func NewListMergeRequestsRequest(server string, owner string, repository string, params *ListMergeRequestsParams) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "isReplace", runtime.ParamLocationQuery, *params.IsReplace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
-	if err != nil {
-		return nil, err
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	var pathParam1 string
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPublicRepositoryRequest generates requests for ListPublicRepository
+func NewListPublicRepositoryRequest(server string, params *ListPublicRepositoryParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/public")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3557,9 +4300,9 @@ func NewListMergeRequestsRequest(server string, owner string, repository string,
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.After != nil {
+		if params.Prefix != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3573,9 +4316,9 @@ func NewListMergeRequestsRequest(server string, owner string, repository string,
 
 		}
 
-		if params.Amount != nil {
+		if params.After != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3589,9 +4332,9 @@ func NewListMergeRequestsRequest(server string, owner string, repository string,
 
 		}
 
-		if params.State != nil {
+		if params.Amount != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3616,19 +4359,8 @@ func NewListMergeRequestsRequest(server string, owner string, repository string,
 	return req, nil
 }
 
-// NewCreateMergeRequestRequest calls the generic CreateMergeRequest builder with application/json body
-func NewCreateMergeRequestRequest(server string, owner string, repository string, body CreateMergeRequestJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateMergeRequestRequestWithBody(server, owner, repository, "application/json", bodyReader)
-}
-
-// NewCreateMergeRequestRequestWithBody generates requests for CreateMergeRequest with any type of body
-func NewCreateMergeRequestRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteRepositoryRequest generates requests for DeleteRepository
+func NewDeleteRepositoryRequest(server string, owner string, repository string, params *DeleteRepositoryParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3650,7 +4382,7 @@ func NewCreateMergeRequestRequestWithBody(server string, owner string, repositor
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3660,18 +4392,38 @@ func NewCreateMergeRequestRequestWithBody(server string, owner string, repositor
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.IsCleanData != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "is_clean_data", runtime.ParamLocationQuery, *params.IsCleanData); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewGetMergeRequestRequest generates requests for GetMergeRequest
-func NewGetMergeRequestRequest(server string, owner string, repository string, mrSeq uint64) (*http.Request, error) {
+// NewGetRepositoryRequest generates requests for GetRepository
+func NewGetRepositoryRequest(server string, owner string, repository string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3688,19 +4440,12 @@ func NewGetMergeRequestRequest(server string, owner string, repository string, m
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/repos/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3718,19 +4463,19 @@ func NewGetMergeRequestRequest(server string, owner string, repository string, m
 	return req, nil
 }
 
-// NewUpdateMergeRequestRequest calls the generic UpdateMergeRequest builder with application/json body
-func NewUpdateMergeRequestRequest(server string, owner string, repository string, mrSeq uint64, body UpdateMergeRequestJSONRequestBody) (*http.Request, error) {
+// NewUpdateRepositoryRequest calls the generic UpdateRepository builder with application/json body
+func NewUpdateRepositoryRequest(server string, owner string, repository string, body UpdateRepositoryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMergeRequestRequestWithBody(server, owner, repository, mrSeq, "application/json", bodyReader)
+	return NewUpdateRepositoryRequestWithBody(server, owner, repository, "application/json", bodyReader)
 }
 
-// NewUpdateMergeRequestRequestWithBody generates requests for UpdateMergeRequest with any type of body
-func NewUpdateMergeRequestRequestWithBody(server string, owner string, repository string, mrSeq uint64, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateRepositoryRequestWithBody generates requests for UpdateRepository with any type of body
+func NewUpdateRepositoryRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3747,19 +4492,12 @@ func NewUpdateMergeRequestRequestWithBody(server string, owner string, repositor
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/repos/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3779,19 +4517,8 @@ func NewUpdateMergeRequestRequestWithBody(server string, owner string, repositor
 	return req, nil
 }
 
-// NewMergeRequest calls the generic Merge builder with application/json body
-func NewMergeRequest(server string, owner string, repository string, mrSeq uint64, body MergeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewMergeRequestWithBody(server, owner, repository, mrSeq, "application/json", bodyReader)
-}
-
-// NewMergeRequestWithBody generates requests for Merge with any type of body
-func NewMergeRequestWithBody(server string, owner string, repository string, mrSeq uint64, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteMergeApprovalRuleRequest generates requests for DeleteMergeApprovalRule
+func NewDeleteMergeApprovalRuleRequest(server string, owner string, repository string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3808,19 +4535,12 @@ func NewMergeRequestWithBody(server string, owner string, repository string, mrS
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s/merge", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/repos/%s/%s/approvalrule", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3830,18 +4550,16 @@ func NewMergeRequestWithBody(server string, owner string, repository string, mrS
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteTagRequest generates requests for DeleteTag
-func NewDeleteTagRequest(server string, owner string, repository string, params *DeleteTagParams) (*http.Request, error) {
+// NewGetMergeApprovalRuleRequest generates requests for GetMergeApprovalRule
+func NewGetMergeApprovalRuleRequest(server string, owner string, repository string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3863,7 +4581,7 @@ func NewDeleteTagRequest(server string, owner string, repository string, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/tag", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/approvalrule", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3873,25 +4591,7 @@ func NewDeleteTagRequest(server string, owner string, repository string, params
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3899,8 +4599,19 @@ func NewDeleteTagRequest(server string, owner string, repository string, params
 	return req, nil
 }
 
-// NewGetTagRequest generates requests for GetTag
-func NewGetTagRequest(server string, owner string, repository string, params *GetTagParams) (*http.Request, error) {
+// NewSetMergeApprovalRuleRequest calls the generic SetMergeApprovalRule builder with application/json body
+func NewSetMergeApprovalRuleRequest(server string, owner string, repository string, body SetMergeApprovalRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetMergeApprovalRuleRequestWithBody(server, owner, repository, "application/json", bodyReader)
+}
+
+// NewSetMergeApprovalRuleRequestWithBody generates requests for SetMergeApprovalRule with any type of body
+func NewSetMergeApprovalRuleRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3922,7 +4633,7 @@ func NewGetTagRequest(server string, owner string, repository string, params *Ge
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/tag", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/approvalrule", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3932,45 +4643,18 @@ func NewGetTagRequest(server string, owner string, repository string, params *Ge
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewCreateTagRequest calls the generic CreateTag builder with application/json body
-func NewCreateTagRequest(server string, owner string, repository string, body CreateTagJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTagRequestWithBody(server, owner, repository, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
-func NewCreateTagRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetArchiveRequest generates requests for GetArchive
+func NewGetArchiveRequest(server string, owner string, repository string, params *GetArchiveParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3992,7 +4676,7 @@ func NewCreateTagRequestWithBody(server string, owner string, repository string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/tag", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/archive", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4002,18 +4686,58 @@ func NewCreateTagRequestWithBody(server string, owner string, repository string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archive_type", runtime.ParamLocationQuery, params.ArchiveType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refType", runtime.ParamLocationQuery, params.RefType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewListTagsRequest generates requests for ListTags
-func NewListTagsRequest(server string, owner string, repository string, params *ListTagsParams) (*http.Request, error) {
+// NewImportArchiveRequestWithBody generates requests for ImportArchive with any type of body
+func NewImportArchiveRequestWithBody(server string, owner string, repository string, params *ImportArchiveParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4035,7 +4759,7 @@ func NewListTagsRequest(server string, owner string, repository string, params *
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/tags", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/archive", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4048,25 +4772,21 @@ func NewListTagsRequest(server string, owner string, repository string, params *
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Prefix != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
-		if params.After != nil {
+		if params.Path != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4080,9 +4800,9 @@ func NewListTagsRequest(server string, owner string, repository string, params *
 
 		}
 
-		if params.Amount != nil {
+		if params.IsReplace != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "isReplace", runtime.ParamLocationQuery, *params.IsReplace); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4099,16 +4819,18 @@ func NewListTagsRequest(server string, owner string, repository string, params *
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewChangeVisibleRequest generates requests for ChangeVisible
-func NewChangeVisibleRequest(server string, owner string, repository string, params *ChangeVisibleParams) (*http.Request, error) {
+// NewGetBlameRequest generates requests for GetBlame
+func NewGetBlameRequest(server string, owner string, repository string, params *GetBlameParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4130,7 +4852,7 @@ func NewChangeVisibleRequest(server string, owner string, repository string, par
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repos/%s/%s/visible", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/blame", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4143,7 +4865,7 @@ func NewChangeVisibleRequest(server string, owner string, repository string, par
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "visible", runtime.ParamLocationQuery, params.Visible); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -4155,69 +4877,9 @@ func NewChangeVisibleRequest(server string, owner string, repository string, par
 			}
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGetSetupStateRequest generates requests for GetSetupState
-func NewGetSetupStateRequest(server string) (*http.Request, error) {
-	var err error
+		if params.Ref != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/setup")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewDeleteAkskRequest generates requests for DeleteAksk
-func NewDeleteAkskRequest(server string, params *DeleteAkskParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/users/aksk")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Id != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ref", runtime.ParamLocationQuery, *params.Ref); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4231,26 +4893,22 @@ func NewDeleteAkskRequest(server string, params *DeleteAkskParams) (*http.Reques
 
 		}
 
-		if params.AccessKey != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "access_key", runtime.ParamLocationQuery, *params.AccessKey); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4258,16 +4916,30 @@ func NewDeleteAkskRequest(server string, params *DeleteAkskParams) (*http.Reques
 	return req, nil
 }
 
-// NewGetAkskRequest generates requests for GetAksk
-func NewGetAkskRequest(server string, params *GetAkskParams) (*http.Request, error) {
+// NewDeleteBranchRequest generates requests for DeleteBranch
+func NewDeleteBranchRequest(server string, owner string, repository string, params *DeleteBranchParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/aksk")
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4280,42 +4952,22 @@ func NewGetAkskRequest(server string, params *GetAkskParams) (*http.Request, err
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Id != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.AccessKey != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "access_key", runtime.ParamLocationQuery, *params.AccessKey); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4323,16 +4975,30 @@ func NewGetAkskRequest(server string, params *GetAkskParams) (*http.Request, err
 	return req, nil
 }
 
-// NewCreateAkskRequest generates requests for CreateAksk
-func NewCreateAkskRequest(server string, params *CreateAkskParams) (*http.Request, error) {
+// NewGetBranchRequest generates requests for GetBranch
+func NewGetBranchRequest(server string, owner string, repository string, params *GetBranchParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/aksk")
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4345,26 +5011,22 @@ func NewCreateAkskRequest(server string, params *CreateAkskParams) (*http.Reques
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Description != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4372,81 +5034,41 @@ func NewCreateAkskRequest(server string, params *CreateAkskParams) (*http.Reques
 	return req, nil
 }
 
-// NewListAksksRequest generates requests for ListAksks
-func NewListAksksRequest(server string, params *ListAksksParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// NewCreateBranchRequest calls the generic CreateBranch builder with application/json body
+func NewCreateBranchRequest(server string, owner string, repository string, body CreateBranchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBranchRequestWithBody(server, owner, repository, "application/json", bodyReader)
+}
 
-	operationPath := fmt.Sprintf("/users/aksks")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// NewCreateBranchRequestWithBody generates requests for CreateBranch with any type of body
+func NewCreateBranchRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	queryURL, err := serverURL.Parse(operationPath)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.After != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Amount != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	var pathParam1 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewRefreshTokenRequest generates requests for RefreshToken
-func NewRefreshTokenRequest(server string) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/refreshtoken")
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4456,64 +5078,51 @@ func NewRefreshTokenRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewRegisterRequest calls the generic Register builder with application/json body
-func NewRegisterRequest(server string, body RegisterJSONRequestBody) (*http.Request, error) {
+// NewCherryPickRequest calls the generic CherryPick builder with application/json body
+func NewCherryPickRequest(server string, owner string, repository string, params *CherryPickParams, body CherryPickJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewRegisterRequestWithBody(server, "application/json", bodyReader)
+	return NewCherryPickRequestWithBody(server, owner, repository, params, "application/json", bodyReader)
 }
 
-// NewRegisterRequestWithBody generates requests for Register with any type of body
-func NewRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCherryPickRequestWithBody generates requests for CherryPick with any type of body
+func NewCherryPickRequestWithBody(server string, owner string, repository string, params *CherryPickParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam1 string
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewListRepositoryOfAuthenticatedUserRequest generates requests for ListRepositoryOfAuthenticatedUser
-func NewListRepositoryOfAuthenticatedUserRequest(server string, params *ListRepositoryOfAuthenticatedUserParams) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/repos")
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch/cherrypick", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4526,95 +5135,21 @@ func NewListRepositoryOfAuthenticatedUserRequest(server string, params *ListRepo
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Prefix != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.After != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Amount != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewCreateRepositoryRequest calls the generic CreateRepository builder with application/json body
-func NewCreateRepositoryRequest(server string, body CreateRepositoryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateRepositoryRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreateRepositoryRequestWithBody generates requests for CreateRepository with any type of body
-func NewCreateRepositoryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/users/repos")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
 	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -4625,40 +5160,20 @@ func NewCreateRepositoryRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
-// NewGetUserInfoRequest generates requests for GetUserInfo
-func NewGetUserInfoRequest(server string) (*http.Request, error) {
+// NewListBranchReflogRequest generates requests for ListBranchReflog
+func NewListBranchReflogRequest(server string, owner string, repository string, params *ListBranchReflogParams) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/users/user")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewListRepositoryRequest generates requests for ListRepository
-func NewListRepositoryRequest(server string, owner string, params *ListRepositoryParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
@@ -4668,7 +5183,7 @@ func NewListRepositoryRequest(server string, owner string, params *ListRepositor
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s/repos", pathParam0)
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch/reflog", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4681,20 +5196,16 @@ func NewListRepositoryRequest(server string, owner string, params *ListRepositor
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Prefix != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
 		if params.After != nil {
@@ -4740,35 +5251,19 @@ func NewListRepositoryRequest(server string, owner string, params *ListRepositor
 	return req, nil
 }
 
-// NewGetVersionRequest generates requests for GetVersion
-func NewGetVersionRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/version")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// NewRestoreBranchRequest calls the generic RestoreBranch builder with application/json body
+func NewRestoreBranchRequest(server string, owner string, repository string, params *RestoreBranchParams, body RestoreBranchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewRestoreBranchRequestWithBody(server, owner, repository, params, "application/json", bodyReader)
 }
 
-// NewDeleteWipRequest generates requests for DeleteWip
-func NewDeleteWipRequest(server string, owner string, repository string, params *DeleteWipParams) (*http.Request, error) {
+// NewRestoreBranchRequestWithBody generates requests for RestoreBranch with any type of body
+func NewRestoreBranchRequestWithBody(server string, owner string, repository string, params *RestoreBranchParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4790,7 +5285,7 @@ func NewDeleteWipRequest(server string, owner string, repository string, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/wip/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch/restore", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4818,16 +5313,29 @@ func NewDeleteWipRequest(server string, owner string, repository string, params
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetWipRequest generates requests for GetWip
-func NewGetWipRequest(server string, owner string, repository string, params *GetWipParams) (*http.Request, error) {
+// NewRevertCommitRequest calls the generic RevertCommit builder with application/json body
+func NewRevertCommitRequest(server string, owner string, repository string, params *RevertCommitParams, body RevertCommitJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRevertCommitRequestWithBody(server, owner, repository, params, "application/json", bodyReader)
+}
+
+// NewRevertCommitRequestWithBody generates requests for RevertCommit with any type of body
+func NewRevertCommitRequestWithBody(server string, owner string, repository string, params *RevertCommitParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4849,7 +5357,7 @@ func NewGetWipRequest(server string, owner string, repository string, params *Ge
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/wip/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch/revert", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4877,27 +5385,18 @@ func NewGetWipRequest(server string, owner string, repository string, params *Ge
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewUpdateWipRequest calls the generic UpdateWip builder with application/json body
-func NewUpdateWipRequest(server string, owner string, repository string, params *UpdateWipParams, body UpdateWipJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateWipRequestWithBody(server, owner, repository, params, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewUpdateWipRequestWithBody generates requests for UpdateWip with any type of body
-func NewUpdateWipRequestWithBody(server string, owner string, repository string, params *UpdateWipParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUndeleteBranchRequest generates requests for UndeleteBranch
+func NewUndeleteBranchRequest(server string, owner string, repository string, params *UndeleteBranchParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4919,7 +5418,7 @@ func NewUpdateWipRequestWithBody(server string, owner string, repository string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/wip/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch/undelete", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4947,18 +5446,16 @@ func NewUpdateWipRequestWithBody(server string, owner string, repository string,
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetWipChangesRequest generates requests for GetWipChanges
-func NewGetWipChangesRequest(server string, owner string, repository string, params *GetWipChangesParams) (*http.Request, error) {
+// NewListBranchProtectionRulesRequest generates requests for ListBranchProtectionRules
+func NewListBranchProtectionRulesRequest(server string, owner string, repository string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4980,7 +5477,7 @@ func NewGetWipChangesRequest(server string, owner string, repository string, par
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/wip/%s/%s/changes", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch_protections", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4990,50 +5487,27 @@ func NewGetWipChangesRequest(server string, owner string, repository string, par
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Path != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// NewCreateBranchProtectionRuleRequest calls the generic CreateBranchProtectionRule builder with application/json body
+func NewCreateBranchProtectionRuleRequest(server string, owner string, repository string, body CreateBranchProtectionRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBranchProtectionRuleRequestWithBody(server, owner, repository, "application/json", bodyReader)
 }
 
-// NewCommitWipRequest generates requests for CommitWip
-func NewCommitWipRequest(server string, owner string, repository string, params *CommitWipParams) (*http.Request, error) {
+// NewCreateBranchProtectionRuleRequestWithBody generates requests for CreateBranchProtectionRule with any type of body
+func NewCreateBranchProtectionRuleRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5055,7 +5529,7 @@ func NewCommitWipRequest(server string, owner string, repository string, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/wip/%s/%s/commit", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch_protections", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5065,46 +5539,18 @@ func NewCommitWipRequest(server string, owner string, repository string, params
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "msg", runtime.ParamLocationQuery, params.Msg); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListWipRequest generates requests for ListWip
-func NewListWipRequest(server string, owner string, repository string) (*http.Request, error) {
+// NewDeleteBranchProtectionRuleRequest generates requests for DeleteBranchProtectionRule
+func NewDeleteBranchProtectionRuleRequest(server string, owner string, repository string, ruleId openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5121,12 +5567,19 @@ func NewListWipRequest(server string, owner string, repository string) (*http.Re
 		return nil, err
 	}
 
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/wip/%s/%s/list", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/branch_protections/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5136,7 +5589,7 @@ func NewListWipRequest(server string, owner string, repository string) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5144,8 +5597,8 @@ func NewListWipRequest(server string, owner string, repository string) (*http.Re
 	return req, nil
 }
 
-// NewRevertWipChangesRequest generates requests for RevertWipChanges
-func NewRevertWipChangesRequest(server string, owner string, repository string, params *RevertWipChangesParams) (*http.Request, error) {
+// NewListBranchesRequest generates requests for ListBranches
+func NewListBranchesRequest(server string, owner string, repository string, params *ListBranchesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5167,7 +5620,7 @@ func NewRevertWipChangesRequest(server string, owner string, repository string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/wip/%s/%s/revert", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repos/%s/%s/branches", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5180,21 +5633,41 @@ func NewRevertWipChangesRequest(server string, owner string, repository string,
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		if params.PathPrefix != nil {
+		if params.After != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pathPrefix", runtime.ParamLocationQuery, *params.PathPrefix); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Amount != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5211,7 +5684,7 @@ func NewRevertWipChangesRequest(server string, owner string, repository string,
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5219,3329 +5692,9191 @@ func NewRevertWipChangesRequest(server string, owner string, repository string,
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewGetCommitChangesRequest generates requests for GetCommitChanges
+func NewGetCommitChangesRequest(server string, owner string, repository string, commitId string, params *GetCommitChangesParams) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LoginWithBodyWithResponse request with any body
-	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+	var pathParam2 string
 
-	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "commit_id", runtime.ParamLocationPath, commitId)
+	if err != nil {
+		return nil, err
+	}
 
-	// LogoutWithResponse request
-	LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListRepoGroupWithResponse request
-	ListRepoGroupWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRepoGroupResponse, error)
+	operationPath := fmt.Sprintf("/repos/%s/%s/changes/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteObjectWithResponse request
-	DeleteObjectWithResponse(ctx context.Context, owner string, repository string, params *DeleteObjectParams, reqEditors ...RequestEditorFn) (*DeleteObjectResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetObjectWithResponse request
-	GetObjectWithResponse(ctx context.Context, owner string, repository string, params *GetObjectParams, reqEditors ...RequestEditorFn) (*GetObjectResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// HeadObjectWithResponse request
-	HeadObjectWithResponse(ctx context.Context, owner string, repository string, params *HeadObjectParams, reqEditors ...RequestEditorFn) (*HeadObjectResponse, error)
+		if params.Path != nil {
 
-	// UploadObjectWithBodyWithResponse request with any body
-	UploadObjectWithBodyWithResponse(ctx context.Context, owner string, repository string, params *UploadObjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadObjectResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetFilesWithResponse request
-	GetFilesWithResponse(ctx context.Context, owner string, repository string, params *GetFilesParams, reqEditors ...RequestEditorFn) (*GetFilesResponse, error)
+		}
 
-	// ListPublicRepositoryWithResponse request
-	ListPublicRepositoryWithResponse(ctx context.Context, params *ListPublicRepositoryParams, reqEditors ...RequestEditorFn) (*ListPublicRepositoryResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// DeleteRepositoryWithResponse request
-	DeleteRepositoryWithResponse(ctx context.Context, owner string, repository string, params *DeleteRepositoryParams, reqEditors ...RequestEditorFn) (*DeleteRepositoryResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetRepositoryWithResponse request
-	GetRepositoryWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*GetRepositoryResponse, error)
+	return req, nil
+}
 
-	// UpdateRepositoryWithBodyWithResponse request with any body
-	UpdateRepositoryWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRepositoryResponse, error)
+// NewGetCommitsInRefRequest generates requests for GetCommitsInRef
+func NewGetCommitsInRefRequest(server string, owner string, repository string, params *GetCommitsInRefParams) (*http.Request, error) {
+	var err error
 
-	UpdateRepositoryWithResponse(ctx context.Context, owner string, repository string, body UpdateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRepositoryResponse, error)
+	var pathParam0 string
 
-	// GetArchiveWithResponse request
-	GetArchiveWithResponse(ctx context.Context, owner string, repository string, params *GetArchiveParams, reqEditors ...RequestEditorFn) (*GetArchiveResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteBranchWithResponse request
-	DeleteBranchWithResponse(ctx context.Context, owner string, repository string, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*DeleteBranchResponse, error)
+	var pathParam1 string
 
-	// GetBranchWithResponse request
-	GetBranchWithResponse(ctx context.Context, owner string, repository string, params *GetBranchParams, reqEditors ...RequestEditorFn) (*GetBranchResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateBranchWithBodyWithResponse request with any body
-	CreateBranchWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBranchResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateBranchWithResponse(ctx context.Context, owner string, repository string, body CreateBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBranchResponse, error)
+	operationPath := fmt.Sprintf("/repos/%s/%s/commits", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListBranchesWithResponse request
-	ListBranchesWithResponse(ctx context.Context, owner string, repository string, params *ListBranchesParams, reqEditors ...RequestEditorFn) (*ListBranchesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCommitChangesWithResponse request
-	GetCommitChangesWithResponse(ctx context.Context, owner string, repository string, commitId string, params *GetCommitChangesParams, reqEditors ...RequestEditorFn) (*GetCommitChangesResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetCommitsInRefWithResponse request
-	GetCommitsInRefWithResponse(ctx context.Context, owner string, repository string, params *GetCommitsInRefParams, reqEditors ...RequestEditorFn) (*GetCommitsInRefResponse, error)
+		if params.After != nil {
 
-	// CompareCommitWithResponse request
-	CompareCommitWithResponse(ctx context.Context, owner string, repository string, basehead string, params *CompareCommitParams, reqEditors ...RequestEditorFn) (*CompareCommitResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetEntriesInRefWithResponse request
-	GetEntriesInRefWithResponse(ctx context.Context, owner string, repository string, params *GetEntriesInRefParams, reqEditors ...RequestEditorFn) (*GetEntriesInRefResponse, error)
+		}
 
-	// RevokeMemberWithResponse request
-	RevokeMemberWithResponse(ctx context.Context, owner string, repository string, params *RevokeMemberParams, reqEditors ...RequestEditorFn) (*RevokeMemberResponse, error)
+		if params.Amount != nil {
 
-	// UpdateMemberGroupWithResponse request
-	UpdateMemberGroupWithResponse(ctx context.Context, owner string, repository string, params *UpdateMemberGroupParams, reqEditors ...RequestEditorFn) (*UpdateMemberGroupResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// InviteMemberWithResponse request
-	InviteMemberWithResponse(ctx context.Context, owner string, repository string, params *InviteMemberParams, reqEditors ...RequestEditorFn) (*InviteMemberResponse, error)
+		}
 
-	// ListMembersWithResponse request
-	ListMembersWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListMembersResponse, error)
+		if params.RefName != nil {
 
-	// ListMergeRequestsWithResponse request
-	ListMergeRequestsWithResponse(ctx context.Context, owner string, repository string, params *ListMergeRequestsParams, reqEditors ...RequestEditorFn) (*ListMergeRequestsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, *params.RefName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateMergeRequestWithBodyWithResponse request with any body
-	CreateMergeRequestWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMergeRequestResponse, error)
+		}
 
-	CreateMergeRequestWithResponse(ctx context.Context, owner string, repository string, body CreateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMergeRequestResponse, error)
+		if params.Path != nil {
 
-	// GetMergeRequestWithResponse request
-	GetMergeRequestWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*GetMergeRequestResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// UpdateMergeRequestWithBodyWithResponse request with any body
-	UpdateMergeRequestWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMergeRequestResponse, error)
+		}
 
-	UpdateMergeRequestWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body UpdateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMergeRequestResponse, error)
+		if params.Follow != nil {
 
-	// MergeWithBodyWithResponse request with any body
-	MergeWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow", runtime.ParamLocationQuery, *params.Follow); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	MergeWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body MergeJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeResponse, error)
+		}
 
-	// DeleteTagWithResponse request
-	DeleteTagWithResponse(ctx context.Context, owner string, repository string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+		if params.Metadata != nil {
 
-	// GetTagWithResponse request
-	GetTagWithResponse(ctx context.Context, owner string, repository string, params *GetTagParams, reqEditors ...RequestEditorFn) (*GetTagResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "metadata", runtime.ParamLocationQuery, *params.Metadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateTagWithBodyWithResponse request with any body
-	CreateTagWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+		}
 
-	CreateTagWithResponse(ctx context.Context, owner string, repository string, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// ListTagsWithResponse request
-	ListTagsWithResponse(ctx context.Context, owner string, repository string, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ChangeVisibleWithResponse request
-	ChangeVisibleWithResponse(ctx context.Context, owner string, repository string, params *ChangeVisibleParams, reqEditors ...RequestEditorFn) (*ChangeVisibleResponse, error)
+	return req, nil
+}
 
-	// GetSetupStateWithResponse request
-	GetSetupStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSetupStateResponse, error)
+// NewCompareCommitRequest generates requests for CompareCommit
+func NewCompareCommitRequest(server string, owner string, repository string, basehead string, params *CompareCommitParams) (*http.Request, error) {
+	var err error
 
-	// DeleteAkskWithResponse request
-	DeleteAkskWithResponse(ctx context.Context, params *DeleteAkskParams, reqEditors ...RequestEditorFn) (*DeleteAkskResponse, error)
+	var pathParam0 string
 
-	// GetAkskWithResponse request
-	GetAkskWithResponse(ctx context.Context, params *GetAkskParams, reqEditors ...RequestEditorFn) (*GetAkskResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateAkskWithResponse request
-	CreateAkskWithResponse(ctx context.Context, params *CreateAkskParams, reqEditors ...RequestEditorFn) (*CreateAkskResponse, error)
+	var pathParam1 string
 
-	// ListAksksWithResponse request
-	ListAksksWithResponse(ctx context.Context, params *ListAksksParams, reqEditors ...RequestEditorFn) (*ListAksksResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
 
-	// RefreshTokenWithResponse request
-	RefreshTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+	var pathParam2 string
 
-	// RegisterWithBodyWithResponse request with any body
-	RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "basehead", runtime.ParamLocationPath, basehead)
+	if err != nil {
+		return nil, err
+	}
 
-	RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListRepositoryOfAuthenticatedUserWithResponse request
-	ListRepositoryOfAuthenticatedUserWithResponse(ctx context.Context, params *ListRepositoryOfAuthenticatedUserParams, reqEditors ...RequestEditorFn) (*ListRepositoryOfAuthenticatedUserResponse, error)
+	operationPath := fmt.Sprintf("/repos/%s/%s/compare/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateRepositoryWithBodyWithResponse request with any body
-	CreateRepositoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRepositoryResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateRepositoryWithResponse(ctx context.Context, body CreateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRepositoryResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetUserInfoWithResponse request
-	GetUserInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserInfoResponse, error)
+		if params.Path != nil {
 
-	// ListRepositoryWithResponse request
-	ListRepositoryWithResponse(ctx context.Context, owner string, params *ListRepositoryParams, reqEditors ...RequestEditorFn) (*ListRepositoryResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetVersionWithResponse request
-	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
+		}
 
-	// DeleteWipWithResponse request
-	DeleteWipWithResponse(ctx context.Context, owner string, repository string, params *DeleteWipParams, reqEditors ...RequestEditorFn) (*DeleteWipResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// GetWipWithResponse request
-	GetWipWithResponse(ctx context.Context, owner string, repository string, params *GetWipParams, reqEditors ...RequestEditorFn) (*GetWipResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateWipWithBodyWithResponse request with any body
-	UpdateWipWithBodyWithResponse(ctx context.Context, owner string, repository string, params *UpdateWipParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWipResponse, error)
+	return req, nil
+}
 
-	UpdateWipWithResponse(ctx context.Context, owner string, repository string, params *UpdateWipParams, body UpdateWipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWipResponse, error)
+// NewCompareTableRequest generates requests for CompareTable
+func NewCompareTableRequest(server string, owner string, repository string, basehead string, params *CompareTableParams) (*http.Request, error) {
+	var err error
 
-	// GetWipChangesWithResponse request
-	GetWipChangesWithResponse(ctx context.Context, owner string, repository string, params *GetWipChangesParams, reqEditors ...RequestEditorFn) (*GetWipChangesResponse, error)
+	var pathParam0 string
 
-	// CommitWipWithResponse request
-	CommitWipWithResponse(ctx context.Context, owner string, repository string, params *CommitWipParams, reqEditors ...RequestEditorFn) (*CommitWipResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListWipWithResponse request
-	ListWipWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListWipResponse, error)
+	var pathParam1 string
 
-	// RevertWipChangesWithResponse request
-	RevertWipChangesWithResponse(ctx context.Context, owner string, repository string, params *RevertWipChangesParams, reqEditors ...RequestEditorFn) (*RevertWipChangesResponse, error)
-}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
 
-type LoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthenticationToken
-}
+	var pathParam2 string
 
-// Status returns HTTPResponse.Status
-func (r LoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "basehead", runtime.ParamLocationPath, basehead)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type LogoutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
-
-// Status returns HTTPResponse.Status
-func (r LogoutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/repos/%s/%s/compare/%s/table", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LogoutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListRepoGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Group
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r ListRepoGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListRepoGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if params.PrimaryKey != nil {
 
-type DeleteObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "primaryKey", runtime.ParamLocationQuery, *params.PrimaryKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// Status returns HTTPResponse.Status
-func (r DeleteObjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteObjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if params.SampleSize != nil {
 
-type GetObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sampleSize", runtime.ParamLocationQuery, *params.SampleSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// Status returns HTTPResponse.Status
-func (r GetObjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetObjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return 0
-}
 
-type HeadObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
-
-// Status returns HTTPResponse.Status
-func (r HeadObjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HeadObjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type UploadObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ObjectStats
-}
+// NewGetEntriesInRefRequest generates requests for GetEntriesInRef
+func NewGetEntriesInRefRequest(server string, owner string, repository string, params *GetEntriesInRefParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r UploadObjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadObjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetFilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]string
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetFilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type ListPublicRepositoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RepositoryList
-}
 
-// Status returns HTTPResponse.Status
-func (r ListPublicRepositoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/repos/%s/%s/contents", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListPublicRepositoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteRepositoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r DeleteRepositoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Path != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteRepositoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetRepositoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Repository
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetRepositoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Ref != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRepositoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ref", runtime.ParamLocationQuery, *params.Ref); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type UpdateRepositoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r UpdateRepositoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateRepositoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetArchiveResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetArchiveResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewListWebhooksRequest generates requests for ListWebhooks
+func NewListWebhooksRequest(server string, owner string, repository string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetArchiveResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteBranchResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteBranchResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteBranchResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBranchResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Branch
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/hooks", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetBranchResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBranchResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateBranchResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Branch
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateBranchResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
+func NewCreateWebhookRequest(server string, owner string, repository string, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookRequestWithBody(server, owner, repository, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateBranchResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
+func NewCreateWebhookRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListBranchesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BranchList
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r ListBranchesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListBranchesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCommitChangesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Change
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/hooks", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetCommitChangesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCommitChangesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCommitsInRefResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Commit
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetCommitsInRefResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCommitsInRefResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewDeleteWebhookRequest generates requests for DeleteWebhook
+func NewDeleteWebhookRequest(server string, owner string, repository string, hookId openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-type CompareCommitResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Change
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r CompareCommitResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CompareCommitResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetEntriesInRefResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]FullTreeEntry
-}
+	var pathParam2 string
 
-// Status returns HTTPResponse.Status
-func (r GetEntriesInRefResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hookId", runtime.ParamLocationPath, hookId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetEntriesInRefResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type RevokeMemberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/hooks/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r RevokeMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RevokeMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateMemberGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateMemberGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetWebhookRequest generates requests for GetWebhook
+func NewGetWebhookRequest(server string, owner string, repository string, hookId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMemberGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type InviteMemberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	var pathParam2 string
 
-// Status returns HTTPResponse.Status
-func (r InviteMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hookId", runtime.ParamLocationPath, hookId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r InviteMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListMembersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Member
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/hooks/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListMembersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMembersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListMergeRequestsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MergeRequestList
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListMergeRequestsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateWebhookRequest calls the generic UpdateWebhook builder with application/json body
+func NewUpdateWebhookRequest(server string, owner string, repository string, hookId openapi_types.UUID, body UpdateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWebhookRequestWithBody(server, owner, repository, hookId, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMergeRequestsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateWebhookRequestWithBody generates requests for UpdateWebhook with any type of body
+func NewUpdateWebhookRequestWithBody(server string, owner string, repository string, hookId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type CreateMergeRequestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *MergeRequest
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r CreateMergeRequestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateMergeRequestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMergeRequestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MergeRequestFullState
-}
+	var pathParam2 string
 
-// Status returns HTTPResponse.Status
-func (r GetMergeRequestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hookId", runtime.ParamLocationPath, hookId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMergeRequestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateMergeRequestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/hooks/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateMergeRequestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMergeRequestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type MergeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Commit
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r MergeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MergeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewListWebhookDeliveriesRequest generates requests for ListWebhookDeliveries
+func NewListWebhookDeliveriesRequest(server string, owner string, repository string, hookId openapi_types.UUID, params *ListWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
 
-type DeleteTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Tag
-}
+	var pathParam2 string
 
-// Status returns HTTPResponse.Status
-func (r GetTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hookId", runtime.ParamLocationPath, hookId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type CreateTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Tag
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/repos/%s/%s/hooks/%s/deliveries", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TagList
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r ListTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Amount != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type ChangeVisibleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r ChangeVisibleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ChangeVisibleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetSetupStateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SetupState
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSetupStateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewRevokeMemberRequest generates requests for RevokeMember
+func NewRevokeMemberRequest(server string, owner string, repository string, params *RevokeMemberParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSetupStateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteAkskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteAkskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAkskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetAkskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SafeAksk
-}
 
-// Status returns HTTPResponse.Status
-func (r GetAkskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/repos/%s/%s/member", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAkskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateAkskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Aksk
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r CreateAkskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateAkskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListAksksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AkskList
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListAksksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewUpdateMemberGroupRequest generates requests for UpdateMemberGroup
+func NewUpdateMemberGroupRequest(server string, owner string, repository string, params *UpdateMemberGroupParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListAksksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type RefreshTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthenticationToken
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r RefreshTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RefreshTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type RegisterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *UserInfo
-}
 
-// Status returns HTTPResponse.Status
-func (r RegisterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/repos/%s/%s/member", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RegisterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListRepositoryOfAuthenticatedUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RepositoryList
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r ListRepositoryOfAuthenticatedUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_id", runtime.ParamLocationQuery, params.GroupId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListRepositoryOfAuthenticatedUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateRepositoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Repository
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateRepositoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewInviteMemberRequest generates requests for InviteMember
+func NewInviteMemberRequest(server string, owner string, repository string, params *InviteMemberParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateRepositoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetUserInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserInfo
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetUserInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/repos/%s/%s/member/invite", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListRepositoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RepositoryList
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r ListRepositoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_id", runtime.ParamLocationQuery, params.GroupId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListRepositoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *VersionResult
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewListMembersRequest generates requests for ListMembers
+func NewListMembersRequest(server string, owner string, repository string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteWipResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteWipResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteWipResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetWipResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Wip
-	JSON201      *Wip
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/members", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetWipResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetWipResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type UpdateWipResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// NewListMergeRequestsRequest generates requests for ListMergeRequests
+func NewListMergeRequestsRequest(server string, owner string, repository string, params *ListMergeRequestsParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r UpdateWipResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateWipResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetWipChangesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Change
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetWipChangesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetWipChangesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type CommitWipResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Wip
-}
 
-// Status returns HTTPResponse.Status
-func (r CommitWipResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CommitWipResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListWipResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Wip
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r ListWipResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.After != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListWipResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type RevertWipChangesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r RevertWipChangesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Amount != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RevertWipChangesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return 0
-}
 
-// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
-func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.Login(ctx, body, reqEditors...)
+// NewCreateMergeRequestRequest calls the generic CreateMergeRequest builder with application/json body
+func NewCreateMergeRequestRequest(server string, owner string, repository string, body CreateMergeRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMergeRequestRequestWithBody(server, owner, repository, "application/json", bodyReader)
 }
 
-// LogoutWithResponse request returning *LogoutResponse
-func (c *ClientWithResponses) LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResponse, error) {
-	rsp, err := c.Logout(ctx, reqEditors...)
+// NewCreateMergeRequestRequestWithBody generates requests for CreateMergeRequest with any type of body
+func NewCreateMergeRequestRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLogoutResponse(rsp)
-}
 
-// ListRepoGroupWithResponse request returning *ListRepoGroupResponse
-func (c *ClientWithResponses) ListRepoGroupWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRepoGroupResponse, error) {
-	rsp, err := c.ListRepoGroup(ctx, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListRepoGroupResponse(rsp)
-}
 
-// DeleteObjectWithResponse request returning *DeleteObjectResponse
-func (c *ClientWithResponses) DeleteObjectWithResponse(ctx context.Context, owner string, repository string, params *DeleteObjectParams, reqEditors ...RequestEditorFn) (*DeleteObjectResponse, error) {
-	rsp, err := c.DeleteObject(ctx, owner, repository, params, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteObjectResponse(rsp)
-}
 
-// GetObjectWithResponse request returning *GetObjectResponse
-func (c *ClientWithResponses) GetObjectWithResponse(ctx context.Context, owner string, repository string, params *GetObjectParams, reqEditors ...RequestEditorFn) (*GetObjectResponse, error) {
-	rsp, err := c.GetObject(ctx, owner, repository, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseGetObjectResponse(rsp)
-}
 
-// HeadObjectWithResponse request returning *HeadObjectResponse
-func (c *ClientWithResponses) HeadObjectWithResponse(ctx context.Context, owner string, repository string, params *HeadObjectParams, reqEditors ...RequestEditorFn) (*HeadObjectResponse, error) {
-	rsp, err := c.HeadObject(ctx, owner, repository, params, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHeadObjectResponse(rsp)
-}
 
-// UploadObjectWithBodyWithResponse request with arbitrary body returning *UploadObjectResponse
-func (c *ClientWithResponses) UploadObjectWithBodyWithResponse(ctx context.Context, owner string, repository string, params *UploadObjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadObjectResponse, error) {
-	rsp, err := c.UploadObjectWithBody(ctx, owner, repository, params, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUploadObjectResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetFilesWithResponse request returning *GetFilesResponse
-func (c *ClientWithResponses) GetFilesWithResponse(ctx context.Context, owner string, repository string, params *GetFilesParams, reqEditors ...RequestEditorFn) (*GetFilesResponse, error) {
-	rsp, err := c.GetFiles(ctx, owner, repository, params, reqEditors...)
+// NewGetMergeRequestRequest generates requests for GetMergeRequest
+func NewGetMergeRequestRequest(server string, owner string, repository string, mrSeq uint64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetFilesResponse(rsp)
-}
 
-// ListPublicRepositoryWithResponse request returning *ListPublicRepositoryResponse
-func (c *ClientWithResponses) ListPublicRepositoryWithResponse(ctx context.Context, params *ListPublicRepositoryParams, reqEditors ...RequestEditorFn) (*ListPublicRepositoryResponse, error) {
-	rsp, err := c.ListPublicRepository(ctx, params, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListPublicRepositoryResponse(rsp)
-}
 
-// DeleteRepositoryWithResponse request returning *DeleteRepositoryResponse
-func (c *ClientWithResponses) DeleteRepositoryWithResponse(ctx context.Context, owner string, repository string, params *DeleteRepositoryParams, reqEditors ...RequestEditorFn) (*DeleteRepositoryResponse, error) {
-	rsp, err := c.DeleteRepository(ctx, owner, repository, params, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteRepositoryResponse(rsp)
-}
 
-// GetRepositoryWithResponse request returning *GetRepositoryResponse
-func (c *ClientWithResponses) GetRepositoryWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*GetRepositoryResponse, error) {
-	rsp, err := c.GetRepository(ctx, owner, repository, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRepositoryResponse(rsp)
-}
 
-// UpdateRepositoryWithBodyWithResponse request with arbitrary body returning *UpdateRepositoryResponse
-func (c *ClientWithResponses) UpdateRepositoryWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRepositoryResponse, error) {
-	rsp, err := c.UpdateRepositoryWithBody(ctx, owner, repository, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseUpdateRepositoryResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateRepositoryWithResponse(ctx context.Context, owner string, repository string, body UpdateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRepositoryResponse, error) {
-	rsp, err := c.UpdateRepository(ctx, owner, repository, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateRepositoryResponse(rsp)
-}
 
-// GetArchiveWithResponse request returning *GetArchiveResponse
-func (c *ClientWithResponses) GetArchiveWithResponse(ctx context.Context, owner string, repository string, params *GetArchiveParams, reqEditors ...RequestEditorFn) (*GetArchiveResponse, error) {
-	rsp, err := c.GetArchive(ctx, owner, repository, params, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetArchiveResponse(rsp)
+
+	return req, nil
 }
 
-// DeleteBranchWithResponse request returning *DeleteBranchResponse
-func (c *ClientWithResponses) DeleteBranchWithResponse(ctx context.Context, owner string, repository string, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*DeleteBranchResponse, error) {
-	rsp, err := c.DeleteBranch(ctx, owner, repository, params, reqEditors...)
+// NewUpdateMergeRequestRequest calls the generic UpdateMergeRequest builder with application/json body
+func NewUpdateMergeRequestRequest(server string, owner string, repository string, mrSeq uint64, body UpdateMergeRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteBranchResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMergeRequestRequestWithBody(server, owner, repository, mrSeq, "application/json", bodyReader)
 }
 
-// GetBranchWithResponse request returning *GetBranchResponse
-func (c *ClientWithResponses) GetBranchWithResponse(ctx context.Context, owner string, repository string, params *GetBranchParams, reqEditors ...RequestEditorFn) (*GetBranchResponse, error) {
-	rsp, err := c.GetBranch(ctx, owner, repository, params, reqEditors...)
+// NewUpdateMergeRequestRequestWithBody generates requests for UpdateMergeRequest with any type of body
+func NewUpdateMergeRequestRequestWithBody(server string, owner string, repository string, mrSeq uint64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetBranchResponse(rsp)
-}
 
-// CreateBranchWithBodyWithResponse request with arbitrary body returning *CreateBranchResponse
-func (c *ClientWithResponses) CreateBranchWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBranchResponse, error) {
-	rsp, err := c.CreateBranchWithBody(ctx, owner, repository, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateBranchResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateBranchWithResponse(ctx context.Context, owner string, repository string, body CreateBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBranchResponse, error) {
-	rsp, err := c.CreateBranch(ctx, owner, repository, body, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateBranchResponse(rsp)
-}
 
-// ListBranchesWithResponse request returning *ListBranchesResponse
-func (c *ClientWithResponses) ListBranchesWithResponse(ctx context.Context, owner string, repository string, params *ListBranchesParams, reqEditors ...RequestEditorFn) (*ListBranchesResponse, error) {
-	rsp, err := c.ListBranches(ctx, owner, repository, params, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListBranchesResponse(rsp)
-}
 
-// GetCommitChangesWithResponse request returning *GetCommitChangesResponse
-func (c *ClientWithResponses) GetCommitChangesWithResponse(ctx context.Context, owner string, repository string, commitId string, params *GetCommitChangesParams, reqEditors ...RequestEditorFn) (*GetCommitChangesResponse, error) {
-	rsp, err := c.GetCommitChanges(ctx, owner, repository, commitId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseGetCommitChangesResponse(rsp)
-}
 
-// GetCommitsInRefWithResponse request returning *GetCommitsInRefResponse
-func (c *ClientWithResponses) GetCommitsInRefWithResponse(ctx context.Context, owner string, repository string, params *GetCommitsInRefParams, reqEditors ...RequestEditorFn) (*GetCommitsInRefResponse, error) {
-	rsp, err := c.GetCommitsInRef(ctx, owner, repository, params, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCommitsInRefResponse(rsp)
-}
 
-// CompareCommitWithResponse request returning *CompareCommitResponse
-func (c *ClientWithResponses) CompareCommitWithResponse(ctx context.Context, owner string, repository string, basehead string, params *CompareCommitParams, reqEditors ...RequestEditorFn) (*CompareCommitResponse, error) {
-	rsp, err := c.CompareCommit(ctx, owner, repository, basehead, params, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCompareCommitResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetEntriesInRefWithResponse request returning *GetEntriesInRefResponse
-func (c *ClientWithResponses) GetEntriesInRefWithResponse(ctx context.Context, owner string, repository string, params *GetEntriesInRefParams, reqEditors ...RequestEditorFn) (*GetEntriesInRefResponse, error) {
-	rsp, err := c.GetEntriesInRef(ctx, owner, repository, params, reqEditors...)
+// NewListMergeRequestCommentsRequest generates requests for ListMergeRequestComments
+func NewListMergeRequestCommentsRequest(server string, owner string, repository string, mrSeq uint64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEntriesInRefResponse(rsp)
-}
 
-// RevokeMemberWithResponse request returning *RevokeMemberResponse
-func (c *ClientWithResponses) RevokeMemberWithResponse(ctx context.Context, owner string, repository string, params *RevokeMemberParams, reqEditors ...RequestEditorFn) (*RevokeMemberResponse, error) {
-	rsp, err := c.RevokeMember(ctx, owner, repository, params, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRevokeMemberResponse(rsp)
-}
 
-// UpdateMemberGroupWithResponse request returning *UpdateMemberGroupResponse
-func (c *ClientWithResponses) UpdateMemberGroupWithResponse(ctx context.Context, owner string, repository string, params *UpdateMemberGroupParams, reqEditors ...RequestEditorFn) (*UpdateMemberGroupResponse, error) {
-	rsp, err := c.UpdateMemberGroup(ctx, owner, repository, params, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMemberGroupResponse(rsp)
-}
 
-// InviteMemberWithResponse request returning *InviteMemberResponse
-func (c *ClientWithResponses) InviteMemberWithResponse(ctx context.Context, owner string, repository string, params *InviteMemberParams, reqEditors ...RequestEditorFn) (*InviteMemberResponse, error) {
-	rsp, err := c.InviteMember(ctx, owner, repository, params, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseInviteMemberResponse(rsp)
-}
 
-// ListMembersWithResponse request returning *ListMembersResponse
-func (c *ClientWithResponses) ListMembersWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListMembersResponse, error) {
-	rsp, err := c.ListMembers(ctx, owner, repository, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s/comments", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseListMembersResponse(rsp)
-}
 
-// ListMergeRequestsWithResponse request returning *ListMergeRequestsResponse
-func (c *ClientWithResponses) ListMergeRequestsWithResponse(ctx context.Context, owner string, repository string, params *ListMergeRequestsParams, reqEditors ...RequestEditorFn) (*ListMergeRequestsResponse, error) {
-	rsp, err := c.ListMergeRequests(ctx, owner, repository, params, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListMergeRequestsResponse(rsp)
-}
 
-// CreateMergeRequestWithBodyWithResponse request with arbitrary body returning *CreateMergeRequestResponse
-func (c *ClientWithResponses) CreateMergeRequestWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMergeRequestResponse, error) {
-	rsp, err := c.CreateMergeRequestWithBody(ctx, owner, repository, contentType, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateMergeRequestResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateMergeRequestWithResponse(ctx context.Context, owner string, repository string, body CreateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMergeRequestResponse, error) {
-	rsp, err := c.CreateMergeRequest(ctx, owner, repository, body, reqEditors...)
+// NewCreateMergeRequestCommentRequest calls the generic CreateMergeRequestComment builder with application/json body
+func NewCreateMergeRequestCommentRequest(server string, owner string, repository string, mrSeq uint64, body CreateMergeRequestCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateMergeRequestResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMergeRequestCommentRequestWithBody(server, owner, repository, mrSeq, "application/json", bodyReader)
 }
 
-// GetMergeRequestWithResponse request returning *GetMergeRequestResponse
-func (c *ClientWithResponses) GetMergeRequestWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*GetMergeRequestResponse, error) {
-	rsp, err := c.GetMergeRequest(ctx, owner, repository, mrSeq, reqEditors...)
+// NewCreateMergeRequestCommentRequestWithBody generates requests for CreateMergeRequestComment with any type of body
+func NewCreateMergeRequestCommentRequestWithBody(server string, owner string, repository string, mrSeq uint64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMergeRequestResponse(rsp)
-}
 
-// UpdateMergeRequestWithBodyWithResponse request with arbitrary body returning *UpdateMergeRequestResponse
-func (c *ClientWithResponses) UpdateMergeRequestWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMergeRequestResponse, error) {
-	rsp, err := c.UpdateMergeRequestWithBody(ctx, owner, repository, mrSeq, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMergeRequestResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateMergeRequestWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body UpdateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMergeRequestResponse, error) {
-	rsp, err := c.UpdateMergeRequest(ctx, owner, repository, mrSeq, body, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMergeRequestResponse(rsp)
-}
 
-// MergeWithBodyWithResponse request with arbitrary body returning *MergeResponse
-func (c *ClientWithResponses) MergeWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeResponse, error) {
-	rsp, err := c.MergeWithBody(ctx, owner, repository, mrSeq, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMergeResponse(rsp)
-}
 
-func (c *ClientWithResponses) MergeWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body MergeJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeResponse, error) {
-	rsp, err := c.Merge(ctx, owner, repository, mrSeq, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s/comments", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseMergeResponse(rsp)
-}
 
-// DeleteTagWithResponse request returning *DeleteTagResponse
-func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, owner string, repository string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
-	rsp, err := c.DeleteTag(ctx, owner, repository, params, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteTagResponse(rsp)
-}
 
-// GetTagWithResponse request returning *GetTagResponse
-func (c *ClientWithResponses) GetTagWithResponse(ctx context.Context, owner string, repository string, params *GetTagParams, reqEditors ...RequestEditorFn) (*GetTagResponse, error) {
-	rsp, err := c.GetTag(ctx, owner, repository, params, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTagResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResponse
-func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
-	rsp, err := c.CreateTagWithBody(ctx, owner, repository, contentType, body, reqEditors...)
+// NewDeleteMergeRequestCommentRequest generates requests for DeleteMergeRequestComment
+func NewDeleteMergeRequestCommentRequest(server string, owner string, repository string, mrSeq uint64, commentId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTagResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, owner string, repository string, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
-	rsp, err := c.CreateTag(ctx, owner, repository, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTagResponse(rsp)
-}
 
-// ListTagsWithResponse request returning *ListTagsResponse
-func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, owner string, repository string, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
-	rsp, err := c.ListTags(ctx, owner, repository, params, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListTagsResponse(rsp)
-}
 
-// ChangeVisibleWithResponse request returning *ChangeVisibleResponse
-func (c *ClientWithResponses) ChangeVisibleWithResponse(ctx context.Context, owner string, repository string, params *ChangeVisibleParams, reqEditors ...RequestEditorFn) (*ChangeVisibleResponse, error) {
-	rsp, err := c.ChangeVisible(ctx, owner, repository, params, reqEditors...)
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "commentId", runtime.ParamLocationPath, commentId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseChangeVisibleResponse(rsp)
-}
 
-// GetSetupStateWithResponse request returning *GetSetupStateResponse
-func (c *ClientWithResponses) GetSetupStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSetupStateResponse, error) {
-	rsp, err := c.GetSetupState(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSetupStateResponse(rsp)
-}
 
-// DeleteAkskWithResponse request returning *DeleteAkskResponse
-func (c *ClientWithResponses) DeleteAkskWithResponse(ctx context.Context, params *DeleteAkskParams, reqEditors ...RequestEditorFn) (*DeleteAkskResponse, error) {
-	rsp, err := c.DeleteAksk(ctx, params, reqEditors...)
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s/comments/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAkskResponse(rsp)
-}
 
-// GetAkskWithResponse request returning *GetAkskResponse
-func (c *ClientWithResponses) GetAkskWithResponse(ctx context.Context, params *GetAkskParams, reqEditors ...RequestEditorFn) (*GetAkskResponse, error) {
-	rsp, err := c.GetAksk(ctx, params, reqEditors...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAkskResponse(rsp)
-}
 
-// CreateAkskWithResponse request returning *CreateAkskResponse
-func (c *ClientWithResponses) CreateAkskWithResponse(ctx context.Context, params *CreateAkskParams, reqEditors ...RequestEditorFn) (*CreateAkskResponse, error) {
-	rsp, err := c.CreateAksk(ctx, params, reqEditors...)
+	return req, nil
+}
+
+// NewMergeRequest calls the generic Merge builder with application/json body
+func NewMergeRequest(server string, owner string, repository string, mrSeq uint64, body MergeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAkskResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewMergeRequestWithBody(server, owner, repository, mrSeq, "application/json", bodyReader)
 }
 
-// ListAksksWithResponse request returning *ListAksksResponse
-func (c *ClientWithResponses) ListAksksWithResponse(ctx context.Context, params *ListAksksParams, reqEditors ...RequestEditorFn) (*ListAksksResponse, error) {
-	rsp, err := c.ListAksks(ctx, params, reqEditors...)
+// NewMergeRequestWithBody generates requests for Merge with any type of body
+func NewMergeRequestWithBody(server string, owner string, repository string, mrSeq uint64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListAksksResponse(rsp)
-}
 
-// RefreshTokenWithResponse request returning *RefreshTokenResponse
-func (c *ClientWithResponses) RefreshTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
-	rsp, err := c.RefreshToken(ctx, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRefreshTokenResponse(rsp)
-}
 
-// RegisterWithBodyWithResponse request with arbitrary body returning *RegisterResponse
-func (c *ClientWithResponses) RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
-	rsp, err := c.RegisterWithBody(ctx, contentType, body, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRegisterResponse(rsp)
-}
 
-func (c *ClientWithResponses) RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
-	rsp, err := c.Register(ctx, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRegisterResponse(rsp)
-}
 
-// ListRepositoryOfAuthenticatedUserWithResponse request returning *ListRepositoryOfAuthenticatedUserResponse
-func (c *ClientWithResponses) ListRepositoryOfAuthenticatedUserWithResponse(ctx context.Context, params *ListRepositoryOfAuthenticatedUserParams, reqEditors ...RequestEditorFn) (*ListRepositoryOfAuthenticatedUserResponse, error) {
-	rsp, err := c.ListRepositoryOfAuthenticatedUser(ctx, params, reqEditors...)
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s/merge", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListRepositoryOfAuthenticatedUserResponse(rsp)
-}
 
-// CreateRepositoryWithBodyWithResponse request with arbitrary body returning *CreateRepositoryResponse
-func (c *ClientWithResponses) CreateRepositoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRepositoryResponse, error) {
-	rsp, err := c.CreateRepositoryWithBody(ctx, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateRepositoryResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateRepositoryWithResponse(ctx context.Context, body CreateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRepositoryResponse, error) {
-	rsp, err := c.CreateRepository(ctx, body, reqEditors...)
+// NewRemoveReviewerRequest generates requests for RemoveReviewer
+func NewRemoveReviewerRequest(server string, owner string, repository string, mrSeq uint64, params *RemoveReviewerParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateRepositoryResponse(rsp)
-}
 
-// GetUserInfoWithResponse request returning *GetUserInfoResponse
-func (c *ClientWithResponses) GetUserInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserInfoResponse, error) {
-	rsp, err := c.GetUserInfo(ctx, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUserInfoResponse(rsp)
-}
 
-// ListRepositoryWithResponse request returning *ListRepositoryResponse
-func (c *ClientWithResponses) ListRepositoryWithResponse(ctx context.Context, owner string, params *ListRepositoryParams, reqEditors ...RequestEditorFn) (*ListRepositoryResponse, error) {
-	rsp, err := c.ListRepository(ctx, owner, params, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListRepositoryResponse(rsp)
-}
 
-// GetVersionWithResponse request returning *GetVersionResponse
-func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
-	rsp, err := c.GetVersion(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetVersionResponse(rsp)
-}
 
-// DeleteWipWithResponse request returning *DeleteWipResponse
-func (c *ClientWithResponses) DeleteWipWithResponse(ctx context.Context, owner string, repository string, params *DeleteWipParams, reqEditors ...RequestEditorFn) (*DeleteWipResponse, error) {
-	rsp, err := c.DeleteWip(ctx, owner, repository, params, reqEditors...)
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s/reviewers", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteWipResponse(rsp)
-}
 
-// GetWipWithResponse request returning *GetWipResponse
-func (c *ClientWithResponses) GetWipWithResponse(ctx context.Context, owner string, repository string, params *GetWipParams, reqEditors ...RequestEditorFn) (*GetWipResponse, error) {
-	rsp, err := c.GetWip(ctx, owner, repository, params, reqEditors...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reviewer", runtime.ParamLocationQuery, params.Reviewer); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWipResponse(rsp)
+
+	return req, nil
 }
 
-// UpdateWipWithBodyWithResponse request with arbitrary body returning *UpdateWipResponse
-func (c *ClientWithResponses) UpdateWipWithBodyWithResponse(ctx context.Context, owner string, repository string, params *UpdateWipParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWipResponse, error) {
-	rsp, err := c.UpdateWipWithBody(ctx, owner, repository, params, contentType, body, reqEditors...)
+// NewListReviewersRequest generates requests for ListReviewers
+func NewListReviewersRequest(server string, owner string, repository string, mrSeq uint64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateWipResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateWipWithResponse(ctx context.Context, owner string, repository string, params *UpdateWipParams, body UpdateWipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWipResponse, error) {
-	rsp, err := c.UpdateWip(ctx, owner, repository, params, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateWipResponse(rsp)
-}
 
-// GetWipChangesWithResponse request returning *GetWipChangesResponse
-func (c *ClientWithResponses) GetWipChangesWithResponse(ctx context.Context, owner string, repository string, params *GetWipChangesParams, reqEditors ...RequestEditorFn) (*GetWipChangesResponse, error) {
-	rsp, err := c.GetWipChanges(ctx, owner, repository, params, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWipChangesResponse(rsp)
-}
 
-// CommitWipWithResponse request returning *CommitWipResponse
-func (c *ClientWithResponses) CommitWipWithResponse(ctx context.Context, owner string, repository string, params *CommitWipParams, reqEditors ...RequestEditorFn) (*CommitWipResponse, error) {
-	rsp, err := c.CommitWip(ctx, owner, repository, params, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCommitWipResponse(rsp)
-}
 
-// ListWipWithResponse request returning *ListWipResponse
-func (c *ClientWithResponses) ListWipWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListWipResponse, error) {
-	rsp, err := c.ListWip(ctx, owner, repository, reqEditors...)
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s/reviewers", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListWipResponse(rsp)
-}
 
-// RevertWipChangesWithResponse request returning *RevertWipChangesResponse
-func (c *ClientWithResponses) RevertWipChangesWithResponse(ctx context.Context, owner string, repository string, params *RevertWipChangesParams, reqEditors ...RequestEditorFn) (*RevertWipChangesResponse, error) {
-	rsp, err := c.RevertWipChanges(ctx, owner, repository, params, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRevertWipChangesResponse(rsp)
+
+	return req, nil
 }
 
-// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
-func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewAddReviewersRequest calls the generic AddReviewers builder with application/json body
+func NewAddReviewersRequest(server string, owner string, repository string, mrSeq uint64, body AddReviewersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddReviewersRequestWithBody(server, owner, repository, mrSeq, "application/json", bodyReader)
+}
 
-	response := &LoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// NewAddReviewersRequestWithBody generates requests for AddReviewers with any type of body
+func NewAddReviewersRequestWithBody(server string, owner string, repository string, mrSeq uint64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthenticationToken
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam1 string
 
-// ParseLogoutResponse parses an HTTP response from a LogoutWithResponse call
-func ParseLogoutResponse(rsp *http.Response) (*LogoutResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LogoutResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
+	var pathParam2 string
 
-// ParseListRepoGroupResponse parses an HTTP response from a ListRepoGroupWithResponse call
-func ParseListRepoGroupResponse(rsp *http.Response) (*ListRepoGroupResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListRepoGroupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Group
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s/reviewers", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseDeleteObjectResponse parses an HTTP response from a DeleteObjectWithResponse call
-func ParseDeleteObjectResponse(rsp *http.Response) (*DeleteObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParseGetObjectResponse parses an HTTP response from a GetObjectWithResponse call
-func ParseGetObjectResponse(rsp *http.Response) (*GetObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewListReviewsRequest generates requests for ListReviews
+func NewListReviewsRequest(server string, owner string, repository string, mrSeq uint64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam2 string
 
-// ParseHeadObjectResponse parses an HTTP response from a HeadObjectWithResponse call
-func ParseHeadObjectResponse(rsp *http.Response) (*HeadObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HeadObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s/reviews", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseUploadObjectResponse parses an HTTP response from a UploadObjectWithResponse call
-func ParseUploadObjectResponse(rsp *http.Response) (*UploadObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UploadObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ObjectStats
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	return req, nil
+}
 
+// NewReviewMergeRequestRequest calls the generic ReviewMergeRequest builder with application/json body
+func NewReviewMergeRequestRequest(server string, owner string, repository string, mrSeq uint64, body ReviewMergeRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewReviewMergeRequestRequestWithBody(server, owner, repository, mrSeq, "application/json", bodyReader)
 }
 
-// ParseGetFilesResponse parses an HTTP response from a GetFilesWithResponse call
-func ParseGetFilesResponse(rsp *http.Response) (*GetFilesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewReviewMergeRequestRequestWithBody generates requests for ReviewMergeRequest with any type of body
+func NewReviewMergeRequestRequestWithBody(server string, owner string, repository string, mrSeq uint64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFilesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	var pathParam2 string
 
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "mrSeq", runtime.ParamLocationPath, mrSeq)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
-
-// ParseListPublicRepositoryResponse parses an HTTP response from a ListPublicRepositoryWithResponse call
-func ParseListPublicRepositoryResponse(rsp *http.Response) (*ListPublicRepositoryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListPublicRepositoryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/repos/%s/%s/mergerequest/%s/reviews", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RepositoryList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParseDeleteRepositoryResponse parses an HTTP response from a DeleteRepositoryWithResponse call
-func ParseDeleteRepositoryResponse(rsp *http.Response) (*DeleteRepositoryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetCombinedCommitStatusRequest generates requests for GetCombinedCommitStatus
+func NewGetCombinedCommitStatusRequest(server string, owner string, repository string, commitId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteRepositoryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam2 string
 
-// ParseGetRepositoryResponse parses an HTTP response from a GetRepositoryWithResponse call
-func ParseGetRepositoryResponse(rsp *http.Response) (*GetRepositoryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "commit_id", runtime.ParamLocationPath, commitId)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRepositoryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Repository
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/repos/%s/%s/status/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseUpdateRepositoryResponse parses an HTTP response from a UpdateRepositoryWithResponse call
-func ParseUpdateRepositoryResponse(rsp *http.Response) (*UpdateRepositoryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewListCommitStatusesRequest generates requests for ListCommitStatuses
+func NewListCommitStatusesRequest(server string, owner string, repository string, commitId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateRepositoryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam2 string
 
-// ParseGetArchiveResponse parses an HTTP response from a GetArchiveWithResponse call
-func ParseGetArchiveResponse(rsp *http.Response) (*GetArchiveResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "commit_id", runtime.ParamLocationPath, commitId)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetArchiveResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/statuses/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseDeleteBranchResponse parses an HTTP response from a DeleteBranchWithResponse call
-func ParseDeleteBranchResponse(rsp *http.Response) (*DeleteBranchResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBranchResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetBranchResponse parses an HTTP response from a GetBranchWithResponse call
-func ParseGetBranchResponse(rsp *http.Response) (*GetBranchResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewCreateCommitStatusRequest calls the generic CreateCommitStatus builder with application/json body
+func NewCreateCommitStatusRequest(server string, owner string, repository string, commitId string, body CreateCommitStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCommitStatusRequestWithBody(server, owner, repository, commitId, "application/json", bodyReader)
+}
 
-	response := &GetBranchResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// NewCreateCommitStatusRequestWithBody generates requests for CreateCommitStatus with any type of body
+func NewCreateCommitStatusRequestWithBody(server string, owner string, repository string, commitId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Branch
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam1 string
 
-// ParseCreateBranchResponse parses an HTTP response from a CreateBranchWithResponse call
-func ParseCreateBranchResponse(rsp *http.Response) (*CreateBranchResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateBranchResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Branch
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	var pathParam2 string
 
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "commit_id", runtime.ParamLocationPath, commitId)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
-
-// ParseListBranchesResponse parses an HTTP response from a ListBranchesWithResponse call
-func ParseListBranchesResponse(rsp *http.Response) (*ListBranchesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListBranchesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/repos/%s/%s/statuses/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BranchList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParseGetCommitChangesResponse parses an HTTP response from a GetCommitChangesWithResponse call
-func ParseGetCommitChangesResponse(rsp *http.Response) (*GetCommitChangesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewDeleteTagRequest generates requests for DeleteTag
+func NewDeleteTagRequest(server string, owner string, repository string, params *DeleteTagParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCommitChangesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var pathParam1 string
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Change
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/tag", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseGetCommitsInRefResponse parses an HTTP response from a GetCommitsInRefWithResponse call
-func ParseGetCommitsInRefResponse(rsp *http.Response) (*GetCommitsInRefResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCommitsInRefResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Commit
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
 			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseCompareCommitResponse parses an HTTP response from a CompareCommitWithResponse call
-func ParseCompareCommitResponse(rsp *http.Response) (*CompareCommitResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetTagRequest generates requests for GetTag
+func NewGetTagRequest(server string, owner string, repository string, params *GetTagParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CompareCommitResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var pathParam1 string
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Change
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/tag", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseGetEntriesInRefResponse parses an HTTP response from a GetEntriesInRefWithResponse call
-func ParseGetEntriesInRefResponse(rsp *http.Response) (*GetEntriesInRefResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEntriesInRefResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []FullTreeEntry
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseRevokeMemberResponse parses an HTTP response from a RevokeMemberWithResponse call
-func ParseRevokeMemberResponse(rsp *http.Response) (*RevokeMemberResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RevokeMemberResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
+	return req, nil
 }
 
-// ParseUpdateMemberGroupResponse parses an HTTP response from a UpdateMemberGroupWithResponse call
-func ParseUpdateMemberGroupResponse(rsp *http.Response) (*UpdateMemberGroupResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewCreateTagRequest calls the generic CreateTag builder with application/json body
+func NewCreateTagRequest(server string, owner string, repository string, body CreateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTagRequestWithBody(server, owner, repository, "application/json", bodyReader)
+}
 
-	response := &UpdateMemberGroupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
+func NewCreateTagRequestWithBody(server string, owner string, repository string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	return response, nil
-}
+	var pathParam0 string
 
-// ParseInviteMemberResponse parses an HTTP response from a InviteMemberWithResponse call
-func ParseInviteMemberResponse(rsp *http.Response) (*InviteMemberResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &InviteMemberResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
+	var pathParam1 string
 
-// ParseListMembersResponse parses an HTTP response from a ListMembersWithResponse call
-func ParseListMembersResponse(rsp *http.Response) (*ListMembersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListMembersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Member
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	operationPath := fmt.Sprintf("/repos/%s/%s/tag", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseListMergeRequestsResponse parses an HTTP response from a ListMergeRequestsWithResponse call
-func ParseListMergeRequestsResponse(rsp *http.Response) (*ListMergeRequestsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListMergeRequestsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MergeRequestList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
+	req.Header.Add("Content-Type", contentType)
 
-	return response, nil
+	return req, nil
 }
 
-// ParseCreateMergeRequestResponse parses an HTTP response from a CreateMergeRequestWithResponse call
-func ParseCreateMergeRequestResponse(rsp *http.Response) (*CreateMergeRequestResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewListTagsRequest generates requests for ListTags
+func NewListTagsRequest(server string, owner string, repository string, params *ListTagsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateMergeRequestResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var pathParam1 string
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest MergeRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/tags", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseGetMergeRequestResponse parses an HTTP response from a GetMergeRequestWithResponse call
-func ParseGetMergeRequestResponse(rsp *http.Response) (*GetMergeRequestResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetMergeRequestResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MergeRequestFullState
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON200 = &dest
 
-	}
+		if params.After != nil {
 
-	return response, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// ParseUpdateMergeRequestResponse parses an HTTP response from a UpdateMergeRequestWithResponse call
-func ParseUpdateMergeRequestResponse(rsp *http.Response) (*UpdateMergeRequestResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	response := &UpdateMergeRequestResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		if params.Amount != nil {
 
-	return response, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// ParseMergeResponse parses an HTTP response from a MergeWithResponse call
-func ParseMergeResponse(rsp *http.Response) (*MergeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MergeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	return req, nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Commit
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// NewChangeVisibleRequest generates requests for ChangeVisible
+func NewChangeVisibleRequest(server string, owner string, repository string, params *ChangeVisibleParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam1 string
 
-// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
-func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTagResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/repos/%s/%s/visible", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseGetTagResponse parses an HTTP response from a GetTagWithResponse call
-func ParseGetTagResponse(rsp *http.Response) (*GetTagResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTagResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tag
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "visible", runtime.ParamLocationQuery, params.Visible); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseCreateTagResponse parses an HTTP response from a CreateTagWithResponse call
-func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetSetupStateRequest generates requests for GetSetupState
+func NewGetSetupStateRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateTagResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/setup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Tag
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseListTagsResponse parses an HTTP response from a ListTagsWithResponse call
-func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewDeleteAkskRequest generates requests for DeleteAksk
+func NewDeleteAkskRequest(server string, params *DeleteAkskParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListTagsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/users/aksk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseChangeVisibleResponse parses an HTTP response from a ChangeVisibleWithResponse call
-func ParseChangeVisibleResponse(rsp *http.Response) (*ChangeVisibleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ChangeVisibleResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return response, nil
-}
+		if params.Id != nil {
 
-// ParseGetSetupStateResponse parses an HTTP response from a GetSetupStateWithResponse call
-func ParseGetSetupStateResponse(rsp *http.Response) (*GetSetupStateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	response := &GetSetupStateResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		}
+
+		if params.AccessKey != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "access_key", runtime.ParamLocationQuery, *params.AccessKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SetupState
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseDeleteAkskResponse parses an HTTP response from a DeleteAkskWithResponse call
-func ParseDeleteAkskResponse(rsp *http.Response) (*DeleteAkskResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetAkskRequest generates requests for GetAksk
+func NewGetAkskRequest(server string, params *GetAkskParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAkskResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/users/aksk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseGetAkskResponse parses an HTTP response from a GetAkskWithResponse call
-func ParseGetAkskResponse(rsp *http.Response) (*GetAkskResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAkskResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SafeAksk
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		if params.Id != nil {
 
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return response, nil
-}
+		}
 
-// ParseCreateAkskResponse parses an HTTP response from a CreateAkskWithResponse call
-func ParseCreateAkskResponse(rsp *http.Response) (*CreateAkskResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		if params.AccessKey != nil {
 
-	response := &CreateAkskResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "access_key", runtime.ParamLocationQuery, *params.AccessKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Aksk
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON201 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseListAksksResponse parses an HTTP response from a ListAksksWithResponse call
-func ParseListAksksResponse(rsp *http.Response) (*ListAksksResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListAksksResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	return req, nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AkskList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// NewCreateAkskRequest generates requests for CreateAksk
+func NewCreateAkskRequest(server string, params *CreateAkskParams) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/users/aksk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseRefreshTokenResponse parses an HTTP response from a RefreshTokenWithResponse call
-func ParseRefreshTokenResponse(rsp *http.Response) (*RefreshTokenResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RefreshTokenResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Description != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthenticationToken
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
-func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewListAksksRequest generates requests for ListAksks
+func NewListAksksRequest(server string, params *ListAksksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RegisterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/users/aksks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest UserInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// ParseListRepositoryOfAuthenticatedUserResponse parses an HTTP response from a ListRepositoryOfAuthenticatedUserWithResponse call
-func ParseListRepositoryOfAuthenticatedUserResponse(rsp *http.Response) (*ListRepositoryOfAuthenticatedUserResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		if params.After != nil {
 
-	response := &ListRepositoryOfAuthenticatedUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RepositoryList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON200 = &dest
-
-	}
-
-	return response, nil
-}
 
-// ParseCreateRepositoryResponse parses an HTTP response from a CreateRepositoryWithResponse call
-func ParseCreateRepositoryResponse(rsp *http.Response) (*CreateRepositoryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		if params.Amount != nil {
 
-	response := &CreateRepositoryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Repository
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON201 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseGetUserInfoResponse parses an HTTP response from a GetUserInfoWithResponse call
-func ParseGetUserInfoResponse(rsp *http.Response) (*GetUserInfoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUserInfoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
-	return response, nil
+	return req, nil
 }
 
-// ParseListRepositoryResponse parses an HTTP response from a ListRepositoryWithResponse call
-func ParseListRepositoryResponse(rsp *http.Response) (*ListRepositoryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewRefreshTokenRequest generates requests for RefreshToken
+func NewRefreshTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListRepositoryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RepositoryList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	operationPath := fmt.Sprintf("/users/refreshtoken")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseGetVersionResponse parses an HTTP response from a GetVersionWithResponse call
-func ParseGetVersionResponse(rsp *http.Response) (*GetVersionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetVersionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VersionResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseDeleteWipResponse parses an HTTP response from a DeleteWipWithResponse call
-func ParseDeleteWipResponse(rsp *http.Response) (*DeleteWipResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewRegisterRequest calls the generic Register builder with application/json body
+func NewRegisterRequest(server string, body RegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	response := &DeleteWipResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ParseGetWipResponse parses an HTTP response from a GetWipWithResponse call
-func ParseGetWipResponse(rsp *http.Response) (*GetWipResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewRegisterRequestWithBody generates requests for Register with any type of body
+func NewRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWipResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/users/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Wip
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Wip
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
-
-// ParseUpdateWipResponse parses an HTTP response from a UpdateWipWithResponse call
-func ParseUpdateWipResponse(rsp *http.Response) (*UpdateWipResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateWipResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	req.Header.Add("Content-Type", contentType)
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetWipChangesResponse parses an HTTP response from a GetWipChangesWithResponse call
-func ParseGetWipChangesResponse(rsp *http.Response) (*GetWipChangesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewListRepositoryOfAuthenticatedUserRequest generates requests for ListRepositoryOfAuthenticatedUser
+func NewListRepositoryOfAuthenticatedUserRequest(server string, params *ListRepositoryOfAuthenticatedUserParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWipChangesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Change
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	operationPath := fmt.Sprintf("/users/repos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseCommitWipResponse parses an HTTP response from a CommitWipWithResponse call
-func ParseCommitWipResponse(rsp *http.Response) (*CommitWipResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CommitWipResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Wip
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+		if params.Prefix != nil {
 
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Amount != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseListWipResponse parses an HTTP response from a ListWipWithResponse call
-func ParseListWipResponse(rsp *http.Response) (*ListWipResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewCreateRepositoryRequest calls the generic CreateRepository builder with application/json body
+func NewCreateRepositoryRequest(server string, body CreateRepositoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRepositoryRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRepositoryRequestWithBody generates requests for CreateRepository with any type of body
+func NewCreateRepositoryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListWipResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/users/repos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Wip
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParseRevertWipChangesResponse parses an HTTP response from a RevertWipChangesWithResponse call
-func ParseRevertWipChangesResponse(rsp *http.Response) (*RevertWipChangesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetUserInfoRequest generates requests for GetUserInfo
+func NewGetUserInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RevertWipChangesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/users/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// perform a login
-	// (POST /auth/login)
-	Login(ctx context.Context, w *JiaozifsResponse, r *http.Request, body LoginJSONRequestBody)
-	// perform a logout
-	// (POST /auth/logout)
-	Logout(ctx context.Context, w *JiaozifsResponse, r *http.Request)
-	// list groups for repo
-	// (GET /groups/repo)
-	ListRepoGroup(ctx context.Context, w *JiaozifsResponse, r *http.Request)
-	// delete object. Missing objects will not return a NotFound error.
-	// (DELETE /object/{owner}/{repository})
-	DeleteObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteObjectParams)
-	// get object content
-	// (GET /object/{owner}/{repository})
-	GetObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetObjectParams)
-	// check if object exists
-	// (HEAD /object/{owner}/{repository})
-	HeadObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params HeadObjectParams)
+// NewListRepositoryRequest generates requests for ListRepository
+func NewListRepositoryRequest(server string, owner string, params *ListRepositoryParams) (*http.Request, error) {
+	var err error
 
-	// (POST /object/{owner}/{repository})
-	UploadObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params UploadObjectParams)
-	// get files by pattern
-	// (GET /object/{owner}/{repository}/files)
-	GetFiles(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetFilesParams)
-	// list public repository in all system
-	// (GET /repos/public)
-	ListPublicRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListPublicRepositoryParams)
-	// delete repository
-	// (DELETE /repos/{owner}/{repository})
-	DeleteRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteRepositoryParams)
-	// get repository
-	// (GET /repos/{owner}/{repository})
-	GetRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string)
-	// update repository
-	// (POST /repos/{owner}/{repository})
-	UpdateRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateRepositoryJSONRequestBody, owner string, repository string)
-	// get repo files archive
-	// (GET /repos/{owner}/{repository}/archive)
-	GetArchive(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetArchiveParams)
-	// delete branch
-	// (DELETE /repos/{owner}/{repository}/branch)
-	DeleteBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteBranchParams)
-	// get branch
-	// (GET /repos/{owner}/{repository}/branch)
-	GetBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetBranchParams)
-	// create branch
-	// (POST /repos/{owner}/{repository}/branch)
-	CreateBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateBranchJSONRequestBody, owner string, repository string)
-	// list branches
-	// (GET /repos/{owner}/{repository}/branches)
-	ListBranches(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListBranchesParams)
-	// get changes in commit
-	// (GET /repos/{owner}/{repository}/changes/{commit_id})
-	GetCommitChanges(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, commitId string, params GetCommitChangesParams)
-	// get commits in ref
-	// (GET /repos/{owner}/{repository}/commits)
-	GetCommitsInRef(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetCommitsInRefParams)
-	// compare two commit
-	// (GET /repos/{owner}/{repository}/compare/{basehead})
-	CompareCommit(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, basehead string, params CompareCommitParams)
-	// list entries in ref
-	// (GET /repos/{owner}/{repository}/contents)
-	GetEntriesInRef(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetEntriesInRefParams)
-	// Revoke member in repository
-	// (DELETE /repos/{owner}/{repository}/member)
-	RevokeMember(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params RevokeMemberParams)
-	// update member by user id and change group role
-	// (POST /repos/{owner}/{repository}/member)
-	UpdateMemberGroup(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params UpdateMemberGroupParams)
-	// invite member
-	// (POST /repos/{owner}/{repository}/member/invite)
-	InviteMember(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params InviteMemberParams)
-	// get list of members in repository
-	// (GET /repos/{owner}/{repository}/members)
-	ListMembers(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string)
-	// get list of merge request in repository
-	// (GET /repos/{owner}/{repository}/mergerequest)
-	ListMergeRequests(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListMergeRequestsParams)
-	// create merge request
-	// (POST /repos/{owner}/{repository}/mergerequest)
-	CreateMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateMergeRequestJSONRequestBody, owner string, repository string)
-	// get merge request
-	// (GET /repos/{owner}/{repository}/mergerequest/{mrSeq})
-	GetMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64)
-	// update merge request
-	// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq})
-	UpdateMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateMergeRequestJSONRequestBody, owner string, repository string, mrSeq uint64)
-	// merge a mergerequest
-	// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq}/merge)
-	Merge(ctx context.Context, w *JiaozifsResponse, r *http.Request, body MergeJSONRequestBody, owner string, repository string, mrSeq uint64)
-	// delete tag
-	// (DELETE /repos/{owner}/{repository}/tag)
-	DeleteTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteTagParams)
-	// get tag
-	// (GET /repos/{owner}/{repository}/tag)
-	GetTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetTagParams)
-	// create tag
-	// (POST /repos/{owner}/{repository}/tag)
-	CreateTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateTagJSONRequestBody, owner string, repository string)
-	// list tags
-	// (GET /repos/{owner}/{repository}/tags)
-	ListTags(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListTagsParams)
-	// change repository visible(true for public, false for private)
-	// (POST /repos/{owner}/{repository}/visible)
-	ChangeVisible(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ChangeVisibleParams)
-	// check if jiaozifs setup
-	// (GET /setup)
-	GetSetupState(ctx context.Context, w *JiaozifsResponse, r *http.Request)
-	// delete aksk
-	// (DELETE /users/aksk)
-	DeleteAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params DeleteAkskParams)
-	// get aksk
-	// (GET /users/aksk)
-	GetAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params GetAkskParams)
-	// create aksk
-	// (POST /users/aksk)
-	CreateAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params CreateAkskParams)
-	// list aksks
-	// (GET /users/aksks)
-	ListAksks(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListAksksParams)
-	// refresh token for more time
-	// (GET /users/refreshtoken)
-	RefreshToken(ctx context.Context, w *JiaozifsResponse, r *http.Request)
-	// perform user registration
-	// (POST /users/register)
-	Register(ctx context.Context, w *JiaozifsResponse, r *http.Request, body RegisterJSONRequestBody)
-	// list repository
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/repos", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Amount != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount", runtime.ParamLocationQuery, *params.Amount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetVersionRequest generates requests for GetVersion
+func NewGetVersionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteWipRequest generates requests for DeleteWip
+func NewDeleteWipRequest(server string, owner string, repository string, params *DeleteWipParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wip/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWipRequest generates requests for GetWip
+func NewGetWipRequest(server string, owner string, repository string, params *GetWipParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wip/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWipRequest calls the generic UpdateWip builder with application/json body
+func NewUpdateWipRequest(server string, owner string, repository string, params *UpdateWipParams, body UpdateWipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWipRequestWithBody(server, owner, repository, params, "application/json", bodyReader)
+}
+
+// NewUpdateWipRequestWithBody generates requests for UpdateWip with any type of body
+func NewUpdateWipRequestWithBody(server string, owner string, repository string, params *UpdateWipParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wip/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWipChangesRequest generates requests for GetWipChanges
+func NewGetWipChangesRequest(server string, owner string, repository string, params *GetWipChangesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wip/%s/%s/changes", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Path != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCommitWipRequest calls the generic CommitWip builder with application/json body
+func NewCommitWipRequest(server string, owner string, repository string, params *CommitWipParams, body CommitWipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCommitWipRequestWithBody(server, owner, repository, params, "application/json", bodyReader)
+}
+
+// NewCommitWipRequestWithBody generates requests for CommitWip with any type of body
+func NewCommitWipRequestWithBody(server string, owner string, repository string, params *CommitWipParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wip/%s/%s/commit", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "msg", runtime.ParamLocationQuery, params.Msg); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListWipRequest generates requests for ListWip
+func NewListWipRequest(server string, owner string, repository string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wip/%s/%s/list", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevertWipChangesRequest generates requests for RevertWipChanges
+func NewRevertWipChangesRequest(server string, owner string, repository string, params *RevertWipChangesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repository", runtime.ParamLocationPath, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wip/%s/%s/revert", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refName", runtime.ParamLocationQuery, params.RefName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.PathPrefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pathPrefix", runtime.ParamLocationQuery, *params.PathPrefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	// LogoutWithResponse request
+	LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResponse, error)
+
+	// ListRepoGroupWithResponse request
+	ListRepoGroupWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRepoGroupResponse, error)
+
+	// DeleteObjectWithResponse request
+	DeleteObjectWithResponse(ctx context.Context, owner string, repository string, params *DeleteObjectParams, reqEditors ...RequestEditorFn) (*DeleteObjectResponse, error)
+
+	// GetObjectWithResponse request
+	GetObjectWithResponse(ctx context.Context, owner string, repository string, params *GetObjectParams, reqEditors ...RequestEditorFn) (*GetObjectResponse, error)
+
+	// HeadObjectWithResponse request
+	HeadObjectWithResponse(ctx context.Context, owner string, repository string, params *HeadObjectParams, reqEditors ...RequestEditorFn) (*HeadObjectResponse, error)
+
+	// UploadObjectWithBodyWithResponse request with any body
+	UploadObjectWithBodyWithResponse(ctx context.Context, owner string, repository string, params *UploadObjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadObjectResponse, error)
+
+	// GetFilesWithResponse request
+	GetFilesWithResponse(ctx context.Context, owner string, repository string, params *GetFilesParams, reqEditors ...RequestEditorFn) (*GetFilesResponse, error)
+
+	// ListMultipartUploadsWithResponse request
+	ListMultipartUploadsWithResponse(ctx context.Context, owner string, repository string, params *ListMultipartUploadsParams, reqEditors ...RequestEditorFn) (*ListMultipartUploadsResponse, error)
+
+	// CreateMultipartUploadWithResponse request
+	CreateMultipartUploadWithResponse(ctx context.Context, owner string, repository string, params *CreateMultipartUploadParams, reqEditors ...RequestEditorFn) (*CreateMultipartUploadResponse, error)
+
+	// AbortMultipartUploadWithResponse request
+	AbortMultipartUploadWithResponse(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, reqEditors ...RequestEditorFn) (*AbortMultipartUploadResponse, error)
+
+	// GetMultipartUploadWithResponse request
+	GetMultipartUploadWithResponse(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMultipartUploadResponse, error)
+
+	// CompleteMultipartUploadWithResponse request
+	CompleteMultipartUploadWithResponse(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, params *CompleteMultipartUploadParams, reqEditors ...RequestEditorFn) (*CompleteMultipartUploadResponse, error)
+
+	// UploadMultipartPartWithBodyWithResponse request with any body
+	UploadMultipartPartWithBodyWithResponse(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, partNumber int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadMultipartPartResponse, error)
+
+	// GetPhysicalAddressWithResponse request
+	GetPhysicalAddressWithResponse(ctx context.Context, owner string, repository string, params *GetPhysicalAddressParams, reqEditors ...RequestEditorFn) (*GetPhysicalAddressResponse, error)
+
+	// LinkPhysicalAddressWithBodyWithResponse request with any body
+	LinkPhysicalAddressWithBodyWithResponse(ctx context.Context, owner string, repository string, params *LinkPhysicalAddressParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LinkPhysicalAddressResponse, error)
+
+	LinkPhysicalAddressWithResponse(ctx context.Context, owner string, repository string, params *LinkPhysicalAddressParams, body LinkPhysicalAddressJSONRequestBody, reqEditors ...RequestEditorFn) (*LinkPhysicalAddressResponse, error)
+
+	// ListPublicRepositoryWithResponse request
+	ListPublicRepositoryWithResponse(ctx context.Context, params *ListPublicRepositoryParams, reqEditors ...RequestEditorFn) (*ListPublicRepositoryResponse, error)
+
+	// DeleteRepositoryWithResponse request
+	DeleteRepositoryWithResponse(ctx context.Context, owner string, repository string, params *DeleteRepositoryParams, reqEditors ...RequestEditorFn) (*DeleteRepositoryResponse, error)
+
+	// GetRepositoryWithResponse request
+	GetRepositoryWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*GetRepositoryResponse, error)
+
+	// UpdateRepositoryWithBodyWithResponse request with any body
+	UpdateRepositoryWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRepositoryResponse, error)
+
+	UpdateRepositoryWithResponse(ctx context.Context, owner string, repository string, body UpdateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRepositoryResponse, error)
+
+	// DeleteMergeApprovalRuleWithResponse request
+	DeleteMergeApprovalRuleWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*DeleteMergeApprovalRuleResponse, error)
+
+	// GetMergeApprovalRuleWithResponse request
+	GetMergeApprovalRuleWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*GetMergeApprovalRuleResponse, error)
+
+	// SetMergeApprovalRuleWithBodyWithResponse request with any body
+	SetMergeApprovalRuleWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetMergeApprovalRuleResponse, error)
+
+	SetMergeApprovalRuleWithResponse(ctx context.Context, owner string, repository string, body SetMergeApprovalRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetMergeApprovalRuleResponse, error)
+
+	// GetArchiveWithResponse request
+	GetArchiveWithResponse(ctx context.Context, owner string, repository string, params *GetArchiveParams, reqEditors ...RequestEditorFn) (*GetArchiveResponse, error)
+
+	// ImportArchiveWithBodyWithResponse request with any body
+	ImportArchiveWithBodyWithResponse(ctx context.Context, owner string, repository string, params *ImportArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportArchiveResponse, error)
+
+	// GetBlameWithResponse request
+	GetBlameWithResponse(ctx context.Context, owner string, repository string, params *GetBlameParams, reqEditors ...RequestEditorFn) (*GetBlameResponse, error)
+
+	// DeleteBranchWithResponse request
+	DeleteBranchWithResponse(ctx context.Context, owner string, repository string, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*DeleteBranchResponse, error)
+
+	// GetBranchWithResponse request
+	GetBranchWithResponse(ctx context.Context, owner string, repository string, params *GetBranchParams, reqEditors ...RequestEditorFn) (*GetBranchResponse, error)
+
+	// CreateBranchWithBodyWithResponse request with any body
+	CreateBranchWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBranchResponse, error)
+
+	CreateBranchWithResponse(ctx context.Context, owner string, repository string, body CreateBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBranchResponse, error)
+
+	// CherryPickWithBodyWithResponse request with any body
+	CherryPickWithBodyWithResponse(ctx context.Context, owner string, repository string, params *CherryPickParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CherryPickResponse, error)
+
+	CherryPickWithResponse(ctx context.Context, owner string, repository string, params *CherryPickParams, body CherryPickJSONRequestBody, reqEditors ...RequestEditorFn) (*CherryPickResponse, error)
+
+	// ListBranchReflogWithResponse request
+	ListBranchReflogWithResponse(ctx context.Context, owner string, repository string, params *ListBranchReflogParams, reqEditors ...RequestEditorFn) (*ListBranchReflogResponse, error)
+
+	// RestoreBranchWithBodyWithResponse request with any body
+	RestoreBranchWithBodyWithResponse(ctx context.Context, owner string, repository string, params *RestoreBranchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestoreBranchResponse, error)
+
+	RestoreBranchWithResponse(ctx context.Context, owner string, repository string, params *RestoreBranchParams, body RestoreBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*RestoreBranchResponse, error)
+
+	// RevertCommitWithBodyWithResponse request with any body
+	RevertCommitWithBodyWithResponse(ctx context.Context, owner string, repository string, params *RevertCommitParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevertCommitResponse, error)
+
+	RevertCommitWithResponse(ctx context.Context, owner string, repository string, params *RevertCommitParams, body RevertCommitJSONRequestBody, reqEditors ...RequestEditorFn) (*RevertCommitResponse, error)
+
+	// UndeleteBranchWithResponse request
+	UndeleteBranchWithResponse(ctx context.Context, owner string, repository string, params *UndeleteBranchParams, reqEditors ...RequestEditorFn) (*UndeleteBranchResponse, error)
+
+	// ListBranchProtectionRulesWithResponse request
+	ListBranchProtectionRulesWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListBranchProtectionRulesResponse, error)
+
+	// CreateBranchProtectionRuleWithBodyWithResponse request with any body
+	CreateBranchProtectionRuleWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBranchProtectionRuleResponse, error)
+
+	CreateBranchProtectionRuleWithResponse(ctx context.Context, owner string, repository string, body CreateBranchProtectionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBranchProtectionRuleResponse, error)
+
+	// DeleteBranchProtectionRuleWithResponse request
+	DeleteBranchProtectionRuleWithResponse(ctx context.Context, owner string, repository string, ruleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBranchProtectionRuleResponse, error)
+
+	// ListBranchesWithResponse request
+	ListBranchesWithResponse(ctx context.Context, owner string, repository string, params *ListBranchesParams, reqEditors ...RequestEditorFn) (*ListBranchesResponse, error)
+
+	// GetCommitChangesWithResponse request
+	GetCommitChangesWithResponse(ctx context.Context, owner string, repository string, commitId string, params *GetCommitChangesParams, reqEditors ...RequestEditorFn) (*GetCommitChangesResponse, error)
+
+	// GetCommitsInRefWithResponse request
+	GetCommitsInRefWithResponse(ctx context.Context, owner string, repository string, params *GetCommitsInRefParams, reqEditors ...RequestEditorFn) (*GetCommitsInRefResponse, error)
+
+	// CompareCommitWithResponse request
+	CompareCommitWithResponse(ctx context.Context, owner string, repository string, basehead string, params *CompareCommitParams, reqEditors ...RequestEditorFn) (*CompareCommitResponse, error)
+
+	// CompareTableWithResponse request
+	CompareTableWithResponse(ctx context.Context, owner string, repository string, basehead string, params *CompareTableParams, reqEditors ...RequestEditorFn) (*CompareTableResponse, error)
+
+	// GetEntriesInRefWithResponse request
+	GetEntriesInRefWithResponse(ctx context.Context, owner string, repository string, params *GetEntriesInRefParams, reqEditors ...RequestEditorFn) (*GetEntriesInRefResponse, error)
+
+	// ListWebhooksWithResponse request
+	ListWebhooksWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListWebhooksResponse, error)
+
+	// CreateWebhookWithBodyWithResponse request with any body
+	CreateWebhookWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	CreateWebhookWithResponse(ctx context.Context, owner string, repository string, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	// DeleteWebhookWithResponse request
+	DeleteWebhookWithResponse(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
+
+	// GetWebhookWithResponse request
+	GetWebhookWithResponse(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error)
+
+	// UpdateWebhookWithBodyWithResponse request with any body
+	UpdateWebhookWithBodyWithResponse(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	UpdateWebhookWithResponse(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	// ListWebhookDeliveriesWithResponse request
+	ListWebhookDeliveriesWithResponse(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error)
+
+	// RevokeMemberWithResponse request
+	RevokeMemberWithResponse(ctx context.Context, owner string, repository string, params *RevokeMemberParams, reqEditors ...RequestEditorFn) (*RevokeMemberResponse, error)
+
+	// UpdateMemberGroupWithResponse request
+	UpdateMemberGroupWithResponse(ctx context.Context, owner string, repository string, params *UpdateMemberGroupParams, reqEditors ...RequestEditorFn) (*UpdateMemberGroupResponse, error)
+
+	// InviteMemberWithResponse request
+	InviteMemberWithResponse(ctx context.Context, owner string, repository string, params *InviteMemberParams, reqEditors ...RequestEditorFn) (*InviteMemberResponse, error)
+
+	// ListMembersWithResponse request
+	ListMembersWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListMembersResponse, error)
+
+	// ListMergeRequestsWithResponse request
+	ListMergeRequestsWithResponse(ctx context.Context, owner string, repository string, params *ListMergeRequestsParams, reqEditors ...RequestEditorFn) (*ListMergeRequestsResponse, error)
+
+	// CreateMergeRequestWithBodyWithResponse request with any body
+	CreateMergeRequestWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMergeRequestResponse, error)
+
+	CreateMergeRequestWithResponse(ctx context.Context, owner string, repository string, body CreateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMergeRequestResponse, error)
+
+	// GetMergeRequestWithResponse request
+	GetMergeRequestWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*GetMergeRequestResponse, error)
+
+	// UpdateMergeRequestWithBodyWithResponse request with any body
+	UpdateMergeRequestWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMergeRequestResponse, error)
+
+	UpdateMergeRequestWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body UpdateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMergeRequestResponse, error)
+
+	// ListMergeRequestCommentsWithResponse request
+	ListMergeRequestCommentsWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*ListMergeRequestCommentsResponse, error)
+
+	// CreateMergeRequestCommentWithBodyWithResponse request with any body
+	CreateMergeRequestCommentWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMergeRequestCommentResponse, error)
+
+	CreateMergeRequestCommentWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body CreateMergeRequestCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMergeRequestCommentResponse, error)
+
+	// DeleteMergeRequestCommentWithResponse request
+	DeleteMergeRequestCommentWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMergeRequestCommentResponse, error)
+
+	// MergeWithBodyWithResponse request with any body
+	MergeWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeResponse, error)
+
+	MergeWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body MergeJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeResponse, error)
+
+	// RemoveReviewerWithResponse request
+	RemoveReviewerWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, params *RemoveReviewerParams, reqEditors ...RequestEditorFn) (*RemoveReviewerResponse, error)
+
+	// ListReviewersWithResponse request
+	ListReviewersWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*ListReviewersResponse, error)
+
+	// AddReviewersWithBodyWithResponse request with any body
+	AddReviewersWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddReviewersResponse, error)
+
+	AddReviewersWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body AddReviewersJSONRequestBody, reqEditors ...RequestEditorFn) (*AddReviewersResponse, error)
+
+	// ListReviewsWithResponse request
+	ListReviewsWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*ListReviewsResponse, error)
+
+	// ReviewMergeRequestWithBodyWithResponse request with any body
+	ReviewMergeRequestWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReviewMergeRequestResponse, error)
+
+	ReviewMergeRequestWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body ReviewMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*ReviewMergeRequestResponse, error)
+
+	// GetCombinedCommitStatusWithResponse request
+	GetCombinedCommitStatusWithResponse(ctx context.Context, owner string, repository string, commitId string, reqEditors ...RequestEditorFn) (*GetCombinedCommitStatusResponse, error)
+
+	// ListCommitStatusesWithResponse request
+	ListCommitStatusesWithResponse(ctx context.Context, owner string, repository string, commitId string, reqEditors ...RequestEditorFn) (*ListCommitStatusesResponse, error)
+
+	// CreateCommitStatusWithBodyWithResponse request with any body
+	CreateCommitStatusWithBodyWithResponse(ctx context.Context, owner string, repository string, commitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCommitStatusResponse, error)
+
+	CreateCommitStatusWithResponse(ctx context.Context, owner string, repository string, commitId string, body CreateCommitStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCommitStatusResponse, error)
+
+	// DeleteTagWithResponse request
+	DeleteTagWithResponse(ctx context.Context, owner string, repository string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+
+	// GetTagWithResponse request
+	GetTagWithResponse(ctx context.Context, owner string, repository string, params *GetTagParams, reqEditors ...RequestEditorFn) (*GetTagResponse, error)
+
+	// CreateTagWithBodyWithResponse request with any body
+	CreateTagWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	CreateTagWithResponse(ctx context.Context, owner string, repository string, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	// ListTagsWithResponse request
+	ListTagsWithResponse(ctx context.Context, owner string, repository string, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+
+	// ChangeVisibleWithResponse request
+	ChangeVisibleWithResponse(ctx context.Context, owner string, repository string, params *ChangeVisibleParams, reqEditors ...RequestEditorFn) (*ChangeVisibleResponse, error)
+
+	// GetSetupStateWithResponse request
+	GetSetupStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSetupStateResponse, error)
+
+	// DeleteAkskWithResponse request
+	DeleteAkskWithResponse(ctx context.Context, params *DeleteAkskParams, reqEditors ...RequestEditorFn) (*DeleteAkskResponse, error)
+
+	// GetAkskWithResponse request
+	GetAkskWithResponse(ctx context.Context, params *GetAkskParams, reqEditors ...RequestEditorFn) (*GetAkskResponse, error)
+
+	// CreateAkskWithResponse request
+	CreateAkskWithResponse(ctx context.Context, params *CreateAkskParams, reqEditors ...RequestEditorFn) (*CreateAkskResponse, error)
+
+	// ListAksksWithResponse request
+	ListAksksWithResponse(ctx context.Context, params *ListAksksParams, reqEditors ...RequestEditorFn) (*ListAksksResponse, error)
+
+	// RefreshTokenWithResponse request
+	RefreshTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+
+	// RegisterWithBodyWithResponse request with any body
+	RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	// ListRepositoryOfAuthenticatedUserWithResponse request
+	ListRepositoryOfAuthenticatedUserWithResponse(ctx context.Context, params *ListRepositoryOfAuthenticatedUserParams, reqEditors ...RequestEditorFn) (*ListRepositoryOfAuthenticatedUserResponse, error)
+
+	// CreateRepositoryWithBodyWithResponse request with any body
+	CreateRepositoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRepositoryResponse, error)
+
+	CreateRepositoryWithResponse(ctx context.Context, body CreateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRepositoryResponse, error)
+
+	// GetUserInfoWithResponse request
+	GetUserInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserInfoResponse, error)
+
+	// ListRepositoryWithResponse request
+	ListRepositoryWithResponse(ctx context.Context, owner string, params *ListRepositoryParams, reqEditors ...RequestEditorFn) (*ListRepositoryResponse, error)
+
+	// GetVersionWithResponse request
+	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
+
+	// DeleteWipWithResponse request
+	DeleteWipWithResponse(ctx context.Context, owner string, repository string, params *DeleteWipParams, reqEditors ...RequestEditorFn) (*DeleteWipResponse, error)
+
+	// GetWipWithResponse request
+	GetWipWithResponse(ctx context.Context, owner string, repository string, params *GetWipParams, reqEditors ...RequestEditorFn) (*GetWipResponse, error)
+
+	// UpdateWipWithBodyWithResponse request with any body
+	UpdateWipWithBodyWithResponse(ctx context.Context, owner string, repository string, params *UpdateWipParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWipResponse, error)
+
+	UpdateWipWithResponse(ctx context.Context, owner string, repository string, params *UpdateWipParams, body UpdateWipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWipResponse, error)
+
+	// GetWipChangesWithResponse request
+	GetWipChangesWithResponse(ctx context.Context, owner string, repository string, params *GetWipChangesParams, reqEditors ...RequestEditorFn) (*GetWipChangesResponse, error)
+
+	// CommitWipWithBodyWithResponse request with any body
+	CommitWipWithBodyWithResponse(ctx context.Context, owner string, repository string, params *CommitWipParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommitWipResponse, error)
+
+	CommitWipWithResponse(ctx context.Context, owner string, repository string, params *CommitWipParams, body CommitWipJSONRequestBody, reqEditors ...RequestEditorFn) (*CommitWipResponse, error)
+
+	// ListWipWithResponse request
+	ListWipWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListWipResponse, error)
+
+	// RevertWipChangesWithResponse request
+	RevertWipChangesWithResponse(ctx context.Context, owner string, repository string, params *RevertWipChangesParams, reqEditors ...RequestEditorFn) (*RevertWipChangesResponse, error)
+}
+
+type LoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthenticationToken
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRepoGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Group
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRepoGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRepoGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteObjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetObjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HeadObjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HeadObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HeadObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadObjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ObjectStats
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMultipartUploadsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]MultipartUpload
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMultipartUploadsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMultipartUploadsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMultipartUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *MultipartUpload
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMultipartUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMultipartUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AbortMultipartUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AbortMultipartUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AbortMultipartUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMultipartUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MultipartUpload
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMultipartUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMultipartUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteMultipartUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ObjectStats
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteMultipartUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteMultipartUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadMultipartPartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MultipartUploadPart
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadMultipartPartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadMultipartPartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPhysicalAddressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PhysicalAddress
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPhysicalAddressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPhysicalAddressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LinkPhysicalAddressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ObjectStats
+}
+
+// Status returns HTTPResponse.Status
+func (r LinkPhysicalAddressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LinkPhysicalAddressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPublicRepositoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepositoryList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPublicRepositoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPublicRepositoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRepositoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRepositoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRepositoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepositoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Repository
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRepositoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRepositoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRepositoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMergeApprovalRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMergeApprovalRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMergeApprovalRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMergeApprovalRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MergeApprovalRule
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMergeApprovalRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMergeApprovalRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetMergeApprovalRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MergeApprovalRule
+}
+
+// Status returns HTTPResponse.Status
+func (r SetMergeApprovalRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetMergeApprovalRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetArchiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportArchiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ArchiveImportResult
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBlameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BlameHunk
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBlameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBlameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBranchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBranchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBranchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBranchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Branch
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBranchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBranchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBranchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Branch
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBranchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBranchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CherryPickResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Commit
+}
+
+// Status returns HTTPResponse.Status
+func (r CherryPickResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CherryPickResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBranchReflogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BranchReflogList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBranchReflogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBranchReflogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreBranchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Branch
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreBranchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreBranchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevertCommitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Commit
+}
+
+// Status returns HTTPResponse.Status
+func (r RevertCommitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevertCommitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UndeleteBranchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Branch
+}
+
+// Status returns HTTPResponse.Status
+func (r UndeleteBranchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UndeleteBranchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBranchProtectionRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BranchProtectionRule
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBranchProtectionRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBranchProtectionRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBranchProtectionRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BranchProtectionRule
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBranchProtectionRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBranchProtectionRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBranchProtectionRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBranchProtectionRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBranchProtectionRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBranchesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BranchList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBranchesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBranchesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCommitChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Change
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCommitChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCommitChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCommitsInRefResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Commit
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCommitsInRefResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCommitsInRefResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompareCommitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Change
+}
+
+// Status returns HTTPResponse.Status
+func (r CompareCommitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompareCommitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompareTableResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TableDiff
+}
+
+// Status returns HTTPResponse.Status
+func (r CompareTableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompareTableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEntriesInRefResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FullTreeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEntriesInRefResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEntriesInRefResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookDelivery
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMemberGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMemberGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMemberGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InviteMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r InviteMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InviteMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Member
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMergeRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MergeRequestList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMergeRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMergeRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMergeRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *MergeRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMergeRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMergeRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMergeRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MergeRequestFullState
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMergeRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMergeRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMergeRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMergeRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMergeRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMergeRequestCommentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ReviewComment
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMergeRequestCommentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMergeRequestCommentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMergeRequestCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ReviewComment
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMergeRequestCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMergeRequestCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMergeRequestCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMergeRequestCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMergeRequestCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Commit
+}
+
+// Status returns HTTPResponse.Status
+func (r MergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveReviewerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveReviewerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveReviewerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListReviewersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Reviewer
+}
+
+// Status returns HTTPResponse.Status
+func (r ListReviewersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListReviewersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddReviewersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AddReviewersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddReviewersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListReviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Review
+}
+
+// Status returns HTTPResponse.Status
+func (r ListReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReviewMergeRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Review
+}
+
+// Status returns HTTPResponse.Status
+func (r ReviewMergeRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReviewMergeRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCombinedCommitStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CombinedCommitStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCombinedCommitStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCombinedCommitStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCommitStatusesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CommitStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCommitStatusesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCommitStatusesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCommitStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CommitStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCommitStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCommitStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tag
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Tag
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ChangeVisibleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ChangeVisibleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ChangeVisibleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSetupStateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SetupState
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSetupStateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSetupStateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAkskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAkskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAkskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAkskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SafeAksk
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAkskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAkskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAkskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Aksk
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAkskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAkskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAksksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AkskList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAksksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAksksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthenticationToken
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UserInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRepositoryOfAuthenticatedUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepositoryList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRepositoryOfAuthenticatedUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRepositoryOfAuthenticatedUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRepositoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Repository
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRepositoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRepositoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRepositoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepositoryList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRepositoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRepositoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VersionResult
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Wip
+	JSON201      *Wip
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWipChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Change
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWipChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWipChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CommitWipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Wip
+}
+
+// Status returns HTTPResponse.Status
+func (r CommitWipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CommitWipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Wip
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevertWipChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RevertWipChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevertWipChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+// LogoutWithResponse request returning *LogoutResponse
+func (c *ClientWithResponses) LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResponse, error) {
+	rsp, err := c.Logout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutResponse(rsp)
+}
+
+// ListRepoGroupWithResponse request returning *ListRepoGroupResponse
+func (c *ClientWithResponses) ListRepoGroupWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRepoGroupResponse, error) {
+	rsp, err := c.ListRepoGroup(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRepoGroupResponse(rsp)
+}
+
+// DeleteObjectWithResponse request returning *DeleteObjectResponse
+func (c *ClientWithResponses) DeleteObjectWithResponse(ctx context.Context, owner string, repository string, params *DeleteObjectParams, reqEditors ...RequestEditorFn) (*DeleteObjectResponse, error) {
+	rsp, err := c.DeleteObject(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteObjectResponse(rsp)
+}
+
+// GetObjectWithResponse request returning *GetObjectResponse
+func (c *ClientWithResponses) GetObjectWithResponse(ctx context.Context, owner string, repository string, params *GetObjectParams, reqEditors ...RequestEditorFn) (*GetObjectResponse, error) {
+	rsp, err := c.GetObject(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetObjectResponse(rsp)
+}
+
+// HeadObjectWithResponse request returning *HeadObjectResponse
+func (c *ClientWithResponses) HeadObjectWithResponse(ctx context.Context, owner string, repository string, params *HeadObjectParams, reqEditors ...RequestEditorFn) (*HeadObjectResponse, error) {
+	rsp, err := c.HeadObject(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHeadObjectResponse(rsp)
+}
+
+// UploadObjectWithBodyWithResponse request with arbitrary body returning *UploadObjectResponse
+func (c *ClientWithResponses) UploadObjectWithBodyWithResponse(ctx context.Context, owner string, repository string, params *UploadObjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadObjectResponse, error) {
+	rsp, err := c.UploadObjectWithBody(ctx, owner, repository, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadObjectResponse(rsp)
+}
+
+// GetFilesWithResponse request returning *GetFilesResponse
+func (c *ClientWithResponses) GetFilesWithResponse(ctx context.Context, owner string, repository string, params *GetFilesParams, reqEditors ...RequestEditorFn) (*GetFilesResponse, error) {
+	rsp, err := c.GetFiles(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFilesResponse(rsp)
+}
+
+// ListMultipartUploadsWithResponse request returning *ListMultipartUploadsResponse
+func (c *ClientWithResponses) ListMultipartUploadsWithResponse(ctx context.Context, owner string, repository string, params *ListMultipartUploadsParams, reqEditors ...RequestEditorFn) (*ListMultipartUploadsResponse, error) {
+	rsp, err := c.ListMultipartUploads(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMultipartUploadsResponse(rsp)
+}
+
+// CreateMultipartUploadWithResponse request returning *CreateMultipartUploadResponse
+func (c *ClientWithResponses) CreateMultipartUploadWithResponse(ctx context.Context, owner string, repository string, params *CreateMultipartUploadParams, reqEditors ...RequestEditorFn) (*CreateMultipartUploadResponse, error) {
+	rsp, err := c.CreateMultipartUpload(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMultipartUploadResponse(rsp)
+}
+
+// AbortMultipartUploadWithResponse request returning *AbortMultipartUploadResponse
+func (c *ClientWithResponses) AbortMultipartUploadWithResponse(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, reqEditors ...RequestEditorFn) (*AbortMultipartUploadResponse, error) {
+	rsp, err := c.AbortMultipartUpload(ctx, owner, repository, uploadId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAbortMultipartUploadResponse(rsp)
+}
+
+// GetMultipartUploadWithResponse request returning *GetMultipartUploadResponse
+func (c *ClientWithResponses) GetMultipartUploadWithResponse(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMultipartUploadResponse, error) {
+	rsp, err := c.GetMultipartUpload(ctx, owner, repository, uploadId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMultipartUploadResponse(rsp)
+}
+
+// CompleteMultipartUploadWithResponse request returning *CompleteMultipartUploadResponse
+func (c *ClientWithResponses) CompleteMultipartUploadWithResponse(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, params *CompleteMultipartUploadParams, reqEditors ...RequestEditorFn) (*CompleteMultipartUploadResponse, error) {
+	rsp, err := c.CompleteMultipartUpload(ctx, owner, repository, uploadId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteMultipartUploadResponse(rsp)
+}
+
+// UploadMultipartPartWithBodyWithResponse request with arbitrary body returning *UploadMultipartPartResponse
+func (c *ClientWithResponses) UploadMultipartPartWithBodyWithResponse(ctx context.Context, owner string, repository string, uploadId openapi_types.UUID, partNumber int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadMultipartPartResponse, error) {
+	rsp, err := c.UploadMultipartPartWithBody(ctx, owner, repository, uploadId, partNumber, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadMultipartPartResponse(rsp)
+}
+
+// GetPhysicalAddressWithResponse request returning *GetPhysicalAddressResponse
+func (c *ClientWithResponses) GetPhysicalAddressWithResponse(ctx context.Context, owner string, repository string, params *GetPhysicalAddressParams, reqEditors ...RequestEditorFn) (*GetPhysicalAddressResponse, error) {
+	rsp, err := c.GetPhysicalAddress(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPhysicalAddressResponse(rsp)
+}
+
+// LinkPhysicalAddressWithBodyWithResponse request with arbitrary body returning *LinkPhysicalAddressResponse
+func (c *ClientWithResponses) LinkPhysicalAddressWithBodyWithResponse(ctx context.Context, owner string, repository string, params *LinkPhysicalAddressParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LinkPhysicalAddressResponse, error) {
+	rsp, err := c.LinkPhysicalAddressWithBody(ctx, owner, repository, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLinkPhysicalAddressResponse(rsp)
+}
+
+func (c *ClientWithResponses) LinkPhysicalAddressWithResponse(ctx context.Context, owner string, repository string, params *LinkPhysicalAddressParams, body LinkPhysicalAddressJSONRequestBody, reqEditors ...RequestEditorFn) (*LinkPhysicalAddressResponse, error) {
+	rsp, err := c.LinkPhysicalAddress(ctx, owner, repository, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLinkPhysicalAddressResponse(rsp)
+}
+
+// ListPublicRepositoryWithResponse request returning *ListPublicRepositoryResponse
+func (c *ClientWithResponses) ListPublicRepositoryWithResponse(ctx context.Context, params *ListPublicRepositoryParams, reqEditors ...RequestEditorFn) (*ListPublicRepositoryResponse, error) {
+	rsp, err := c.ListPublicRepository(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPublicRepositoryResponse(rsp)
+}
+
+// DeleteRepositoryWithResponse request returning *DeleteRepositoryResponse
+func (c *ClientWithResponses) DeleteRepositoryWithResponse(ctx context.Context, owner string, repository string, params *DeleteRepositoryParams, reqEditors ...RequestEditorFn) (*DeleteRepositoryResponse, error) {
+	rsp, err := c.DeleteRepository(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRepositoryResponse(rsp)
+}
+
+// GetRepositoryWithResponse request returning *GetRepositoryResponse
+func (c *ClientWithResponses) GetRepositoryWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*GetRepositoryResponse, error) {
+	rsp, err := c.GetRepository(ctx, owner, repository, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRepositoryResponse(rsp)
+}
+
+// UpdateRepositoryWithBodyWithResponse request with arbitrary body returning *UpdateRepositoryResponse
+func (c *ClientWithResponses) UpdateRepositoryWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRepositoryResponse, error) {
+	rsp, err := c.UpdateRepositoryWithBody(ctx, owner, repository, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRepositoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRepositoryWithResponse(ctx context.Context, owner string, repository string, body UpdateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRepositoryResponse, error) {
+	rsp, err := c.UpdateRepository(ctx, owner, repository, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRepositoryResponse(rsp)
+}
+
+// DeleteMergeApprovalRuleWithResponse request returning *DeleteMergeApprovalRuleResponse
+func (c *ClientWithResponses) DeleteMergeApprovalRuleWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*DeleteMergeApprovalRuleResponse, error) {
+	rsp, err := c.DeleteMergeApprovalRule(ctx, owner, repository, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMergeApprovalRuleResponse(rsp)
+}
+
+// GetMergeApprovalRuleWithResponse request returning *GetMergeApprovalRuleResponse
+func (c *ClientWithResponses) GetMergeApprovalRuleWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*GetMergeApprovalRuleResponse, error) {
+	rsp, err := c.GetMergeApprovalRule(ctx, owner, repository, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMergeApprovalRuleResponse(rsp)
+}
+
+// SetMergeApprovalRuleWithBodyWithResponse request with arbitrary body returning *SetMergeApprovalRuleResponse
+func (c *ClientWithResponses) SetMergeApprovalRuleWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetMergeApprovalRuleResponse, error) {
+	rsp, err := c.SetMergeApprovalRuleWithBody(ctx, owner, repository, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetMergeApprovalRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetMergeApprovalRuleWithResponse(ctx context.Context, owner string, repository string, body SetMergeApprovalRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetMergeApprovalRuleResponse, error) {
+	rsp, err := c.SetMergeApprovalRule(ctx, owner, repository, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetMergeApprovalRuleResponse(rsp)
+}
+
+// GetArchiveWithResponse request returning *GetArchiveResponse
+func (c *ClientWithResponses) GetArchiveWithResponse(ctx context.Context, owner string, repository string, params *GetArchiveParams, reqEditors ...RequestEditorFn) (*GetArchiveResponse, error) {
+	rsp, err := c.GetArchive(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetArchiveResponse(rsp)
+}
+
+// ImportArchiveWithBodyWithResponse request with arbitrary body returning *ImportArchiveResponse
+func (c *ClientWithResponses) ImportArchiveWithBodyWithResponse(ctx context.Context, owner string, repository string, params *ImportArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportArchiveResponse, error) {
+	rsp, err := c.ImportArchiveWithBody(ctx, owner, repository, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportArchiveResponse(rsp)
+}
+
+// GetBlameWithResponse request returning *GetBlameResponse
+func (c *ClientWithResponses) GetBlameWithResponse(ctx context.Context, owner string, repository string, params *GetBlameParams, reqEditors ...RequestEditorFn) (*GetBlameResponse, error) {
+	rsp, err := c.GetBlame(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBlameResponse(rsp)
+}
+
+// DeleteBranchWithResponse request returning *DeleteBranchResponse
+func (c *ClientWithResponses) DeleteBranchWithResponse(ctx context.Context, owner string, repository string, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*DeleteBranchResponse, error) {
+	rsp, err := c.DeleteBranch(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBranchResponse(rsp)
+}
+
+// GetBranchWithResponse request returning *GetBranchResponse
+func (c *ClientWithResponses) GetBranchWithResponse(ctx context.Context, owner string, repository string, params *GetBranchParams, reqEditors ...RequestEditorFn) (*GetBranchResponse, error) {
+	rsp, err := c.GetBranch(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBranchResponse(rsp)
+}
+
+// CreateBranchWithBodyWithResponse request with arbitrary body returning *CreateBranchResponse
+func (c *ClientWithResponses) CreateBranchWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBranchResponse, error) {
+	rsp, err := c.CreateBranchWithBody(ctx, owner, repository, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBranchResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBranchWithResponse(ctx context.Context, owner string, repository string, body CreateBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBranchResponse, error) {
+	rsp, err := c.CreateBranch(ctx, owner, repository, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBranchResponse(rsp)
+}
+
+// CherryPickWithBodyWithResponse request with arbitrary body returning *CherryPickResponse
+func (c *ClientWithResponses) CherryPickWithBodyWithResponse(ctx context.Context, owner string, repository string, params *CherryPickParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CherryPickResponse, error) {
+	rsp, err := c.CherryPickWithBody(ctx, owner, repository, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCherryPickResponse(rsp)
+}
+
+func (c *ClientWithResponses) CherryPickWithResponse(ctx context.Context, owner string, repository string, params *CherryPickParams, body CherryPickJSONRequestBody, reqEditors ...RequestEditorFn) (*CherryPickResponse, error) {
+	rsp, err := c.CherryPick(ctx, owner, repository, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCherryPickResponse(rsp)
+}
+
+// ListBranchReflogWithResponse request returning *ListBranchReflogResponse
+func (c *ClientWithResponses) ListBranchReflogWithResponse(ctx context.Context, owner string, repository string, params *ListBranchReflogParams, reqEditors ...RequestEditorFn) (*ListBranchReflogResponse, error) {
+	rsp, err := c.ListBranchReflog(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBranchReflogResponse(rsp)
+}
+
+// RestoreBranchWithBodyWithResponse request with arbitrary body returning *RestoreBranchResponse
+func (c *ClientWithResponses) RestoreBranchWithBodyWithResponse(ctx context.Context, owner string, repository string, params *RestoreBranchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestoreBranchResponse, error) {
+	rsp, err := c.RestoreBranchWithBody(ctx, owner, repository, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreBranchResponse(rsp)
+}
+
+func (c *ClientWithResponses) RestoreBranchWithResponse(ctx context.Context, owner string, repository string, params *RestoreBranchParams, body RestoreBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*RestoreBranchResponse, error) {
+	rsp, err := c.RestoreBranch(ctx, owner, repository, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreBranchResponse(rsp)
+}
+
+// RevertCommitWithBodyWithResponse request with arbitrary body returning *RevertCommitResponse
+func (c *ClientWithResponses) RevertCommitWithBodyWithResponse(ctx context.Context, owner string, repository string, params *RevertCommitParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevertCommitResponse, error) {
+	rsp, err := c.RevertCommitWithBody(ctx, owner, repository, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevertCommitResponse(rsp)
+}
+
+func (c *ClientWithResponses) RevertCommitWithResponse(ctx context.Context, owner string, repository string, params *RevertCommitParams, body RevertCommitJSONRequestBody, reqEditors ...RequestEditorFn) (*RevertCommitResponse, error) {
+	rsp, err := c.RevertCommit(ctx, owner, repository, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevertCommitResponse(rsp)
+}
+
+// UndeleteBranchWithResponse request returning *UndeleteBranchResponse
+func (c *ClientWithResponses) UndeleteBranchWithResponse(ctx context.Context, owner string, repository string, params *UndeleteBranchParams, reqEditors ...RequestEditorFn) (*UndeleteBranchResponse, error) {
+	rsp, err := c.UndeleteBranch(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUndeleteBranchResponse(rsp)
+}
+
+// ListBranchProtectionRulesWithResponse request returning *ListBranchProtectionRulesResponse
+func (c *ClientWithResponses) ListBranchProtectionRulesWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListBranchProtectionRulesResponse, error) {
+	rsp, err := c.ListBranchProtectionRules(ctx, owner, repository, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBranchProtectionRulesResponse(rsp)
+}
+
+// CreateBranchProtectionRuleWithBodyWithResponse request with arbitrary body returning *CreateBranchProtectionRuleResponse
+func (c *ClientWithResponses) CreateBranchProtectionRuleWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBranchProtectionRuleResponse, error) {
+	rsp, err := c.CreateBranchProtectionRuleWithBody(ctx, owner, repository, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBranchProtectionRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBranchProtectionRuleWithResponse(ctx context.Context, owner string, repository string, body CreateBranchProtectionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBranchProtectionRuleResponse, error) {
+	rsp, err := c.CreateBranchProtectionRule(ctx, owner, repository, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBranchProtectionRuleResponse(rsp)
+}
+
+// DeleteBranchProtectionRuleWithResponse request returning *DeleteBranchProtectionRuleResponse
+func (c *ClientWithResponses) DeleteBranchProtectionRuleWithResponse(ctx context.Context, owner string, repository string, ruleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBranchProtectionRuleResponse, error) {
+	rsp, err := c.DeleteBranchProtectionRule(ctx, owner, repository, ruleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBranchProtectionRuleResponse(rsp)
+}
+
+// ListBranchesWithResponse request returning *ListBranchesResponse
+func (c *ClientWithResponses) ListBranchesWithResponse(ctx context.Context, owner string, repository string, params *ListBranchesParams, reqEditors ...RequestEditorFn) (*ListBranchesResponse, error) {
+	rsp, err := c.ListBranches(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBranchesResponse(rsp)
+}
+
+// GetCommitChangesWithResponse request returning *GetCommitChangesResponse
+func (c *ClientWithResponses) GetCommitChangesWithResponse(ctx context.Context, owner string, repository string, commitId string, params *GetCommitChangesParams, reqEditors ...RequestEditorFn) (*GetCommitChangesResponse, error) {
+	rsp, err := c.GetCommitChanges(ctx, owner, repository, commitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCommitChangesResponse(rsp)
+}
+
+// GetCommitsInRefWithResponse request returning *GetCommitsInRefResponse
+func (c *ClientWithResponses) GetCommitsInRefWithResponse(ctx context.Context, owner string, repository string, params *GetCommitsInRefParams, reqEditors ...RequestEditorFn) (*GetCommitsInRefResponse, error) {
+	rsp, err := c.GetCommitsInRef(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCommitsInRefResponse(rsp)
+}
+
+// CompareCommitWithResponse request returning *CompareCommitResponse
+func (c *ClientWithResponses) CompareCommitWithResponse(ctx context.Context, owner string, repository string, basehead string, params *CompareCommitParams, reqEditors ...RequestEditorFn) (*CompareCommitResponse, error) {
+	rsp, err := c.CompareCommit(ctx, owner, repository, basehead, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompareCommitResponse(rsp)
+}
+
+// CompareTableWithResponse request returning *CompareTableResponse
+func (c *ClientWithResponses) CompareTableWithResponse(ctx context.Context, owner string, repository string, basehead string, params *CompareTableParams, reqEditors ...RequestEditorFn) (*CompareTableResponse, error) {
+	rsp, err := c.CompareTable(ctx, owner, repository, basehead, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompareTableResponse(rsp)
+}
+
+// GetEntriesInRefWithResponse request returning *GetEntriesInRefResponse
+func (c *ClientWithResponses) GetEntriesInRefWithResponse(ctx context.Context, owner string, repository string, params *GetEntriesInRefParams, reqEditors ...RequestEditorFn) (*GetEntriesInRefResponse, error) {
+	rsp, err := c.GetEntriesInRef(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEntriesInRefResponse(rsp)
+}
+
+// ListWebhooksWithResponse request returning *ListWebhooksResponse
+func (c *ClientWithResponses) ListWebhooksWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListWebhooksResponse, error) {
+	rsp, err := c.ListWebhooks(ctx, owner, repository, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhooksResponse(rsp)
+}
+
+// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
+func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhookWithBody(ctx, owner, repository, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, owner string, repository string, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhook(ctx, owner, repository, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
+func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
+	rsp, err := c.DeleteWebhook(ctx, owner, repository, hookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookResponse(rsp)
+}
+
+// GetWebhookWithResponse request returning *GetWebhookResponse
+func (c *ClientWithResponses) GetWebhookWithResponse(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error) {
+	rsp, err := c.GetWebhook(ctx, owner, repository, hookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhookResponse(rsp)
+}
+
+// UpdateWebhookWithBodyWithResponse request with arbitrary body returning *UpdateWebhookResponse
+func (c *ClientWithResponses) UpdateWebhookWithBodyWithResponse(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhookWithBody(ctx, owner, repository, hookId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWebhookWithResponse(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhook(ctx, owner, repository, hookId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookResponse(rsp)
+}
+
+// ListWebhookDeliveriesWithResponse request returning *ListWebhookDeliveriesResponse
+func (c *ClientWithResponses) ListWebhookDeliveriesWithResponse(ctx context.Context, owner string, repository string, hookId openapi_types.UUID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error) {
+	rsp, err := c.ListWebhookDeliveries(ctx, owner, repository, hookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookDeliveriesResponse(rsp)
+}
+
+// RevokeMemberWithResponse request returning *RevokeMemberResponse
+func (c *ClientWithResponses) RevokeMemberWithResponse(ctx context.Context, owner string, repository string, params *RevokeMemberParams, reqEditors ...RequestEditorFn) (*RevokeMemberResponse, error) {
+	rsp, err := c.RevokeMember(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeMemberResponse(rsp)
+}
+
+// UpdateMemberGroupWithResponse request returning *UpdateMemberGroupResponse
+func (c *ClientWithResponses) UpdateMemberGroupWithResponse(ctx context.Context, owner string, repository string, params *UpdateMemberGroupParams, reqEditors ...RequestEditorFn) (*UpdateMemberGroupResponse, error) {
+	rsp, err := c.UpdateMemberGroup(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMemberGroupResponse(rsp)
+}
+
+// InviteMemberWithResponse request returning *InviteMemberResponse
+func (c *ClientWithResponses) InviteMemberWithResponse(ctx context.Context, owner string, repository string, params *InviteMemberParams, reqEditors ...RequestEditorFn) (*InviteMemberResponse, error) {
+	rsp, err := c.InviteMember(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInviteMemberResponse(rsp)
+}
+
+// ListMembersWithResponse request returning *ListMembersResponse
+func (c *ClientWithResponses) ListMembersWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListMembersResponse, error) {
+	rsp, err := c.ListMembers(ctx, owner, repository, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMembersResponse(rsp)
+}
+
+// ListMergeRequestsWithResponse request returning *ListMergeRequestsResponse
+func (c *ClientWithResponses) ListMergeRequestsWithResponse(ctx context.Context, owner string, repository string, params *ListMergeRequestsParams, reqEditors ...RequestEditorFn) (*ListMergeRequestsResponse, error) {
+	rsp, err := c.ListMergeRequests(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMergeRequestsResponse(rsp)
+}
+
+// CreateMergeRequestWithBodyWithResponse request with arbitrary body returning *CreateMergeRequestResponse
+func (c *ClientWithResponses) CreateMergeRequestWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMergeRequestResponse, error) {
+	rsp, err := c.CreateMergeRequestWithBody(ctx, owner, repository, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMergeRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateMergeRequestWithResponse(ctx context.Context, owner string, repository string, body CreateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMergeRequestResponse, error) {
+	rsp, err := c.CreateMergeRequest(ctx, owner, repository, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMergeRequestResponse(rsp)
+}
+
+// GetMergeRequestWithResponse request returning *GetMergeRequestResponse
+func (c *ClientWithResponses) GetMergeRequestWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*GetMergeRequestResponse, error) {
+	rsp, err := c.GetMergeRequest(ctx, owner, repository, mrSeq, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMergeRequestResponse(rsp)
+}
+
+// UpdateMergeRequestWithBodyWithResponse request with arbitrary body returning *UpdateMergeRequestResponse
+func (c *ClientWithResponses) UpdateMergeRequestWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMergeRequestResponse, error) {
+	rsp, err := c.UpdateMergeRequestWithBody(ctx, owner, repository, mrSeq, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMergeRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMergeRequestWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body UpdateMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMergeRequestResponse, error) {
+	rsp, err := c.UpdateMergeRequest(ctx, owner, repository, mrSeq, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMergeRequestResponse(rsp)
+}
+
+// ListMergeRequestCommentsWithResponse request returning *ListMergeRequestCommentsResponse
+func (c *ClientWithResponses) ListMergeRequestCommentsWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*ListMergeRequestCommentsResponse, error) {
+	rsp, err := c.ListMergeRequestComments(ctx, owner, repository, mrSeq, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMergeRequestCommentsResponse(rsp)
+}
+
+// CreateMergeRequestCommentWithBodyWithResponse request with arbitrary body returning *CreateMergeRequestCommentResponse
+func (c *ClientWithResponses) CreateMergeRequestCommentWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMergeRequestCommentResponse, error) {
+	rsp, err := c.CreateMergeRequestCommentWithBody(ctx, owner, repository, mrSeq, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMergeRequestCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateMergeRequestCommentWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body CreateMergeRequestCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMergeRequestCommentResponse, error) {
+	rsp, err := c.CreateMergeRequestComment(ctx, owner, repository, mrSeq, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMergeRequestCommentResponse(rsp)
+}
+
+// DeleteMergeRequestCommentWithResponse request returning *DeleteMergeRequestCommentResponse
+func (c *ClientWithResponses) DeleteMergeRequestCommentWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMergeRequestCommentResponse, error) {
+	rsp, err := c.DeleteMergeRequestComment(ctx, owner, repository, mrSeq, commentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMergeRequestCommentResponse(rsp)
+}
+
+// MergeWithBodyWithResponse request with arbitrary body returning *MergeResponse
+func (c *ClientWithResponses) MergeWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeResponse, error) {
+	rsp, err := c.MergeWithBody(ctx, owner, repository, mrSeq, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergeResponse(rsp)
+}
+
+func (c *ClientWithResponses) MergeWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body MergeJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeResponse, error) {
+	rsp, err := c.Merge(ctx, owner, repository, mrSeq, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergeResponse(rsp)
+}
+
+// RemoveReviewerWithResponse request returning *RemoveReviewerResponse
+func (c *ClientWithResponses) RemoveReviewerWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, params *RemoveReviewerParams, reqEditors ...RequestEditorFn) (*RemoveReviewerResponse, error) {
+	rsp, err := c.RemoveReviewer(ctx, owner, repository, mrSeq, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveReviewerResponse(rsp)
+}
+
+// ListReviewersWithResponse request returning *ListReviewersResponse
+func (c *ClientWithResponses) ListReviewersWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*ListReviewersResponse, error) {
+	rsp, err := c.ListReviewers(ctx, owner, repository, mrSeq, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListReviewersResponse(rsp)
+}
+
+// AddReviewersWithBodyWithResponse request with arbitrary body returning *AddReviewersResponse
+func (c *ClientWithResponses) AddReviewersWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddReviewersResponse, error) {
+	rsp, err := c.AddReviewersWithBody(ctx, owner, repository, mrSeq, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddReviewersResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddReviewersWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body AddReviewersJSONRequestBody, reqEditors ...RequestEditorFn) (*AddReviewersResponse, error) {
+	rsp, err := c.AddReviewers(ctx, owner, repository, mrSeq, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddReviewersResponse(rsp)
+}
+
+// ListReviewsWithResponse request returning *ListReviewsResponse
+func (c *ClientWithResponses) ListReviewsWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, reqEditors ...RequestEditorFn) (*ListReviewsResponse, error) {
+	rsp, err := c.ListReviews(ctx, owner, repository, mrSeq, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListReviewsResponse(rsp)
+}
+
+// ReviewMergeRequestWithBodyWithResponse request with arbitrary body returning *ReviewMergeRequestResponse
+func (c *ClientWithResponses) ReviewMergeRequestWithBodyWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReviewMergeRequestResponse, error) {
+	rsp, err := c.ReviewMergeRequestWithBody(ctx, owner, repository, mrSeq, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewMergeRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReviewMergeRequestWithResponse(ctx context.Context, owner string, repository string, mrSeq uint64, body ReviewMergeRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*ReviewMergeRequestResponse, error) {
+	rsp, err := c.ReviewMergeRequest(ctx, owner, repository, mrSeq, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewMergeRequestResponse(rsp)
+}
+
+// GetCombinedCommitStatusWithResponse request returning *GetCombinedCommitStatusResponse
+func (c *ClientWithResponses) GetCombinedCommitStatusWithResponse(ctx context.Context, owner string, repository string, commitId string, reqEditors ...RequestEditorFn) (*GetCombinedCommitStatusResponse, error) {
+	rsp, err := c.GetCombinedCommitStatus(ctx, owner, repository, commitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCombinedCommitStatusResponse(rsp)
+}
+
+// ListCommitStatusesWithResponse request returning *ListCommitStatusesResponse
+func (c *ClientWithResponses) ListCommitStatusesWithResponse(ctx context.Context, owner string, repository string, commitId string, reqEditors ...RequestEditorFn) (*ListCommitStatusesResponse, error) {
+	rsp, err := c.ListCommitStatuses(ctx, owner, repository, commitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCommitStatusesResponse(rsp)
+}
+
+// CreateCommitStatusWithBodyWithResponse request with arbitrary body returning *CreateCommitStatusResponse
+func (c *ClientWithResponses) CreateCommitStatusWithBodyWithResponse(ctx context.Context, owner string, repository string, commitId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCommitStatusResponse, error) {
+	rsp, err := c.CreateCommitStatusWithBody(ctx, owner, repository, commitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCommitStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCommitStatusWithResponse(ctx context.Context, owner string, repository string, commitId string, body CreateCommitStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCommitStatusResponse, error) {
+	rsp, err := c.CreateCommitStatus(ctx, owner, repository, commitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCommitStatusResponse(rsp)
+}
+
+// DeleteTagWithResponse request returning *DeleteTagResponse
+func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, owner string, repository string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
+	rsp, err := c.DeleteTag(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTagResponse(rsp)
+}
+
+// GetTagWithResponse request returning *GetTagResponse
+func (c *ClientWithResponses) GetTagWithResponse(ctx context.Context, owner string, repository string, params *GetTagParams, reqEditors ...RequestEditorFn) (*GetTagResponse, error) {
+	rsp, err := c.GetTag(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTagResponse(rsp)
+}
+
+// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResponse
+func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, owner string, repository string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTagWithBody(ctx, owner, repository, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, owner string, repository string, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTag(ctx, owner, repository, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
+}
+
+// ListTagsWithResponse request returning *ListTagsResponse
+func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, owner string, repository string, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
+	rsp, err := c.ListTags(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTagsResponse(rsp)
+}
+
+// ChangeVisibleWithResponse request returning *ChangeVisibleResponse
+func (c *ClientWithResponses) ChangeVisibleWithResponse(ctx context.Context, owner string, repository string, params *ChangeVisibleParams, reqEditors ...RequestEditorFn) (*ChangeVisibleResponse, error) {
+	rsp, err := c.ChangeVisible(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangeVisibleResponse(rsp)
+}
+
+// GetSetupStateWithResponse request returning *GetSetupStateResponse
+func (c *ClientWithResponses) GetSetupStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSetupStateResponse, error) {
+	rsp, err := c.GetSetupState(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSetupStateResponse(rsp)
+}
+
+// DeleteAkskWithResponse request returning *DeleteAkskResponse
+func (c *ClientWithResponses) DeleteAkskWithResponse(ctx context.Context, params *DeleteAkskParams, reqEditors ...RequestEditorFn) (*DeleteAkskResponse, error) {
+	rsp, err := c.DeleteAksk(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAkskResponse(rsp)
+}
+
+// GetAkskWithResponse request returning *GetAkskResponse
+func (c *ClientWithResponses) GetAkskWithResponse(ctx context.Context, params *GetAkskParams, reqEditors ...RequestEditorFn) (*GetAkskResponse, error) {
+	rsp, err := c.GetAksk(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAkskResponse(rsp)
+}
+
+// CreateAkskWithResponse request returning *CreateAkskResponse
+func (c *ClientWithResponses) CreateAkskWithResponse(ctx context.Context, params *CreateAkskParams, reqEditors ...RequestEditorFn) (*CreateAkskResponse, error) {
+	rsp, err := c.CreateAksk(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAkskResponse(rsp)
+}
+
+// ListAksksWithResponse request returning *ListAksksResponse
+func (c *ClientWithResponses) ListAksksWithResponse(ctx context.Context, params *ListAksksParams, reqEditors ...RequestEditorFn) (*ListAksksResponse, error) {
+	rsp, err := c.ListAksks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAksksResponse(rsp)
+}
+
+// RefreshTokenWithResponse request returning *RefreshTokenResponse
+func (c *ClientWithResponses) RefreshTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
+	rsp, err := c.RefreshToken(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTokenResponse(rsp)
+}
+
+// RegisterWithBodyWithResponse request with arbitrary body returning *RegisterResponse
+func (c *ClientWithResponses) RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
+	rsp, err := c.RegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
+	rsp, err := c.Register(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterResponse(rsp)
+}
+
+// ListRepositoryOfAuthenticatedUserWithResponse request returning *ListRepositoryOfAuthenticatedUserResponse
+func (c *ClientWithResponses) ListRepositoryOfAuthenticatedUserWithResponse(ctx context.Context, params *ListRepositoryOfAuthenticatedUserParams, reqEditors ...RequestEditorFn) (*ListRepositoryOfAuthenticatedUserResponse, error) {
+	rsp, err := c.ListRepositoryOfAuthenticatedUser(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRepositoryOfAuthenticatedUserResponse(rsp)
+}
+
+// CreateRepositoryWithBodyWithResponse request with arbitrary body returning *CreateRepositoryResponse
+func (c *ClientWithResponses) CreateRepositoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRepositoryResponse, error) {
+	rsp, err := c.CreateRepositoryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRepositoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRepositoryWithResponse(ctx context.Context, body CreateRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRepositoryResponse, error) {
+	rsp, err := c.CreateRepository(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRepositoryResponse(rsp)
+}
+
+// GetUserInfoWithResponse request returning *GetUserInfoResponse
+func (c *ClientWithResponses) GetUserInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserInfoResponse, error) {
+	rsp, err := c.GetUserInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserInfoResponse(rsp)
+}
+
+// ListRepositoryWithResponse request returning *ListRepositoryResponse
+func (c *ClientWithResponses) ListRepositoryWithResponse(ctx context.Context, owner string, params *ListRepositoryParams, reqEditors ...RequestEditorFn) (*ListRepositoryResponse, error) {
+	rsp, err := c.ListRepository(ctx, owner, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRepositoryResponse(rsp)
+}
+
+// GetVersionWithResponse request returning *GetVersionResponse
+func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
+	rsp, err := c.GetVersion(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVersionResponse(rsp)
+}
+
+// DeleteWipWithResponse request returning *DeleteWipResponse
+func (c *ClientWithResponses) DeleteWipWithResponse(ctx context.Context, owner string, repository string, params *DeleteWipParams, reqEditors ...RequestEditorFn) (*DeleteWipResponse, error) {
+	rsp, err := c.DeleteWip(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWipResponse(rsp)
+}
+
+// GetWipWithResponse request returning *GetWipResponse
+func (c *ClientWithResponses) GetWipWithResponse(ctx context.Context, owner string, repository string, params *GetWipParams, reqEditors ...RequestEditorFn) (*GetWipResponse, error) {
+	rsp, err := c.GetWip(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWipResponse(rsp)
+}
+
+// UpdateWipWithBodyWithResponse request with arbitrary body returning *UpdateWipResponse
+func (c *ClientWithResponses) UpdateWipWithBodyWithResponse(ctx context.Context, owner string, repository string, params *UpdateWipParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWipResponse, error) {
+	rsp, err := c.UpdateWipWithBody(ctx, owner, repository, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWipResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWipWithResponse(ctx context.Context, owner string, repository string, params *UpdateWipParams, body UpdateWipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWipResponse, error) {
+	rsp, err := c.UpdateWip(ctx, owner, repository, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWipResponse(rsp)
+}
+
+// GetWipChangesWithResponse request returning *GetWipChangesResponse
+func (c *ClientWithResponses) GetWipChangesWithResponse(ctx context.Context, owner string, repository string, params *GetWipChangesParams, reqEditors ...RequestEditorFn) (*GetWipChangesResponse, error) {
+	rsp, err := c.GetWipChanges(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWipChangesResponse(rsp)
+}
+
+// CommitWipWithBodyWithResponse request with arbitrary body returning *CommitWipResponse
+func (c *ClientWithResponses) CommitWipWithBodyWithResponse(ctx context.Context, owner string, repository string, params *CommitWipParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommitWipResponse, error) {
+	rsp, err := c.CommitWipWithBody(ctx, owner, repository, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommitWipResponse(rsp)
+}
+
+func (c *ClientWithResponses) CommitWipWithResponse(ctx context.Context, owner string, repository string, params *CommitWipParams, body CommitWipJSONRequestBody, reqEditors ...RequestEditorFn) (*CommitWipResponse, error) {
+	rsp, err := c.CommitWip(ctx, owner, repository, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommitWipResponse(rsp)
+}
+
+// ListWipWithResponse request returning *ListWipResponse
+func (c *ClientWithResponses) ListWipWithResponse(ctx context.Context, owner string, repository string, reqEditors ...RequestEditorFn) (*ListWipResponse, error) {
+	rsp, err := c.ListWip(ctx, owner, repository, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWipResponse(rsp)
+}
+
+// RevertWipChangesWithResponse request returning *RevertWipChangesResponse
+func (c *ClientWithResponses) RevertWipChangesWithResponse(ctx context.Context, owner string, repository string, params *RevertWipChangesParams, reqEditors ...RequestEditorFn) (*RevertWipChangesResponse, error) {
+	rsp, err := c.RevertWipChanges(ctx, owner, repository, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevertWipChangesResponse(rsp)
+}
+
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthenticationToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogoutResponse parses an HTTP response from a LogoutWithResponse call
+func ParseLogoutResponse(rsp *http.Response) (*LogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListRepoGroupResponse parses an HTTP response from a ListRepoGroupWithResponse call
+func ParseListRepoGroupResponse(rsp *http.Response) (*ListRepoGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRepoGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteObjectResponse parses an HTTP response from a DeleteObjectWithResponse call
+func ParseDeleteObjectResponse(rsp *http.Response) (*DeleteObjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetObjectResponse parses an HTTP response from a GetObjectWithResponse call
+func ParseGetObjectResponse(rsp *http.Response) (*GetObjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseHeadObjectResponse parses an HTTP response from a HeadObjectWithResponse call
+func ParseHeadObjectResponse(rsp *http.Response) (*HeadObjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HeadObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUploadObjectResponse parses an HTTP response from a UploadObjectWithResponse call
+func ParseUploadObjectResponse(rsp *http.Response) (*UploadObjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ObjectStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFilesResponse parses an HTTP response from a GetFilesWithResponse call
+func ParseGetFilesResponse(rsp *http.Response) (*GetFilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMultipartUploadsResponse parses an HTTP response from a ListMultipartUploadsWithResponse call
+func ParseListMultipartUploadsResponse(rsp *http.Response) (*ListMultipartUploadsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMultipartUploadsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []MultipartUpload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateMultipartUploadResponse parses an HTTP response from a CreateMultipartUploadWithResponse call
+func ParseCreateMultipartUploadResponse(rsp *http.Response) (*CreateMultipartUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateMultipartUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest MultipartUpload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAbortMultipartUploadResponse parses an HTTP response from a AbortMultipartUploadWithResponse call
+func ParseAbortMultipartUploadResponse(rsp *http.Response) (*AbortMultipartUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AbortMultipartUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetMultipartUploadResponse parses an HTTP response from a GetMultipartUploadWithResponse call
+func ParseGetMultipartUploadResponse(rsp *http.Response) (*GetMultipartUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMultipartUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MultipartUpload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompleteMultipartUploadResponse parses an HTTP response from a CompleteMultipartUploadWithResponse call
+func ParseCompleteMultipartUploadResponse(rsp *http.Response) (*CompleteMultipartUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteMultipartUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ObjectStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadMultipartPartResponse parses an HTTP response from a UploadMultipartPartWithResponse call
+func ParseUploadMultipartPartResponse(rsp *http.Response) (*UploadMultipartPartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadMultipartPartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MultipartUploadPart
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPhysicalAddressResponse parses an HTTP response from a GetPhysicalAddressWithResponse call
+func ParseGetPhysicalAddressResponse(rsp *http.Response) (*GetPhysicalAddressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPhysicalAddressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PhysicalAddress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLinkPhysicalAddressResponse parses an HTTP response from a LinkPhysicalAddressWithResponse call
+func ParseLinkPhysicalAddressResponse(rsp *http.Response) (*LinkPhysicalAddressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LinkPhysicalAddressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ObjectStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPublicRepositoryResponse parses an HTTP response from a ListPublicRepositoryWithResponse call
+func ParseListPublicRepositoryResponse(rsp *http.Response) (*ListPublicRepositoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPublicRepositoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepositoryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRepositoryResponse parses an HTTP response from a DeleteRepositoryWithResponse call
+func ParseDeleteRepositoryResponse(rsp *http.Response) (*DeleteRepositoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRepositoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetRepositoryResponse parses an HTTP response from a GetRepositoryWithResponse call
+func ParseGetRepositoryResponse(rsp *http.Response) (*GetRepositoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRepositoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Repository
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRepositoryResponse parses an HTTP response from a UpdateRepositoryWithResponse call
+func ParseUpdateRepositoryResponse(rsp *http.Response) (*UpdateRepositoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRepositoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMergeApprovalRuleResponse parses an HTTP response from a DeleteMergeApprovalRuleWithResponse call
+func ParseDeleteMergeApprovalRuleResponse(rsp *http.Response) (*DeleteMergeApprovalRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMergeApprovalRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetMergeApprovalRuleResponse parses an HTTP response from a GetMergeApprovalRuleWithResponse call
+func ParseGetMergeApprovalRuleResponse(rsp *http.Response) (*GetMergeApprovalRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMergeApprovalRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MergeApprovalRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetMergeApprovalRuleResponse parses an HTTP response from a SetMergeApprovalRuleWithResponse call
+func ParseSetMergeApprovalRuleResponse(rsp *http.Response) (*SetMergeApprovalRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetMergeApprovalRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MergeApprovalRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetArchiveResponse parses an HTTP response from a GetArchiveWithResponse call
+func ParseGetArchiveResponse(rsp *http.Response) (*GetArchiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetArchiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseImportArchiveResponse parses an HTTP response from a ImportArchiveWithResponse call
+func ParseImportArchiveResponse(rsp *http.Response) (*ImportArchiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportArchiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ArchiveImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBlameResponse parses an HTTP response from a GetBlameWithResponse call
+func ParseGetBlameResponse(rsp *http.Response) (*GetBlameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBlameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BlameHunk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBranchResponse parses an HTTP response from a DeleteBranchWithResponse call
+func ParseDeleteBranchResponse(rsp *http.Response) (*DeleteBranchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBranchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetBranchResponse parses an HTTP response from a GetBranchWithResponse call
+func ParseGetBranchResponse(rsp *http.Response) (*GetBranchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBranchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Branch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBranchResponse parses an HTTP response from a CreateBranchWithResponse call
+func ParseCreateBranchResponse(rsp *http.Response) (*CreateBranchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBranchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Branch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCherryPickResponse parses an HTTP response from a CherryPickWithResponse call
+func ParseCherryPickResponse(rsp *http.Response) (*CherryPickResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CherryPickResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Commit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBranchReflogResponse parses an HTTP response from a ListBranchReflogWithResponse call
+func ParseListBranchReflogResponse(rsp *http.Response) (*ListBranchReflogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBranchReflogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BranchReflogList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreBranchResponse parses an HTTP response from a RestoreBranchWithResponse call
+func ParseRestoreBranchResponse(rsp *http.Response) (*RestoreBranchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreBranchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Branch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevertCommitResponse parses an HTTP response from a RevertCommitWithResponse call
+func ParseRevertCommitResponse(rsp *http.Response) (*RevertCommitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevertCommitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Commit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUndeleteBranchResponse parses an HTTP response from a UndeleteBranchWithResponse call
+func ParseUndeleteBranchResponse(rsp *http.Response) (*UndeleteBranchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UndeleteBranchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Branch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBranchProtectionRulesResponse parses an HTTP response from a ListBranchProtectionRulesWithResponse call
+func ParseListBranchProtectionRulesResponse(rsp *http.Response) (*ListBranchProtectionRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBranchProtectionRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BranchProtectionRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBranchProtectionRuleResponse parses an HTTP response from a CreateBranchProtectionRuleWithResponse call
+func ParseCreateBranchProtectionRuleResponse(rsp *http.Response) (*CreateBranchProtectionRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBranchProtectionRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BranchProtectionRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBranchProtectionRuleResponse parses an HTTP response from a DeleteBranchProtectionRuleWithResponse call
+func ParseDeleteBranchProtectionRuleResponse(rsp *http.Response) (*DeleteBranchProtectionRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBranchProtectionRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListBranchesResponse parses an HTTP response from a ListBranchesWithResponse call
+func ParseListBranchesResponse(rsp *http.Response) (*ListBranchesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBranchesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BranchList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCommitChangesResponse parses an HTTP response from a GetCommitChangesWithResponse call
+func ParseGetCommitChangesResponse(rsp *http.Response) (*GetCommitChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCommitChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Change
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCommitsInRefResponse parses an HTTP response from a GetCommitsInRefWithResponse call
+func ParseGetCommitsInRefResponse(rsp *http.Response) (*GetCommitsInRefResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCommitsInRefResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Commit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompareCommitResponse parses an HTTP response from a CompareCommitWithResponse call
+func ParseCompareCommitResponse(rsp *http.Response) (*CompareCommitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompareCommitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Change
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompareTableResponse parses an HTTP response from a CompareTableWithResponse call
+func ParseCompareTableResponse(rsp *http.Response) (*CompareTableResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompareTableResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TableDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEntriesInRefResponse parses an HTTP response from a GetEntriesInRefWithResponse call
+func ParseGetEntriesInRefResponse(rsp *http.Response) (*GetEntriesInRefResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEntriesInRefResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FullTreeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhooksResponse parses an HTTP response from a ListWebhooksWithResponse call
+func ParseListWebhooksResponse(rsp *http.Response) (*ListWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebhookResponse parses an HTTP response from a CreateWebhookWithResponse call
+func ParseCreateWebhookResponse(rsp *http.Response) (*CreateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhookResponse parses an HTTP response from a DeleteWebhookWithResponse call
+func ParseDeleteWebhookResponse(rsp *http.Response) (*DeleteWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookResponse parses an HTTP response from a GetWebhookWithResponse call
+func ParseGetWebhookResponse(rsp *http.Response) (*GetWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWebhookResponse parses an HTTP response from a UpdateWebhookWithResponse call
+func ParseUpdateWebhookResponse(rsp *http.Response) (*UpdateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhookDeliveriesResponse parses an HTTP response from a ListWebhookDeliveriesWithResponse call
+func ParseListWebhookDeliveriesResponse(rsp *http.Response) (*ListWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeMemberResponse parses an HTTP response from a RevokeMemberWithResponse call
+func ParseRevokeMemberResponse(rsp *http.Response) (*RevokeMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMemberGroupResponse parses an HTTP response from a UpdateMemberGroupWithResponse call
+func ParseUpdateMemberGroupResponse(rsp *http.Response) (*UpdateMemberGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMemberGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseInviteMemberResponse parses an HTTP response from a InviteMemberWithResponse call
+func ParseInviteMemberResponse(rsp *http.Response) (*InviteMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InviteMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListMembersResponse parses an HTTP response from a ListMembersWithResponse call
+func ParseListMembersResponse(rsp *http.Response) (*ListMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Member
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMergeRequestsResponse parses an HTTP response from a ListMergeRequestsWithResponse call
+func ParseListMergeRequestsResponse(rsp *http.Response) (*ListMergeRequestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMergeRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MergeRequestList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateMergeRequestResponse parses an HTTP response from a CreateMergeRequestWithResponse call
+func ParseCreateMergeRequestResponse(rsp *http.Response) (*CreateMergeRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateMergeRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest MergeRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMergeRequestResponse parses an HTTP response from a GetMergeRequestWithResponse call
+func ParseGetMergeRequestResponse(rsp *http.Response) (*GetMergeRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMergeRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MergeRequestFullState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMergeRequestResponse parses an HTTP response from a UpdateMergeRequestWithResponse call
+func ParseUpdateMergeRequestResponse(rsp *http.Response) (*UpdateMergeRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMergeRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListMergeRequestCommentsResponse parses an HTTP response from a ListMergeRequestCommentsWithResponse call
+func ParseListMergeRequestCommentsResponse(rsp *http.Response) (*ListMergeRequestCommentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMergeRequestCommentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ReviewComment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateMergeRequestCommentResponse parses an HTTP response from a CreateMergeRequestCommentWithResponse call
+func ParseCreateMergeRequestCommentResponse(rsp *http.Response) (*CreateMergeRequestCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateMergeRequestCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ReviewComment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMergeRequestCommentResponse parses an HTTP response from a DeleteMergeRequestCommentWithResponse call
+func ParseDeleteMergeRequestCommentResponse(rsp *http.Response) (*DeleteMergeRequestCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMergeRequestCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseMergeResponse parses an HTTP response from a MergeWithResponse call
+func ParseMergeResponse(rsp *http.Response) (*MergeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MergeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Commit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveReviewerResponse parses an HTTP response from a RemoveReviewerWithResponse call
+func ParseRemoveReviewerResponse(rsp *http.Response) (*RemoveReviewerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveReviewerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListReviewersResponse parses an HTTP response from a ListReviewersWithResponse call
+func ParseListReviewersResponse(rsp *http.Response) (*ListReviewersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListReviewersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Reviewer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddReviewersResponse parses an HTTP response from a AddReviewersWithResponse call
+func ParseAddReviewersResponse(rsp *http.Response) (*AddReviewersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddReviewersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListReviewsResponse parses an HTTP response from a ListReviewsWithResponse call
+func ParseListReviewsResponse(rsp *http.Response) (*ListReviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Review
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReviewMergeRequestResponse parses an HTTP response from a ReviewMergeRequestWithResponse call
+func ParseReviewMergeRequestResponse(rsp *http.Response) (*ReviewMergeRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReviewMergeRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Review
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCombinedCommitStatusResponse parses an HTTP response from a GetCombinedCommitStatusWithResponse call
+func ParseGetCombinedCommitStatusResponse(rsp *http.Response) (*GetCombinedCommitStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCombinedCommitStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CombinedCommitStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCommitStatusesResponse parses an HTTP response from a ListCommitStatusesWithResponse call
+func ParseListCommitStatusesResponse(rsp *http.Response) (*ListCommitStatusesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCommitStatusesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CommitStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCommitStatusResponse parses an HTTP response from a CreateCommitStatusWithResponse call
+func ParseCreateCommitStatusResponse(rsp *http.Response) (*CreateCommitStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCommitStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CommitStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
+func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetTagResponse parses an HTTP response from a GetTagWithResponse call
+func ParseGetTagResponse(rsp *http.Response) (*GetTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTagResponse parses an HTTP response from a CreateTagWithResponse call
+func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTagsResponse parses an HTTP response from a ListTagsWithResponse call
+func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseChangeVisibleResponse parses an HTTP response from a ChangeVisibleWithResponse call
+func ParseChangeVisibleResponse(rsp *http.Response) (*ChangeVisibleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ChangeVisibleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetSetupStateResponse parses an HTTP response from a GetSetupStateWithResponse call
+func ParseGetSetupStateResponse(rsp *http.Response) (*GetSetupStateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSetupStateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SetupState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAkskResponse parses an HTTP response from a DeleteAkskWithResponse call
+func ParseDeleteAkskResponse(rsp *http.Response) (*DeleteAkskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAkskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetAkskResponse parses an HTTP response from a GetAkskWithResponse call
+func ParseGetAkskResponse(rsp *http.Response) (*GetAkskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAkskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SafeAksk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAkskResponse parses an HTTP response from a CreateAkskWithResponse call
+func ParseCreateAkskResponse(rsp *http.Response) (*CreateAkskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAkskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Aksk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAksksResponse parses an HTTP response from a ListAksksWithResponse call
+func ParseListAksksResponse(rsp *http.Response) (*ListAksksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAksksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AkskList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshTokenResponse parses an HTTP response from a RefreshTokenWithResponse call
+func ParseRefreshTokenResponse(rsp *http.Response) (*RefreshTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthenticationToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
+func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UserInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRepositoryOfAuthenticatedUserResponse parses an HTTP response from a ListRepositoryOfAuthenticatedUserWithResponse call
+func ParseListRepositoryOfAuthenticatedUserResponse(rsp *http.Response) (*ListRepositoryOfAuthenticatedUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRepositoryOfAuthenticatedUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepositoryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRepositoryResponse parses an HTTP response from a CreateRepositoryWithResponse call
+func ParseCreateRepositoryResponse(rsp *http.Response) (*CreateRepositoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRepositoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Repository
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserInfoResponse parses an HTTP response from a GetUserInfoWithResponse call
+func ParseGetUserInfoResponse(rsp *http.Response) (*GetUserInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRepositoryResponse parses an HTTP response from a ListRepositoryWithResponse call
+func ParseListRepositoryResponse(rsp *http.Response) (*ListRepositoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRepositoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepositoryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVersionResponse parses an HTTP response from a GetVersionWithResponse call
+func ParseGetVersionResponse(rsp *http.Response) (*GetVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VersionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWipResponse parses an HTTP response from a DeleteWipWithResponse call
+func ParseDeleteWipResponse(rsp *http.Response) (*DeleteWipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetWipResponse parses an HTTP response from a GetWipWithResponse call
+func ParseGetWipResponse(rsp *http.Response) (*GetWipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wip
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Wip
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWipResponse parses an HTTP response from a UpdateWipWithResponse call
+func ParseUpdateWipResponse(rsp *http.Response) (*UpdateWipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetWipChangesResponse parses an HTTP response from a GetWipChangesWithResponse call
+func ParseGetWipChangesResponse(rsp *http.Response) (*GetWipChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWipChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Change
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCommitWipResponse parses an HTTP response from a CommitWipWithResponse call
+func ParseCommitWipResponse(rsp *http.Response) (*CommitWipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CommitWipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Wip
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWipResponse parses an HTTP response from a ListWipWithResponse call
+func ParseListWipResponse(rsp *http.Response) (*ListWipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Wip
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevertWipChangesResponse parses an HTTP response from a RevertWipChangesWithResponse call
+func ParseRevertWipChangesResponse(rsp *http.Response) (*RevertWipChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevertWipChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// perform a login
+	// (POST /auth/login)
+	Login(ctx context.Context, w *JiaozifsResponse, r *http.Request, body LoginJSONRequestBody)
+	// perform a logout
+	// (POST /auth/logout)
+	Logout(ctx context.Context, w *JiaozifsResponse, r *http.Request)
+	// list groups for repo
+	// (GET /groups/repo)
+	ListRepoGroup(ctx context.Context, w *JiaozifsResponse, r *http.Request)
+	// delete object. Missing objects will not return a NotFound error.
+	// (DELETE /object/{owner}/{repository})
+	DeleteObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteObjectParams)
+	// get object content
+	// (GET /object/{owner}/{repository})
+	GetObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetObjectParams)
+	// check if object exists
+	// (HEAD /object/{owner}/{repository})
+	HeadObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params HeadObjectParams)
+
+	// (POST /object/{owner}/{repository})
+	UploadObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params UploadObjectParams)
+	// get files by pattern
+	// (GET /object/{owner}/{repository}/files)
+	GetFiles(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetFilesParams)
+	// list unfinished multipart uploads of current user
+	// (GET /object/{owner}/{repository}/multipart)
+	ListMultipartUploads(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListMultipartUploadsParams)
+	// initiate a resumable multipart upload
+	// (POST /object/{owner}/{repository}/multipart)
+	CreateMultipartUpload(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params CreateMultipartUploadParams)
+	// abort multipart upload and remove uploaded parts
+	// (DELETE /object/{owner}/{repository}/multipart/{uploadId})
+	AbortMultipartUpload(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, uploadId openapi_types.UUID)
+	// get multipart upload with uploaded parts
+	// (GET /object/{owner}/{repository}/multipart/{uploadId})
+	GetMultipartUpload(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, uploadId openapi_types.UUID)
+	// assemble uploaded parts and add object to working in process of ref
+	// (POST /object/{owner}/{repository}/multipart/{uploadId}/complete)
+	CompleteMultipartUpload(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, uploadId openapi_types.UUID, params CompleteMultipartUploadParams)
+	// upload one part, upload the same part number again replace it
+	// (PUT /object/{owner}/{repository}/multipart/{uploadId}/parts/{partNumber})
+	UploadMultipartPart(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, uploadId openapi_types.UUID, partNumber int)
+	// get a pre-signed url to upload content directly to storage
+	// (GET /object/{owner}/{repository}/physical_address)
+	GetPhysicalAddress(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetPhysicalAddressParams)
+	// verify content uploaded to physical address and add it to wip
+	// (PUT /object/{owner}/{repository}/physical_address)
+	LinkPhysicalAddress(ctx context.Context, w *JiaozifsResponse, r *http.Request, body LinkPhysicalAddressJSONRequestBody, owner string, repository string, params LinkPhysicalAddressParams)
+	// list public repository in all system
+	// (GET /repos/public)
+	ListPublicRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListPublicRepositoryParams)
+	// delete repository
+	// (DELETE /repos/{owner}/{repository})
+	DeleteRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteRepositoryParams)
+	// get repository
+	// (GET /repos/{owner}/{repository})
+	GetRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string)
+	// update repository
+	// (POST /repos/{owner}/{repository})
+	UpdateRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateRepositoryJSONRequestBody, owner string, repository string)
+	// delete approval rule of merge requests
+	// (DELETE /repos/{owner}/{repository}/approvalrule)
+	DeleteMergeApprovalRule(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string)
+	// get approval rule of merge requests
+	// (GET /repos/{owner}/{repository}/approvalrule)
+	GetMergeApprovalRule(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string)
+	// set approval rule of merge requests
+	// (POST /repos/{owner}/{repository}/approvalrule)
+	SetMergeApprovalRule(ctx context.Context, w *JiaozifsResponse, r *http.Request, body SetMergeApprovalRuleJSONRequestBody, owner string, repository string)
+	// get repo files archive
+	// (GET /repos/{owner}/{repository}/archive)
+	GetArchive(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetArchiveParams)
+	// import files of unixfs dag in car archive to wip
+	// (POST /repos/{owner}/{repository}/archive)
+	ImportArchive(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ImportArchiveParams)
+	// attribute each line of text file to the commit introduced it, hunks are streamed once attributed and not ordered by line
+	// (GET /repos/{owner}/{repository}/blame)
+	GetBlame(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetBlameParams)
+	// delete branch
+	// (DELETE /repos/{owner}/{repository}/branch)
+	DeleteBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteBranchParams)
+	// get branch
+	// (GET /repos/{owner}/{repository}/branch)
+	GetBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetBranchParams)
+	// create branch
+	// (POST /repos/{owner}/{repository}/branch)
+	CreateBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateBranchJSONRequestBody, owner string, repository string)
+	// apply changes of commit to branch
+	// (POST /repos/{owner}/{repository}/branch/cherrypick)
+	CherryPick(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CherryPickJSONRequestBody, owner string, repository string, params CherryPickParams)
+	// list head movements of branch from newest to oldest, entries of deleted branch are included
+	// (GET /repos/{owner}/{repository}/branch/reflog)
+	ListBranchReflog(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListBranchReflogParams)
+	// move branch head to the commit recorded in reflog entry
+	// (POST /repos/{owner}/{repository}/branch/restore)
+	RestoreBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, body RestoreBranchJSONRequestBody, owner string, repository string, params RestoreBranchParams)
+	// undo changes of commit in branch
+	// (POST /repos/{owner}/{repository}/branch/revert)
+	RevertCommit(ctx context.Context, w *JiaozifsResponse, r *http.Request, body RevertCommitJSONRequestBody, owner string, repository string, params RevertCommitParams)
+	// recreate deleted branch at the head it had when deleted
+	// (POST /repos/{owner}/{repository}/branch/undelete)
+	UndeleteBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params UndeleteBranchParams)
+	// list branch protection rules of repository
+	// (GET /repos/{owner}/{repository}/branch_protections)
+	ListBranchProtectionRules(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string)
+	// create branch protection rule, replace rule with the same pattern
+	// (POST /repos/{owner}/{repository}/branch_protections)
+	CreateBranchProtectionRule(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateBranchProtectionRuleJSONRequestBody, owner string, repository string)
+	// delete branch protection rule
+	// (DELETE /repos/{owner}/{repository}/branch_protections/{ruleId})
+	DeleteBranchProtectionRule(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, ruleId openapi_types.UUID)
+	// list branches
+	// (GET /repos/{owner}/{repository}/branches)
+	ListBranches(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListBranchesParams)
+	// get changes in commit
+	// (GET /repos/{owner}/{repository}/changes/{commit_id})
+	GetCommitChanges(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, commitId string, params GetCommitChangesParams)
+	// get commits in ref
+	// (GET /repos/{owner}/{repository}/commits)
+	GetCommitsInRef(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetCommitsInRefParams)
+	// compare two commit
+	// (GET /repos/{owner}/{repository}/compare/{basehead})
+	CompareCommit(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, basehead string, params CompareCommitParams)
+	// compare csv, tsv or parquet file between two commit
+	// (GET /repos/{owner}/{repository}/compare/{basehead}/table)
+	CompareTable(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, basehead string, params CompareTableParams)
+	// list entries in ref
+	// (GET /repos/{owner}/{repository}/contents)
+	GetEntriesInRef(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetEntriesInRefParams)
+	// list webhooks of repository
+	// (GET /repos/{owner}/{repository}/hooks)
+	ListWebhooks(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string)
+	// create webhook of repository
+	// (POST /repos/{owner}/{repository}/hooks)
+	CreateWebhook(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateWebhookJSONRequestBody, owner string, repository string)
+	// delete webhook of repository
+	// (DELETE /repos/{owner}/{repository}/hooks/{hookId})
+	DeleteWebhook(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, hookId openapi_types.UUID)
+	// get webhook of repository
+	// (GET /repos/{owner}/{repository}/hooks/{hookId})
+	GetWebhook(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, hookId openapi_types.UUID)
+	// update webhook of repository
+	// (POST /repos/{owner}/{repository}/hooks/{hookId})
+	UpdateWebhook(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateWebhookJSONRequestBody, owner string, repository string, hookId openapi_types.UUID)
+	// list latest deliveries of webhook
+	// (GET /repos/{owner}/{repository}/hooks/{hookId}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, hookId openapi_types.UUID, params ListWebhookDeliveriesParams)
+	// Revoke member in repository
+	// (DELETE /repos/{owner}/{repository}/member)
+	RevokeMember(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params RevokeMemberParams)
+	// update member by user id and change group role
+	// (POST /repos/{owner}/{repository}/member)
+	UpdateMemberGroup(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params UpdateMemberGroupParams)
+	// invite member
+	// (POST /repos/{owner}/{repository}/member/invite)
+	InviteMember(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params InviteMemberParams)
+	// get list of members in repository
+	// (GET /repos/{owner}/{repository}/members)
+	ListMembers(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string)
+	// get list of merge request in repository
+	// (GET /repos/{owner}/{repository}/mergerequest)
+	ListMergeRequests(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListMergeRequestsParams)
+	// create merge request
+	// (POST /repos/{owner}/{repository}/mergerequest)
+	CreateMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateMergeRequestJSONRequestBody, owner string, repository string)
+	// get merge request
+	// (GET /repos/{owner}/{repository}/mergerequest/{mrSeq})
+	GetMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64)
+	// update merge request
+	// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq})
+	UpdateMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateMergeRequestJSONRequestBody, owner string, repository string, mrSeq uint64)
+	// list comments of merge request
+	// (GET /repos/{owner}/{repository}/mergerequest/{mrSeq}/comments)
+	ListMergeRequestComments(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64)
+	// comment on merge request
+	// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq}/comments)
+	CreateMergeRequestComment(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateMergeRequestCommentJSONRequestBody, owner string, repository string, mrSeq uint64)
+	// delete comment and its replies
+	// (DELETE /repos/{owner}/{repository}/mergerequest/{mrSeq}/comments/{commentId})
+	DeleteMergeRequestComment(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64, commentId openapi_types.UUID)
+	// merge a mergerequest
+	// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq}/merge)
+	Merge(ctx context.Context, w *JiaozifsResponse, r *http.Request, body MergeJSONRequestBody, owner string, repository string, mrSeq uint64)
+	// remove reviewer of merge request
+	// (DELETE /repos/{owner}/{repository}/mergerequest/{mrSeq}/reviewers)
+	RemoveReviewer(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64, params RemoveReviewerParams)
+	// list reviewers of merge request
+	// (GET /repos/{owner}/{repository}/mergerequest/{mrSeq}/reviewers)
+	ListReviewers(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64)
+	// request users to review merge request
+	// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq}/reviewers)
+	AddReviewers(ctx context.Context, w *JiaozifsResponse, r *http.Request, body AddReviewersJSONRequestBody, owner string, repository string, mrSeq uint64)
+	// list reviews of merge request
+	// (GET /repos/{owner}/{repository}/mergerequest/{mrSeq}/reviews)
+	ListReviews(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64)
+	// approve or request changes of merge request, review again replace the previous review
+	// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq}/reviews)
+	ReviewMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, body ReviewMergeRequestJSONRequestBody, owner string, repository string, mrSeq uint64)
+	// get combined status of commit
+	// (GET /repos/{owner}/{repository}/status/{commit_id})
+	GetCombinedCommitStatus(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, commitId string)
+	// list statuses of commit
+	// (GET /repos/{owner}/{repository}/statuses/{commit_id})
+	ListCommitStatuses(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, commitId string)
+	// report status of commit, replace status with the same context
+	// (POST /repos/{owner}/{repository}/statuses/{commit_id})
+	CreateCommitStatus(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateCommitStatusJSONRequestBody, owner string, repository string, commitId string)
+	// delete tag
+	// (DELETE /repos/{owner}/{repository}/tag)
+	DeleteTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteTagParams)
+	// get tag
+	// (GET /repos/{owner}/{repository}/tag)
+	GetTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetTagParams)
+	// create tag
+	// (POST /repos/{owner}/{repository}/tag)
+	CreateTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateTagJSONRequestBody, owner string, repository string)
+	// list tags
+	// (GET /repos/{owner}/{repository}/tags)
+	ListTags(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListTagsParams)
+	// change repository visible(true for public, false for private)
+	// (POST /repos/{owner}/{repository}/visible)
+	ChangeVisible(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ChangeVisibleParams)
+	// check if jiaozifs setup
+	// (GET /setup)
+	GetSetupState(ctx context.Context, w *JiaozifsResponse, r *http.Request)
+	// delete aksk
+	// (DELETE /users/aksk)
+	DeleteAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params DeleteAkskParams)
+	// get aksk
+	// (GET /users/aksk)
+	GetAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params GetAkskParams)
+	// create aksk
+	// (POST /users/aksk)
+	CreateAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params CreateAkskParams)
+	// list aksks
+	// (GET /users/aksks)
+	ListAksks(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListAksksParams)
+	// refresh token for more time
+	// (GET /users/refreshtoken)
+	RefreshToken(ctx context.Context, w *JiaozifsResponse, r *http.Request)
+	// perform user registration
+	// (POST /users/register)
+	Register(ctx context.Context, w *JiaozifsResponse, r *http.Request, body RegisterJSONRequestBody)
+	// list repository
 	// (GET /users/repos)
 	ListRepositoryOfAuthenticatedUser(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListRepositoryOfAuthenticatedUserParams)
 	// create repository
@@ -8570,7 +14905,7 @@ type ServerInterface interface {
 	GetWipChanges(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetWipChangesParams)
 	// commit working in process to branch
 	// (POST /wip/{owner}/{repository}/commit)
-	CommitWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params CommitWipParams)
+	CommitWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CommitWipJSONRequestBody, owner string, repository string, params CommitWipParams)
 	// list wip in specific project and user
 	// (GET /wip/{owner}/{repository}/list)
 	ListWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string)
@@ -8579,370 +14914,3126 @@ type ServerInterface interface {
 	RevertWipChanges(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params RevertWipChangesParams)
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// perform a login
+// (POST /auth/login)
+func (_ Unimplemented) Login(ctx context.Context, w *JiaozifsResponse, r *http.Request, body LoginJSONRequestBody) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// perform a logout
+// (POST /auth/logout)
+func (_ Unimplemented) Logout(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list groups for repo
+// (GET /groups/repo)
+func (_ Unimplemented) ListRepoGroup(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// delete object. Missing objects will not return a NotFound error.
+// (DELETE /object/{owner}/{repository})
+func (_ Unimplemented) DeleteObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteObjectParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get object content
+// (GET /object/{owner}/{repository})
+func (_ Unimplemented) GetObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetObjectParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// check if object exists
+// (HEAD /object/{owner}/{repository})
+func (_ Unimplemented) HeadObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params HeadObjectParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /object/{owner}/{repository})
+func (_ Unimplemented) UploadObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params UploadObjectParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get files by pattern
+// (GET /object/{owner}/{repository}/files)
+func (_ Unimplemented) GetFiles(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetFilesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list unfinished multipart uploads of current user
+// (GET /object/{owner}/{repository}/multipart)
+func (_ Unimplemented) ListMultipartUploads(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListMultipartUploadsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// initiate a resumable multipart upload
+// (POST /object/{owner}/{repository}/multipart)
+func (_ Unimplemented) CreateMultipartUpload(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params CreateMultipartUploadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// abort multipart upload and remove uploaded parts
+// (DELETE /object/{owner}/{repository}/multipart/{uploadId})
+func (_ Unimplemented) AbortMultipartUpload(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, uploadId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get multipart upload with uploaded parts
+// (GET /object/{owner}/{repository}/multipart/{uploadId})
+func (_ Unimplemented) GetMultipartUpload(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, uploadId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// assemble uploaded parts and add object to working in process of ref
+// (POST /object/{owner}/{repository}/multipart/{uploadId}/complete)
+func (_ Unimplemented) CompleteMultipartUpload(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, uploadId openapi_types.UUID, params CompleteMultipartUploadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// upload one part, upload the same part number again replace it
+// (PUT /object/{owner}/{repository}/multipart/{uploadId}/parts/{partNumber})
+func (_ Unimplemented) UploadMultipartPart(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, uploadId openapi_types.UUID, partNumber int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get a pre-signed url to upload content directly to storage
+// (GET /object/{owner}/{repository}/physical_address)
+func (_ Unimplemented) GetPhysicalAddress(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetPhysicalAddressParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// verify content uploaded to physical address and add it to wip
+// (PUT /object/{owner}/{repository}/physical_address)
+func (_ Unimplemented) LinkPhysicalAddress(ctx context.Context, w *JiaozifsResponse, r *http.Request, body LinkPhysicalAddressJSONRequestBody, owner string, repository string, params LinkPhysicalAddressParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list public repository in all system
+// (GET /repos/public)
+func (_ Unimplemented) ListPublicRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListPublicRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// delete repository
+// (DELETE /repos/{owner}/{repository})
+func (_ Unimplemented) DeleteRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get repository
+// (GET /repos/{owner}/{repository})
+func (_ Unimplemented) GetRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// update repository
+// (POST /repos/{owner}/{repository})
+func (_ Unimplemented) UpdateRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateRepositoryJSONRequestBody, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// delete approval rule of merge requests
+// (DELETE /repos/{owner}/{repository}/approvalrule)
+func (_ Unimplemented) DeleteMergeApprovalRule(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get approval rule of merge requests
+// (GET /repos/{owner}/{repository}/approvalrule)
+func (_ Unimplemented) GetMergeApprovalRule(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// set approval rule of merge requests
+// (POST /repos/{owner}/{repository}/approvalrule)
+func (_ Unimplemented) SetMergeApprovalRule(ctx context.Context, w *JiaozifsResponse, r *http.Request, body SetMergeApprovalRuleJSONRequestBody, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get repo files archive
+// (GET /repos/{owner}/{repository}/archive)
+func (_ Unimplemented) GetArchive(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// import files of unixfs dag in car archive to wip
+// (POST /repos/{owner}/{repository}/archive)
+func (_ Unimplemented) ImportArchive(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ImportArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// attribute each line of text file to the commit introduced it, hunks are streamed once attributed and not ordered by line
+// (GET /repos/{owner}/{repository}/blame)
+func (_ Unimplemented) GetBlame(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetBlameParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// delete branch
+// (DELETE /repos/{owner}/{repository}/branch)
+func (_ Unimplemented) DeleteBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteBranchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get branch
+// (GET /repos/{owner}/{repository}/branch)
+func (_ Unimplemented) GetBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetBranchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// create branch
+// (POST /repos/{owner}/{repository}/branch)
+func (_ Unimplemented) CreateBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateBranchJSONRequestBody, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// apply changes of commit to branch
+// (POST /repos/{owner}/{repository}/branch/cherrypick)
+func (_ Unimplemented) CherryPick(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CherryPickJSONRequestBody, owner string, repository string, params CherryPickParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list head movements of branch from newest to oldest, entries of deleted branch are included
+// (GET /repos/{owner}/{repository}/branch/reflog)
+func (_ Unimplemented) ListBranchReflog(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListBranchReflogParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// move branch head to the commit recorded in reflog entry
+// (POST /repos/{owner}/{repository}/branch/restore)
+func (_ Unimplemented) RestoreBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, body RestoreBranchJSONRequestBody, owner string, repository string, params RestoreBranchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// undo changes of commit in branch
+// (POST /repos/{owner}/{repository}/branch/revert)
+func (_ Unimplemented) RevertCommit(ctx context.Context, w *JiaozifsResponse, r *http.Request, body RevertCommitJSONRequestBody, owner string, repository string, params RevertCommitParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// recreate deleted branch at the head it had when deleted
+// (POST /repos/{owner}/{repository}/branch/undelete)
+func (_ Unimplemented) UndeleteBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params UndeleteBranchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list branch protection rules of repository
+// (GET /repos/{owner}/{repository}/branch_protections)
+func (_ Unimplemented) ListBranchProtectionRules(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// create branch protection rule, replace rule with the same pattern
+// (POST /repos/{owner}/{repository}/branch_protections)
+func (_ Unimplemented) CreateBranchProtectionRule(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateBranchProtectionRuleJSONRequestBody, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// delete branch protection rule
+// (DELETE /repos/{owner}/{repository}/branch_protections/{ruleId})
+func (_ Unimplemented) DeleteBranchProtectionRule(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, ruleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list branches
+// (GET /repos/{owner}/{repository}/branches)
+func (_ Unimplemented) ListBranches(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListBranchesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get changes in commit
+// (GET /repos/{owner}/{repository}/changes/{commit_id})
+func (_ Unimplemented) GetCommitChanges(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, commitId string, params GetCommitChangesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get commits in ref
+// (GET /repos/{owner}/{repository}/commits)
+func (_ Unimplemented) GetCommitsInRef(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetCommitsInRefParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// compare two commit
+// (GET /repos/{owner}/{repository}/compare/{basehead})
+func (_ Unimplemented) CompareCommit(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, basehead string, params CompareCommitParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// compare csv, tsv or parquet file between two commit
+// (GET /repos/{owner}/{repository}/compare/{basehead}/table)
+func (_ Unimplemented) CompareTable(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, basehead string, params CompareTableParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list entries in ref
+// (GET /repos/{owner}/{repository}/contents)
+func (_ Unimplemented) GetEntriesInRef(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetEntriesInRefParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list webhooks of repository
+// (GET /repos/{owner}/{repository}/hooks)
+func (_ Unimplemented) ListWebhooks(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// create webhook of repository
+// (POST /repos/{owner}/{repository}/hooks)
+func (_ Unimplemented) CreateWebhook(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateWebhookJSONRequestBody, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// delete webhook of repository
+// (DELETE /repos/{owner}/{repository}/hooks/{hookId})
+func (_ Unimplemented) DeleteWebhook(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, hookId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get webhook of repository
+// (GET /repos/{owner}/{repository}/hooks/{hookId})
+func (_ Unimplemented) GetWebhook(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, hookId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// update webhook of repository
+// (POST /repos/{owner}/{repository}/hooks/{hookId})
+func (_ Unimplemented) UpdateWebhook(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateWebhookJSONRequestBody, owner string, repository string, hookId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list latest deliveries of webhook
+// (GET /repos/{owner}/{repository}/hooks/{hookId}/deliveries)
+func (_ Unimplemented) ListWebhookDeliveries(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, hookId openapi_types.UUID, params ListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke member in repository
+// (DELETE /repos/{owner}/{repository}/member)
+func (_ Unimplemented) RevokeMember(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params RevokeMemberParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// update member by user id and change group role
+// (POST /repos/{owner}/{repository}/member)
+func (_ Unimplemented) UpdateMemberGroup(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params UpdateMemberGroupParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// invite member
+// (POST /repos/{owner}/{repository}/member/invite)
+func (_ Unimplemented) InviteMember(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params InviteMemberParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get list of members in repository
+// (GET /repos/{owner}/{repository}/members)
+func (_ Unimplemented) ListMembers(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get list of merge request in repository
+// (GET /repos/{owner}/{repository}/mergerequest)
+func (_ Unimplemented) ListMergeRequests(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListMergeRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// create merge request
+// (POST /repos/{owner}/{repository}/mergerequest)
+func (_ Unimplemented) CreateMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateMergeRequestJSONRequestBody, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get merge request
+// (GET /repos/{owner}/{repository}/mergerequest/{mrSeq})
+func (_ Unimplemented) GetMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// update merge request
+// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq})
+func (_ Unimplemented) UpdateMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateMergeRequestJSONRequestBody, owner string, repository string, mrSeq uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list comments of merge request
+// (GET /repos/{owner}/{repository}/mergerequest/{mrSeq}/comments)
+func (_ Unimplemented) ListMergeRequestComments(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// comment on merge request
+// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq}/comments)
+func (_ Unimplemented) CreateMergeRequestComment(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateMergeRequestCommentJSONRequestBody, owner string, repository string, mrSeq uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// delete comment and its replies
+// (DELETE /repos/{owner}/{repository}/mergerequest/{mrSeq}/comments/{commentId})
+func (_ Unimplemented) DeleteMergeRequestComment(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64, commentId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// merge a mergerequest
+// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq}/merge)
+func (_ Unimplemented) Merge(ctx context.Context, w *JiaozifsResponse, r *http.Request, body MergeJSONRequestBody, owner string, repository string, mrSeq uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// remove reviewer of merge request
+// (DELETE /repos/{owner}/{repository}/mergerequest/{mrSeq}/reviewers)
+func (_ Unimplemented) RemoveReviewer(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64, params RemoveReviewerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list reviewers of merge request
+// (GET /repos/{owner}/{repository}/mergerequest/{mrSeq}/reviewers)
+func (_ Unimplemented) ListReviewers(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// request users to review merge request
+// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq}/reviewers)
+func (_ Unimplemented) AddReviewers(ctx context.Context, w *JiaozifsResponse, r *http.Request, body AddReviewersJSONRequestBody, owner string, repository string, mrSeq uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list reviews of merge request
+// (GET /repos/{owner}/{repository}/mergerequest/{mrSeq}/reviews)
+func (_ Unimplemented) ListReviews(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// approve or request changes of merge request, review again replace the previous review
+// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq}/reviews)
+func (_ Unimplemented) ReviewMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, body ReviewMergeRequestJSONRequestBody, owner string, repository string, mrSeq uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get combined status of commit
+// (GET /repos/{owner}/{repository}/status/{commit_id})
+func (_ Unimplemented) GetCombinedCommitStatus(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, commitId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list statuses of commit
+// (GET /repos/{owner}/{repository}/statuses/{commit_id})
+func (_ Unimplemented) ListCommitStatuses(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, commitId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// report status of commit, replace status with the same context
+// (POST /repos/{owner}/{repository}/statuses/{commit_id})
+func (_ Unimplemented) CreateCommitStatus(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateCommitStatusJSONRequestBody, owner string, repository string, commitId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// delete tag
+// (DELETE /repos/{owner}/{repository}/tag)
+func (_ Unimplemented) DeleteTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteTagParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get tag
+// (GET /repos/{owner}/{repository}/tag)
+func (_ Unimplemented) GetTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetTagParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// create tag
+// (POST /repos/{owner}/{repository}/tag)
+func (_ Unimplemented) CreateTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateTagJSONRequestBody, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list tags
+// (GET /repos/{owner}/{repository}/tags)
+func (_ Unimplemented) ListTags(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListTagsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// change repository visible(true for public, false for private)
+// (POST /repos/{owner}/{repository}/visible)
+func (_ Unimplemented) ChangeVisible(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ChangeVisibleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// check if jiaozifs setup
+// (GET /setup)
+func (_ Unimplemented) GetSetupState(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// delete aksk
+// (DELETE /users/aksk)
+func (_ Unimplemented) DeleteAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params DeleteAkskParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get aksk
+// (GET /users/aksk)
+func (_ Unimplemented) GetAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params GetAkskParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// create aksk
+// (POST /users/aksk)
+func (_ Unimplemented) CreateAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params CreateAkskParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list aksks
+// (GET /users/aksks)
+func (_ Unimplemented) ListAksks(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListAksksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// refresh token for more time
+// (GET /users/refreshtoken)
+func (_ Unimplemented) RefreshToken(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// perform user registration
+// (POST /users/register)
+func (_ Unimplemented) Register(ctx context.Context, w *JiaozifsResponse, r *http.Request, body RegisterJSONRequestBody) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list repository
+// (GET /users/repos)
+func (_ Unimplemented) ListRepositoryOfAuthenticatedUser(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListRepositoryOfAuthenticatedUserParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// create repository
+// (POST /users/repos)
+func (_ Unimplemented) CreateRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateRepositoryJSONRequestBody) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get information of the currently logged-in user
+// (GET /users/user)
+func (_ Unimplemented) GetUserInfo(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list repository in specific owner
+// (GET /users/{owner}/repos)
+func (_ Unimplemented) ListRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, params ListRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// return program and runtime version
+// (GET /version)
+func (_ Unimplemented) GetVersion(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// remove working in process
+// (DELETE /wip/{owner}/{repository})
+func (_ Unimplemented) DeleteWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteWipParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get working in process
+// (GET /wip/{owner}/{repository})
+func (_ Unimplemented) GetWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetWipParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// update wip
+// (POST /wip/{owner}/{repository})
+func (_ Unimplemented) UpdateWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateWipJSONRequestBody, owner string, repository string, params UpdateWipParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get working in process changes
+// (GET /wip/{owner}/{repository}/changes)
+func (_ Unimplemented) GetWipChanges(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetWipChangesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// commit working in process to branch
+// (POST /wip/{owner}/{repository}/commit)
+func (_ Unimplemented) CommitWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CommitWipJSONRequestBody, owner string, repository string, params CommitWipParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// list wip in specific project and user
+// (GET /wip/{owner}/{repository}/list)
+func (_ Unimplemented) ListWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// revert changes in working in process, empty path will revert all
+// (POST /wip/{owner}/{repository}/revert)
+func (_ Unimplemented) RevertWipChanges(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params RevertWipChangesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Body parse -------------
+	var body LoginJSONRequestBody
+	parseBody := r.ContentLength != 0
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'Login' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(r.Context(), &JiaozifsResponse{w}, r, body)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Logout(r.Context(), &JiaozifsResponse{w}, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListRepoGroup operation middleware
+func (siw *ServerInterfaceWrapper) ListRepoGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRepoGroup(r.Context(), &JiaozifsResponse{w}, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteObject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteObjectParams
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteObject(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetObject operation middleware
+func (siw *ServerInterfaceWrapper) GetObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetObjectParams
+
+	// ------------- Required query parameter "type" -------------
+
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "presign" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "presign", r.URL.Query(), &params.Presign)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "presign", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Range", valueList[0], &Range, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
+			return
+		}
+
+		params.Range = &Range
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetObject(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HeadObject operation middleware
+func (siw *ServerInterfaceWrapper) HeadObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HeadObjectParams
+
+	// ------------- Required query parameter "type" -------------
+
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Range", valueList[0], &Range, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
+			return
+		}
+
+		params.Range = &Range
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HeadObject(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UploadObject operation middleware
+func (siw *ServerInterfaceWrapper) UploadObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadObjectParams
+
+	// ------------- Optional query parameter "isReplace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "isReplace", r.URL.Query(), &params.IsReplace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isReplace", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadObject(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetFiles operation middleware
+func (siw *ServerInterfaceWrapper) GetFiles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFilesParams
+
+	// ------------- Optional query parameter "pattern" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pattern", r.URL.Query(), &params.Pattern)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pattern", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "type" -------------
+
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFiles(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListMultipartUploads operation middleware
+func (siw *ServerInterfaceWrapper) ListMultipartUploads(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMultipartUploadsParams
+
+	// ------------- Optional query parameter "refName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMultipartUploads(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateMultipartUpload operation middleware
+func (siw *ServerInterfaceWrapper) CreateMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateMultipartUploadParams
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMultipartUpload(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AbortMultipartUpload operation middleware
+func (siw *ServerInterfaceWrapper) AbortMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadId", chi.URLParam(r, "uploadId"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AbortMultipartUpload(r.Context(), &JiaozifsResponse{w}, r, owner, repository, uploadId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMultipartUpload operation middleware
+func (siw *ServerInterfaceWrapper) GetMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadId", chi.URLParam(r, "uploadId"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMultipartUpload(r.Context(), &JiaozifsResponse{w}, r, owner, repository, uploadId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CompleteMultipartUpload operation middleware
+func (siw *ServerInterfaceWrapper) CompleteMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadId", chi.URLParam(r, "uploadId"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CompleteMultipartUploadParams
+
+	// ------------- Optional query parameter "isReplace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "isReplace", r.URL.Query(), &params.IsReplace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isReplace", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompleteMultipartUpload(r.Context(), &JiaozifsResponse{w}, r, owner, repository, uploadId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UploadMultipartPart operation middleware
+func (siw *ServerInterfaceWrapper) UploadMultipartPart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadId", chi.URLParam(r, "uploadId"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "partNumber" -------------
+	var partNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "partNumber", chi.URLParam(r, "partNumber"), &partNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "partNumber", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadMultipartPart(r.Context(), &JiaozifsResponse{w}, r, owner, repository, uploadId, partNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPhysicalAddress operation middleware
+func (siw *ServerInterfaceWrapper) GetPhysicalAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPhysicalAddressParams
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPhysicalAddress(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// LinkPhysicalAddress operation middleware
+func (siw *ServerInterfaceWrapper) LinkPhysicalAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Body parse -------------
+	var body LinkPhysicalAddressJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'LinkPhysicalAddress' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LinkPhysicalAddressParams
+
+	// ------------- Optional query parameter "isReplace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "isReplace", r.URL.Query(), &params.IsReplace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isReplace", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LinkPhysicalAddress(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPublicRepository operation middleware
+func (siw *ServerInterfaceWrapper) ListPublicRepository(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPublicRepositoryParams
+
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", r.URL.Query(), &params.Prefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prefix", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "amount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amount", r.URL.Query(), &params.Amount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPublicRepository(r.Context(), &JiaozifsResponse{w}, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteRepository operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteRepositoryParams
+
+	// ------------- Optional query parameter "is_clean_data" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_clean_data", r.URL.Query(), &params.IsCleanData)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "is_clean_data", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRepository(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRepository operation middleware
+func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepository(r.Context(), &JiaozifsResponse{w}, r, owner, repository)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateRepository operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRepository(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Body parse -------------
+	var body UpdateRepositoryJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'UpdateRepository' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRepository(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteMergeApprovalRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMergeApprovalRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMergeApprovalRule(r.Context(), &JiaozifsResponse{w}, r, owner, repository)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMergeApprovalRule operation middleware
+func (siw *ServerInterfaceWrapper) GetMergeApprovalRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMergeApprovalRule(r.Context(), &JiaozifsResponse{w}, r, owner, repository)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetMergeApprovalRule operation middleware
+func (siw *ServerInterfaceWrapper) SetMergeApprovalRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Body parse -------------
+	var body SetMergeApprovalRuleJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'SetMergeApprovalRule' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetMergeApprovalRule(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetArchive operation middleware
+func (siw *ServerInterfaceWrapper) GetArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArchiveParams
+
+	// ------------- Required query parameter "archive_type" -------------
+
+	if paramValue := r.URL.Query().Get("archive_type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "archive_type"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "archive_type", r.URL.Query(), &params.ArchiveType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "archive_type", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "refType" -------------
+
+	if paramValue := r.URL.Query().Get("refType"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refType"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refType", r.URL.Query(), &params.RefType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refType", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArchive(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportArchive operation middleware
+func (siw *ServerInterfaceWrapper) ImportArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportArchiveParams
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "isReplace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "isReplace", r.URL.Query(), &params.IsReplace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isReplace", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportArchive(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBlame operation middleware
+func (siw *ServerInterfaceWrapper) GetBlame(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBlameParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ref" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ref", r.URL.Query(), &params.Ref)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ref", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "type" -------------
+
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBlame(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteBranch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBranch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBranchParams
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBranch(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBranch operation middleware
+func (siw *ServerInterfaceWrapper) GetBranch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBranchParams
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBranch(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateBranch operation middleware
+func (siw *ServerInterfaceWrapper) CreateBranch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Body parse -------------
+	var body CreateBranchJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'CreateBranch' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBranch(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CherryPick operation middleware
+func (siw *ServerInterfaceWrapper) CherryPick(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Body parse -------------
+	var body CherryPickJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'CherryPick' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CherryPickParams
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CherryPick(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListBranchReflog operation middleware
+func (siw *ServerInterfaceWrapper) ListBranchReflog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBranchReflogParams
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
 
-type Unimplemented struct{}
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
 
-// perform a login
-// (POST /auth/login)
-func (_ Unimplemented) Login(ctx context.Context, w *JiaozifsResponse, r *http.Request, body LoginJSONRequestBody) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "amount" -------------
 
-// perform a logout
-// (POST /auth/logout)
-func (_ Unimplemented) Logout(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "amount", r.URL.Query(), &params.Amount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount", Err: err})
+		return
+	}
 
-// list groups for repo
-// (GET /groups/repo)
-func (_ Unimplemented) ListRepoGroup(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBranchReflog(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
 
-// delete object. Missing objects will not return a NotFound error.
-// (DELETE /object/{owner}/{repository})
-func (_ Unimplemented) DeleteObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteObjectParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// get object content
-// (GET /object/{owner}/{repository})
-func (_ Unimplemented) GetObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetObjectParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// check if object exists
-// (HEAD /object/{owner}/{repository})
-func (_ Unimplemented) HeadObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params HeadObjectParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// RestoreBranch operation middleware
+func (siw *ServerInterfaceWrapper) RestoreBranch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// (POST /object/{owner}/{repository})
-func (_ Unimplemented) UploadObject(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params UploadObjectParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// get files by pattern
-// (GET /object/{owner}/{repository}/files)
-func (_ Unimplemented) GetFiles(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetFilesParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Body parse -------------
+	var body RestoreBranchJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'RestoreBranch' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
 
-// list public repository in all system
-// (GET /repos/public)
-func (_ Unimplemented) ListPublicRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListPublicRepositoryParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "owner" -------------
+	var owner string
 
-// delete repository
-// (DELETE /repos/{owner}/{repository})
-func (_ Unimplemented) DeleteRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteRepositoryParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
 
-// get repository
-// (GET /repos/{owner}/{repository})
-func (_ Unimplemented) GetRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "repository" -------------
+	var repository string
 
-// update repository
-// (POST /repos/{owner}/{repository})
-func (_ Unimplemented) UpdateRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateRepositoryJSONRequestBody, owner string, repository string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
 
-// get repo files archive
-// (GET /repos/{owner}/{repository}/archive)
-func (_ Unimplemented) GetArchive(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetArchiveParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
-// delete branch
-// (DELETE /repos/{owner}/{repository}/branch)
-func (_ Unimplemented) DeleteBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteBranchParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-// get branch
-// (GET /repos/{owner}/{repository}/branch)
-func (_ Unimplemented) GetBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetBranchParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
 
-// create branch
-// (POST /repos/{owner}/{repository}/branch)
-func (_ Unimplemented) CreateBranch(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateBranchJSONRequestBody, owner string, repository string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
 
-// list branches
-// (GET /repos/{owner}/{repository}/branches)
-func (_ Unimplemented) ListBranches(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListBranchesParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
 
-// get changes in commit
-// (GET /repos/{owner}/{repository}/changes/{commit_id})
-func (_ Unimplemented) GetCommitChanges(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, commitId string, params GetCommitChangesParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
 
-// get commits in ref
-// (GET /repos/{owner}/{repository}/commits)
-func (_ Unimplemented) GetCommitsInRef(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetCommitsInRefParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreBranchParams
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreBranch(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// compare two commit
-// (GET /repos/{owner}/{repository}/compare/{basehead})
-func (_ Unimplemented) CompareCommit(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, basehead string, params CompareCommitParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// RevertCommit operation middleware
+func (siw *ServerInterfaceWrapper) RevertCommit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Body parse -------------
+	var body RevertCommitJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'RevertCommit' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevertCommitParams
+
+	// ------------- Required query parameter "refName" -------------
+
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevertCommit(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// list entries in ref
-// (GET /repos/{owner}/{repository}/contents)
-func (_ Unimplemented) GetEntriesInRef(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetEntriesInRefParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// UndeleteBranch operation middleware
+func (siw *ServerInterfaceWrapper) UndeleteBranch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
 
-// Revoke member in repository
-// (DELETE /repos/{owner}/{repository}/member)
-func (_ Unimplemented) RevokeMember(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params RevokeMemberParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "repository" -------------
+	var repository string
 
-// update member by user id and change group role
-// (POST /repos/{owner}/{repository}/member)
-func (_ Unimplemented) UpdateMemberGroup(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params UpdateMemberGroupParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
 
-// invite member
-// (POST /repos/{owner}/{repository}/member/invite)
-func (_ Unimplemented) InviteMember(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params InviteMemberParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
-// get list of members in repository
-// (GET /repos/{owner}/{repository}/members)
-func (_ Unimplemented) ListMembers(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-// get list of merge request in repository
-// (GET /repos/{owner}/{repository}/mergerequest)
-func (_ Unimplemented) ListMergeRequests(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListMergeRequestsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
 
-// create merge request
-// (POST /repos/{owner}/{repository}/mergerequest)
-func (_ Unimplemented) CreateMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateMergeRequestJSONRequestBody, owner string, repository string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
 
-// get merge request
-// (GET /repos/{owner}/{repository}/mergerequest/{mrSeq})
-func (_ Unimplemented) GetMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, mrSeq uint64) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
 
-// update merge request
-// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq})
-func (_ Unimplemented) UpdateMergeRequest(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateMergeRequestJSONRequestBody, owner string, repository string, mrSeq uint64) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
 
-// merge a mergerequest
-// (POST /repos/{owner}/{repository}/mergerequest/{mrSeq}/merge)
-func (_ Unimplemented) Merge(ctx context.Context, w *JiaozifsResponse, r *http.Request, body MergeJSONRequestBody, owner string, repository string, mrSeq uint64) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
 
-// delete tag
-// (DELETE /repos/{owner}/{repository}/tag)
-func (_ Unimplemented) DeleteTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteTagParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-// get tag
-// (GET /repos/{owner}/{repository}/tag)
-func (_ Unimplemented) GetTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetTagParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UndeleteBranchParams
 
-// create tag
-// (POST /repos/{owner}/{repository}/tag)
-func (_ Unimplemented) CreateTag(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateTagJSONRequestBody, owner string, repository string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Required query parameter "refName" -------------
 
-// list tags
-// (GET /repos/{owner}/{repository}/tags)
-func (_ Unimplemented) ListTags(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ListTagsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
 
-// change repository visible(true for public, false for private)
-// (POST /repos/{owner}/{repository}/visible)
-func (_ Unimplemented) ChangeVisible(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params ChangeVisibleParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		return
+	}
 
-// check if jiaozifs setup
-// (GET /setup)
-func (_ Unimplemented) GetSetupState(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		return
+	}
 
-// delete aksk
-// (DELETE /users/aksk)
-func (_ Unimplemented) DeleteAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params DeleteAkskParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UndeleteBranch(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
 
-// get aksk
-// (GET /users/aksk)
-func (_ Unimplemented) GetAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params GetAkskParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// create aksk
-// (POST /users/aksk)
-func (_ Unimplemented) CreateAksk(ctx context.Context, w *JiaozifsResponse, r *http.Request, params CreateAkskParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// list aksks
-// (GET /users/aksks)
-func (_ Unimplemented) ListAksks(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListAksksParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ListBranchProtectionRules operation middleware
+func (siw *ServerInterfaceWrapper) ListBranchProtectionRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// refresh token for more time
-// (GET /users/refreshtoken)
-func (_ Unimplemented) RefreshToken(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// perform user registration
-// (POST /users/register)
-func (_ Unimplemented) Register(ctx context.Context, w *JiaozifsResponse, r *http.Request, body RegisterJSONRequestBody) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "owner" -------------
+	var owner string
 
-// list repository
-// (GET /users/repos)
-func (_ Unimplemented) ListRepositoryOfAuthenticatedUser(ctx context.Context, w *JiaozifsResponse, r *http.Request, params ListRepositoryOfAuthenticatedUserParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
 
-// create repository
-// (POST /users/repos)
-func (_ Unimplemented) CreateRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, body CreateRepositoryJSONRequestBody) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "repository" -------------
+	var repository string
 
-// get information of the currently logged-in user
-// (GET /users/user)
-func (_ Unimplemented) GetUserInfo(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
 
-// list repository in specific owner
-// (GET /users/{owner}/repos)
-func (_ Unimplemented) ListRepository(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, params ListRepositoryParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
-// return program and runtime version
-// (GET /version)
-func (_ Unimplemented) GetVersion(ctx context.Context, w *JiaozifsResponse, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-// remove working in process
-// (DELETE /wip/{owner}/{repository})
-func (_ Unimplemented) DeleteWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params DeleteWipParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
 
-// get working in process
-// (GET /wip/{owner}/{repository})
-func (_ Unimplemented) GetWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetWipParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
 
-// update wip
-// (POST /wip/{owner}/{repository})
-func (_ Unimplemented) UpdateWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, body UpdateWipJSONRequestBody, owner string, repository string, params UpdateWipParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
 
-// get working in process changes
-// (GET /wip/{owner}/{repository}/changes)
-func (_ Unimplemented) GetWipChanges(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params GetWipChangesParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
 
-// commit working in process to branch
-// (POST /wip/{owner}/{repository}/commit)
-func (_ Unimplemented) CommitWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params CommitWipParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
 
-// list wip in specific project and user
-// (GET /wip/{owner}/{repository}/list)
-func (_ Unimplemented) ListWip(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-// revert changes in working in process, empty path will revert all
-// (POST /wip/{owner}/{repository}/revert)
-func (_ Unimplemented) RevertWipChanges(ctx context.Context, w *JiaozifsResponse, r *http.Request, owner string, repository string, params RevertWipChangesParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBranchProtectionRules(r.Context(), &JiaozifsResponse{w}, r, owner, repository)
+	}))
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+// CreateBranchProtectionRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateBranchProtectionRule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
 	// ------------- Body parse -------------
-	var body LoginJSONRequestBody
-	parseBody := r.ContentLength != 0
+	var body CreateBranchProtectionRuleJSONRequestBody
+	parseBody := true
 	if parseBody {
 		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-			http.Error(w, "Error unmarshalling body 'Login' as JSON", http.StatusBadRequest)
+			http.Error(w, "Error unmarshalling body 'CreateBranchProtectionRule' as JSON", http.StatusBadRequest)
 			return
 		}
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(r.Context(), &JiaozifsResponse{w}, r, body)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Path parameter "repository" -------------
+	var repository string
 
-// Logout operation middleware
-func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
 
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
@@ -8961,7 +18052,7 @@ func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Logout(r.Context(), &JiaozifsResponse{w}, r)
+		siw.Handler.CreateBranchProtectionRule(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8971,10 +18062,39 @@ func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListRepoGroup operation middleware
-func (siw *ServerInterfaceWrapper) ListRepoGroup(w http.ResponseWriter, r *http.Request) {
+// DeleteBranchProtectionRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBranchProtectionRule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", chi.URLParam(r, "ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -8992,7 +18112,7 @@ func (siw *ServerInterfaceWrapper) ListRepoGroup(w http.ResponseWriter, r *http.
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRepoGroup(r.Context(), &JiaozifsResponse{w}, r)
+		siw.Handler.DeleteBranchProtectionRule(r.Context(), &JiaozifsResponse{w}, r, owner, repository, ruleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9002,8 +18122,8 @@ func (siw *ServerInterfaceWrapper) ListRepoGroup(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteObject operation middleware
-func (siw *ServerInterfaceWrapper) DeleteObject(w http.ResponseWriter, r *http.Request) {
+// ListBranches operation middleware
+func (siw *ServerInterfaceWrapper) ListBranches(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -9043,40 +18163,105 @@ func (siw *ServerInterfaceWrapper) DeleteObject(w http.ResponseWriter, r *http.R
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteObjectParams
+	var params ListBranchesParams
 
-	// ------------- Required query parameter "refName" -------------
+	// ------------- Optional query parameter "prefix" -------------
 
-	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "prefix", r.URL.Query(), &params.Prefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prefix", Err: err})
+		return
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	// ------------- Optional query parameter "amount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amount", r.URL.Query(), &params.Amount)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "path" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBranches(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCommitChanges operation middleware
+func (siw *ServerInterfaceWrapper) GetCommitChanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "commit_id" -------------
+	var commitId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commit_id", chi.URLParam(r, "commit_id"), &commitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commit_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCommitChangesParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteObject(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.GetCommitChanges(r.Context(), &JiaozifsResponse{w}, r, owner, repository, commitId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9086,8 +18271,8 @@ func (siw *ServerInterfaceWrapper) DeleteObject(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetObject operation middleware
-func (siw *ServerInterfaceWrapper) GetObject(w http.ResponseWriter, r *http.Request) {
+// GetCommitsInRef operation middleware
+func (siw *ServerInterfaceWrapper) GetCommitsInRef(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -9127,76 +18312,129 @@ func (siw *ServerInterfaceWrapper) GetObject(w http.ResponseWriter, r *http.Requ
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetObjectParams
+	var params GetCommitsInRefParams
 
-	// ------------- Required query parameter "type" -------------
+	// ------------- Optional query parameter "after" -------------
 
-	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
+	// ------------- Optional query parameter "amount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amount", r.URL.Query(), &params.Amount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
+	// ------------- Optional query parameter "refName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "refName", r.URL.Query(), &params.RefName)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "refName" -------------
+	// ------------- Optional query parameter "path" -------------
 
-	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metadata", r.URL.Query(), &params.Metadata)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metadata", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "path" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCommitsInRef(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+	}))
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CompareCommit operation middleware
+func (siw *ServerInterfaceWrapper) CompareCommit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
 		return
 	}
 
-	headers := r.Header
+	// ------------- Path parameter "basehead" -------------
+	var basehead string
 
-	// ------------- Optional header parameter "Range" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
-		var Range string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
-			return
-		}
+	err = runtime.BindStyledParameterWithOptions("simple", "basehead", chi.URLParam(r, "basehead"), &basehead, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "basehead", Err: err})
+		return
+	}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "Range", valueList[0], &Range, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
-			return
-		}
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CompareCommitParams
 
-		params.Range = &Range
+	// ------------- Optional query parameter "path" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetObject(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.CompareCommit(r.Context(), &JiaozifsResponse{w}, r, owner, repository, basehead, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9206,8 +18444,8 @@ func (siw *ServerInterfaceWrapper) GetObject(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HeadObject operation middleware
-func (siw *ServerInterfaceWrapper) HeadObject(w http.ResponseWriter, r *http.Request) {
+// CompareTable operation middleware
+func (siw *ServerInterfaceWrapper) CompareTable(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -9230,6 +18468,15 @@ func (siw *ServerInterfaceWrapper) HeadObject(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Path parameter "basehead" -------------
+	var basehead string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "basehead", chi.URLParam(r, "basehead"), &basehead, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "basehead", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -9247,37 +18494,7 @@ func (siw *ServerInterfaceWrapper) HeadObject(w http.ResponseWriter, r *http.Req
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params HeadObjectParams
-
-	// ------------- Required query parameter "type" -------------
-
-	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
-	}
-
-	// ------------- Required query parameter "refName" -------------
-
-	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
-		return
-	}
+	var params CompareTableParams
 
 	// ------------- Required query parameter "path" -------------
 
@@ -9294,29 +18511,24 @@ func (siw *ServerInterfaceWrapper) HeadObject(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	headers := r.Header
-
-	// ------------- Optional header parameter "Range" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
-		var Range string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
-			return
-		}
+	// ------------- Optional query parameter "primaryKey" -------------
 
-		err = runtime.BindStyledParameterWithOptions("simple", "Range", valueList[0], &Range, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
-			return
-		}
+	err = runtime.BindQueryParameter("form", true, false, "primaryKey", r.URL.Query(), &params.PrimaryKey)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "primaryKey", Err: err})
+		return
+	}
 
-		params.Range = &Range
+	// ------------- Optional query parameter "sampleSize" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "sampleSize", r.URL.Query(), &params.SampleSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sampleSize", Err: err})
+		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HeadObject(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.CompareTable(r.Context(), &JiaozifsResponse{w}, r, owner, repository, basehead, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9326,8 +18538,8 @@ func (siw *ServerInterfaceWrapper) HeadObject(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UploadObject operation middleware
-func (siw *ServerInterfaceWrapper) UploadObject(w http.ResponseWriter, r *http.Request) {
+// GetEntriesInRef operation middleware
+func (siw *ServerInterfaceWrapper) GetEntriesInRef(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -9367,48 +18579,41 @@ func (siw *ServerInterfaceWrapper) UploadObject(w http.ResponseWriter, r *http.R
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UploadObjectParams
+	var params GetEntriesInRefParams
 
-	// ------------- Optional query parameter "isReplace" -------------
+	// ------------- Optional query parameter "path" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "isReplace", r.URL.Query(), &params.IsReplace)
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isReplace", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "refName" -------------
-
-	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
-		return
-	}
+	// ------------- Optional query parameter "ref" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	err = runtime.BindQueryParameter("form", true, false, "ref", r.URL.Query(), &params.Ref)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ref", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "path" -------------
+	// ------------- Required query parameter "type" -------------
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadObject(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.GetEntriesInRef(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9418,8 +18623,8 @@ func (siw *ServerInterfaceWrapper) UploadObject(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetFiles operation middleware
-func (siw *ServerInterfaceWrapper) GetFiles(w http.ResponseWriter, r *http.Request) {
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -9458,49 +18663,69 @@ func (siw *ServerInterfaceWrapper) GetFiles(w http.ResponseWriter, r *http.Reque
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFilesParams
-
-	// ------------- Optional query parameter "pattern" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(r.Context(), &JiaozifsResponse{w}, r, owner, repository)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "pattern", r.URL.Query(), &params.Pattern)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pattern", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Required query parameter "type" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
-		return
-	}
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
+	// ------------- Body parse -------------
+	var body CreateWebhookJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'CreateWebhook' as JSON", http.StatusBadRequest)
+			return
+		}
 	}
 
-	// ------------- Required query parameter "refName" -------------
-
-	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+	// ------------- Path parameter "owner" -------------
+	var owner string
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFiles(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.CreateWebhook(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9510,12 +18735,39 @@ func (siw *ServerInterfaceWrapper) GetFiles(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListPublicRepository operation middleware
-func (siw *ServerInterfaceWrapper) ListPublicRepository(w http.ResponseWriter, r *http.Request) {
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repository" -------------
+	var repository string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "hookId" -------------
+	var hookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hookId", chi.URLParam(r, "hookId"), &hookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hookId", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -9532,35 +18784,68 @@ func (siw *ServerInterfaceWrapper) ListPublicRepository(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPublicRepositoryParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(r.Context(), &JiaozifsResponse{w}, r, owner, repository, hookId)
+	}))
 
-	// ------------- Optional query parameter "prefix" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "prefix", r.URL.Query(), &params.Prefix)
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prefix", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "after" -------------
+	// ------------- Path parameter "repository" -------------
+	var repository string
 
-	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "amount" -------------
+	// ------------- Path parameter "hookId" -------------
+	var hookId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "amount", r.URL.Query(), &params.Amount)
+	err = runtime.BindStyledParameterWithOptions("simple", "hookId", chi.URLParam(r, "hookId"), &hookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hookId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPublicRepository(r.Context(), &JiaozifsResponse{w}, r, params)
+		siw.Handler.GetWebhook(r.Context(), &JiaozifsResponse{w}, r, owner, repository, hookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9570,12 +18855,22 @@ func (siw *ServerInterfaceWrapper) ListPublicRepository(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteRepository operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *http.Request) {
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Body parse -------------
+	var body UpdateWebhookJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'UpdateWebhook' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
 	// ------------- Path parameter "owner" -------------
 	var owner string
 
@@ -9594,6 +18889,15 @@ func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Path parameter "hookId" -------------
+	var hookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hookId", chi.URLParam(r, "hookId"), &hookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hookId", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -9610,19 +18914,8 @@ func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteRepositoryParams
-
-	// ------------- Optional query parameter "is_clean_data" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "is_clean_data", r.URL.Query(), &params.IsCleanData)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "is_clean_data", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRepository(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.UpdateWebhook(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, hookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9632,8 +18925,8 @@ func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetRepository operation middleware
-func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.Request) {
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -9656,6 +18949,15 @@ func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// ------------- Path parameter "hookId" -------------
+	var hookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hookId", chi.URLParam(r, "hookId"), &hookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hookId", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -9672,8 +18974,19 @@ func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "amount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amount", r.URL.Query(), &params.Amount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRepository(r.Context(), &JiaozifsResponse{w}, r, owner, repository)
+		siw.Handler.ListWebhookDeliveries(r.Context(), &JiaozifsResponse{w}, r, owner, repository, hookId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9683,22 +18996,12 @@ func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateRepository operation middleware
-func (siw *ServerInterfaceWrapper) UpdateRepository(w http.ResponseWriter, r *http.Request) {
+// RevokeMember operation middleware
+func (siw *ServerInterfaceWrapper) RevokeMember(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Body parse -------------
-	var body UpdateRepositoryJSONRequestBody
-	parseBody := true
-	if parseBody {
-		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-			http.Error(w, "Error unmarshalling body 'UpdateRepository' as JSON", http.StatusBadRequest)
-			return
-		}
-	}
-
 	// ------------- Path parameter "owner" -------------
 	var owner string
 
@@ -9733,8 +19036,26 @@ func (siw *ServerInterfaceWrapper) UpdateRepository(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevokeMemberParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateRepository(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository)
+		siw.Handler.RevokeMember(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9744,8 +19065,8 @@ func (siw *ServerInterfaceWrapper) UpdateRepository(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetArchive operation middleware
-func (siw *ServerInterfaceWrapper) GetArchive(w http.ResponseWriter, r *http.Request) {
+// UpdateMemberGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMemberGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -9785,55 +19106,40 @@ func (siw *ServerInterfaceWrapper) GetArchive(w http.ResponseWriter, r *http.Req
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArchiveParams
-
-	// ------------- Required query parameter "archive_type" -------------
-
-	if paramValue := r.URL.Query().Get("archive_type"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "archive_type"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "archive_type", r.URL.Query(), &params.ArchiveType)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "archive_type", Err: err})
-		return
-	}
+	var params UpdateMemberGroupParams
 
-	// ------------- Required query parameter "refType" -------------
+	// ------------- Required query parameter "user_id" -------------
 
-	if paramValue := r.URL.Query().Get("refType"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refType"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "refType", r.URL.Query(), &params.RefType)
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refType", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "refName" -------------
+	// ------------- Required query parameter "group_id" -------------
 
-	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("group_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "group_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	err = runtime.BindQueryParameter("form", true, true, "group_id", r.URL.Query(), &params.GroupId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArchive(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.UpdateMemberGroup(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9843,8 +19149,8 @@ func (siw *ServerInterfaceWrapper) GetArchive(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteBranch operation middleware
-func (siw *ServerInterfaceWrapper) DeleteBranch(w http.ResponseWriter, r *http.Request) {
+// InviteMember operation middleware
+func (siw *ServerInterfaceWrapper) InviteMember(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -9884,25 +19190,40 @@ func (siw *ServerInterfaceWrapper) DeleteBranch(w http.ResponseWriter, r *http.R
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteBranchParams
+	var params InviteMemberParams
 
-	// ------------- Required query parameter "refName" -------------
+	// ------------- Required query parameter "user_id" -------------
 
-	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "group_id" -------------
+
+	if paramValue := r.URL.Query().Get("group_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "group_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "group_id", r.URL.Query(), &params.GroupId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteBranch(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.InviteMember(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9912,8 +19233,8 @@ func (siw *ServerInterfaceWrapper) DeleteBranch(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetBranch operation middleware
-func (siw *ServerInterfaceWrapper) GetBranch(w http.ResponseWriter, r *http.Request) {
+// ListMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListMembers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -9952,26 +19273,8 @@ func (siw *ServerInterfaceWrapper) GetBranch(w http.ResponseWriter, r *http.Requ
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBranchParams
-
-	// ------------- Required query parameter "refName" -------------
-
-	if paramValue := r.URL.Query().Get("refName"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "refName"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "refName", r.URL.Query(), &params.RefName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBranch(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.ListMembers(r.Context(), &JiaozifsResponse{w}, r, owner, repository)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9981,22 +19284,12 @@ func (siw *ServerInterfaceWrapper) GetBranch(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateBranch operation middleware
-func (siw *ServerInterfaceWrapper) CreateBranch(w http.ResponseWriter, r *http.Request) {
+// ListMergeRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListMergeRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Body parse -------------
-	var body CreateBranchJSONRequestBody
-	parseBody := true
-	if parseBody {
-		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-			http.Error(w, "Error unmarshalling body 'CreateBranch' as JSON", http.StatusBadRequest)
-			return
-		}
-	}
-
 	// ------------- Path parameter "owner" -------------
 	var owner string
 
@@ -10031,8 +19324,35 @@ func (siw *ServerInterfaceWrapper) CreateBranch(w http.ResponseWriter, r *http.R
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMergeRequestsParams
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "amount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amount", r.URL.Query(), &params.Amount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateBranch(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository)
+		siw.Handler.ListMergeRequests(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10042,12 +19362,22 @@ func (siw *ServerInterfaceWrapper) CreateBranch(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListBranches operation middleware
-func (siw *ServerInterfaceWrapper) ListBranches(w http.ResponseWriter, r *http.Request) {
+// CreateMergeRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateMergeRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Body parse -------------
+	var body CreateMergeRequestJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'CreateMergeRequest' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
 	// ------------- Path parameter "owner" -------------
 	var owner string
 
@@ -10080,37 +19410,10 @@ func (siw *ServerInterfaceWrapper) ListBranches(w http.ResponseWriter, r *http.R
 
 	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
 
-	ctx = context.WithValue(ctx, TimestampScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListBranchesParams
-
-	// ------------- Optional query parameter "prefix" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "prefix", r.URL.Query(), &params.Prefix)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prefix", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "after" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "amount" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "amount", r.URL.Query(), &params.Amount)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListBranches(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.CreateMergeRequest(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10120,8 +19423,8 @@ func (siw *ServerInterfaceWrapper) ListBranches(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCommitChanges operation middleware
-func (siw *ServerInterfaceWrapper) GetCommitChanges(w http.ResponseWriter, r *http.Request) {
+// GetMergeRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetMergeRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -10144,12 +19447,12 @@ func (siw *ServerInterfaceWrapper) GetCommitChanges(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "commit_id" -------------
-	var commitId string
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "commit_id", chi.URLParam(r, "commit_id"), &commitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commit_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
 		return
 	}
 
@@ -10169,19 +19472,8 @@ func (siw *ServerInterfaceWrapper) GetCommitChanges(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCommitChangesParams
-
-	// ------------- Optional query parameter "path" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCommitChanges(r.Context(), &JiaozifsResponse{w}, r, owner, repository, commitId, params)
+		siw.Handler.GetMergeRequest(r.Context(), &JiaozifsResponse{w}, r, owner, repository, mrSeq)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10191,12 +19483,22 @@ func (siw *ServerInterfaceWrapper) GetCommitChanges(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCommitsInRef operation middleware
-func (siw *ServerInterfaceWrapper) GetCommitsInRef(w http.ResponseWriter, r *http.Request) {
+// UpdateMergeRequest operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMergeRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Body parse -------------
+	var body UpdateMergeRequestJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'UpdateMergeRequest' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
 	// ------------- Path parameter "owner" -------------
 	var owner string
 
@@ -10215,6 +19517,15 @@ func (siw *ServerInterfaceWrapper) GetCommitsInRef(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -10231,35 +19542,8 @@ func (siw *ServerInterfaceWrapper) GetCommitsInRef(w http.ResponseWriter, r *htt
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCommitsInRefParams
-
-	// ------------- Optional query parameter "after" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "amount" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "amount", r.URL.Query(), &params.Amount)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "refName" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "refName", r.URL.Query(), &params.RefName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refName", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCommitsInRef(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.UpdateMergeRequest(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, mrSeq)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10269,8 +19553,8 @@ func (siw *ServerInterfaceWrapper) GetCommitsInRef(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CompareCommit operation middleware
-func (siw *ServerInterfaceWrapper) CompareCommit(w http.ResponseWriter, r *http.Request) {
+// ListMergeRequestComments operation middleware
+func (siw *ServerInterfaceWrapper) ListMergeRequestComments(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -10293,12 +19577,12 @@ func (siw *ServerInterfaceWrapper) CompareCommit(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Path parameter "basehead" -------------
-	var basehead string
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "basehead", chi.URLParam(r, "basehead"), &basehead, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "basehead", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
 		return
 	}
 
@@ -10318,19 +19602,8 @@ func (siw *ServerInterfaceWrapper) CompareCommit(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CompareCommitParams
-
-	// ------------- Optional query parameter "path" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CompareCommit(r.Context(), &JiaozifsResponse{w}, r, owner, repository, basehead, params)
+		siw.Handler.ListMergeRequestComments(r.Context(), &JiaozifsResponse{w}, r, owner, repository, mrSeq)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10340,12 +19613,22 @@ func (siw *ServerInterfaceWrapper) CompareCommit(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetEntriesInRef operation middleware
-func (siw *ServerInterfaceWrapper) GetEntriesInRef(w http.ResponseWriter, r *http.Request) {
+// CreateMergeRequestComment operation middleware
+func (siw *ServerInterfaceWrapper) CreateMergeRequestComment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Body parse -------------
+	var body CreateMergeRequestCommentJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'CreateMergeRequestComment' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
 	// ------------- Path parameter "owner" -------------
 	var owner string
 
@@ -10364,6 +19647,15 @@ func (siw *ServerInterfaceWrapper) GetEntriesInRef(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -10380,42 +19672,77 @@ func (siw *ServerInterfaceWrapper) GetEntriesInRef(w http.ResponseWriter, r *htt
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEntriesInRefParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMergeRequestComment(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, mrSeq)
+	}))
 
-	// ------------- Optional query parameter "path" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteMergeRequestComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMergeRequestComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "ref" -------------
+	// ------------- Path parameter "repository" -------------
+	var repository string
 
-	err = runtime.BindQueryParameter("form", true, false, "ref", r.URL.Query(), &params.Ref)
+	err = runtime.BindStyledParameterWithOptions("simple", "repository", chi.URLParam(r, "repository"), &repository, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "type" -------------
-
-	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, Cookie_authScopes, []string{})
+
+	ctx = context.WithValue(ctx, JiaozifsAccessKeyIdScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureMethodScopes, []string{})
+
+	ctx = context.WithValue(ctx, SignatureVersionScopes, []string{})
+
+	ctx = context.WithValue(ctx, TimestampScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEntriesInRef(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.DeleteMergeRequestComment(r.Context(), &JiaozifsResponse{w}, r, owner, repository, mrSeq, commentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10425,12 +19752,22 @@ func (siw *ServerInterfaceWrapper) GetEntriesInRef(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RevokeMember operation middleware
-func (siw *ServerInterfaceWrapper) RevokeMember(w http.ResponseWriter, r *http.Request) {
+// Merge operation middleware
+func (siw *ServerInterfaceWrapper) Merge(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Body parse -------------
+	var body MergeJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'Merge' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
 	// ------------- Path parameter "owner" -------------
 	var owner string
 
@@ -10449,6 +19786,15 @@ func (siw *ServerInterfaceWrapper) RevokeMember(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -10465,26 +19811,8 @@ func (siw *ServerInterfaceWrapper) RevokeMember(w http.ResponseWriter, r *http.R
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params RevokeMemberParams
-
-	// ------------- Required query parameter "user_id" -------------
-
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RevokeMember(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.Merge(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, mrSeq)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10494,8 +19822,8 @@ func (siw *ServerInterfaceWrapper) RevokeMember(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateMemberGroup operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMemberGroup(w http.ResponseWriter, r *http.Request) {
+// RemoveReviewer operation middleware
+func (siw *ServerInterfaceWrapper) RemoveReviewer(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -10518,6 +19846,15 @@ func (siw *ServerInterfaceWrapper) UpdateMemberGroup(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -10534,41 +19871,26 @@ func (siw *ServerInterfaceWrapper) UpdateMemberGroup(w http.ResponseWriter, r *h
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params UpdateMemberGroupParams
-
-	// ------------- Required query parameter "user_id" -------------
-
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemoveReviewerParams
 
-	// ------------- Required query parameter "group_id" -------------
+	// ------------- Required query parameter "reviewer" -------------
 
-	if paramValue := r.URL.Query().Get("group_id"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("reviewer"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "group_id"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "reviewer"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "group_id", r.URL.Query(), &params.GroupId)
+	err = runtime.BindQueryParameter("form", true, true, "reviewer", r.URL.Query(), &params.Reviewer)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateMemberGroup(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.RemoveReviewer(r.Context(), &JiaozifsResponse{w}, r, owner, repository, mrSeq, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10578,8 +19900,8 @@ func (siw *ServerInterfaceWrapper) UpdateMemberGroup(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// InviteMember operation middleware
-func (siw *ServerInterfaceWrapper) InviteMember(w http.ResponseWriter, r *http.Request) {
+// ListReviewers operation middleware
+func (siw *ServerInterfaceWrapper) ListReviewers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -10602,6 +19924,15 @@ func (siw *ServerInterfaceWrapper) InviteMember(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -10618,41 +19949,8 @@ func (siw *ServerInterfaceWrapper) InviteMember(w http.ResponseWriter, r *http.R
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params InviteMemberParams
-
-	// ------------- Required query parameter "user_id" -------------
-
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
-	}
-
-	// ------------- Required query parameter "group_id" -------------
-
-	if paramValue := r.URL.Query().Get("group_id"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "group_id"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "group_id", r.URL.Query(), &params.GroupId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.InviteMember(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.ListReviewers(r.Context(), &JiaozifsResponse{w}, r, owner, repository, mrSeq)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10662,12 +19960,22 @@ func (siw *ServerInterfaceWrapper) InviteMember(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListMembers operation middleware
-func (siw *ServerInterfaceWrapper) ListMembers(w http.ResponseWriter, r *http.Request) {
+// AddReviewers operation middleware
+func (siw *ServerInterfaceWrapper) AddReviewers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Body parse -------------
+	var body AddReviewersJSONRequestBody
+	parseBody := true
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'AddReviewers' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
 	// ------------- Path parameter "owner" -------------
 	var owner string
 
@@ -10686,6 +19994,15 @@ func (siw *ServerInterfaceWrapper) ListMembers(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -10703,7 +20020,7 @@ func (siw *ServerInterfaceWrapper) ListMembers(w http.ResponseWriter, r *http.Re
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListMembers(r.Context(), &JiaozifsResponse{w}, r, owner, repository)
+		siw.Handler.AddReviewers(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, mrSeq)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10713,8 +20030,8 @@ func (siw *ServerInterfaceWrapper) ListMembers(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListMergeRequests operation middleware
-func (siw *ServerInterfaceWrapper) ListMergeRequests(w http.ResponseWriter, r *http.Request) {
+// ListReviews operation middleware
+func (siw *ServerInterfaceWrapper) ListReviews(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -10737,6 +20054,15 @@ func (siw *ServerInterfaceWrapper) ListMergeRequests(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -10753,35 +20079,8 @@ func (siw *ServerInterfaceWrapper) ListMergeRequests(w http.ResponseWriter, r *h
 
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListMergeRequestsParams
-
-	// ------------- Optional query parameter "after" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "amount" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "amount", r.URL.Query(), &params.Amount)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "state" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListMergeRequests(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.ListReviews(r.Context(), &JiaozifsResponse{w}, r, owner, repository, mrSeq)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10791,18 +20090,18 @@ func (siw *ServerInterfaceWrapper) ListMergeRequests(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateMergeRequest operation middleware
-func (siw *ServerInterfaceWrapper) CreateMergeRequest(w http.ResponseWriter, r *http.Request) {
+// ReviewMergeRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReviewMergeRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// ------------- Body parse -------------
-	var body CreateMergeRequestJSONRequestBody
+	var body ReviewMergeRequestJSONRequestBody
 	parseBody := true
 	if parseBody {
 		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-			http.Error(w, "Error unmarshalling body 'CreateMergeRequest' as JSON", http.StatusBadRequest)
+			http.Error(w, "Error unmarshalling body 'ReviewMergeRequest' as JSON", http.StatusBadRequest)
 			return
 		}
 	}
@@ -10825,6 +20124,15 @@ func (siw *ServerInterfaceWrapper) CreateMergeRequest(w http.ResponseWriter, r *
 		return
 	}
 
+	// ------------- Path parameter "mrSeq" -------------
+	var mrSeq uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Jwt_tokenScopes, []string{})
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
@@ -10842,7 +20150,7 @@ func (siw *ServerInterfaceWrapper) CreateMergeRequest(w http.ResponseWriter, r *
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateMergeRequest(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository)
+		siw.Handler.ReviewMergeRequest(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, mrSeq)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10852,8 +20160,8 @@ func (siw *ServerInterfaceWrapper) CreateMergeRequest(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetMergeRequest operation middleware
-func (siw *ServerInterfaceWrapper) GetMergeRequest(w http.ResponseWriter, r *http.Request) {
+// GetCombinedCommitStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetCombinedCommitStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -10876,12 +20184,12 @@ func (siw *ServerInterfaceWrapper) GetMergeRequest(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// ------------- Path parameter "mrSeq" -------------
-	var mrSeq uint64
+	// ------------- Path parameter "commit_id" -------------
+	var commitId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "commit_id", chi.URLParam(r, "commit_id"), &commitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commit_id", Err: err})
 		return
 	}
 
@@ -10902,7 +20210,7 @@ func (siw *ServerInterfaceWrapper) GetMergeRequest(w http.ResponseWriter, r *htt
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMergeRequest(r.Context(), &JiaozifsResponse{w}, r, owner, repository, mrSeq)
+		siw.Handler.GetCombinedCommitStatus(r.Context(), &JiaozifsResponse{w}, r, owner, repository, commitId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10912,22 +20220,12 @@ func (siw *ServerInterfaceWrapper) GetMergeRequest(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateMergeRequest operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMergeRequest(w http.ResponseWriter, r *http.Request) {
+// ListCommitStatuses operation middleware
+func (siw *ServerInterfaceWrapper) ListCommitStatuses(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Body parse -------------
-	var body UpdateMergeRequestJSONRequestBody
-	parseBody := true
-	if parseBody {
-		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-			http.Error(w, "Error unmarshalling body 'UpdateMergeRequest' as JSON", http.StatusBadRequest)
-			return
-		}
-	}
-
 	// ------------- Path parameter "owner" -------------
 	var owner string
 
@@ -10946,12 +20244,12 @@ func (siw *ServerInterfaceWrapper) UpdateMergeRequest(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Path parameter "mrSeq" -------------
-	var mrSeq uint64
+	// ------------- Path parameter "commit_id" -------------
+	var commitId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "commit_id", chi.URLParam(r, "commit_id"), &commitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commit_id", Err: err})
 		return
 	}
 
@@ -10972,7 +20270,7 @@ func (siw *ServerInterfaceWrapper) UpdateMergeRequest(w http.ResponseWriter, r *
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateMergeRequest(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, mrSeq)
+		siw.Handler.ListCommitStatuses(r.Context(), &JiaozifsResponse{w}, r, owner, repository, commitId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -10982,18 +20280,18 @@ func (siw *ServerInterfaceWrapper) UpdateMergeRequest(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Merge operation middleware
-func (siw *ServerInterfaceWrapper) Merge(w http.ResponseWriter, r *http.Request) {
+// CreateCommitStatus operation middleware
+func (siw *ServerInterfaceWrapper) CreateCommitStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// ------------- Body parse -------------
-	var body MergeJSONRequestBody
+	var body CreateCommitStatusJSONRequestBody
 	parseBody := true
 	if parseBody {
 		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-			http.Error(w, "Error unmarshalling body 'Merge' as JSON", http.StatusBadRequest)
+			http.Error(w, "Error unmarshalling body 'CreateCommitStatus' as JSON", http.StatusBadRequest)
 			return
 		}
 	}
@@ -11016,12 +20314,12 @@ func (siw *ServerInterfaceWrapper) Merge(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// ------------- Path parameter "mrSeq" -------------
-	var mrSeq uint64
+	// ------------- Path parameter "commit_id" -------------
+	var commitId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "mrSeq", chi.URLParam(r, "mrSeq"), &mrSeq, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "commit_id", chi.URLParam(r, "commit_id"), &commitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mrSeq", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commit_id", Err: err})
 		return
 	}
 
@@ -11042,7 +20340,7 @@ func (siw *ServerInterfaceWrapper) Merge(w http.ResponseWriter, r *http.Request)
 	ctx = context.WithValue(ctx, TimestampScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Merge(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, mrSeq)
+		siw.Handler.CreateCommitStatus(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, commitId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -12173,6 +21471,16 @@ func (siw *ServerInterfaceWrapper) CommitWip(w http.ResponseWriter, r *http.Requ
 
 	var err error
 
+	// ------------- Body parse -------------
+	var body CommitWipJSONRequestBody
+	parseBody := r.ContentLength != 0
+	if parseBody {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Error unmarshalling body 'CommitWip' as JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
 	// ------------- Path parameter "owner" -------------
 	var owner string
 
@@ -12241,7 +21549,7 @@ func (siw *ServerInterfaceWrapper) CommitWip(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CommitWip(r.Context(), &JiaozifsResponse{w}, r, owner, repository, params)
+		siw.Handler.CommitWip(r.Context(), &JiaozifsResponse{w}, r, body, owner, repository, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -12521,6 +21829,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/object/{owner}/{repository}/files", wrapper.GetFiles)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/object/{owner}/{repository}/multipart", wrapper.ListMultipartUploads)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/object/{owner}/{repository}/multipart", wrapper.CreateMultipartUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/object/{owner}/{repository}/multipart/{uploadId}", wrapper.AbortMultipartUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/object/{owner}/{repository}/multipart/{uploadId}", wrapper.GetMultipartUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/object/{owner}/{repository}/multipart/{uploadId}/complete", wrapper.CompleteMultipartUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/object/{owner}/{repository}/multipart/{uploadId}/parts/{partNumber}", wrapper.UploadMultipartPart)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/object/{owner}/{repository}/physical_address", wrapper.GetPhysicalAddress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/object/{owner}/{repository}/physical_address", wrapper.LinkPhysicalAddress)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/repos/public", wrapper.ListPublicRepository)
 	})
@@ -12533,9 +21865,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/repos/{owner}/{repository}", wrapper.UpdateRepository)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/repos/{owner}/{repository}/approvalrule", wrapper.DeleteMergeApprovalRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/approvalrule", wrapper.GetMergeApprovalRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/approvalrule", wrapper.SetMergeApprovalRule)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/repos/{owner}/{repository}/archive", wrapper.GetArchive)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/archive", wrapper.ImportArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/blame", wrapper.GetBlame)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/repos/{owner}/{repository}/branch", wrapper.DeleteBranch)
 	})
@@ -12545,6 +21892,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/repos/{owner}/{repository}/branch", wrapper.CreateBranch)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/branch/cherrypick", wrapper.CherryPick)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/branch/reflog", wrapper.ListBranchReflog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/branch/restore", wrapper.RestoreBranch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/branch/revert", wrapper.RevertCommit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/branch/undelete", wrapper.UndeleteBranch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/branch_protections", wrapper.ListBranchProtectionRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/branch_protections", wrapper.CreateBranchProtectionRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/repos/{owner}/{repository}/branch_protections/{ruleId}", wrapper.DeleteBranchProtectionRule)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/repos/{owner}/{repository}/branches", wrapper.ListBranches)
 	})
@@ -12557,9 +21928,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/repos/{owner}/{repository}/compare/{basehead}", wrapper.CompareCommit)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/compare/{basehead}/table", wrapper.CompareTable)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/repos/{owner}/{repository}/contents", wrapper.GetEntriesInRef)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/hooks", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/hooks", wrapper.CreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/repos/{owner}/{repository}/hooks/{hookId}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/hooks/{hookId}", wrapper.GetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/hooks/{hookId}", wrapper.UpdateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/hooks/{hookId}/deliveries", wrapper.ListWebhookDeliveries)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/repos/{owner}/{repository}/member", wrapper.RevokeMember)
 	})
@@ -12584,9 +21976,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/repos/{owner}/{repository}/mergerequest/{mrSeq}", wrapper.UpdateMergeRequest)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/mergerequest/{mrSeq}/comments", wrapper.ListMergeRequestComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/mergerequest/{mrSeq}/comments", wrapper.CreateMergeRequestComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/repos/{owner}/{repository}/mergerequest/{mrSeq}/comments/{commentId}", wrapper.DeleteMergeRequestComment)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/repos/{owner}/{repository}/mergerequest/{mrSeq}/merge", wrapper.Merge)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/repos/{owner}/{repository}/mergerequest/{mrSeq}/reviewers", wrapper.RemoveReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/mergerequest/{mrSeq}/reviewers", wrapper.ListReviewers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/mergerequest/{mrSeq}/reviewers", wrapper.AddReviewers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/mergerequest/{mrSeq}/reviews", wrapper.ListReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/mergerequest/{mrSeq}/reviews", wrapper.ReviewMergeRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/status/{commit_id}", wrapper.GetCombinedCommitStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repos/{owner}/{repository}/statuses/{commit_id}", wrapper.ListCommitStatuses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repos/{owner}/{repository}/statuses/{commit_id}", wrapper.CreateCommitStatus)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/repos/{owner}/{repository}/tag", wrapper.DeleteTag)
 	})
@@ -12666,107 +22091,189 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9bXPbtrL/V8Hwf2b+yb2yZSdp5x53OmcSN2nTJm3GdpoXsa8GIpciapJgAdCy4vF3",
-	"v4MHPoMUKUuW5fpNG1MgsLvY/WF3sQRuHJdGCY0hFtw5unESzHAEApj66xOekRgLQuPXEU1jIZ95wF1G",
-	"EvnQOXICOkcRjheICIg4EhQxECmLnZFD5O9/p8AWzsiJcQTOkYN1NyOHuwFEWPfn4zQUztHhwcHIifA1",
-	"idJI/SX/JLH+c+9w5IhFIvsgsYAZMOf2dlQi8H0svn/12hfAmkRqkgyJWLZBIiAcXeEwhTZKVVdlQn3K",
-	"Iiw0Ad+/cpbQ84mBT66X0JKoRuChORHBcpp08wpRhgYuGIlnNRJO1cONyqQ+/G32o1Kf15f8UikVowkw",
-	"QUA9xa4LnE8uYWHpYeS4DLAAb4JFL6GPqnxZOiRepaM0JV7RT9GMg8tAtJKVJt4Qsm5HDoO/U8LAc46+",
-	"OmrIEuOV4So8V0a6yDum07/AFZIQKdQPhIumYJN85uVf/2LgO0fO/xsXBj42czMudMRRhPI01Oav1GHZ",
-	"26fYBzW1tzl5mDG8aHBdIqgYxcoTcwNyBWfq+Y0DsTT5r843kkjhYFZ6qZiR16kIIBbEVSOc0UuImzIR",
-	"2eOq9mP065czpH5EIsACuTQNPTQFlHLwJIzhondAkinggtv0RnUygeuEsFz21cE+x+QavU2oGyASIw4u",
-	"jT3Z1VAl0rzY5PeG4dgNmty7NIqImASYB+uxNfUCZZOeNrUm09TwY3mfQUI5EZQt+lK0BjOuDjqqCNnQ",
-	"WhHUMPPWU3ks3zBSq05pqyw4TZkL9jWhzIMh0DRvJ2G7GGM0em0IcxzgeAa2xSjjxYDO4ejF6OWFTfen",
-	"mEO7KSVY2H8QtO2lBi8iUKuEoqidiU+YsCYjhE9cGvshcUVpqCmlIWA1AyH4YpnUjZS62GFkFvTux85h",
-	"mVQrm8qgLHOVioCypasTmcVYpEyxoW3TOED93xoKi61aEQGbwUTgWcuvnOMZtOgTg1ijClTNpqlhFQtZ",
-	"BRUFgw7VvhtmGlyso6aZzPIUlcVVCKdMXV0sw6BVgSp8lGOc6AW9qWO1FSsPR76T0UgL5k6mCqwmrdAs",
-	"MJuBWN6MiBBqo46WgIalaytZWe/tcjnJJ6gplWlI3UsuKANluWTWdHJUEyTb4Bkg3QqlLEQQu9QDD/3F",
-	"FUgP9hFaxXVFOJmGYEM725Jn4/xdGoZnDOBtLGxsrw8HCJ94GrWbwNy+opNv0HPgu5mo0RBjYoZWM/4w",
-	"E/uZ0TRZgyDv6hgmNCQuqQHnchisAekanEUj2pyeYeL8QGckPs4trirUkzevj5t2KJ+iOQlDxCDCJEYQ",
-	"42kIHqIx+vnze0R8dO7AtQAW4/Dc2UfoTMY/NA4XaE7ZJT+PVTICxyhrpWIhxIFdERf2z6UVZxEaJ1ES",
-	"Ep+A5DVrb43WfByGU+xeTkLJ0yTEUwib1KvHMvxKQuyCpLn2XsrCfWd59ymzdK4jL8wW6PPJBzkI9X1g",
-	"MuJjKnOVckA+ZUh1YR1Fd+5SeklA4WpzzXD0r0j9mkeTCjtlzCntq/dCrofzMQnBm5ScheqA5gc5jEd4",
-	"EuKFYYZxNA8oku/LJ6q3HxBGfhqGiEMsIHZBh7+EIwaxBwy885jE6Jezjx8Qjj0U4YUEcyE1CaOQxJcq",
-	"OEaFLFW3KAIRUO88bpeadUoSRqLShPSaAZoKe2fNTmYkniGaCktXNWMtaLTOcmVgm6V+hGgKbA3IN5MI",
-	"2tdv69lM+l4bCpBHjlS0fp3b8DF7u8R4Qe8wsFSOXbd3l4UdEwachlfKmLDnEalAOPxUzRx1OiqScJ3t",
-	"dinzkAgAqT5T+TOivnqSDTdCcI2jJIRnN+fOdIz3xbU4d47OVUx27tw+dyzsRFxhPg5DOn8bJWLxp8rM",
-	"HgmWwjLRyndbRdQqHe2S91WUbeVpdYzABRYpr49sHZdLfmO36kql7XRWvOd+qWP9xhAzq/jtQ94YNEgW",
-	"UGwiDZaLtc5MXYIN+TR4ySitTe6opJErQIHRc+njnwos4M4Kr5Ia/VNYpWyNZW1/Mp8n81m7+WQquhFD",
-	"2m5CuLJ0rS0t/If6l4QHbvEWAnAvuQx0bFsnVPrPYiLMnlXVO9D9ogg8gpFqYjVFgT0s8DLWdWefObCP",
-	"2RvybUEiWONmU0fOV/4wiajXxICXL+wYQL7BZLoQwFexj1zuoyxjrAgwYtR8t09mRU5D/LtGf58qql3V",
-	"jQDzSUSZZQJ+h2uBEhmQEY7wFSahjL8LrkuZnwhfTxJgk8Qa133E1yTCIYpTGVpInxJiwQhwlABTIzil",
-	"AokD2zzEcC0m1Pc5WEo31I5pHqEykH1fgXJc44wHezSRW26N85xQVUTAkU/T2JNqaNxj9Vo3zc3ksRZz",
-	"TVgFFVUmbWpxAn59YzmH1rnaYdYJZ52ItiYvunKj295DDQB7G9lcpfMYelNpEr8T7OFEqFliuCXLkTVV",
-	"kXWC3bUssSqSnCTpNCTuxIxgT7f2TxuXE3i5MIoOjOitI99hA7jQte0uuCWdX9tym1eO7EpR0LqrfoYo",
-	"wimINGmJXCRWTRIGPp9EhHNJbQOOBUsBkSwTEUWq2owjzACZd/atq1KW/sqyzl1KUk5QK9M21GZAS2Ii",
-	"CA7JN5UgjqmYlJ9c2PIYTTnkW7MNMUCESViZGf1kCMzNA10gtOKmSTag6sY2jWd4dv+LRu9gsH0Deo1F",
-	"Nzpe2VQkVd9ZtlXgGAqGGeAZnrXX4awkukIQNVNVz5F2S9TWAaIMaYcEBXA9Qj5hXCDBFlkjHKusY2xa",
-	"Lc22ZrvAmoIWdre74khLWdtS81nN7aDNfoun0jtL0pYruG0lrcunXNHnax/sC7HsyqqCJjcvtmkuuilT",
-	"xRaCQW/WOLD3sU/XgXhmdE5m8YTEq7+oWS9eTK5e2UBqwFrSE/ZCzFcgv/JWT9pbAWd9O9iZMIYAqNSG",
-	"E5gRLtq0Yh0LeII5n1Om5iQi8QeIZyJwjv6nJyJmA+bd2Dj5ExgnND5RgGPxXhMyudJNLJX2aSxIBChr",
-	"YNUUAVyUu2hWu7R1nzA6Yzhq777GdtGuTLWN6dVAY8OezRJQGrAn6k8GbJ8Oc3hyP3jpurEGA61IZFSZ",
-	"oKZzZNjOSFw5TtUfTKSMiMWpXL7rUZyRlO0rkl8Jpt+Iz1+rxr/B4n1Jhjghv8HClNsSd4JTnZNUPoIK",
-	"VOTjon0gRKLTsWq3PmtOikqMYmApRRbjULWacOBVeymG/msuJvmHA1PADNi7bGZ0DUdBjvq1SQ8vBy02",
-	"KRRRjYWA/O2JrqtY2slH3ayzqxKCdPb1Zx1Iis4kjnGBo6Stk7O8QeNtqTLELAJVBPvLKAT65ezsE3r9",
-	"6b0zckLiQsyhqHh3XifYDQC92D+QuslCI2x+NB7P5/N9rH7ep2w2Nu/y8Yf3x29/P32792L/YD8QUVhy",
-	"1IpB9Xi5cJzD/YP9A5X/SiDGCXGOnJfqkU5DKz0fSw0aq0BZISTV3qXESf0xmucc6eItRxsscPGGegtT",
-	"gyBAf0qHkyQ0n6+MVXlkpuh4QN1/efnrteB1LHS3+hWeUCk/2eOLg4NBRHf597YPdtSItTKtVAGDn4a6",
-	"Dsgk2swniacg9o61YVcGNhUWbWb+I566Hhy+ePnd9z+gT1gEP45/QL8IkfwRhwvLminJenVwaNtf0Xtp",
-	"5Bt46E8cEk9x85YxqgD91YsDS3xHqf5KMv+QSHFtPnyst35vGECnwK6AIdN3CXKdo68XI4enUYRl+OAk",
-	"wOTSgXAuMYFnXM65AsQL+W6uszQVnUorf7drQdc8ybcepszsUtJcWsSk6pD4WC6cchgTr9ekRLiQ8Zsu",
-	"d72jyfSKjfVIzei4YT0h4QJJ4v8/R7PspVe2+bNNxLLZ041eNhu9o2xKPA/imswVOVqkqihPibWQu6ZQ",
-	"C16D0PhGpdpvxzeF63KrxwtBO1XVufhJPdd7f82peNUkVY+DdH8eKtQ4XKxNBrKFZejfqXhH09gbovQV",
-	"cWqikWZhH33UeVzzN9d1vzEV5iNshFE2IgI5x/sl0Zt3nIvbkV3JfwaRS7X8WfjXBtGLBBCJPf2tZHkv",
-	"0Wc0QnOSjHXSaizwbISMDaN8E87mSJjN3mL50mVv/RaabMfv9nZUp/XNQgBiOJ5VCFXFy9n6ofatfzzY",
-	"Ozx48TKjTi9ABXkn6vumMj0JFhKBnCPnf3UHz56dn3v/tSf/M/oP+s/z/37+L8s6czEIPKgrQOxxwQBH",
-	"VRDJI4cpiTGzrmgjux1kQ1VW2WP9cO8nwpURkjpo1T8m1ywgn4RVYWIhsBtEEIsf1I9Sfj+eKzHuJ55/",
-	"7ljj1Wz4LJa/GfgZ/luTdO/6Tv4D5mLvI/V09XpnY9n8xcH39zUxCWaC4BD1maBVJZS9f5J9FnlnTd6I",
-	"1F8evLB84gAeYVIyqhI9YbAngxzwVBW5XGREkEFkVWgfqIubqryS69cK8WbSJAj7OdQfHrQ2VB+Om/4O",
-	"v7cxqxYC8JCaKgno6BQLwn2i6kpWXUlmIJoKZlsbslxzdXH4BbD3tDpsaXVoUSSiTyhYI0psDkf7IB5S",
-	"6YJ/Iuw9SvjpiN6ykF19ZAZMO6s1wFJVgYj4qK7vNtCqIRLRSiaCwkZVlNGJIY1ZtPZTRClDO6t9P5tj",
-	"oIQeXTDnt8AfA/93ncu5w4AMQizIFSwfzjDcf6yLUUt24XMS0vZ1o+VTl7qqlFcS/ZmgUoUiDkKUSQNo",
-	"4YbwE/2a7SCjohTsom/i7i6u38iJ0lAQCX9j2XovK1ptywKWaKgVHMfhAmEko8FQu+GqSjRVAkfzgLgB",
-	"ilIu0FR/muih86yzc2e/XB/cQWyPbOHh2rKF5dLs9uglKlVEry3LYc1RrRbxpyysgfHBv20oq2v80XF2",
-	"LobCY4vv+4mpim4Vkb1TH4YO9AAbaDlyrveucn734NoNUw/2pkrrpQUuS86Mpbbx1lzZzyDeqQar2fss",
-	"pFNk1mbl3EdYuIHRcHOeiB2z1Go+CBIVI8tc1LHeW7tfT/ViXTnGJd8cN+1MyyQkXDjrd0xWDVw0UdMF",
-	"Kqb5yQvotTJLW1bEjnXFcmeK+5NqclLmrSZSm/YWTcaN4xAlx73fKR3pOOg9c1blnY2mX3W0qpmzGE6h",
-	"EiXr2WoaXs84KhFGYoTDEPEFFxCVjEhl6XVWXivLakn5Ls2xu2YTV7pfE7WiL3fPeu5QqZMRdOKcVYra",
-	"tzcfTXIawm/Pyp9UwWbjGm7TbonCWxTm0v2VxpLRLeoHv1J0hFS1YtLVCw66tKExzG21ukA5igNtUhcY",
-	"PRibbJIzEBDHWB9j2uUKm5NOlyVNPTqPVeT2jSSqFBwz7fS0ncyru53cyd0sn8JqTRj46lNWfVaQct6z",
-	"YnTKkP6GrsVPOdtQvpaB/6xwmZ4jU+GyNm/paXNuNzbn/hnbNRJqTOiDcxgpI9SORD0XS2C0ONWh2718",
-	"kwXePVzLNZq/LWLL/MsVi0ledaSEpAZ1F42crb1SynCTZzYyJdMPoLtoZEvTshY3Jzt2uAnI0/xA4h2d",
-	"0+IDs7YJ3V0nWJ9gmiveJhzg2rncvdzfw3vQS12ynzliBn+GOdL9NbVX7pqjL0QE6Ex/aXh/Cl6RhF3H",
-	"ey08HbnsD4QbeLPlszeaDCtf5vHgsmGlE+NboXMNOeSt4qdKoU2Lyd9RCF1iAuYEqfGNudWAeLdd4aw+",
-	"qv04P3ZqlR0enoBLfOKq7ZwRIr7yxvOnppQ1O/uGxIjR1s1dI6PNOQ8DTn7rs7tiPvH2iO+vPfnxnS35",
-	"YQos8oILaPEUjB5IcRcfmBuNzw7K2ZEdFktnuXKv13ZUr3y5vfD38Yna1ll1Abnrzsiop2mulF/ZtvFp",
-	"7exhfErPzZxZLED/ogBHTdYOJo2XK2yCGYxvpphDALgD64910+MMC56A/hEAvZl/JOb0MaJ8ptVrthml",
-	"QJ0o/1arcAvKPzxbGQ0k6plERLUYjJDAM/Ov7LgazIPnI1WqMyeJOqdGLyHRqHLATVY+o+v4sh3palHN",
-	"s1/evv7p+ah9yRlW3zOoFH2363y6hqveetIbvB7KJm+tpK4ZpJWtorJy7xKkLcOhKL9woC1JfgJX9BLM",
-	"xQS9srHFYfztdC47479X0pwp0pDmoZq02lZu4DsbnX3yAicVXpTOWTaQzXQ9hloDrVHZp8/3pFYje9eV",
-	"SyM2qrKa92ya1bg7rrhphaPpQl0Zg4inlmwd/xs+GQ3Bpsu9IGpM4itizrDcWc1/r3i4byzdutJrth8H",
-	"TpMyLytrc/fewEfT5j68OKOMPdw39QOifsb7bs7fDITaQigY4a2rbViai0fi7bEZsOIcyw4NLA685NvN",
-	"MNqgKzt1zF4xZKsX2uS2VeN2C4vxKMlnGvwoTKfET4e7WtK3x1AbUDkIdjMVApaB7rlKoDn249Nls8tf",
-	"ZaVVcQfA6vgmYqfwd+d2Z0OL7gGYivurHjE69ZzOnU1FK9Xq6a+3ls4ujcs3DnGWgVb9DiCPPsvL0SMJ",
-	"qDcFTfrhTkTS2zADpZcb0vzm5aL9FX9b+9taEcuKtOMGphnCFZZWNjCBZ8tLzM/UVy3brC8XePY4i8v1",
-	"B0PZ3Kn/d1WVb2Mm1oIc6laLpnEKfdnFDheTt0zgrkeKWtE2sYaU75O558iwRQlNMCUx5qly3K7Qy1eR",
-	"7pTwmWzwdG5CSRHbMm1SCx9DjbjQM76DwLhE10s3J+4wyKuNzT/zOxx7eBTFhY9Lxx94QIUmplz/ZMba",
-	"cUfdbePrmbqU0KfMHEYyQj4OuXnCyBUW8Nz+2T0HkSZdubnSdYkbxK/SKBYIyy+VUNQivdsx6IjE1lpO",
-	"4gJK4+I+4a7rAPKjEqv01CqhaGxEm3K5DmBzL2d3QKRu7+xb0mg948VzBm6BD+i8ctfmHQMvJY+dj7Kw",
-	"nq/8noNLftkdZz3mCV4PAmR32Np22ndbZ2RU16owXTHTnZWmTOuwiV1fjPRIJ9UENy3zWsX/7lDmtWqx",
-	"vZqCTVq15K0tMJGSeRSRCTYT2K4EDHwGPMgvP7PqwolupC9welD3RRnykTCkPd0b1bg36qZ8u93XC2mI",
-	"lbvzvl7cVnzJikj1AayUARIkAvv9SZki6Ws/22+ayi4G3dRWZf3u0Q0fV5zffGs9ClLSoXlfmmh7gz1k",
-	"9pjQXklT0MO4YkwVCZcZ6taChPKlt2jpEPEPv2Tv4El5PuXNBpw3ml/89RDO0qsTY/t6tsOf3Phxho1h",
-	"7jkf3324ZgzzBzOTxn1cdiqitnf5364cTQ6SG7SULiA+LVwFdf+Nr+FMN+8pvTtHWCTWMbHEdOqrQ57N",
-	"Fb7hAoV0NgNvj8SKsi5szVK0QzD2CVB3+gDn6snN+cfAWar9Xg4oUJsEpauE20y9uEV4Y1NYvZTddpFa",
-	"7Wr0Lv/GfFGdvYJjD1kubrelT+ckWfF87C8kcVY7x3pOtnyvJ4OIXgGaU3ZJ4plUx4RR5dcWUpJEdqUa",
-	"29lfi3rI7i1KYSFZXUt1uOmBMZLrenN4ZG5d3+6EypWp12wuB5W1lgyutI/YPLh4/ScDd5XiZpq9qQrc",
-	"XMNWL7y16OFKJSAbOoV7TpKG7nWBbXZwWdeS9IUkrSeVbVxj+p6x0X2nzU6deWODOiP/Bwh1OW2rQN5D",
-	"qNxoNw1dMbwjZePbw25dWa2xe5Uze8wBOBFwjmdtFEd8dsfS1I07KoaPzOtUrnB2hvyciED7MVvwQEfO",
-	"d7b7gHvdHql5spi9oM0jY3usNyok7Iq61+Dd9sLjL3oihoPxAwho5ySpRLIJo+qUfalytfzHI8FiBlfA",
-	"emLxP8CPboyRqDQTov6Sy/0Ck49aCehP1CRU3MFBQbiexK1BoGU4k3LMU5C23KOhunSyaxMTRgjkIqeE",
-	"j+YkDDNecRg28XHpzuIUc+IWG4uWvcbRjfOrKVJ7reT7GyzkHH29GDmnZBZjkTKo/fkRREDrbbJ8k3p6",
-	"RiLgAkdJvp+p5GNz9UslcnrxiL2E6rMIUhY6R04gRHI0HofUxWFAuTh6+erfhy/HOCHjq0OnqcFLO8xf",
-	"vbj9vwAAAP//ceug9n7BAAA=",
+	"H4sIAAAAAAAC/+x9a3Mbt5LoX0HxbtVN9o5E2Y5Td5VK7VWcl8+Jz3FJSrJVsZcFzjRJRDPAHAAjinHp",
+	"/vYtvObBwbz4kijri2XOYIBGo9Hd6Bc+jUKWpIwClWJ0/mmUYo4TkMD1r/d4TiiWhNGLhGVUqmcRiJCT",
+	"VD0cnY8WbIkSTFeISEgEkgxxkBmno2BE1Pt/ZcBXo2BEcQKj8xE23QQjES4gwaa/Gc5iOTp/cXYWjBJ8",
+	"R5Is0b/UT0LNz5MXwUiuUtUHoRLmwEf390EJwLdUfv3VxUwCrwNpQLIgYtUGyQUR6BbHGTRBqrsqAzpj",
+	"PMHSAPD1V6MOeN5zmJG7DlhS3QgitCRy0Q2TaV4BysIgJCd0vgbClX64V5ysD3/vXmryubgRN+pvylkK",
+	"XBLQT3EYghCTG1h5eghGIQcsIZpg2QvpQXVeng5JVOkoy0g0CurNBIQcZCNYWRoNAes+GHH4V0Y4RKPz",
+	"P0Z6yNLEK8NV5lwZ6WPeMZv+CaFUgCik/kKErCM2zVde/fo3DrPR+eh/jYsNPrZrMy5oZKQBFVlstr8m",
+	"h66vr/AM9NLe5+BhzvGqNusSQMUo3jnxcEFu4W2SMi4vdcP69GYkhkno2FCdDjhjchKSqPS2tLrkL5hM",
+	"VxLEJsuXdx2Uoaj02jKta/380wio4mR/jP4iqVpzzEsfFZBeZHIBVJJQI+6a3QCt40K6x9VNjdHffr9G",
+	"+iWSCyxRyLI4QlNAmYBIcWdc9A5ITRGEFL7toDuZwF1KeE5S1cF+peQO/ZCycIEIRQJCRiPV1VDkmrn4",
+	"8PddjBP4OaM39cFDRgWEmSS3gGJCQSA2Q2ptEKGSsygLIULTFZILQAIngEKWJESt2RozyuSC8U6KJ3OK",
+	"ZcZBMyjTU10UYrFQUJj3ZTjkAoQF04dq9aKNsBMQAs/BT9cSczlRPdQBmhEupB5WgbXI6E2AdHs04yxB",
+	"L7qXptR7BcwcCYFDYAGldyE5puGiTsaml4nC3G5kgf6A8UlPnr8j0WHEo+d7DikTRDK+6gvRDsRMddCg",
+	"gmQLawVRw8SPWco36guLteqSNuJCsIyH4NdZynOwANrmzSA8rAy0FL0zCWj6e8+ZhFA1v8xi8OyXofth",
+	"xviURJMIYlgj8iljMWBaajRjPISJWX5/w540nGIpgdOGHaGRM0mAz2FiBZB/NIfHSbiA8Ka6NLV+q6uw",
+	"k33kptEEdB27flTWZ7LJjquSRvP+22bF18SHbqSUhoTdAppqMNRPK+CWC6JEv0CUSaQ+BRphKrUIzDgH",
+	"KtECcIlCSgCUSKQ66DxmU9WDHU0xgwDB/BQlmNAAcYgBCxj/u4/uGmnLOy87C8ncWBHhEMp4FaBwgekc",
+	"BAoxRYzGK6VA6U61SqH/hwoq6EW5a9qLfo6STEgkMn0sQFOYMQ7V3jUExeCE5tCOgt6bocaSDOqbSe0S",
+	"ZjGb10nLjGwlWXVCJCot2nIBVC+9JpwocM85WLJ3x12rmqlFRgssEEYUlsio2V1MxgLTKG4Gs8qezK1N",
+	"GaOwzHWZNd1QYcOcshVOEqAyQJCkcoVmjCO9WxV1ubWtdc3iqK1rRzyevh3OW/pOodDx3SHFfFbW9DQR",
+	"joKR+FdmVIkZFlLxkCXmWotYAOerSUrCG83zboGrz8zc9BMhGVf/y6h9+LERmr46nI9xF3RaJZMSFktr",
+	"VZ5/sbxVOCrk1LVxHoNiYrfwjtWTS7uCPqETeiRIWW5oMoVboIhU5YOTIJiGunvFSCypRLpBgAzHjkBx",
+	"Y9dfauQhROYwoyFAucT18WSFES/zMq8QUMlXanTFmMwoQQV6IgxDUzLDkY9rrz/u5ltr+C+A8iH9jRZD",
+	"PgOa/0D+AhEqgMsAvbQcJUCvUMIiMlsF6CvEwYjT1yhkqQLW7vUXwcvgVfBV8Pqjjy9OsYDmE5pC/iTF",
+	"0sOYjAaP1Euzpmr0CCmWxFKiFo7E0KA/+geTrAmQuoxbaIObBqUZt+8x4XX8EjEJGZ3FJGzQTGOYya59",
+	"aBfv3vLNiZAcS5ivPLtES3z33hAUEQZvCg4yV/aV0z//wlJyMs0kiAAxc6hnGReBIkCi/maUMBqgKaGY",
+	"rwIk4U6+WuLVIBxzMl/0npwf7WX8eXHPkimhEL3RkuVKYpmJJttAk9HDKKw4jv85G53/0QFtaZwr/en9",
+	"x2BdKcQkzjgo5qS8GUI3RuqpIVngXDGkAKVAI0Lnaw2JyF8wjihzzzmkjEv1HZML4EsiwCl8biKZgP7c",
+	"vYKyLu6eS26Dr9JwDati8b0jC5mEgV8N1dgamZLZcRLPG942a3AJSBxhqX0bOIqIog4cv68gpN2KNLqB",
+	"lXGiCISlxOHCCAx73FAaWUwo4DkEiNAwziKt2Lv3ViWprU6KOVBjxIGBR+HhRijJoYXlb3e0thNcN1Ll",
+	"ZsSCdsrrWFbMCujW0TLsXG0I/iJN41Ub92k18iqLepqWz4x4jgkVEhEpkDHAGhgR5qDbEvDi3HHMCQfB",
+	"4lvYggIzAcYRGzKujc9I95mp105fccMFSAkzxb/MBNx7C6mdZ4DgDidpDF98+jCajvGpvJMfRucftCT8",
+	"MLr/sk6yfl7UvA7NFo1HuifvG6fSLNGoEsk7tXR3T34Lj6gTswOFazCSmM9BTjIe78uX6nBZCDeHkE2Y",
+	"gAG/mQRLK7du9wAqycz6mVZCQpLLfPNM92xMWCEZTzMSRwFS5GwxeKJtQZs4J3a1PNUZxYTeqL0RgcQk",
+	"duzATKPzPLO+Kl0Yv3JTcFYHq0SpDqyWFDjVbBSMtBLmNRropYN3wOdwWdj9qou4hs880uT12ZmnR3N6",
+	"mXSZmSwqO5sRGcPaqF3I9HTtBcv17kW2xstlLmw9Rr2YhTf6tD0xh406SegmSLXBc3BHkozHCGjIFKv8",
+	"U2gDQp2pKZ8jRBP7ab1nkcZEOampBGM4VkdCYeyc7mkEM0KVJFKdiQAJhjJqhJV7qGWrWGCuzFwglwAU",
+	"3QIXhFHhNQZ0ba3Gdbwlgkxjr3vE58ZqW5JbAkufsGv19XZveNOx3ep1d67sBkptUKCygQtSv/yyipjP",
+	"vBKa/oxKksY9rCTF8bTak3qq5HJEZjNFLVVbuVFrzFhqd2gDkmT9mBb1i4fvISa3wFe9GRVEXv70YxbH",
+	"1xzgB20mqmNW+wOypD7lJHqdRzZYQCuGY+2zYNx7xt/dgYqISUS43xjS7PAlf0HPgbdTCCwXtGqahdWO",
+	"P0wb+ImzLN2B23XbuIGUxSQkawe9zu724AO1qM3hGYbOXwi9eb9YCRLi+CKKuNomA2h/AXfI0n+WxgxH",
+	"ELk94OUZdqQJLobacRxYbYigAL8zGuwXNif0TS5jq1i4/O7iTR0D6ilakjhGHJQDFAHFU22RouinX98q",
+	"I9SHEdxJ4BTHH0anCF2rqC/ts1wyfiM+UO1qwxS5VjoCDAngtySE0w+0MAOPBEnSmMzMAdW197KzGY7j",
+	"KQ5vJrGa0yTGU/CpkuqxYvtpjENQMK99l/H4dNTdvVdPNfFmmK/Qr5e/qEHYbAZcxblxHYasDsH6YKe6",
+	"8I5iOg8ZuyGgNSmfo1a/1R5KkcfQaW1JRdoNcMK64YyMmJTE/LoxWL9Qw0REpDFe2clwgZYLpi2S6onu",
+	"7RuE0SyLYyRA7YoQTNAfEYgDjYBD9IESin6+fvcLwjRCCV7pDaQoCSOn6WNU4FJ3ixKQCxZ9oM1Y8y5J",
+	"yklSWpBeK8CyhnNIvZO5tq5m8rRTohcwele5MrBvp76DZAp8B3JgruRJX6tbz2YcUranaLJgpAhtc8er",
+	"+7o08QLeYaJDH+Uu0pSzWxz7g6HMGE6GVqlHc0BsPxfGRZjoVRXuSKs/1ycHHdMIkTmkq7PSRZQQ2hJh",
+	"Ek3yrutD00wNo0Ypxnff9QjyWAug7ZZJHpgaEdp+QH4wE+Rg46IzDRtG1XUkslPXTfdq4zfLuqWlPxFz",
+	"69piyx+Uov+bGmh0LnkGXbtQfduy+A5ZPv+j2ZyiMocAmViP/CWj7pUN4dEmCuckx0Kvq9575jwYIBUj",
+	"cmJjRE70Gw4z5YNCGY1BiLUuiKjEAdgg0EJF8cagVPr36ivtdG98D3156kPlp2xF8cYD7czSHQwmGAmF",
+	"KhpWj29Z8xQrVqles7FfDBFmFXvYkC8GDeIMdfswWudoXZ/MOgZr+KnNxUG6trhBiZg3ELh2iyhrRW7x",
+	"2GqvGKdYf/d2EZXh0aCLaMoOi3c9uuB53z7v20e2b93e2MsOftgYxDIku4tBfJfFkqSYy1+1MehwZjo1",
+	"5oDJV8F8j7n0MbPmACyYNfmR/Nk+M+cGsuFXBtzOkFUfnJsZ51QnbYa5wesCTaE7aqSJOWD5U+V625xr",
+	"RFh0bMcvDMiFha8Dpf/U/1Mip8PO6Yv+UNibmBfruDb9ogQigpFuErSHLrXRp+nsVwH8nftCfS1JAjvM",
+	"8WwhcPVikrCoLqVevfRLqa1stqXlyzeIXEwsGs28mxezgqchB8Zaf+8rzLdKGwssJgnjngX4B9ypCKI5",
+	"6KPRLSaxMgB7nZoJvpukwCep17D4TjmecYwK8wRQyQkIlALXI4xK5RbOfOtA4U5O2GwmwBMJoROVcxMp",
+	"B9X3LegDIXVz8JtUctmyNvMcUHvSnrGM6gO0NSfoz9phrseeGTSvIauAojpJH1l0+jN0yjQIy+16bKcA",
+	"4akAKpU13znZIwYmKt4EkyDd6arnxvP4QapgCIm1MVWZMTzOlSBfRm0itk/V7yVJiyFLO5qDIHMKUZNd",
+	"GE7Mex01IJkdMe95ukLvf73utDF6vC/VkX3rdQmz9fz7XFmz09HMvhagVkyvLXzCE+JQ35kPncas0/D2",
+	"kd/MlhR6Q2lRNMERTqXeehw3+E5cUzWwSHG4E81e26cnaTaNSdi+Xv2jPMpO0hwZRQcW9d6Rt8jBLgjy",
+	"YfX8Ao7daflNgTFrpQI8OXC5vbCSh8h1f8q6b/6n5GhEREKE0H7UENa+0ik+O1Fjd5BRaKHnO4oH2lF9",
+	"AQtTKfayUmJgGCm3hhwVyQdNKvNBkz8rUU69g5j2UkuoiJd3567haK9FNRkXEkSFecKlUTfENV2W6HPL",
+	"w3gLqdc8XjkBdhzIHHiNpn/X1aCUigZw/PwsL5Z0LHWwdl3oaghZXoHM0gbLs+Ixk5TDTEwU81bQ1uSA",
+	"5JlO4XKhgLrAmgkPtd+ceo9OLkjAxea0MdRyGE+ZCbs9RCiRBMfkL72NKJOT8pOPPiW3joc8L6qGBkgw",
+	"iSsrY54MUduUYNwi0M4NqLvxLeO1OqO+YXGWDCkQ42wfvcrD6EYdYzflz+qc1YbRWrAm2WQQiMUwxbeN",
+	"EH9PZjMPi4giiCahnk1/tayMfo/lUSf0crYUfhOaI4pPAwSajfxp5GUctFa165lwUE+26rZI0611zpZi",
+	"ohegqdzcUlkTIhMw19zEzt3fQujAh4GQX7JlM9iSNa6tP1vXrnewRmz1Ravju0xLxcgV1K0hYR1tBQYa",
+	"d0Yx23ruAhawnVHOEmxVimh7kzZs24A2ZScxc/ax2RtCo4oS5Saezzmfrk+FkmybKaytqYbFj8r5DnS0",
+	"gXaJXZRU2V1pNeOJ25dCvp7Q6quzZiEYphNd43lbbuQGqCsQsaY9VUJx1OemWIMJV4K7wCa0Sr5yjVRU",
+	"qVTH7DzXvJ/0NhA0TPdhjRrX2CBpJ9aMX/XaDspL8x7ze/r/m7zg942gDbRt+qIsZzMIpc3cyk3JptaR",
+	"OURulILVYLNsnsnvJPVLiElLJQlbiGYiOfTGmwD+ls7YLtipHV2ZsCeEbv4hSasfprdf+TjggLNDT54a",
+	"Y1FA0Rv8ylc9YW/kZrvLcnHIGMKdFTVcwpwI2UQVuziwpViIJeN6TRJCfwE6l4vR+f/tyW7dgHk3vpn8",
+	"ZhImm6ov45RMbE5lnRHwjEqSgEu69FOKBCHLXdSaNHafcjbnOGnufm3aRbsy1L5J/w7TBWM3/hpLtzt2",
+	"53gnDbdA16RTZyrJ3qL/vfn63kh/nVthYQ8ctjZNwrer0KzmFKtRJQx7d4ByKUqe+QXNJgg2xdnr46mD",
+	"gEoAInOaR+9PWbQKkHAWG+VfIKb8IXD0Xyd/I5j9RWbiJLfpnLx8/bV3ufpg3yDewpdPrgWpLoPVg1Qp",
+	"IUllP81iMM2bXP1Git/KHalc9Bb6/gCleOXCxnxBCCmjWlWIoBc+evleqtnDu5FVBnuF6yVfxWKGdQxt",
+	"tB1/zevC9meN2222/luiDvNG2t+ez78d2uWAlK/ZZEB22LBjcU7JnTS/A+qtYCSoLFD9CG2nXSvxMtRh",
+	"bmgs40SurtTOXHe/WEz5bjxx3PtCN/47rN6WcIhT8ndwJlUSTpRPTnWkt7/eGOpx0X4hZWo8lzoZ0TUn",
+	"RaJpMTChJv1Wt5oIEFXFpxj6z6Wc5LdBTAFz4D+6lTEpqgU4+m0dHlH2NviwULgjPADkX5di3Fs7sfHt",
+	"rV2VVMHWvn5b1wiLziRJQEicpE2dXOcNal8rkiFWm69qAH9agkA/X1+/Rxfv3+qLEUKgxhJpu75IcbgA",
+	"9PL0zOpKBtnifDxeLpenWL8+ZXw+tt+K8S9v3/zwj6sfTl6enp0uZBKXjvPFoGa8HDmjF6dnp2e2aC7F",
+	"KRmdj17pR8ZWr+l8rChorD1c6mfKjA0iL3r7Nhqdm9x0W6kchPyORau1ih+6IJi5k2Ss6704QscDXDzl",
+	"c0yvk0vLieX+viS49bAvz84GAd0mun23sOgR1yLpTPGPWRabNGcb8WMdylcgT96YjV0Z2OY7Nm3zb/E0",
+	"jODFy1evv/4Gvcdy8e34G/SzlOk/aewp9KHB+urshS/c0IQJKM8j+g3HJNKz+UGrZuqjl2f1jyRj5kav",
+	"/HaY+8Ap2vXWb+0E0BXwW+DI9l1iuaPzPz4GI5ElypQ+Oh+lwJXoQDjHmMRzoQ3niiF+VN/mNMsy2Uq0",
+	"6r2fCtrWSX31OHHmx5KZpQdNOodZjJXgVMPMwYclIqSy8pnaJltumV4WVDNS3YZa2z0xESbC9X8LNHcf",
+	"feVbP99CdK2eafSq3uhHV056DecaHINSnb2r0VrgXb+xiDdMaPxJx/zdjz8Vqsu9GS8GCfW1+F4/N5Hl",
+	"9aX4qg6qGSevD1+QcbzaGQ5UC8/Q/2DyRxVxPYToK+g0QCMzhVP0zgRg2N/ClDUxIc76JjqM3Iim2O5p",
+	"CfX2m9HH+8BP5D+BzLFavsLwjxrQqxQQoZG5AKscqa5rBCxJOjaujbHE8wA5A0Nx6YJHkbA+/kJ8mVTt",
+	"foLGxSff3wfrsHIw5Z1skY4F4/JEHSgjtBZRbRPqMxoBj1cKyS6OnFAhbWykQbN6WYSMNVwyqLr2XfNX",
+	"ioJdh/W7lQTEdQXRElJHQUnW6QyOb89OXpy9fOXGNsKyGPzS+guKofObQkb/bTr44osPH6J/P1H/BP+J",
+	"/vPL//Plv3lk4sdBjI6FEuSJkBxwUmV4+SnHFNX2Sd/Av2cLLJc0gjfm4cn3RGiGQdYZ7DoFmCm4UukF",
+	"Mk3ZgQSo/Ea/VPj79oNG42kazT6MvEZSN7wzIH8aeL3lD9aN3Hb/5C8qI/9dKS6iubFq/vLs60MtTIq5",
+	"JDhGfRZoUwy57y9dxMLWlLwXrL86e+mpNlXhNyUeo2rxKIGoqzs4NltC2i8sxHVS3khNbRRHdtGUwJjl",
+	"YunFWWNDk4Zjm33tm6yNa0V6qZTwQVdYEjEjOsNqkKr32jetNSatIBdZamvDTlc5j2YcTWM2XZOeyhNf",
+	"I1SfPHSO0qpA/Blw9PQk4pFImQaCJOaqzR1ym/3x4z6cE2kTyefIPp8oG2s8sTozha4bCPY2jDWGpfNs",
+	"VfD1Or37mNYaRyKuumuxR/XJqpWH1FbR209xMhva2VoR5JwHjova/yYTdWZyi5TpRq0XN1YcnbAbhpCa",
+	"0mKn9g67//8qQLcvTs/++2Wgf/+/Ty/PXn51cvb65OzFvUoFnaq+9I1d1UJRPj7LYfYPnMB2M+MQY32P",
+	"bjGhhuEsZvuP9TFoMN2YmgNNAqqh9tU6TZZFlikxqWmuOGSau1iaDjpEXJrP2o86H/taRbfRVYNR4sox",
+	"jFXrE5dv3mRiLcGwVitAx2QhddSOzblBJ3jbjFtzZ6O+d3BqylpG6IPr7MPodBT0AraHKfbFzkyx5aoK",
+	"zcetpFTMYGcmJK8BcDNzilMBC65/9h8+dm6TEd+4C5Q04/co6++5zh7XR8gfTeHpYYaaGlsORncnt/l8",
+	"T+BOl6w7mWqqVzuwy/I11jGAjYbIn0D+qBtstt/1zaBWCdCnkQTLcGEp3DCmBp5lb3EdwBL1RLp04bEr",
+	"kndIlfjjrgy4XTlu94EXJ8pGOtq9BrSJfVGdkAxQ0xUqlvlZ3XhU6sbHLqaRC75WD8ZatSLRdaq18el5",
+	"dYvOGQ1YG3ejYWvPlixadaP9u2LW0NZnn+frYecnDudoqHtjMjojlIgFRKgGV/mi6UwAP9a936gk20tt",
+	"1pawH+ErE14UOT1YsuM9QOxNr6ztje694C5TPrifctjWMQnHEhDWdZISZQmo7R/vdunNrMefTC9vo1aH",
+	"58WUcVmn4G7HZw3zeKoNpw+EUT06qgNFI2TS/IrMF1d+b4jvshNDZw9J9Q+Ec6Xf1TCuy073wvUx6Hye",
+	"zty2au2qqzb/x032saYWt40/a+w1CmSLoE6R/PgMWE/PNrOr0JaeZpiqOBACEiVUq5xIy4OK2qVv4VHr",
+	"SShKOQtBCBOLMduZ+B3rocef1B9TM/H+c9/A/q4LBPWBs/BKKXaQNdqwc16gC+gewla817hXb/Xi+sau",
+	"0P0j14at5sAoaGgDp0roe01xYp66uqj6+uacHRM52ouh1Feis0k3XC84usfFXx/Ks/AOcuQgf4QsfZto",
+	"DI8OilFnAVMTNxOv3O1gttbtE7JEdp/tj8EXmXmti/VLCp+IR3LY7vch4r6al6GZ27M6uWt18ha4ukPc",
+	"cZNcuEqG1vltrmKSckFmryKpGcDYVJ1tta2/100uy/xijfx9a1Y0KRVcec9hRu5G98GAb96qcKCLmQQ+",
+	"7LuLhGVUjrY2pPercPuLdnzVabJgsyXf2INmMJgVRyXACEU4ju399CV6UU0qxLJZPkMb5fjZ3CRUrGyi",
+	"N/HAs2tzco+5tV4BVJr7w65HHZwa8puNgpdVAb53CvdRt9J/HhCZww2G7ag+Xl9QrVrTfnSA2jC9FID2",
+	"PWlysx/NnqyDM5Ahjt1Fm9xeS9rOHes3mfbBnxsDqUFc/te+91Wup6go0vbkr+udZzxaXlmd+Pol/2II",
+	"A+2L+d0YTmqDedhpZXJPejX1wXn4Uh4vg75qorfdM+kGUuvFpR+I1q0QeDSO8wfdG2KTvdElk3i4sFV5",
+	"mtjhhW3SEUASsSXVxq2/SKrrf2JuwuwajBZ25MlWAY4WtuZM2Jm+98zcLayNMTbaMkASz4sypc22qeu9",
+	"JenOvqgF632pQ6uPK07vOWH28SfMBqP/OrlkTJ68IVEbGkJiUr4Zc7ZpxleB2T0zu6dxzg4+mwwn72nR",
+	"xhCX0FFivMceQvg2SRnvy/lLhZ/3aePPCVLbN7WVXC9BYOiVzIxzJoWQzEgYIDeUeqNbqLhPzDUjdft8",
+	"owjc4HPMNDqoPd8SnqFCW1nWIwCIfm/vdDxmq75iYZ7BFLUqdhRjXvMw2rkbJqSCyim5mwkUYR0zUmLU",
+	"dXO/ZVD93NEtuuM0xkmr5vhdjJNO7uGi4iXcmensynHXd5s6jvGF8hpaBUwph+Z/Ja3py6BS475URLZk",
+	"M68m9Xzx8w8X33/5kArdsBSFjqR+TxLTkqRqJhW/+JGkMrUNp0n354ze9El90BsBqar3jyauYS1tsBqF",
+	"JiUn00wCAhwu1GWtUNmAzolu6YtQyVmUhUqSysBMU1+NZQSJuw0x79WEN6vBGY+AmzAJNUiJBblbU49C",
+	"Sepig3pTdBt0vzPterm6dngS8+pq1mi6h7pgAYL5qeOTRKjoRQmhbFH8H4P1OE/BdARqHkB7+P0DrehO",
+	"VCwLu4+b2TfbHOYe3IbcvqDHntuVE94+zMSm87yo/oFV/2a6NDlUjrVY1jVM3van1F6Ku0C/q7SSa3Mr",
+	"0eEIvIIJP433klnjcAGcr1IS3hxF6HfzrtDzeK/m0T/NMU3jVaf5dzOevftN+UbDeaFgPvSONEN7d6R+",
+	"45IblZpnCOpEU9RhNeGd6R2+ne82vK07Klh8C4eUaJZWzdXK2o6V5/ZvzwQ4zGI2b430M0z50jTcm7rz",
+	"VIL/ythqDv9Tb9VSDla3js9xqcML9V37KuU1UcgqZm4sCxSWIDRBszgCIQMEVHJiqN2VMbYfqLMnodpa",
+	"FR2vhtd3cwrJOBy3eL40kzjIcWlf+rCdw6FDJrqOacgSSPQg4nbGeOhiNMzFt1boVrjadrLXTtAZGWfK",
+	"6zljfIm5yepmmbRwMG6HNbzGiMsIJYDpckFiOCDH06n9ZWCqJjUOIeMRREjnjGlJAFRWQgo3EOO3YIrh",
+	"HDOjUHN443TyZ03+4TR5S07PSvzOeEJGI+bR4QndgQ6f0cL2fMTB6nYWh7ervjikwK4UAzqkyl/ZXkss",
+	"EFWbvBolPtjuVenUFu893KZy2KydEaSWt1r2ah+pqmEKNJ/r5nttYpkSYVT0ODS/z1tfZiYY8gDOS8/I",
+	"vfyYBnfFBHWUqXj6Z9OGiZuSG558jyfoTFijln0epapDPayjYX2T9N0UzwHpNY/MGoaCPOZN/TIFuEql",
+	"M9bLrm7Dhsef1BAdJeXKTngPsfd0nK9N8nPKsGrYCMfKFRs604S0fRG1TvqFPsqDr+j1XnPqr/QkHrVd",
+	"vcmibslz+0LTj0UdAfFkjdv2FDz+ZM7AExLdN+6Gn8AahN6YjzYssuKiPHXN92A9WtxdJuicDsouxxrj",
+	"sh9HjWaDjz56vbU0RGQ227ne8ton0Ox1L/n1L9AQpeOsISpi2ZnvjjFE0N9ZTty73Tu6V9G9X8Rbeqnj",
+	"fzcVINv6WPsGYFdT4b7sl0gyIKhZpy/ZDW6xZy80cS4CpZfqyFfGi7wncz2mskK23hKxGAaM4gGEZoBi",
+	"mwiixl4Q4UY0qSloCiumiwSrgYRNwcoE2Eq2LSDNmML5wGs4fSha4FsFn6oAo2B01ZMCEzVMJCTKRHsD",
+	"q29v9dqqrLk0ZlG+yj7oXC8V+HpfLnEYvmqt5N18VbMwux09zM3i0fh4jjNrvZsXpZjD+NMUC1C2tmYx",
+	"/sY0bfLtPMvwY5Thdv2RXLKjF+Brpwks4PTU+JHtJNUjw33V4wCZJtU2hlHyuW3NZuavSojQ7cqfM7lA",
+	"gkRgs4B8OUKjwDdHt9n2vJXHUufJdmzoa92ob46XarzrJK/1fRJnCTWiUjJ72RNnS+HhF2xpElns3E0E",
+	"Y8cl10SR/t9hNUzqJ/jOVYpVnj+rdmgATJJVxmnDkEJnol+Rv2DktUIQKl+9HAVqCJJkyej8xdnZWTBK",
+	"CLU/A29p4r2d0jVBfK/ZVJ2LGQLYCxPrd3va1qwuFLcBkuJWqYop5v/K7G1SaApyCUCfeeFT4IV6H7Se",
+	"sX4wWkbDGevxqTP7yIZVJSFU/qdaUnOASz77FNmndNdf23A/ZnF8zQF+0HFrvRXYY8iP1TbY8rarnN6e",
+	"UurqgrGbdj/E7zA1jQ5BU3awPtS0dHA9+dgEN9PGYIQnUATPBCK45d9P7IHt/aHCDXLabqTl55CCIqTA",
+	"oqSL5vuwt/En9adXcECZALviARyEn1EAQM9Fac6Xb8fvQbfZEy6f2nuZjtStZrbzfm5qM3WsDyKIzFCH",
+	"zid6FkP9YvVNStM+xNA4gpjcAifQS/P+vmjdYWQt7IvFCIV1MSgfxl+fNRVlNa7bJkPjaA+2xCEnA4uM",
+	"XufNEpqf/BkhxhKELK87mznifeb8Q07ECahN1KYqXsItu4F3pl2vFJVMAJ+QLeHuo5NyDRoyc6hWSHmo",
+	"YLjXZ2eb0fVlZS7mbr06Azavn8QdHYaifuIsSw9HVoG/67mC4iAka+bullmPe+SEm1VmNNVROxwRUxXP",
+	"eP7sPDmrhE/ntNyLRY0JvSXHnvD3Vs/h0Lz0wYneTPtp8GlSnsvG1NyuCr+zbQ6haZqx+iiY+oW5gsF9",
+	"coTrpywGWofMJyIapW1cWosn4f/Q7mmLxg4K5HO4LC7ZeMCQWm+MiMQSDnpq67zmxSKrKVujcm3Jk9g6",
+	"pfm0qKslensKLpvyUu/JXOYZ6MCum/rYT4+Wrd+lOpVGwh3AVsefEn4F/2rN76lR0QEYk4pfuJK5BfZp",
+	"cqeey3m0BiFNWj319cbbdjrP5XtncZ6BNr0/Mz99lsXREzlQ74s1qSVJWuP91lXAN+6DQ5xILuGWwNIO",
+	"2TfSSkP35O3ebqY1DeyZ1w3kdXUtyxHcPrW6NdI+rFrnGdy7j1ClXtPnHp9jccLoIRiySRAHKnuF7zRR",
+	"b5fMdHP6jEJ53JSVTZpIoUu1kErBgWe2Wb6QsTG/W9Pmnj2Sng2iHx6F7f8hhJlmBPu8RHlDVf2h8pQN",
+	"py4T0gZHgo3qdpZuYXQ3Fwt96+4CQnuLlM55MfdyPsbTh8EdrmBvt8KOaz3E+kCaow5USeFL27Rvacy8",
+	"9Y5vkXI9I67Betoi08wR5XMectZoPE1e5ot+uCNkP7dWQY5P/viYT/X5/LityL2IoipJ717yuv6HewEa",
+	"CBzhKHo+1lkeZ9SCTAC3QaMKSfs845kR2m1ul7bN4Xhkfw752fDHZ+64NXc0tHUwZ6kj5YewpzVvmGc7",
+	"WvVGL660SsZdn+WrASq7LXDMGM+xCSzQVXVVeYVUvWGZsC02ZtBCYpkNKcc4JRQicwC90t/u023rHc9v",
+	"r9XtkLBNnunMFSIr46W4e+K52mKn9c2grGepUqWylIn0QOX+q/uidykxN7XnfeL0HYeR5x3SPwKsJgT2",
+	"dWOSGeKh0vd7yJ5iTz3vKEu6XNZkTnEtgH1TvRhAL9add991cGqJ590uwms8f+AL+FUtpe1u33+kLkWJ",
+	"56VV03/bagE8xErsqL7d3F/Zbn7kV+Y3LOCxhygbQtuHXLrG84cSRw1EaKN4FY95vh/fT9DdUqTdJHmt",
+	"Ghz2Uo7HfNf1NW684lpR4VO4jUOaFT9CxthB67dEEFvh94iZvLaa/Wan0kujuM0bd45flMzvpdwZYMq1",
+	"Lu1YRx6CHTbN6wuFN10HNM2mMQkDNMOxsE84ucUSvvTXSxEgs7TNynilGlzZxLa98a/SKB4W9ifB7C8y",
+	"E0hDi0yaXRPKpR/lDfWGibo4m+JbTGJdQVsjHMKME7kanf/xsYp+CG9UfdgqPGuHJEYtarUTb4xvxE33",
+	"gehCtepbvta3mbQ1YVDu9YDOsd40k5uOctu99qbGx9GfsrBZL7fu6mf7OespL/BuOACemV3gS/E+bppR",
+	"p7pGgmk7M21NNGVYH+om5ie6qPZw07CuVf7ffpS50C0eLpl9n7taza3pYKIw8yROJtguYDMRcJhxEAvJ",
+	"boA20sKlaXStG+1zTTK5ACrtx2Y4z/IUZlFkwUfSgqYq/dtA2SuQJ28YuyFQBQDu9H0ZNi5DoXGi1nIi",
+	"TEn5b/E0jODFy1evv/4Gvcdy8e34G/SzlOk/abzyiLP7PiSCfMaU3iriJnRQKIqfRn8u5cQu8B8f1UYM",
+	"NVr0tPWjj1WzfAmlWk9PGAckSVKuRaS/rRLSnAhpKqM1xbjYFnvKkRXA3RBv6YzZtdmb9PhVFOPU41kU",
+	"HGbunYa273CEbNAPOilRCjo4qVToIAWuVDlTnao8oXYqSFlXxJ47Iv5zVtrvECl8PtvNSsFSDk9NUspG",
+	"4LlmB/cp+sIBW6sJt+iTl+tGn33FvRXYOnTsW3XkKlYpLB/NSlr1saucrdnv6t82G03OJPe4U9oY8VWh",
+	"KqizDpsZdmaa98Te1icsQs2ZWPF0fWUooDDjHKiMVyhm8zlEJ0TfSMbbeKsz0Q7hsc8MdQBDLVkvC+X/",
+	"kTBUVTEqv/jJmdoPUrZX9Tu+BS4Io21b/TfbZI9LaIe4BJHF3hVMOZtznCAHbpt+Y2/Pcp+oRGeeUUkS",
+	"yD9vMJ+qG5x8/pIe90eQtFfCuVUZVZ6Fu1qBpA9LjzbJbcn4jbr0l2jMKSBLWFJAtl7vQNJ9kofq3le2",
+	"vg7yfbDbq1v8A2Ok5Hp9+AeLc6/fBNFnNbuZyk6DEDfyI9buC+93R/jOajM7yt5X6aecwjav+OShw41C",
+	"QHZNhw48ktZor43Zjm02RJtI+p2kb2yrjqsR9kAxfe9T3Oby+Ed2BbWP1Vn8P0JWl8O2Cct7DJEbzVvD",
+	"RMQeSfWPh+PdJmbb8O5N7me1Md0JCIHnTRAnYj48NHVfMfLlcMS9Xmbn14gswpx6q3VuC4IJ89YK0wOo",
+	"uioO42W9RX7hs70AuuHe54R4+YtkxW2uAwSbPnu2XvyzvRrd704dsxDDuf4jODmrW4fLR+aUsz8hNPWs",
+	"1gwtT4Tpc7gF3pPpfwYKe22MVNuzlAGuQ+Oyhq+NJMqlXoSK3jnotG8W8cFYoGc4a9vMbZ3+TB4NtctP",
+	"JtTDDQMESppq5KMliWM3VxzHdf7Y6cKcYkHCwoPpcWoGn0Z/s9FwFxq/f4fV28hYga7InGKZcVj7+Q7k",
+	"gq23cYYt/fSaJCAkTtLccarx4ztTlGLxjPCgUcpMtf2Mx6Pz0ULK9Hw8jlmI4wUT8vzVV//x4tUYp2R8",
+	"+2J0HwzuMP/04/3/DAA2PwJof28BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -101,6 +101,32 @@ func Middleware(swagger *openapi3.T,
 	}
 }
 
+// RequirementsMiddleware authenticate requests of handlers not described in swagger with given security requirements.
+// request without valid credential is challenged by basic auth, so that clients like webdav can prompt user
+func RequirementsMiddleware(securityRequirements openapi3.SecurityRequirements,
+	authenticator *BasicAuthenticator,
+	secretStore crypt.SecretStore,
+	userRepo models.IUserRepo,
+	akskRepo models.IAkskRepo,
+	sessionStore sessions.Store,
+	verifier aksk.Verifier,
+) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, err := checkSecurityRequirements(r, securityRequirements, authenticator, sessionStore, secretStore, verifier, userRepo, akskRepo)
+			if err != nil || user == nil {
+				w.Header().Set("WWW-Authenticate", `Basic realm="jiaozifs"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				if err != nil {
+					_, _ = w.Write([]byte(err.Error()))
+				}
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(WithOperator(r.Context(), user)))
+		})
+	}
+}
+
 // checkSecurityRequirements goes over the security requirements and check the authentication. returns the user information and error if the security check was required.
 // it will return nil user and error in case of no security checks to match.
 func checkSecurityRequirements(r *http.Request,
@@ -0,0 +1,439 @@
+package cmd
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	path2 "path"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/gobwas/glob"
+	"github.com/spf13/cobra"
+)
+
+// checkoutManifestPath manifest of files checked out, relative to dest directory
+const checkoutManifestPath = ".jzfs/checkout.json"
+
+type checkoutEntry struct {
+	Hash     string `json:"hash,omitempty"`
+	Checksum string `json:"checksum"`
+	Size     int64  `json:"size"`
+	// Deleted file removed from wip, only used in staged entries
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+type checkoutManifest struct {
+	Owner   string                   `json:"owner"`
+	Repo    string                   `json:"repo"`
+	Ref     string                   `json:"ref"`
+	RefType api.RefType              `json:"ref_type"`
+	Files   map[string]checkoutEntry `json:"files"`
+	// Staged files added to or removed from wip but not committed yet
+	Staged map[string]checkoutEntry `json:"staged,omitempty"`
+}
+
+var checkoutCmd = &cobra.Command{
+	Use:   "checkout",
+	Short: "download files of branch/tag/commit to local directory, only changed files are downloaded in later checkout",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := cmd.Context()
+		client, err := GetClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		owner, err := cmd.Flags().GetString("owner")
+		if err != nil {
+			return err
+		}
+		repo, err := cmd.Flags().GetString("repo")
+		if err != nil {
+			return err
+		}
+		if len(owner) == 0 || len(repo) == 0 {
+			return errors.New("owner and repo must be set")
+		}
+
+		ref, err := cmd.Flags().GetString("ref")
+		if err != nil {
+			return err
+		}
+		if len(ref) == 0 {
+			return errors.New("ref must be set")
+		}
+
+		dest, err := cmd.Flags().GetString("dest")
+		if err != nil {
+			return err
+		}
+		if len(dest) == 0 {
+			return errors.New("dest must be set")
+		}
+
+		includes, err := cmd.Flags().GetStringArray("include")
+		if err != nil {
+			return err
+		}
+		excludes, err := cmd.Flags().GetStringArray("exclude")
+		if err != nil {
+			return err
+		}
+		match, err := newPathMatcher(includes, excludes)
+		if err != nil {
+			return err
+		}
+
+		parallel, err := cmd.Flags().GetInt("parallel")
+		if err != nil {
+			return err
+		}
+		retry, err := cmd.Flags().GetInt("retry")
+		if err != nil {
+			return err
+		}
+
+		refTypeStr, err := cmd.Flags().GetString("ref-type")
+		if err != nil {
+			return err
+		}
+		refType := api.RefType(refTypeStr)
+		if len(refType) == 0 {
+			refType, err = resolveRefType(ctx, client, owner, repo, ref)
+			if err != nil {
+				return err
+			}
+		}
+
+		return checkoutFiles(ctx, client, dest, owner, repo, ref, refType, match, parallel, retry)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(checkoutCmd)
+
+	checkoutCmd.Flags().String("owner", "", "owner")
+	checkoutCmd.Flags().String("repo", "", "repo")
+	checkoutCmd.Flags().String("ref", "main", "branch, tag or commit hash to checkout")
+	checkoutCmd.Flags().String("ref-type", "", "type of ref (branch/tag/commit), detect automatically if not set")
+	checkoutCmd.Flags().String("dest", "", "local directory to save files")
+	checkoutCmd.Flags().StringArray("include", nil, "glob pattern of files to checkout, all files are included if not set")
+	checkoutCmd.Flags().StringArray("exclude", nil, "glob pattern of files not to checkout")
+	checkoutCmd.Flags().Int("parallel", 4, "number of files downloaded in parallel")
+	checkoutCmd.Flags().Int("retry", 3, "retry times of failed download")
+}
+
+// checkoutFiles download files of ref matched into dest, files unchanged since last checkout are skipped
+func checkoutFiles(ctx context.Context, client *api.Client, dest, owner, repo, ref string, refType api.RefType, match func(string) bool, parallel, retry int) error {
+	files, err := listRefFiles(ctx, client, owner, repo, ref, refType)
+	if err != nil {
+		return err
+	}
+	for path := range files {
+		if !match(path) {
+			delete(files, path)
+		}
+	}
+
+	oldManifest, err := readCheckoutManifest(dest)
+	if err != nil {
+		return err
+	}
+	if oldManifest.Owner != owner || oldManifest.Repo != repo {
+		oldManifest.Files = map[string]checkoutEntry{}
+	}
+
+	newManifest := &checkoutManifest{
+		Owner:   owner,
+		Repo:    repo,
+		Ref:     ref,
+		RefType: refType,
+		Files:   map[string]checkoutEntry{},
+		Staged:  map[string]checkoutEntry{},
+	}
+	if oldManifest.Owner == owner && oldManifest.Repo == repo && oldManifest.Ref == ref {
+		newManifest.Staged = oldManifest.Staged
+	}
+
+	var toDownload []string
+	for path, entry := range files {
+		oldEntry, ok := oldManifest.Files[path]
+		if ok && oldEntry.Hash == entry.Hash {
+			if st, err := os.Stat(filepath.Join(dest, filepath.FromSlash(path))); err == nil && st.Size() == entry.Size {
+				newManifest.Files[path] = entry
+				continue
+			}
+		}
+		toDownload = append(toDownload, path)
+	}
+	sort.Strings(toDownload)
+
+	//remove files checked out before but not selected any more
+	for path := range oldManifest.Files {
+		if _, ok := files[path]; ok {
+			continue
+		}
+		localPath, err := checkoutLocalPath(dest, path)
+		if err != nil {
+			return err
+		}
+		err = os.Remove(localPath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	fmt.Printf("%d files in %s, %d files need to be downloaded\n", len(files), ref, len(toDownload))
+	var (
+		lk       sync.Mutex
+		failures []error
+		wg       sync.WaitGroup
+	)
+	pathCh := make(chan string)
+	for i := 0; i < max(parallel, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range pathCh {
+				entry := files[path]
+				err := downloadWithRetry(ctx, client, owner, repo, ref, refType, dest, path, entry, retry)
+				lk.Lock()
+				if err != nil {
+					failures = append(failures, fmt.Errorf("download %s failed %w", path, err))
+				} else {
+					newManifest.Files[path] = entry
+					fmt.Println("Download file success ", path)
+				}
+				lk.Unlock()
+			}
+		}()
+	}
+	for _, path := range toDownload {
+		pathCh <- path
+	}
+	close(pathCh)
+	wg.Wait()
+
+	//manifest record files downloaded, so that failed files are downloaded in next checkout
+	err = writeCheckoutManifest(dest, newManifest)
+	if err != nil {
+		return err
+	}
+	return errors.Join(failures...)
+}
+
+// newPathMatcher return function to check whether path match any include pattern and not match any exclude pattern
+func newPathMatcher(includes, excludes []string) (func(string) bool, error) {
+	compile := func(patterns []string) ([]glob.Glob, error) {
+		globs := make([]glob.Glob, 0, len(patterns))
+		for _, pattern := range patterns {
+			g, err := glob.Compile(pattern, '/')
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %s %w", pattern, err)
+			}
+			globs = append(globs, g)
+		}
+		return globs, nil
+	}
+
+	includeGlobs, err := compile(includes)
+	if err != nil {
+		return nil, err
+	}
+	excludeGlobs, err := compile(excludes)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(path string) bool {
+		for _, g := range excludeGlobs {
+			if g.Match(path) {
+				return false
+			}
+		}
+		if len(includeGlobs) == 0 {
+			return true
+		}
+		for _, g := range includeGlobs {
+			if g.Match(path) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// resolveRefType find out whether ref is branch, tag or commit
+func resolveRefType(ctx context.Context, client *api.Client, owner, repo, ref string) (api.RefType, error) {
+	for _, refType := range []api.RefType{api.RefTypeBranch, api.RefTypeTag, api.RefTypeCommit} {
+		resp, err := client.GetEntriesInRef(ctx, owner, repo, &api.GetEntriesInRefParams{Ref: utils.String(ref), Type: refType})
+		if err != nil {
+			return "", err
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			return refType, nil
+		}
+	}
+	return "", fmt.Errorf("ref %s not found", ref)
+}
+
+// listRefFiles list all files in ref, key is path relative to ref
+func listRefFiles(ctx context.Context, client *api.Client, owner, repo, ref string, refType api.RefType) (map[string]checkoutEntry, error) {
+	files := map[string]checkoutEntry{}
+	dirs := []string{""}
+	for len(dirs) > 0 {
+		dir := dirs[0]
+		dirs = dirs[1:]
+
+		resp, err := client.GetEntriesInRef(ctx, owner, repo, &api.GetEntriesInRefParams{Path: utils.String(dir), Ref: utils.String(ref), Type: refType})
+		if err != nil {
+			return nil, err
+		}
+		result, err := api.ParseGetEntriesInRefResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		if result.JSON200 == nil {
+			return nil, fmt.Errorf("list %s failed %d, %s", dir, result.StatusCode(), string(result.Body))
+		}
+
+		for _, entry := range *result.JSON200 {
+			path := path2.Join(dir, entry.Name)
+			if entry.IsDir {
+				dirs = append(dirs, path)
+				continue
+			}
+			if !filepath.IsLocal(filepath.FromSlash(path)) {
+				return nil, fmt.Errorf("invalid path %s in ref", path)
+			}
+			files[path] = checkoutEntry{
+				Hash:     entry.Hash,
+				Checksum: utils.StringValue(entry.Checksum),
+				Size:     entry.Size,
+			}
+		}
+	}
+	return files, nil
+}
+
+func downloadWithRetry(ctx context.Context, client *api.Client, owner, repo, ref string, refType api.RefType, dest, path string, entry checkoutEntry, retry int) error {
+	var err error
+	for attempt := 0; attempt <= retry; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * time.Second):
+			}
+		}
+		err = downloadFile(ctx, client, owner, repo, ref, refType, dest, path, entry)
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// checkoutLocalPath return local path of file in dest, paths come from server and must not escape dest
+func checkoutLocalPath(dest, path string) (string, error) {
+	localPath := filepath.FromSlash(path)
+	if !filepath.IsLocal(localPath) {
+		return "", fmt.Errorf("path %s is outside of checkout directory", path)
+	}
+	return filepath.Join(dest, localPath), nil
+}
+
+// downloadFile download file to temp file and verify checksum, then move it to dest
+func downloadFile(ctx context.Context, client *api.Client, owner, repo, ref string, refType api.RefType, dest, path string, entry checkoutEntry) error {
+	localPath, err := checkoutLocalPath(dest, path)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(localPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetObject(ctx, owner, repo, &api.GetObjectParams{
+		Type:    refType,
+		RefName: ref,
+		Path:    path,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get object failed %d, %s", resp.StatusCode, tryLogError(resp))
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(localPath), ".jzfs-download-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name()) //nolint
+
+	hasher := md5.New() //nolint:gosec
+	size, err := io.Copy(io.MultiWriter(tmpFile, hasher), resp.Body)
+	closeErr := tmpFile.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	if size != entry.Size {
+		return fmt.Errorf("size mismatch expect %d but got %d", entry.Size, size)
+	}
+	if checksum := hex.EncodeToString(hasher.Sum(nil)); len(entry.Checksum) > 0 && checksum != entry.Checksum {
+		return fmt.Errorf("checksum mismatch expect %s but got %s", entry.Checksum, checksum)
+	}
+	return os.Rename(tmpFile.Name(), localPath)
+}
+
+func readCheckoutManifest(dest string) (*checkoutManifest, error) {
+	manifest := &checkoutManifest{Files: map[string]checkoutEntry{}, Staged: map[string]checkoutEntry{}}
+	data, err := os.ReadFile(filepath.Join(dest, checkoutManifestPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return manifest, nil
+		}
+		return nil, err
+	}
+	err = json.Unmarshal(data, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid checkout manifest %w", err)
+	}
+	if manifest.Files == nil {
+		manifest.Files = map[string]checkoutEntry{}
+	}
+	if manifest.Staged == nil {
+		manifest.Staged = map[string]checkoutEntry{}
+	}
+	return manifest, nil
+}
+
+func writeCheckoutManifest(dest string, manifest *checkoutManifest) error {
+	manifestPath := filepath.Join(dest, checkoutManifestPath)
+	err := os.MkdirAll(filepath.Dir(manifestPath), 0755)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(manifestPath, data, 0644)
+}
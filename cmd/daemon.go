@@ -14,11 +14,15 @@ import (
 	"github.com/GitDataAI/jiaozifs/auth/crypt"
 	"github.com/GitDataAI/jiaozifs/block/params"
 	"github.com/GitDataAI/jiaozifs/config"
+	"github.com/GitDataAI/jiaozifs/dav"
 	"github.com/GitDataAI/jiaozifs/fx_opt"
+	"github.com/GitDataAI/jiaozifs/gateway"
 	"github.com/GitDataAI/jiaozifs/models"
 	"github.com/GitDataAI/jiaozifs/models/migrations"
 	"github.com/GitDataAI/jiaozifs/utils"
 	"github.com/GitDataAI/jiaozifs/version"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/GitDataAI/jiaozifs/webhook"
 	"github.com/gorilla/sessions"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/spf13/cobra"
@@ -60,6 +64,10 @@ var daemonCmd = &cobra.Command{
 			fx_opt.Override(new(*config.APIConfig), &cfg.API),
 			fx_opt.Override(new(*config.AuthConfig), &cfg.Auth),
 			fx_opt.Override(new(*config.DatabaseConfig), &cfg.Database),
+			fx_opt.Override(new(*config.GCConfig), &cfg.GC),
+			fx_opt.Override(new(*config.UploadConfig), &cfg.Upload),
+			fx_opt.Override(new(*config.GatewayConfig), &cfg.Gateway),
+			fx_opt.Override(new(*config.WebhookConfig), &cfg.Webhook),
 			fx_opt.Override(new(params.AdapterConfig), &cfg.Blockstore),
 			//database
 			fx_opt.Override(new(*bun.DB), models.SetupDatabase),
@@ -71,6 +79,7 @@ var daemonCmd = &cobra.Command{
 			}),
 
 			fx_opt.Override(fx_opt.NextInvoke(), migrations.MigrateDatabase),
+			fx_opt.Override(fx_opt.NextInvoke(), versionmgr.CleanupStagingObjects),
 			//permission
 			fx_opt.Override(new(rbac.PermissionCheck), func(repo models.IRepo) rbac.PermissionCheck {
 				return rbac.NewRbacAuth(repo)
@@ -81,7 +90,13 @@ var daemonCmd = &cobra.Command{
 			fx_opt.Override(new(sessions.Store), auth.NewSessionStore),
 			fx_opt.Override(new(*auth.BasicAuthenticator), auth.NewBasicAuthenticator),
 			fx_opt.Override(new(aksk.Verifier), auth.NewAkskVerifier),
+			fx_opt.Override(new(*dav.Handler), dav.NewHandler),
 			fx_opt.Override(fx_opt.NextInvoke(), apiImpl.SetupAPI),
+			fx_opt.Override(fx_opt.NextInvoke(), gateway.SetupGateway),
+			//gc
+			fx_opt.Override(fx_opt.NextInvoke(), versionmgr.RunBackgroundGC),
+			//webhook
+			fx_opt.Override(fx_opt.NextInvoke(), webhook.RunWebhookDelivery),
 		)
 		if err != nil {
 			return err
@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"net/http"
 	"os"
@@ -11,6 +15,7 @@ import (
 	"strings"
 
 	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
 
 	"github.com/GitDataAI/jiaozifs/api"
 
@@ -100,6 +105,21 @@ var uploadCmd = &cobra.Command{
 			if err != nil {
 				return err
 			}
+		} else {
+			files = append(files, path)
+		}
+
+		resume, err := cmd.Flags().GetBool("resume")
+		if err != nil {
+			return err
+		}
+
+		partSize, err := cmd.Flags().GetInt64("part-size")
+		if err != nil {
+			return err
+		}
+		if partSize < versionmgr.MinMultipartPartSize {
+			return fmt.Errorf("part size must be larger than %d", versionmgr.MinMultipartPartSize)
 		}
 
 		fmt.Printf("Files dected, %d files need to be uploaded\n", len(files))
@@ -110,10 +130,6 @@ var uploadCmd = &cobra.Command{
 
 		basename := filepath.Base(path)
 		for _, file := range files {
-			fs, err := os.Open(file)
-			if err != nil {
-				return err
-			}
 			relativePath := strings.Replace(file, path, "", 1)
 
 			var destPath string
@@ -123,6 +139,19 @@ var uploadCmd = &cobra.Command{
 				destPath = path2.Join(uploadPath, relativePath)
 			}
 
+			if resume {
+				err = uploadResumable(cmd.Context(), client, owner, repo, refName, file, destPath, partSize, replace)
+				if err != nil {
+					return err
+				}
+				fmt.Println("Upload file success ", file, " dest path", destPath)
+				continue
+			}
+
+			fs, err := os.Open(file)
+			if err != nil {
+				return err
+			}
 			resp, err := client.UploadObjectWithBody(cmd.Context(), owner, repo, &api.UploadObjectParams{
 				RefName: refName,
 				// Path relative to the ref
@@ -233,6 +262,8 @@ func init() {
 	uploadCmd.Flags().String("upload-path", "", "path to save in server")
 	uploadCmd.Flags().Bool("replace", true, "path to save in server")
 	uploadCmd.Flags().Bool("ignore-root-name", false, "ignore root name")
+	uploadCmd.Flags().Bool("resume", false, "upload by multipart and resume unfinished upload of the same path")
+	uploadCmd.Flags().Int64("part-size", 16<<20, "size of each part in resume mode")
 
 	rootCmd.AddCommand(downloadCmd)
 	downloadCmd.Flags().String("path", "", "path of files to upload")
@@ -242,3 +273,127 @@ func init() {
 	downloadCmd.Flags().String("ref-type", "branch", "reference type")
 	downloadCmd.Flags().String("output", "branch", "reference type")
 }
+
+// uploadResumable upload file by multipart, parts already uploaded by an unfinished upload of the same path are skipped
+func uploadResumable(ctx context.Context, client *api.Client, owner, repo, refName, file, destPath string, partSize int64, replace bool) error {
+	fs, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer fs.Close() //nolint
+
+	st, err := fs.Stat()
+	if err != nil {
+		return err
+	}
+	partCount := int((st.Size() + partSize - 1) / partSize)
+	if partCount == 0 {
+		partCount = 1
+	}
+	partLength := func(partNumber int) int64 {
+		return min(partSize, st.Size()-int64(partNumber-1)*partSize)
+	}
+
+	resp, err := client.ListMultipartUploads(ctx, owner, repo, &api.ListMultipartUploadsParams{RefName: utils.String(refName), Path: utils.String(destPath)})
+	if err != nil {
+		return err
+	}
+	listResult, err := api.ParseListMultipartUploadsResponse(resp)
+	if err != nil {
+		return err
+	}
+	if listResult.JSON200 == nil {
+		return fmt.Errorf("list multipart uploads failed %d, %s", listResult.StatusCode(), string(listResult.Body))
+	}
+
+	var upload *api.MultipartUpload
+	uploaded := map[int]bool{}
+	for _, existUpload := range *listResult.JSON200 {
+		existUpload := existUpload
+		reusable := upload == nil
+		for _, part := range existUpload.Parts {
+			if part.PartNumber > partCount || part.Size != partLength(part.PartNumber) {
+				//file changed or uploaded with another part size
+				reusable = false
+				break
+			}
+		}
+		if reusable {
+			upload = &existUpload
+			for _, part := range existUpload.Parts {
+				//file may be changed without changing size, upload part again if content differs
+				checkSum, err := partMd5(fs, int64(part.PartNumber-1)*partSize, part.Size)
+				if err != nil {
+					return err
+				}
+				uploaded[part.PartNumber] = part.Checksum == checkSum
+			}
+			continue
+		}
+		_, err = client.AbortMultipartUpload(ctx, owner, repo, existUpload.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	if upload == nil {
+		resp, err = client.CreateMultipartUpload(ctx, owner, repo, &api.CreateMultipartUploadParams{RefName: refName, Path: destPath})
+		if err != nil {
+			return err
+		}
+		createResult, err := api.ParseCreateMultipartUploadResponse(resp)
+		if err != nil {
+			return err
+		}
+		if createResult.JSON201 == nil {
+			return fmt.Errorf("create multipart upload failed %d, %s", createResult.StatusCode(), string(createResult.Body))
+		}
+		upload = createResult.JSON201
+	} else {
+		verified := 0
+		for _, ok := range uploaded {
+			if ok {
+				verified++
+			}
+		}
+		fmt.Printf("Resume upload %s, %d of %d parts uploaded\n", destPath, verified, partCount)
+	}
+
+	for partNumber := 1; partNumber <= partCount; partNumber++ {
+		if uploaded[partNumber] {
+			continue
+		}
+		length := partLength(partNumber)
+		resp, err = client.UploadMultipartPartWithBody(ctx, owner, repo, upload.Id, partNumber, "application/octet-stream",
+			io.NewSectionReader(fs, int64(partNumber-1)*partSize, length), func(_ context.Context, req *http.Request) error {
+				req.ContentLength = length
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("upload part %d failed %d, %s", partNumber, resp.StatusCode, tryLogError(resp))
+		}
+		_ = resp.Body.Close()
+	}
+
+	resp, err = client.CompleteMultipartUpload(ctx, owner, repo, upload.Id, &api.CompleteMultipartUploadParams{IsReplace: utils.Bool(replace)})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("complete multipart upload failed %d, %s", resp.StatusCode, tryLogError(resp))
+	}
+	return nil
+}
+
+// partMd5 return hex md5 of part content in local file
+func partMd5(file *os.File, offset, length int64) (string, error) {
+	hasher := md5.New()
+	_, err := io.Copy(hasher, io.NewSectionReader(file, offset, length))
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GitDataAI/jiaozifs/config"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/spf13/cobra"
+)
+
+// gcCmd collect unreachable objects of repositories
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "remove commits, trees, blobs and stored contents which can not be reached from branches, tags and wips",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		cfg, err := config.LoadConfig(cfgFile)
+		if err != nil {
+			return err
+		}
+
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+
+		gracePeriod, err := cmd.Flags().GetDuration("grace-period")
+		if err != nil {
+			return err
+		}
+		if gracePeriod < 0 {
+			return errors.New("grace period must not be negative")
+		}
+
+		owner, err := cmd.Flags().GetString("owner")
+		if err != nil {
+			return err
+		}
+
+		repoName, err := cmd.Flags().GetString("repo")
+		if err != nil {
+			return err
+		}
+		if len(repoName) > 0 && len(owner) == 0 {
+			return errors.New("owner must be set with repo")
+		}
+
+		bunDB, err := models.NewBunDBFromConfig(cmd.Context(), &cfg.Database)
+		if err != nil {
+			return err
+		}
+		defer bunDB.Close() //nolint
+
+		repo := models.NewRepo(bunDB)
+		listParams := models.NewListRepoParams()
+		if len(owner) > 0 {
+			ownerUser, err := repo.UserRepo().Get(cmd.Context(), models.NewGetUserParams().SetName(owner))
+			if err != nil {
+				return err
+			}
+			listParams.SetOwnerID(ownerUser.ID)
+		}
+		if len(repoName) > 0 {
+			listParams.SetName(repoName, models.ExactMatch)
+		}
+
+		reports, err := versionmgr.GCRepositories(cmd.Context(), repo, &cfg.Blockstore, listParams, versionmgr.GCOptions{
+			DryRun:      dryRun,
+			GracePeriod: gracePeriod,
+		})
+		if err != nil {
+			return err
+		}
+
+		action := "removed"
+		if dryRun {
+			action = "would remove"
+		}
+		for _, report := range reports {
+			fmt.Printf("repository %s: reachable %d commits %d trees %d blobs, %s %d commits %d trees %d blobs %d contents (%d bytes)\n",
+				report.RepositoryID, report.ReachableCommits, report.ReachableTrees, report.ReachableBlobs, action,
+				report.SweptCommits, report.SweptTrees, report.SweptBlobs, report.RemovedContents, report.FreedBytes)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(gcCmd)
+
+	gcCmd.Flags().Bool("dry-run", false, "only report unreachable objects, nothing will be deleted")
+	gcCmd.Flags().Duration("grace-period", versionmgr.DefaultGCGracePeriod, "objects created or updated within this period are kept")
+	gcCmd.Flags().String("owner", "", "only collect repositories of this owner")
+	gcCmd.Flags().String("repo", "", "only collect this repository, owner must be set")
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/spf13/cobra"
+)
+
+var logCmd = &cobra.Command{
+	Use:   "log [path]",
+	Short: "show commit history of branch, only commits changed the path are listed if path is set",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		client, err := GetClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		owner, err := cmd.Flags().GetString("owner")
+		if err != nil {
+			return err
+		}
+		repo, err := cmd.Flags().GetString("repo")
+		if err != nil {
+			return err
+		}
+		if len(owner) == 0 || len(repo) == 0 {
+			return errors.New("owner and repo must be set")
+		}
+
+		ref, err := cmd.Flags().GetString("ref")
+		if err != nil {
+			return err
+		}
+		amount, err := cmd.Flags().GetInt("amount")
+		if err != nil {
+			return err
+		}
+		follow, err := cmd.Flags().GetBool("follow")
+		if err != nil {
+			return err
+		}
+		metadata, err := cmd.Flags().GetStringToString("meta")
+		if err != nil {
+			return err
+		}
+
+		params := &api.GetCommitsInRefParams{}
+		if len(ref) > 0 {
+			params.RefName = utils.String(ref)
+		}
+		if amount > 0 {
+			params.Amount = &amount
+		}
+		if len(args) > 0 {
+			params.Path = utils.String(args[0])
+			params.Follow = &follow
+		} else if follow {
+			return errors.New("follow must be used with path")
+		}
+		if len(metadata) > 0 {
+			filter := make([]string, 0, len(metadata))
+			for key, value := range metadata {
+				filter = append(filter, key+"="+value)
+			}
+			params.Metadata = &filter
+		}
+
+		resp, err := client.GetCommitsInRef(ctx, owner, repo, params)
+		if err != nil {
+			return err
+		}
+		result, err := api.ParseGetCommitsInRefResponse(resp)
+		if err != nil {
+			return err
+		}
+		if result.JSON200 == nil {
+			return fmt.Errorf("get commits failed %d, %s", result.StatusCode(), string(result.Body))
+		}
+
+		for _, commit := range *result.JSON200 {
+			fmt.Printf("commit %s\n", commit.Hash)
+			fmt.Printf("Author: %s <%s>\n", commit.Author.Name, commit.Author.Email)
+			fmt.Printf("Date:   %s\n", time.UnixMilli(commit.Author.When).Format(time.RFC1123Z))
+			if commit.Metadata != nil {
+				keys := make([]string, 0, len(*commit.Metadata))
+				for key := range *commit.Metadata {
+					keys = append(keys, key)
+				}
+				sort.Strings(keys)
+				for _, key := range keys {
+					fmt.Printf("Meta:   %s=%s\n", key, (*commit.Metadata)[key])
+				}
+			}
+			fmt.Println()
+			for _, line := range strings.Split(strings.TrimRight(commit.Message, "\n"), "\n") {
+				fmt.Printf("    %s\n", line)
+			}
+			fmt.Println()
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(logCmd)
+
+	logCmd.Flags().String("owner", "", "owner")
+	logCmd.Flags().String("repo", "", "repo")
+	logCmd.Flags().String("ref", "", "branch to show history of, default branch of repository if not set")
+	logCmd.Flags().Int("amount", 0, "max number of commits to show, all commits are shown if not set")
+	logCmd.Flags().Bool("follow", false, "continue listing the history of a file beyond renames")
+	logCmd.Flags().StringToString("meta", nil, "only show commits having all the metadata, eg. --meta pipeline_run_id=42")
+}
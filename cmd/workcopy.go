@@ -0,0 +1,550 @@
+package cmd
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	path2 "path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/spf13/cobra"
+)
+
+var errNotWorkCopy = errors.New("not a jzfs working copy (or any of the parent directories), use clone or checkout first")
+
+var cloneCmd = &cobra.Command{
+	Use:   "clone",
+	Short: "clone branch of repository into local directory as working copy",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := GetClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		owner, err := cmd.Flags().GetString("owner")
+		if err != nil {
+			return err
+		}
+		repo, err := cmd.Flags().GetString("repo")
+		if err != nil {
+			return err
+		}
+		if len(owner) == 0 || len(repo) == 0 {
+			return errors.New("owner and repo must be set")
+		}
+
+		branch, err := cmd.Flags().GetString("branch")
+		if err != nil {
+			return err
+		}
+		if len(branch) == 0 {
+			return errors.New("branch must be set")
+		}
+
+		dest, err := cmd.Flags().GetString("dest")
+		if err != nil {
+			return err
+		}
+		if len(dest) == 0 {
+			dest = repo
+		}
+		if _, err = os.Stat(filepath.Join(dest, checkoutManifestPath)); err == nil {
+			return fmt.Errorf("%s is already a working copy", dest)
+		}
+
+		parallel, err := cmd.Flags().GetInt("parallel")
+		if err != nil {
+			return err
+		}
+		retry, err := cmd.Flags().GetInt("retry")
+		if err != nil {
+			return err
+		}
+
+		match := func(string) bool { return true }
+		return checkoutFiles(cmd.Context(), client, dest, owner, repo, branch, api.RefTypeBranch, match, parallel, retry)
+	},
+}
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "show changes staged in wip and changes of working copy not staged",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		root, manifest, err := openWorkCopy()
+		if err != nil {
+			return err
+		}
+
+		status, err := workCopyStatus(root, manifest, "")
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("On %s %s of %s/%s\n", manifest.RefType, manifest.Ref, manifest.Owner, manifest.Repo)
+		if len(manifest.Staged) > 0 {
+			fmt.Println("Changes to be committed:")
+			for _, path := range sortedKeys(manifest.Staged) {
+				if manifest.Staged[path].Deleted {
+					fmt.Println("\tdeleted:  ", path)
+				} else if _, ok := manifest.Files[path]; ok {
+					fmt.Println("\tmodified: ", path)
+				} else {
+					fmt.Println("\tnew file: ", path)
+				}
+			}
+		}
+		if len(status.Modified) > 0 || len(status.Deleted) > 0 {
+			fmt.Println("Changes not staged for commit:")
+			for _, path := range status.Modified {
+				fmt.Println("\tmodified: ", path)
+			}
+			for _, path := range status.Deleted {
+				fmt.Println("\tdeleted:  ", path)
+			}
+		}
+		if len(status.Untracked) > 0 {
+			fmt.Println("Untracked files:")
+			for _, path := range status.Untracked {
+				fmt.Println("\t" + path)
+			}
+		}
+		if len(manifest.Staged) == 0 && status.Clean() {
+			fmt.Println("nothing to commit, working copy clean")
+		}
+		return nil
+	},
+}
+
+var addCmd = &cobra.Command{
+	Use:   "add <path>...",
+	Short: "upload new and modified files to wip, files deleted locally are removed from wip",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		client, err := GetClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		root, manifest, err := openWorkCopy()
+		if err != nil {
+			return err
+		}
+		if manifest.RefType != api.RefTypeBranch {
+			return fmt.Errorf("%s %s is read only, only branch can be changed", manifest.RefType, manifest.Ref)
+		}
+		err = ensureWip(ctx, client, manifest)
+		if err != nil {
+			return err
+		}
+
+		for _, arg := range args {
+			prefix, err := workCopyPath(root, arg)
+			if err != nil {
+				return err
+			}
+			status, err := workCopyStatus(root, manifest, prefix)
+			if err != nil {
+				return err
+			}
+			if status.Clean() && len(prefix) > 0 {
+				if _, err = os.Stat(filepath.Join(root, filepath.FromSlash(prefix))); err != nil {
+					return fmt.Errorf("pathspec %s did not match any files", arg)
+				}
+			}
+
+			for _, path := range append(status.Modified, status.Untracked...) {
+				entry, err := uploadWorkCopyFile(ctx, client, root, manifest, path)
+				if err != nil {
+					return err
+				}
+				manifest.Staged[path] = entry
+				fmt.Println("Add file success ", path)
+			}
+			for _, path := range status.Deleted {
+				err = removeWipFile(ctx, client, manifest, path)
+				if err != nil {
+					return err
+				}
+				manifest.Staged[path] = checkoutEntry{Deleted: true}
+				fmt.Println("Remove file success ", path)
+			}
+			//save after each path, so that files staged are not lost when later path failed
+			err = writeCheckoutManifest(root, manifest)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var rmCmd = &cobra.Command{
+	Use:   "rm <path>...",
+	Short: "remove files from wip and working copy",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		client, err := GetClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		cached, err := cmd.Flags().GetBool("cached")
+		if err != nil {
+			return err
+		}
+
+		root, manifest, err := openWorkCopy()
+		if err != nil {
+			return err
+		}
+		if manifest.RefType != api.RefTypeBranch {
+			return fmt.Errorf("%s %s is read only, only branch can be changed", manifest.RefType, manifest.Ref)
+		}
+		err = ensureWip(ctx, client, manifest)
+		if err != nil {
+			return err
+		}
+
+		for _, arg := range args {
+			prefix, err := workCopyPath(root, arg)
+			if err != nil {
+				return err
+			}
+
+			var paths []string
+			for path := range trackedFiles(manifest) {
+				if matchPrefix(path, prefix) {
+					paths = append(paths, path)
+				}
+			}
+			if len(paths) == 0 {
+				return fmt.Errorf("pathspec %s did not match any tracked files", arg)
+			}
+			sort.Strings(paths)
+
+			for _, path := range paths {
+				err = removeWipFile(ctx, client, manifest, path)
+				if err != nil {
+					return err
+				}
+				manifest.Staged[path] = checkoutEntry{Deleted: true}
+				if !cached {
+					err = os.Remove(filepath.Join(root, filepath.FromSlash(path)))
+					if err != nil && !os.IsNotExist(err) {
+						return err
+					}
+				}
+				fmt.Println("Remove file success ", path)
+			}
+			err = writeCheckoutManifest(root, manifest)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var commitCmd = &cobra.Command{
+	Use:   "commit",
+	Short: "commit changes staged in wip to branch",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := cmd.Context()
+		client, err := GetClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		msg, err := cmd.Flags().GetString("message")
+		if err != nil {
+			return err
+		}
+		if len(msg) == 0 {
+			return errors.New("message must be set")
+		}
+		metadata, err := cmd.Flags().GetStringToString("meta")
+		if err != nil {
+			return err
+		}
+
+		root, manifest, err := openWorkCopy()
+		if err != nil {
+			return err
+		}
+		if manifest.RefType != api.RefTypeBranch {
+			return fmt.Errorf("%s %s is read only, only branch can be changed", manifest.RefType, manifest.Ref)
+		}
+		if len(manifest.Staged) == 0 {
+			return errors.New("nothing to commit, use add or rm to stage changes")
+		}
+
+		resp, err := client.CommitWip(ctx, manifest.Owner, manifest.Repo, &api.CommitWipParams{Msg: msg, RefName: manifest.Ref}, api.CommitWipJSONRequestBody{Metadata: &metadata})
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusCreated {
+			return fmt.Errorf("commit failed %d, %s", resp.StatusCode, tryLogError(resp))
+		}
+		_ = resp.Body.Close()
+
+		//refresh index with hash of committed files, so that later checkout skip them
+		files, err := listRefFiles(ctx, client, manifest.Owner, manifest.Repo, manifest.Ref, manifest.RefType)
+		if err != nil {
+			return err
+		}
+		for path, staged := range manifest.Staged {
+			if entry, ok := files[path]; ok && !staged.Deleted {
+				manifest.Files[path] = entry
+			} else {
+				delete(manifest.Files, path)
+			}
+		}
+		fmt.Printf("Commit %d changes to %s\n", len(manifest.Staged), manifest.Ref)
+		manifest.Staged = map[string]checkoutEntry{}
+		return writeCheckoutManifest(root, manifest)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cloneCmd)
+	cloneCmd.Flags().String("owner", "", "owner")
+	cloneCmd.Flags().String("repo", "", "repo")
+	cloneCmd.Flags().String("branch", "main", "branch to clone")
+	cloneCmd.Flags().String("dest", "", "local directory of working copy, default to repo name")
+	cloneCmd.Flags().Int("parallel", 4, "number of files downloaded in parallel")
+	cloneCmd.Flags().Int("retry", 3, "retry times of failed download")
+
+	rootCmd.AddCommand(statusCmd)
+
+	rootCmd.AddCommand(addCmd)
+
+	rootCmd.AddCommand(rmCmd)
+	rmCmd.Flags().Bool("cached", false, "only remove from wip, keep local file")
+
+	rootCmd.AddCommand(commitCmd)
+	commitCmd.Flags().StringP("message", "m", "", "commit message")
+	commitCmd.Flags().StringToString("meta", nil, "metadata of commit for lineage, eg. --meta pipeline_run_id=42,source_uri=s3://bucket/data")
+}
+
+// workCopyChanges changes of working copy not staged
+type workCopyChanges struct {
+	Modified  []string
+	Deleted   []string
+	Untracked []string
+}
+
+func (changes *workCopyChanges) Clean() bool {
+	return len(changes.Modified) == 0 && len(changes.Deleted) == 0 && len(changes.Untracked) == 0
+}
+
+// openWorkCopy find working copy contains current directory and load its index
+func openWorkCopy() (string, *checkoutManifest, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", nil, err
+	}
+	for {
+		if _, err = os.Stat(filepath.Join(dir, checkoutManifestPath)); err == nil {
+			manifest, err := readCheckoutManifest(dir)
+			if err != nil {
+				return "", nil, err
+			}
+			return dir, manifest, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil, errNotWorkCopy
+		}
+		dir = parent
+	}
+}
+
+// workCopyPath convert local path to path relative to root of working copy, root is empty string
+func workCopyPath(root, localPath string) (string, error) {
+	absPath, err := filepath.Abs(localPath)
+	if err != nil {
+		return "", err
+	}
+	relPath, err := filepath.Rel(root, absPath)
+	if err != nil {
+		return "", err
+	}
+	if relPath == "." {
+		return "", nil
+	}
+	if !filepath.IsLocal(relPath) {
+		return "", fmt.Errorf("%s is outside working copy %s", localPath, root)
+	}
+	return filepath.ToSlash(relPath), nil
+}
+
+func matchPrefix(path, prefix string) bool {
+	return len(prefix) == 0 || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// trackedFiles files in wip, index of checkout overlaid by staged changes
+func trackedFiles(manifest *checkoutManifest) map[string]checkoutEntry {
+	files := make(map[string]checkoutEntry, len(manifest.Files))
+	for path, entry := range manifest.Files {
+		files[path] = entry
+	}
+	for path, entry := range manifest.Staged {
+		if entry.Deleted {
+			delete(files, path)
+			continue
+		}
+		files[path] = entry
+	}
+	return files
+}
+
+// workCopyStatus compare local files under prefix with tracked files by size and checksum
+func workCopyStatus(root string, manifest *checkoutManifest, prefix string) (*workCopyChanges, error) {
+	changes := &workCopyChanges{}
+	tracked := trackedFiles(manifest)
+	seen := map[string]struct{}{}
+
+	walkRoot := filepath.Join(root, filepath.FromSlash(prefix))
+	err := filepath.WalkDir(walkRoot, func(localPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && localPath == walkRoot {
+				return nil
+			}
+			return err
+		}
+		relPath, err := filepath.Rel(root, localPath)
+		if err != nil {
+			return err
+		}
+		path := filepath.ToSlash(relPath)
+		if d.IsDir() {
+			if path == path2.Dir(checkoutManifestPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		entry, ok := tracked[path]
+		if !ok {
+			changes.Untracked = append(changes.Untracked, path)
+			return nil
+		}
+		seen[path] = struct{}{}
+
+		checksum, size, err := fileChecksum(localPath)
+		if err != nil {
+			return err
+		}
+		if size != entry.Size || (len(entry.Checksum) > 0 && checksum != entry.Checksum) {
+			changes.Modified = append(changes.Modified, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for path := range tracked {
+		if _, ok := seen[path]; !ok && matchPrefix(path, prefix) {
+			changes.Deleted = append(changes.Deleted, path)
+		}
+	}
+	sort.Strings(changes.Modified)
+	sort.Strings(changes.Deleted)
+	sort.Strings(changes.Untracked)
+	return changes, nil
+}
+
+// fileChecksum md5 and size of local file, same as checksum of blob
+func fileChecksum(localPath string) (string, int64, error) {
+	fs, err := os.Open(localPath)
+	if err != nil {
+		return "", 0, err
+	}
+	defer fs.Close() //nolint
+
+	hasher := md5.New() //nolint:gosec
+	size, err := io.Copy(hasher, fs)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
+
+// ensureWip get or create wip of branch, changes can only be staged in wip
+func ensureWip(ctx context.Context, client *api.Client, manifest *checkoutManifest) error {
+	resp, err := client.GetWip(ctx, manifest.Owner, manifest.Repo, &api.GetWipParams{RefName: manifest.Ref})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("get wip of %s failed %d, %s", manifest.Ref, resp.StatusCode, tryLogError(resp))
+	}
+	return resp.Body.Close()
+}
+
+func uploadWorkCopyFile(ctx context.Context, client *api.Client, root string, manifest *checkoutManifest, path string) (checkoutEntry, error) {
+	fs, err := os.Open(filepath.Join(root, filepath.FromSlash(path)))
+	if err != nil {
+		return checkoutEntry{}, err
+	}
+	defer fs.Close() //nolint
+
+	resp, err := client.UploadObjectWithBody(ctx, manifest.Owner, manifest.Repo, &api.UploadObjectParams{
+		RefName:   manifest.Ref,
+		Path:      path,
+		IsReplace: utils.Bool(true),
+	}, "application/octet-stream", fs)
+	if err != nil {
+		return checkoutEntry{}, err
+	}
+	result, err := api.ParseUploadObjectResponse(resp)
+	if err != nil {
+		return checkoutEntry{}, err
+	}
+	if result.JSON201 == nil {
+		return checkoutEntry{}, fmt.Errorf("upload %s failed %d, %s", path, result.StatusCode(), string(result.Body))
+	}
+	return checkoutEntry{
+		Checksum: result.JSON201.Checksum,
+		Size:     utils.Int64Value(result.JSON201.SizeBytes),
+	}, nil
+}
+
+func removeWipFile(ctx context.Context, client *api.Client, manifest *checkoutManifest, path string) error {
+	resp, err := client.DeleteObject(ctx, manifest.Owner, manifest.Repo, &api.DeleteObjectParams{
+		RefName: manifest.Ref,
+		Path:    path,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("remove %s failed %d, %s", path, resp.StatusCode, tryLogError(resp))
+	}
+	return resp.Body.Close()
+}
+
+func sortedKeys(files map[string]checkoutEntry) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
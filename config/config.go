@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"time"
 
 	"github.com/mitchellh/go-homedir"
 	ms "github.com/mitchellh/mapstructure"
@@ -22,6 +23,10 @@ type Config struct {
 	API      APIConfig      `mapstructure:"api"`
 	Database DatabaseConfig `mapstructure:"database"`
 	Auth     AuthConfig     `mapstructure:"auth"`
+	GC       GCConfig       `mapstructure:"gc"`
+	Upload   UploadConfig   `mapstructure:"upload"`
+	Gateway  GatewayConfig  `mapstructure:"gateway"`
+	Webhook  WebhookConfig  `mapstructure:"webhook"`
 
 	Blockstore BlockStoreConfig `mapstructure:"blockstore"`
 }
@@ -39,6 +44,49 @@ type DatabaseConfig struct {
 	Debug      bool   `mapstructure:"debug"`
 }
 
+type GCConfig struct {
+	// Enable run garbage collection in background of daemon
+	Enable      bool          `mapstructure:"enable"`
+	Interval    time.Duration `mapstructure:"interval"`
+	GracePeriod time.Duration `mapstructure:"grace_period"`
+}
+
+type UploadConfig struct {
+	// StreamThreshold upload smaller than this size is hashed in memory, larger one is streamed to storage by multipart upload
+	StreamThreshold int64 `mapstructure:"stream_threshold"`
+	// StagingTTL staging keys older than this are removed as left by interrupted uploads at startup of daemon,
+	// it is never shorter than pre-signed url expiry of repository storage
+	StagingTTL time.Duration `mapstructure:"staging_ttl"`
+	// MaxCarSize max size of car imported as archive, car is spooled to temp file of daemon while importing
+	MaxCarSize int64 `mapstructure:"max_car_size"`
+}
+
+type GatewayConfig struct {
+	// Listen address of s3 compatible gateway, gateway is disabled if empty
+	Listen string `mapstructure:"listen"`
+	// ReadHeaderTimeout max time to read request header
+	ReadHeaderTimeout time.Duration `mapstructure:"read_header_timeout"`
+	// ReadTimeout max time to read whole request include body, it limit duration of one upload
+	ReadTimeout time.Duration `mapstructure:"read_timeout"`
+	// IdleTimeout max time to wait for next request of keep-alive connection
+	IdleTimeout time.Duration `mapstructure:"idle_timeout"`
+}
+
+type WebhookConfig struct {
+	// Enable deliver events to webhooks in background of daemon
+	Enable bool `mapstructure:"enable"`
+	// Interval to poll pending deliveries
+	Interval time.Duration `mapstructure:"interval"`
+	// Timeout of one request to webhook
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MaxAttempts delivery is marked failed after this number of attempts
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// Backoff wait time before the first retry, doubled for each retry
+	Backoff time.Duration `mapstructure:"backoff"`
+	// AllowPrivateNetwork allow webhook to reach loopback, private and link-local addresses, only enable it in trusted network
+	AllowPrivateNetwork bool `mapstructure:"allow_private_network"`
+}
+
 type AuthConfig struct {
 	SecretKey string `mapstructure:"secretKey"`
 
@@ -2,6 +2,7 @@ package config
 
 import (
 	"encoding/hex"
+	"time"
 )
 
 var DefaultLocalBSPath = "~/.jiaozifs/blockstore"
@@ -14,6 +15,30 @@ var defaultCfg = Config{
 	API: APIConfig{
 		Listen: "http://127.0.0.1:34913",
 	},
+	GC: GCConfig{
+		Enable:      false,
+		Interval:    24 * time.Hour,
+		GracePeriod: 24 * time.Hour,
+	},
+	Upload: UploadConfig{
+		StreamThreshold: 32 << 20,
+		StagingTTL:      24 * time.Hour,
+		MaxCarSize:      1 << 30,
+	},
+	Gateway: GatewayConfig{
+		Listen:            "", //disabled by default, eg. http://127.0.0.1:34914
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       time.Hour,
+		IdleTimeout:       2 * time.Minute,
+	},
+	Webhook: WebhookConfig{
+		Enable:              true,
+		Interval:            5 * time.Second,
+		Timeout:             10 * time.Second,
+		MaxAttempts:         8,
+		Backoff:             30 * time.Second,
+		AllowPrivateNetwork: false,
+	},
 	Blockstore: BlockStoreConfig{
 		Type: "local",
 		Local: (*struct {
@@ -11,6 +11,8 @@ import (
 	"github.com/GitDataAI/jiaozifs/auth"
 
 	"github.com/GitDataAI/jiaozifs/auth/rbac"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
 	"go.uber.org/fx"
 )
 
@@ -73,3 +75,32 @@ func (c *BaseController) authorizeMember(ctx context.Context, w *api.JiaozifsRes
 	}
 	return true
 }
+
+// loadRepository find repository by owner and name after check operator has permissions of all actions on it
+func (c *BaseController) loadRepository(ctx context.Context, w *api.JiaozifsResponse, repo models.IRepo, ownerName string, repositoryName string, actions ...string) (*models.Repository, bool) {
+	owner, err := repo.UserRepo().Get(ctx, models.NewGetUserParams().SetName(ownerName))
+	if err != nil {
+		w.Error(err)
+		return nil, false
+	}
+
+	repository, err := repo.RepositoryRepo().Get(ctx, models.NewGetRepoParams().SetOwnerID(owner.ID).SetName(repositoryName))
+	if err != nil {
+		w.Error(err)
+		return nil, false
+	}
+
+	node := rbac.Node{Type: rbac.NodeTypeAnd}
+	for _, action := range actions {
+		node.Nodes = append(node.Nodes, rbac.Node{
+			Permission: rbac.Permission{
+				Action:   action,
+				Resource: rbacmodel.RepoURArn(owner.ID.String(), repository.ID.String()),
+			},
+		})
+	}
+	if !c.authorizeMember(ctx, w, repository.ID, node) {
+		return nil, false
+	}
+	return repository, true
+}
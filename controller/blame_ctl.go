@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/auth"
+	"github.com/GitDataAI/jiaozifs/auth/rbac"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	logging "github.com/ipfs/go-log/v2"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+var blameLog = logging.Logger("blame_ctl")
+
+func (commitCtl CommitController) GetBlame(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, params api.GetBlameParams) {
+	if params.Type == api.RefTypeWip {
+		w.BadRequest("blame is not supported in wip")
+		return
+	}
+	if len(versionmgr.CleanPath(params.Path)) == 0 {
+		w.BadRequest("path must be set")
+		return
+	}
+
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	owner, err := commitCtl.Repo.UserRepo().Get(ctx, models.NewGetUserParams().SetName(ownerName))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	repository, err := commitCtl.Repo.RepositoryRepo().Get(ctx, models.NewGetRepoParams().SetName(repositoryName).SetOwnerID(owner.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	if !commitCtl.authorizeMember(ctx, w, repository.ID, rbac.Node{
+		Permission: rbac.Permission{
+			Action:   rbacmodel.ReadObjectAction,
+			Resource: rbacmodel.RepoURArn(owner.ID.String(), repository.ID.String()),
+		},
+	}) {
+		return
+	}
+
+	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, commitCtl.Repo, commitCtl.PublicStorageConfig)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	refName := utils.StringValue(params.Ref)
+	if len(refName) == 0 && params.Type == api.RefTypeBranch {
+		refName = repository.HEAD
+	}
+	err = workRepo.CheckOutRevision(ctx, versionmgr.WorkRepoState(params.Type), refName)
+	if err != nil {
+		revisionError(w, err)
+		return
+	}
+
+	//hunks are written once attributed, so that client could render large file progressively
+	stream := &jsonArrayStream{w: w}
+	err = workRepo.Blame(ctx, params.Path, func(hunk *versionmgr.BlameHunk) error {
+		return stream.Write(blameHunkToDto(hunk))
+	})
+	if err != nil {
+		if stream.count > 0 {
+			//status code was sent, the response is left as an incomplete array
+			blameLog.Errorf("blame %s of repository %s failed %v", params.Path, repository.Name, err)
+			return
+		}
+		if errors.Is(err, versionmgr.ErrPathNotFound) {
+			w.NotFound()
+			return
+		}
+		if errors.Is(err, versionmgr.ErrNotTextMergeable) {
+			w.BadRequest(err.Error())
+			return
+		}
+		w.Error(err)
+		return
+	}
+	err = stream.Close()
+	if err != nil {
+		blameLog.Errorf("write blame of %s failed %v", params.Path, err)
+	}
+}
+
+// jsonArrayStream write items of json array to response one by one, the status code is sent with the first item
+type jsonArrayStream struct {
+	w     *api.JiaozifsResponse
+	count int
+}
+
+func (s *jsonArrayStream) Write(item any) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	prefix := ","
+	if s.count == 0 {
+		s.w.Header().Set("Content-Type", "application/json")
+		s.w.WriteHeader(http.StatusOK)
+		prefix = "["
+	}
+	s.count++
+	if _, err = s.w.Write([]byte(prefix)); err != nil {
+		return err
+	}
+	if _, err = s.w.Write(data); err != nil {
+		return err
+	}
+	if flusher, ok := s.w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+func (s *jsonArrayStream) Close() error {
+	if s.count == 0 {
+		s.w.JSON([]any{})
+		return nil
+	}
+	_, err := s.w.Write([]byte("]"))
+	return err
+}
+
+func blameHunkToDto(hunk *versionmgr.BlameHunk) *api.BlameHunk {
+	return &api.BlameHunk{
+		StartLine: hunk.StartLine,
+		LineCount: hunk.LineCount,
+		Commit:    hunk.Commit.Hash.Hex(),
+		Author: api.Signature{
+			Name:  hunk.Commit.Author.Name,
+			Email: openapi_types.Email(hunk.Commit.Author.Email),
+			When:  hunk.Commit.Author.When.UnixMilli(),
+		},
+		Message: hunk.Commit.Message,
+	}
+}
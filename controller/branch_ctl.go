@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/GitDataAI/jiaozifs/controller/validator"
 	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
 	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/GitDataAI/jiaozifs/webhook"
 
 	"github.com/GitDataAI/jiaozifs/api"
 	"github.com/GitDataAI/jiaozifs/auth"
@@ -123,19 +125,24 @@ func (bct BranchController) CreateBranch(ctx context.Context, w *api.JiaozifsRes
 		return
 	}
 
-	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, bct.Repo, bct.PublicStorageConfig)
-	if err != nil {
-		w.Error(err)
-		return
-	}
+	var newBranch *models.Branch
+	err = bct.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repo, bct.PublicStorageConfig)
+		if err != nil {
+			return err
+		}
 
-	err = workRepo.CheckOut(ctx, versionmgr.InCommit, sourceBranch.CommitHash.Hex())
-	if err != nil {
-		w.Error(err)
-		return
-	}
+		err = workRepo.CheckOut(ctx, versionmgr.InCommit, sourceBranch.CommitHash.Hex())
+		if err != nil {
+			return err
+		}
 
-	newBranch, err := workRepo.CreateBranch(ctx, body.Name)
+		newBranch, err = workRepo.CreateBranch(ctx, body.Name)
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventBranchCreate, webhook.RefData{Ref: newBranch.Name, Commit: newBranch.CommitHash.Hex()})
+	})
 	if err != nil {
 		if strings.Contains(err.Error(), "already exit") {
 			w.Code(http.StatusConflict)
@@ -182,21 +189,31 @@ func (bct BranchController) DeleteBranch(ctx context.Context, w *api.JiaozifsRes
 		return
 	}
 
-	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, bct.Repo, bct.PublicStorageConfig)
-	if err != nil {
-		w.Error(err)
-		return
-	}
+	err = bct.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repo, bct.PublicStorageConfig)
+		if err != nil {
+			return err
+		}
 
-	err = workRepo.CheckOut(ctx, versionmgr.InBranch, params.RefName)
-	if err != nil {
-		w.Error(err)
-		return
-	}
+		err = workRepo.CheckOut(ctx, versionmgr.InBranch, params.RefName)
+		if err != nil {
+			return err
+		}
+
+		err = checkBranchProtection(ctx, repo, repository.ID, params.RefName, branchOperationDelete)
+		if err != nil {
+			return err
+		}
 
-	err = workRepo.DeleteBranch(ctx)
+		deletedBranch := workRepo.CurBranch()
+		err = workRepo.DeleteBranch(ctx)
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventBranchDelete, webhook.RefData{Ref: deletedBranch.Name, Commit: deletedBranch.CommitHash.Hex()})
+	})
 	if err != nil {
-		w.Error(err)
+		revisionError(w, err)
 		return
 	}
 	w.OK()
@@ -246,3 +263,83 @@ func branchToDto(in *models.Branch) (api.Branch, error) {
 		UpdatedAt:    in.UpdatedAt.UnixMilli(),
 	}, nil
 }
+
+func (bct BranchController) CherryPick(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.CherryPickJSONRequestBody, ownerName string, repositoryName string, params api.CherryPickParams) {
+	bct.applyCommit(ctx, w, body, ownerName, repositoryName, params.RefName, false)
+}
+
+func (bct BranchController) RevertCommit(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.RevertCommitJSONRequestBody, ownerName string, repositoryName string, params api.RevertCommitParams) {
+	bct.applyCommit(ctx, w, body, ownerName, repositoryName, params.RefName, true)
+}
+
+// applyCommit cherry-pick or revert commit in branch
+func (bct BranchController) applyCommit(ctx context.Context, w *api.JiaozifsResponse, body api.CommitApply, ownerName string, repositoryName string, branchName string, revert bool) {
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	repository, ok := bct.loadRepository(ctx, w, bct.Repo, ownerName, repositoryName, rbacmodel.WriteBranchAction, rbacmodel.ReadCommitAction)
+	if !ok {
+		return
+	}
+
+	//accept full or abbreviated hash as well as other revision expressions
+	applied, err := versionmgr.NewRevisionResolver(bct.Repo, repository).Resolve(ctx, versionmgr.InCommit, body.Commit)
+	if err != nil {
+		revisionError(w, err)
+		return
+	}
+	if applied == nil {
+		revisionError(w, fmt.Errorf("%s %w", body.Commit, versionmgr.ErrEmptyRevision))
+		return
+	}
+	commitHash := applied.Hash
+
+	var commit *models.Commit
+	err = bct.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repo, bct.PublicStorageConfig)
+		if err != nil {
+			return err
+		}
+
+		err = workRepo.CheckOut(ctx, versionmgr.InBranch, branchName)
+		if err != nil {
+			return err
+		}
+
+		err = checkBranchProtection(ctx, repo, repository.ID, branchName, branchOperationCommit)
+		if err != nil {
+			return err
+		}
+
+		//paths selected by user first, then try to merge text files line by line
+		conflictResolve := utils.Map(body.ConflictResolve)
+		resolver := versionmgr.ResolveFromSelectorOr(conflictResolve, versionmgr.TextMergeResolver(ctx, workRepo, nil))
+
+		if revert {
+			commit, err = workRepo.RevertCommit(ctx, commitHash, resolver)
+		} else {
+			commit, err = workRepo.CherryPick(ctx, commitHash, resolver)
+		}
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventCommit, commitEventData(branchName, commit))
+	})
+	if err != nil {
+		if errors.Is(err, versionmgr.ErrConflict) || errors.Is(err, versionmgr.ErrNotTextMergeable) {
+			w.String(err.Error(), http.StatusConflict)
+			return
+		}
+		if errors.Is(err, versionmgr.ErrNothingToApply) {
+			w.BadRequest(err.Error())
+			return
+		}
+		revisionError(w, err)
+		return
+	}
+
+	w.JSON(commitToDto(commit), http.StatusCreated)
+}
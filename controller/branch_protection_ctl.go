@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/gobwas/glob"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/fx"
+)
+
+type BranchProtectionController struct {
+	fx.In
+	BaseController
+
+	Repo models.IRepo
+}
+
+func (bpCtl BranchProtectionController) ListBranchProtectionRules(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string) {
+	repository, ok := bpCtl.loadRepository(ctx, w, bpCtl.Repo, ownerName, repositoryName, rbacmodel.ReadRepositoryAction)
+	if !ok {
+		return
+	}
+
+	rules, err := bpCtl.Repo.BranchProtectionRuleRepo().List(ctx, repository.ID)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	results := make([]api.BranchProtectionRule, 0, len(rules))
+	for _, rule := range rules {
+		results = append(results, *branchProtectionRuleToDto(rule))
+	}
+	w.JSON(results)
+}
+
+func (bpCtl BranchProtectionController) CreateBranchProtectionRule(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.CreateBranchProtectionRuleJSONRequestBody, ownerName string, repositoryName string) {
+	pattern := strings.TrimSpace(body.Pattern)
+	if len(pattern) == 0 {
+		w.BadRequest("pattern must be set")
+		return
+	}
+	if _, err := glob.Compile(pattern, '/'); err != nil {
+		w.BadRequest(fmt.Sprintf("invalid pattern %s %v", pattern, err))
+		return
+	}
+
+	repository, ok := bpCtl.loadRepository(ctx, w, bpCtl.Repo, ownerName, repositoryName, rbacmodel.UpdateRepositoryAction)
+	if !ok {
+		return
+	}
+
+	requiredChecks := []string{}
+	if body.RequiredChecks != nil {
+		requiredChecks = *body.RequiredChecks
+	}
+
+	rule, err := bpCtl.Repo.BranchProtectionRuleRepo().Save(ctx, &models.BranchProtectionRule{
+		RepositoryID:        repository.ID,
+		Pattern:             pattern,
+		RequireMergeRequest: utils.BoolValue(body.RequireMergeRequest),
+		ForbidDeletion:      utils.BoolValue(body.ForbidDeletion),
+		ForbidForceUpdate:   utils.BoolValue(body.ForbidForceUpdate),
+		RequiredChecks:      requiredChecks,
+		CreatedAt:           time.Now(),
+		UpdatedAt:           time.Now(),
+	})
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(branchProtectionRuleToDto(rule), http.StatusCreated)
+}
+
+func (bpCtl BranchProtectionController) DeleteBranchProtectionRule(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, ruleID openapi_types.UUID) {
+	repository, ok := bpCtl.loadRepository(ctx, w, bpCtl.Repo, ownerName, repositoryName, rbacmodel.UpdateRepositoryAction)
+	if !ok {
+		return
+	}
+
+	affectRows, err := bpCtl.Repo.BranchProtectionRuleRepo().Delete(ctx, models.NewDeleteBranchProtectionRuleParams().SetID(ruleID).SetRepositoryID(repository.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	if affectRows == 0 {
+		w.NotFound()
+		return
+	}
+	w.OK()
+}
+
+type branchOperation int
+
+const (
+	branchOperationCommit branchOperation = iota
+	branchOperationDelete
+	// branchOperationForceUpdate move branch head to commit which is not descendant of current head
+	branchOperationForceUpdate
+)
+
+// checkBranchProtection check whether operation on branch is allowed by protection rules, error with 403 code and the
+// reason is returned if forbidden. call it in the transaction which moves the head, so rules are checked against the
+// head being changed
+func checkBranchProtection(ctx context.Context, repo models.IRepo, repositoryID uuid.UUID, branchName string, operation branchOperation) error {
+	rules, err := repo.BranchProtectionRuleRepo().Match(ctx, repositoryID, branchName)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		switch {
+		case operation == branchOperationCommit && rule.RequireMergeRequest:
+			return forbidden(fmt.Sprintf("branch %s is protected by rule %s, changes must be merged by merge request", branchName, rule.Pattern))
+		case operation == branchOperationDelete && rule.ForbidDeletion:
+			return forbidden(fmt.Sprintf("branch %s is protected by rule %s, deletion is forbidden", branchName, rule.Pattern))
+		case operation == branchOperationForceUpdate && rule.ForbidForceUpdate:
+			return forbidden(fmt.Sprintf("branch %s is protected by rule %s, force update is forbidden", branchName, rule.Pattern))
+		}
+	}
+	return nil
+}
+
+// forbidden error answered with 403 and the reason
+func forbidden(reason string) error {
+	return fmt.Errorf("%s %w", reason, api.ErrCode(http.StatusForbidden))
+}
+
+func branchProtectionRuleToDto(rule *models.BranchProtectionRule) *api.BranchProtectionRule {
+	requiredChecks := rule.RequiredChecks
+	if requiredChecks == nil {
+		requiredChecks = []string{}
+	}
+	return &api.BranchProtectionRule{
+		Id:                  rule.ID,
+		Pattern:             rule.Pattern,
+		RequireMergeRequest: rule.RequireMergeRequest,
+		ForbidDeletion:      rule.ForbidDeletion,
+		ForbidForceUpdate:   rule.ForbidForceUpdate,
+		RequiredChecks:      requiredChecks,
+		CreatedAt:           rule.CreatedAt.UnixMilli(),
+		UpdatedAt:           rule.UpdatedAt.UnixMilli(),
+	}
+}
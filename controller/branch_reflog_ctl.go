@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/auth"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/GitDataAI/jiaozifs/webhook"
+)
+
+func (bct BranchController) ListBranchReflog(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, params api.ListBranchReflogParams) {
+	repository, ok := bct.loadRepository(ctx, w, bct.Repo, ownerName, repositoryName, rbacmodel.ReadBranchAction)
+	if !ok {
+		return
+	}
+
+	listParams := models.NewListBranchReflogParams().SetRepositoryID(repository.ID).SetBranchName(params.RefName)
+	if params.After != nil {
+		listParams.SetAfter(time.UnixMilli(*params.After))
+	}
+	pageAmount := utils.IntValue(params.Amount)
+	if pageAmount > utils.DefaultMaxPerPage || pageAmount <= 0 {
+		listParams.SetAmount(utils.DefaultMaxPerPage)
+	} else {
+		listParams.SetAmount(pageAmount)
+	}
+
+	reflogs, hasMore, err := bct.Repo.BranchReflogRepo().List(ctx, listParams)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	results := make([]api.BranchReflog, len(reflogs))
+	for index, reflog := range reflogs {
+		results[index] = branchReflogToDto(reflog)
+	}
+	pagMag := utils.PaginationFor(hasMore, results, "CreatedAt")
+	w.JSON(api.BranchReflogList{
+		Pagination: api.Pagination{
+			HasMore:    pagMag.HasMore,
+			MaxPerPage: pagMag.MaxPerPage,
+			NextOffset: pagMag.NextOffset,
+			Results:    pagMag.Results,
+		},
+		Results: results,
+	})
+}
+
+func (bct BranchController) RestoreBranch(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.RestoreBranchJSONRequestBody, ownerName string, repositoryName string, params api.RestoreBranchParams) {
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	repository, ok := bct.loadRepository(ctx, w, bct.Repo, ownerName, repositoryName, rbacmodel.WriteBranchAction)
+	if !ok {
+		return
+	}
+
+	var branch *models.Branch
+	err = bct.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repo, bct.PublicStorageConfig)
+		if err != nil {
+			return err
+		}
+
+		err = workRepo.CheckOut(ctx, versionmgr.InBranch, params.RefName)
+		if err != nil {
+			return err
+		}
+
+		//moving head backwards is allowed only if force is requested and branch is not protected from force update
+		force := utils.BoolValue(body.Force)
+		if force {
+			err = checkBranchProtection(ctx, repo, repository.ID, params.RefName, branchOperationForceUpdate)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = workRepo.RestoreBranch(ctx, body.ReflogId, force)
+		if err != nil {
+			return err
+		}
+		branch = workRepo.CurBranch()
+		return emitEvent(ctx, repo, repository, webhook.EventBranchRestore, webhook.RefData{Ref: branch.Name, Commit: branch.CommitHash.Hex()})
+	})
+	if err != nil {
+		if errors.Is(err, versionmgr.ErrNotFastForward) || errors.Is(err, models.ErrBranchHeadChanged) {
+			w.String(err.Error(), http.StatusConflict)
+			return
+		}
+		if errors.Is(err, versionmgr.ErrInvalidReflogEntry) {
+			w.BadRequest(err.Error())
+			return
+		}
+		revisionError(w, err)
+		return
+	}
+
+	w.JSON(utils.Silent(branchToDto(branch)))
+}
+
+func (bct BranchController) UndeleteBranch(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, params api.UndeleteBranchParams) {
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	repository, ok := bct.loadRepository(ctx, w, bct.Repo, ownerName, repositoryName, rbacmodel.CreateBranchAction)
+	if !ok {
+		return
+	}
+
+	var newBranch *models.Branch
+	err = bct.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repo, bct.PublicStorageConfig)
+		if err != nil {
+			return err
+		}
+
+		newBranch, err = workRepo.UndeleteBranch(ctx, params.RefName)
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventBranchCreate, webhook.RefData{Ref: newBranch.Name, Commit: newBranch.CommitHash.Hex()})
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "already exit") {
+			w.Code(http.StatusConflict)
+			return
+		}
+		w.Error(err)
+		return
+	}
+
+	w.JSON(utils.Silent(branchToDto(newBranch)), http.StatusCreated)
+}
+
+func branchReflogToDto(reflog *models.BranchReflog) api.BranchReflog {
+	return api.BranchReflog{
+		Id:         reflog.ID,
+		BranchId:   reflog.BranchID,
+		BranchName: reflog.BranchName,
+		OldHash:    reflog.OldHash.Hex(),
+		NewHash:    reflog.NewHash.Hex(),
+		Operation:  api.BranchReflogOperation(reflog.Operation),
+		Message:    reflog.Message,
+		OperatorId: reflog.OperatorID,
+		CreatedAt:  reflog.CreatedAt.UnixMilli(),
+	}
+}
@@ -2,10 +2,8 @@ package controller
 
 import (
 	"context"
-	"encoding/hex"
 	"errors"
 	"net/http"
-	"strings"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
@@ -18,6 +16,7 @@ import (
 	"github.com/GitDataAI/jiaozifs/utils"
 	"github.com/GitDataAI/jiaozifs/utils/hash"
 	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/GitDataAI/jiaozifs/versionmgr/tablediff"
 	"go.uber.org/fx"
 )
 
@@ -76,52 +75,21 @@ func (commitCtl CommitController) GetEntriesInRef(ctx context.Context, w *api.Ji
 			return
 		}
 		treeHash = wip.CurrentTree
-	} else if params.Type == api.RefTypeBranch {
-		refName := repository.HEAD
-		if params.Ref != nil {
-			refName = *params.Ref
-		}
-
-		ref, err := commitCtl.Repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetRepositoryID(repository.ID).SetName(refName))
-		if err != nil {
-			w.Error(err)
-			return
-		}
-		if !ref.CommitHash.IsEmpty() {
-			commit, err := commitCtl.Repo.CommitRepo(repository.ID).Commit(ctx, ref.CommitHash)
-			if err != nil {
-				w.Error(err)
-				return
-			}
-			treeHash = commit.TreeHash
-		}
-	} else if params.Type == api.RefTypeTag {
+	} else if params.Type == api.RefTypeBranch || params.Type == api.RefTypeTag || params.Type == api.RefTypeCommit {
 		refName := utils.StringValue(params.Ref)
-		ref, err := commitCtl.Repo.TagRepo().Get(ctx, models.NewGetTagParams().SetRepositoryID(repository.ID).SetName(refName))
-		if err != nil {
-			w.Error(err)
-			return
-		}
-		commit, err := commitCtl.Repo.CommitRepo(repository.ID).Commit(ctx, ref.Target)
-		if err != nil {
-			w.Error(err)
-			return
-		}
-		treeHash = commit.TreeHash
-	} else if params.Type == api.RefTypeCommit {
-		commitHash, err := hash.FromHex(utils.StringValue(params.Ref))
-		if err != nil {
-			w.BadRequest(err.Error())
-			return
+		if len(refName) == 0 && params.Type == api.RefTypeBranch {
+			refName = repository.HEAD
 		}
 
-		if !commitHash.IsEmpty() {
-			commit, err := commitCtl.Repo.CommitRepo(repository.ID).Commit(ctx, commitHash)
+		if len(refName) > 0 {
+			commit, err := versionmgr.NewRevisionResolver(commitCtl.Repo, repository).Resolve(ctx, versionmgr.WorkRepoState(params.Type), refName)
 			if err != nil {
-				w.Error(err)
+				revisionError(w, err)
 				return
 			}
-			treeHash = commit.TreeHash
+			if commit != nil {
+				treeHash = commit.TreeHash
+			}
 		}
 	} else {
 		//check in validate middleware, test cant cover here, keep this check
@@ -155,6 +123,9 @@ func (commitCtl CommitController) GetEntriesInRef(ctx context.Context, w *api.Ji
 			Size:      entry.Size,
 			UpdatedAt: entry.UpdatedAt.UnixMilli(),
 		}
+		if !entry.IsDir {
+			apiTreeEntries[index].Checksum = utils.String(entry.CheckSum.Hex())
+		}
 	}
 	w.JSON(apiTreeEntries)
 }
@@ -187,17 +158,12 @@ func (commitCtl CommitController) CompareCommit(ctx context.Context, w *api.Jiao
 		return
 	}
 
-	baseHead := strings.Split(basehead, "...")
-	if len(baseHead) != 2 {
-		w.BadRequest("invalid basehead must be base...head")
-		return
-	}
-
-	toCommitHash, err := hex.DecodeString(baseHead[1])
+	baseCommit, headCommit, err := versionmgr.NewRevisionResolver(commitCtl.Repo, repository).ResolveBaseHead(ctx, basehead)
 	if err != nil {
-		w.Error(err)
+		revisionError(w, err)
 		return
 	}
+	toCommitHash := headCommit.Hash
 
 	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, commitCtl.Repo, commitCtl.PublicStorageConfig)
 	if err != nil {
@@ -205,7 +171,7 @@ func (commitCtl CommitController) CompareCommit(ctx context.Context, w *api.Jiao
 		return
 	}
 
-	err = workRepo.CheckOut(ctx, versionmgr.InCommit, baseHead[0])
+	err = workRepo.CheckOut(ctx, versionmgr.InCommit, baseCommit.Hash.Hex())
 	if err != nil {
 		w.Error(err)
 		return
@@ -225,6 +191,75 @@ func (commitCtl CommitController) CompareCommit(ctx context.Context, w *api.Jiao
 	w.JSON(changesResp)
 }
 
+func (commitCtl CommitController) CompareTable(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, basehead string, params api.CompareTableParams) {
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	owner, err := commitCtl.Repo.UserRepo().Get(ctx, models.NewGetUserParams().SetName(ownerName))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	repository, err := commitCtl.Repo.RepositoryRepo().Get(ctx, models.NewGetRepoParams().SetName(repositoryName).SetOwnerID(owner.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	if !commitCtl.authorizeMember(ctx, w, repository.ID, rbac.Node{
+		Permission: rbac.Permission{
+			Action:   rbacmodel.ReadCommitAction,
+			Resource: rbacmodel.RepoURArn(owner.ID.String(), repository.ID.String()),
+		},
+	}) {
+		return
+	}
+
+	baseCommit, headCommit, err := versionmgr.NewRevisionResolver(commitCtl.Repo, repository).ResolveBaseHead(ctx, basehead)
+	if err != nil {
+		revisionError(w, err)
+		return
+	}
+	toCommitHash := headCommit.Hash
+
+	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, commitCtl.Repo, commitCtl.PublicStorageConfig)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	err = workRepo.CheckOut(ctx, versionmgr.InCommit, baseCommit.Hash.Hex())
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	opts := tablediff.Options{PrimaryKey: utils.StringValue(params.PrimaryKey)}
+	if params.SampleSize != nil {
+		opts.SampleSize = int(*params.SampleSize)
+	}
+	tableDiff, err := workRepo.DiffTable(ctx, toCommitHash, params.Path, opts)
+	if err != nil {
+		if errors.Is(err, tablediff.ErrUnsupportedFormat) || errors.Is(err, tablediff.ErrInvalidTable) {
+			w.BadRequest(err.Error())
+			return
+		}
+		if errors.Is(err, versionmgr.ErrPathNotFound) {
+			w.NotFound()
+			return
+		}
+		w.Error(err)
+		return
+	}
+
+	format, _ := tablediff.FormatFromPath(params.Path)
+	w.JSON(tableDiffToDTO(params.Path, format, opts.PrimaryKey, tableDiff))
+}
+
 func (commitCtl CommitController) GetCommitChanges(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, commitID string, params api.GetCommitChangesParams) {
 	operator, err := auth.GetOperator(ctx)
 	if err != nil {
@@ -280,6 +315,10 @@ func (commitCtl CommitController) GetCommitChanges(ctx context.Context, w *api.J
 }
 
 func commitToDto(commit *models.Commit) *api.Commit {
+	var metadata *map[string]string
+	if len(commit.Metadata) > 0 {
+		metadata = &commit.Metadata
+	}
 	return &api.Commit{
 		Author: api.Signature{
 			Email: openapi_types.Email(commit.Author.Email),
@@ -295,9 +334,65 @@ func commitToDto(commit *models.Commit) *api.Commit {
 		Hash:         commit.Hash.Hex(),
 		MergeTag:     commit.MergeTag,
 		Message:      commit.Message,
+		Metadata:     metadata,
 		ParentHashes: hash.HexArrayOfHashes(commit.ParentHashes...),
 		RepositoryId: commit.RepositoryID,
 		TreeHash:     commit.TreeHash.Hex(),
 		UpdatedAt:    commit.UpdatedAt.UnixMilli(),
 	}
 }
+
+func tableDiffToDTO(path string, format tablediff.Format, primaryKey string, tableDiff *tablediff.TableDiff) api.TableDiff {
+	columnsToDTO := func(columns []tablediff.Column) []api.TableColumn {
+		result := make([]api.TableColumn, len(columns))
+		for index, column := range columns {
+			result[index] = api.TableColumn{Name: column.Name, Type: column.Type}
+		}
+		return result
+	}
+
+	result := api.TableDiff{
+		Path:           path,
+		Format:         string(format),
+		AddedColumns:   columnsToDTO(tableDiff.AddedColumns),
+		RemovedColumns: columnsToDTO(tableDiff.RemovedColumns),
+		RetypedColumns: make([]api.TableColumnChange, len(tableDiff.RetypedColumns)),
+		BaseRows:       tableDiff.BaseRows,
+		ToRows:         tableDiff.ToRows,
+		RowsAdded:      tableDiff.RowsAdded,
+		RowsRemoved:    tableDiff.RowsRemoved,
+		RowsModified:   tableDiff.RowsModified,
+		Samples:        make([]api.TableRowChange, len(tableDiff.Samples)),
+	}
+	if len(primaryKey) > 0 {
+		result.PrimaryKey = utils.String(primaryKey)
+	}
+	for index, column := range tableDiff.RetypedColumns {
+		result.RetypedColumns[index] = api.TableColumnChange{Name: column.Name, FromType: column.FromType, ToType: column.ToType}
+	}
+	for index, row := range tableDiff.Samples {
+		sample := api.TableRowChange{Kind: api.TableRowChangeKind(row.Kind)}
+		if len(primaryKey) > 0 {
+			sample.Key = utils.String(row.Key)
+		}
+		if row.Base != nil {
+			base := row.Base
+			sample.Base = &base
+		}
+		if row.To != nil {
+			to := row.To
+			sample.To = &to
+		}
+		result.Samples[index] = sample
+	}
+	return result
+}
+
+// revisionError answer bad request if ref is an invalid revision expression
+func revisionError(w *api.JiaozifsResponse, err error) {
+	if errors.Is(err, versionmgr.ErrInvalidRevision) || errors.Is(err, versionmgr.ErrAmbiguousRevision) || errors.Is(err, versionmgr.ErrEmptyRevision) {
+		w.BadRequest(err.Error())
+		return
+	}
+	w.Error(err)
+}
@@ -0,0 +1,231 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/auth"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+)
+
+type CommitStatusController struct {
+	fx.In
+	BaseController
+
+	Repo models.IRepo
+}
+
+func (statusCtl CommitStatusController) ListCommitStatuses(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, commitID string) {
+	repository, commit, ok := statusCtl.loadCommit(ctx, w, ownerName, repositoryName, commitID, rbacmodel.ReadCommitAction)
+	if !ok {
+		return
+	}
+
+	statuses, err := statusCtl.Repo.CommitStatusRepo().List(ctx, repository.ID, commit.Hash)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	results, err := commitStatusesToDto(ctx, statusCtl.Repo, statuses)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(results)
+}
+
+func (statusCtl CommitStatusController) CreateCommitStatus(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.CreateCommitStatusJSONRequestBody, ownerName string, repositoryName string, commitID string) {
+	statusContext := strings.TrimSpace(body.Context)
+	if len(statusContext) == 0 {
+		w.BadRequest("context must be set")
+		return
+	}
+
+	state, ok := commitStatusStateFromDto(body.State)
+	if !ok {
+		w.BadRequest(fmt.Sprintf("unsupported state %s", body.State))
+		return
+	}
+
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	repository, commit, ok := statusCtl.loadCommit(ctx, w, ownerName, repositoryName, commitID, rbacmodel.CreateCommitAction)
+	if !ok {
+		return
+	}
+
+	status, err := statusCtl.Repo.CommitStatusRepo().Save(ctx, &models.CommitStatus{
+		RepositoryID: repository.ID,
+		CommitHash:   commit.Hash,
+		Context:      statusContext,
+		State:        state,
+		Description:  body.Description,
+		TargetURL:    body.TargetUrl,
+		CreatorID:    operator.ID,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	})
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(commitStatusToDto(status, operator), http.StatusCreated)
+}
+
+func (statusCtl CommitStatusController) GetCombinedCommitStatus(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, commitID string) {
+	repository, commit, ok := statusCtl.loadCommit(ctx, w, ownerName, repositoryName, commitID, rbacmodel.ReadCommitAction)
+	if !ok {
+		return
+	}
+
+	combinedStatus, err := combinedCommitStatus(ctx, statusCtl.Repo, repository.ID, commit.Hash)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(combinedStatus)
+}
+
+func (statusCtl CommitStatusController) loadCommit(ctx context.Context, w *api.JiaozifsResponse, ownerName string, repositoryName string, commitID string, action string) (*models.Repository, *models.Commit, bool) {
+	repository, ok := statusCtl.loadRepository(ctx, w, statusCtl.Repo, ownerName, repositoryName, action)
+	if !ok {
+		return nil, nil, false
+	}
+
+	//accept full or abbreviated hash as well as other revision expressions
+	commit, err := versionmgr.NewRevisionResolver(statusCtl.Repo, repository).Resolve(ctx, versionmgr.InCommit, commitID)
+	if err != nil {
+		revisionError(w, err)
+		return nil, nil, false
+	}
+	if commit == nil {
+		revisionError(w, fmt.Errorf("%s %w", commitID, versionmgr.ErrEmptyRevision))
+		return nil, nil, false
+	}
+	return repository, commit, true
+}
+
+func combinedCommitStatus(ctx context.Context, repo models.IRepo, repositoryID uuid.UUID, commitHash hash.Hash) (*api.CombinedCommitStatus, error) {
+	statuses, err := repo.CommitStatusRepo().List(ctx, repositoryID, commitHash)
+	if err != nil {
+		return nil, err
+	}
+
+	statusesDto, err := commitStatusesToDto(ctx, repo, statuses)
+	if err != nil {
+		return nil, err
+	}
+	return &api.CombinedCommitStatus{
+		Commit:   commitHash.Hex(),
+		State:    commitStatusStateToDto(models.CombineCommitStatus(statuses)),
+		Statuses: statusesDto,
+	}, nil
+}
+
+// checkRequiredChecks check whether checks required by protection rules of target branch are success on commit, return reason if not
+func checkRequiredChecks(ctx context.Context, repo models.IRepo, repositoryID uuid.UUID, targetBranchName string, commitHash hash.Hash) (string, error) {
+	rules, err := repo.BranchProtectionRuleRepo().Match(ctx, repositoryID, targetBranchName)
+	if err != nil {
+		return "", err
+	}
+
+	var requiredChecks []string
+	for _, rule := range rules {
+		requiredChecks = append(requiredChecks, rule.RequiredChecks...)
+	}
+	if len(requiredChecks) == 0 {
+		return "", nil
+	}
+
+	statuses, err := repo.CommitStatusRepo().List(ctx, repositoryID, commitHash)
+	if err != nil {
+		return "", err
+	}
+
+	states := make(map[string]models.CommitStatusState, len(statuses))
+	for _, status := range statuses {
+		states[status.Context] = status.State
+	}
+
+	var notPassed []string
+	for _, check := range requiredChecks {
+		if states[check] != models.CommitStatusSuccess {
+			notPassed = append(notPassed, check)
+		}
+	}
+	if len(notPassed) > 0 {
+		return fmt.Sprintf("branch %s requires checks to success on commit %s: %s", targetBranchName, commitHash.Hex(), strings.Join(notPassed, ", ")), nil
+	}
+	return "", nil
+}
+
+func commitStatusesToDto(ctx context.Context, repo models.IRepo, statuses []*models.CommitStatus) ([]api.CommitStatus, error) {
+	creators := make(map[uuid.UUID]*models.User)
+	results := make([]api.CommitStatus, 0, len(statuses))
+	for _, status := range statuses {
+		creator, ok := creators[status.CreatorID]
+		if !ok {
+			var err error
+			creator, err = repo.UserRepo().Get(ctx, models.NewGetUserParams().SetID(status.CreatorID))
+			if err != nil {
+				return nil, err
+			}
+			creators[status.CreatorID] = creator
+		}
+		results = append(results, *commitStatusToDto(status, creator))
+	}
+	return results, nil
+}
+
+func commitStatusToDto(status *models.CommitStatus, creator *models.User) *api.CommitStatus {
+	return &api.CommitStatus{
+		Id:          status.ID,
+		Context:     status.Context,
+		State:       commitStatusStateToDto(status.State),
+		Description: status.Description,
+		TargetUrl:   status.TargetURL,
+		Creator:     creator.Name,
+		CreatedAt:   status.CreatedAt.UnixMilli(),
+		UpdatedAt:   status.UpdatedAt.UnixMilli(),
+	}
+}
+
+func commitStatusStateFromDto(state api.CommitStatusState) (models.CommitStatusState, bool) {
+	switch state {
+	case api.CommitStatusStatePending:
+		return models.CommitStatusPending, true
+	case api.CommitStatusStateSuccess:
+		return models.CommitStatusSuccess, true
+	case api.CommitStatusStateFailure:
+		return models.CommitStatusFailure, true
+	case api.CommitStatusStateError:
+		return models.CommitStatusError, true
+	}
+	return 0, false
+}
+
+func commitStatusStateToDto(state models.CommitStatusState) api.CommitStatusState {
+	switch state {
+	case models.CommitStatusSuccess:
+		return api.CommitStatusStateSuccess
+	case models.CommitStatusFailure:
+		return api.CommitStatusStateFailure
+	case models.CommitStatusError:
+		return api.CommitStatusStateError
+	}
+	return api.CommitStatusStatePending
+}
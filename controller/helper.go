@@ -6,6 +6,7 @@ import (
 	"github.com/GitDataAI/jiaozifs/api"
 	"github.com/GitDataAI/jiaozifs/utils"
 	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/GitDataAI/jiaozifs/versionmgr/merkletrie"
 )
 
 func changesToDTO(changes *versionmgr.Changes) ([]api.Change, error) {
@@ -20,6 +21,9 @@ func changesToDTO(changes *versionmgr.Changes) ([]api.Change, error) {
 			Action: api.ChangeAction(action),
 			Path:   fullPath,
 		}
+		if action == merkletrie.Rename || action == merkletrie.Copy {
+			apiChange.FromPath = utils.String(change.From().String())
+		}
 		if change.From() != nil {
 			apiChange.BaseHash = utils.String(hex.EncodeToString(change.From().Hash()))
 		}
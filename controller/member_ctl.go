@@ -7,6 +7,7 @@ import (
 
 	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
 	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/webhook"
 
 	"github.com/GitDataAI/jiaozifs/api"
 	"github.com/GitDataAI/jiaozifs/auth/rbac"
@@ -43,7 +44,13 @@ func (memberCtl MemberController) UpdateMemberGroup(ctx context.Context, w *api.
 	}
 
 	listMemberParams := models.NewUpdateMemberParams().SetFilterUserID(params.UserId).SetFilterRepoID(repository.ID).SetUpdateGroupID(params.GroupId)
-	err = memberCtl.Repo.MemberRepo().UpdateMember(ctx, listMemberParams)
+	err = memberCtl.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		err := repo.MemberRepo().UpdateMember(ctx, listMemberParams)
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventMemberChange, webhook.MemberData{Action: "update", UserID: params.UserId, GroupID: params.GroupId})
+	})
 	if err != nil {
 		w.Error(err)
 		return
@@ -77,12 +84,19 @@ func (memberCtl MemberController) InviteMember(ctx context.Context, w *api.Jiaoz
 	}
 
 	// todo user need to confirm?
-	member, err := memberCtl.Repo.MemberRepo().Insert(ctx, &models.Member{
-		UserID:    params.UserId,
-		RepoID:    repository.ID,
-		GroupID:   params.GroupId,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+	var member *models.Member
+	err = memberCtl.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		member, err = repo.MemberRepo().Insert(ctx, &models.Member{
+			UserID:    params.UserId,
+			RepoID:    repository.ID,
+			GroupID:   params.GroupId,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventMemberChange, webhook.MemberData{Action: "invite", UserID: member.UserID, GroupID: member.GroupID})
 	})
 	if err != nil {
 		w.Error(err)
@@ -113,7 +127,13 @@ func (memberCtl MemberController) RevokeMember(ctx context.Context, w *api.Jiaoz
 		return
 	}
 
-	_, err = memberCtl.Repo.MemberRepo().DeleteMember(ctx, models.NewDeleteMemberParams().SetRepoID(repository.ID).SetUserID(params.UserId))
+	err = memberCtl.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		_, err := repo.MemberRepo().DeleteMember(ctx, models.NewDeleteMemberParams().SetRepoID(repository.ID).SetUserID(params.UserId))
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventMemberChange, webhook.MemberData{Action: "revoke", UserID: params.UserId})
+	})
 	if err != nil {
 		w.Error(err)
 		return
@@ -8,12 +8,14 @@ import (
 	"time"
 
 	"github.com/GitDataAI/jiaozifs/auth/rbac"
+	"github.com/GitDataAI/jiaozifs/controller/validator"
 	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
 	"github.com/GitDataAI/jiaozifs/utils/hash"
 
 	"github.com/GitDataAI/jiaozifs/utils"
 
 	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/GitDataAI/jiaozifs/webhook"
 
 	"github.com/GitDataAI/jiaozifs/api"
 	"github.com/GitDataAI/jiaozifs/auth"
@@ -80,6 +82,7 @@ func (mrCtl MergeRequestController) ListMergeRequests(ctx context.Context, w *ap
 			Description:  mr.Description,
 			AuthorId:     mr.AuthorID,
 			MergeStatus:  int(mr.MergeState),
+			MergeMethod:  mergeMethodToDto(mr.MergeMethod),
 			SourceBranch: mr.SourceBranchID,
 			SourceRepoId: mr.SourceRepoID,
 			TargetBranch: mr.TargetBranchID,
@@ -158,19 +161,31 @@ func (mrCtl MergeRequestController) CreateMergeRequest(ctx context.Context, w *a
 		return
 	}
 
-	mrModel, err := mrCtl.Repo.MergeRequestRepo().Insert(ctx, &models.MergeRequest{
-		TargetBranchID: targetBranch.ID,
-		SourceBranchID: sourceBranch.ID,
-		SourceRepoID:   repository.ID,
-		TargetRepoID:   repository.ID,
-		Title:          body.Title,
-		MergeState:     models.MergeStateInit,
-		Description:    body.Description,
-		AuthorID:       operator.ID,
-		CreatedAt:      time.Now(),
-		UpdatedAt:      time.Now(),
+	var mrModel *models.MergeRequest
+	err = mrCtl.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		mrModel, err = repo.MergeRequestRepo().Insert(ctx, &models.MergeRequest{
+			TargetBranchID: targetBranch.ID,
+			SourceBranchID: sourceBranch.ID,
+			SourceRepoID:   repository.ID,
+			TargetRepoID:   repository.ID,
+			Title:          body.Title,
+			MergeState:     models.MergeStateInit,
+			Description:    body.Description,
+			AuthorID:       operator.ID,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventMergeRequestOpen, webhook.MergeRequestData{
+			Sequence:     mrModel.Sequence,
+			Title:        mrModel.Title,
+			SourceBranch: sourceBranch.Name,
+			TargetBranch: targetBranch.Name,
+			State:        int(mrModel.MergeState),
+		})
 	})
-
 	if err != nil {
 		w.Error(err)
 		return
@@ -201,6 +216,7 @@ func (mrCtl MergeRequestController) CreateMergeRequest(ctx context.Context, w *a
 		Description:  mrModel.Description,
 		AuthorId:     mrModel.AuthorID,
 		MergeStatus:  int(mrModel.MergeState),
+		MergeMethod:  mergeMethodToDto(mrModel.MergeMethod),
 		SourceBranch: mrModel.SourceBranchID,
 		SourceRepoId: mrModel.SourceRepoID,
 		TargetBranch: mrModel.TargetBranchID,
@@ -288,6 +304,7 @@ func (mrCtl MergeRequestController) GetMergeRequest(ctx context.Context, w *api.
 		Description:  mergeRequest.Description,
 		AuthorId:     mergeRequest.AuthorID,
 		MergeStatus:  int(mergeRequest.MergeState),
+		MergeMethod:  mergeMethodToDto(mergeRequest.MergeMethod),
 		SourceBranch: mergeRequest.SourceBranchID,
 		SourceRepoId: mergeRequest.SourceRepoID,
 		TargetBranch: mergeRequest.TargetBranchID,
@@ -300,6 +317,12 @@ func (mrCtl MergeRequestController) GetMergeRequest(ctx context.Context, w *api.
 		w.Error(err)
 		return
 	}
+
+	resp.Checks, err = combinedCommitStatus(ctx, mrCtl.Repo, repository.ID, sourceBranch.CommitHash)
+	if err != nil {
+		w.Error(err)
+		return
+	}
 	w.JSON(resp)
 }
 
@@ -336,7 +359,22 @@ func (mrCtl MergeRequestController) UpdateMergeRequest(ctx context.Context, w *a
 		updateParams.SetState(models.MergeState(utils.IntValue(body.Status)))
 	}
 
-	err = mrCtl.Repo.MergeRequestRepo().UpdateByID(ctx, updateParams)
+	err = mrCtl.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		err := repo.MergeRequestRepo().UpdateByID(ctx, updateParams)
+		if err != nil {
+			return err
+		}
+
+		mergeRequest, err := repo.MergeRequestRepo().Get(ctx, models.NewGetMergeRequestParams().SetTargetRepo(repository.ID).SetNumber(mrSeq))
+		if err != nil {
+			return err
+		}
+		eventData, err := mergeRequestEventData(ctx, repo, mergeRequest)
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventMergeRequestUpdate, eventData)
+	})
 	if err != nil {
 		w.Error(err)
 		return
@@ -345,6 +383,12 @@ func (mrCtl MergeRequestController) UpdateMergeRequest(ctx context.Context, w *a
 }
 
 func (mrCtl MergeRequestController) Merge(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.MergeJSONRequestBody, ownerName string, repositoryName string, mrSeq uint64) {
+	metadata := utils.Map(body.Metadata)
+	if err := validator.ValidateCommitMetadata(metadata); err != nil {
+		w.BadRequest(err.Error())
+		return
+	}
+
 	operator, err := auth.GetOperator(ctx)
 	if err != nil {
 		w.Error(err)
@@ -379,6 +423,36 @@ func (mrCtl MergeRequestController) Merge(ctx context.Context, w *api.JiaozifsRe
 		return
 	}
 
+	mergeMethod := models.MergeMethodMerge
+	if body.MergeMethod != nil {
+		mergeMethod = models.MergeMethod(*body.MergeMethod)
+	}
+	if !mergeMethod.Valid() {
+		w.BadRequest(fmt.Sprintf("unsupported merge method %s", mergeMethod))
+		return
+	}
+	if mergeMethod == models.MergeMethodFastForwardOnly && len(metadata) > 0 {
+		w.BadRequest("metadata is not supported by fast-forward merge which creates no commit")
+		return
+	}
+
+	sourceBranch, err := mrCtl.Repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetID(mergeRequest.SourceBranchID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	checkedHash := sourceBranch.CommitHash
+	reason, err := checkApprovals(ctx, mrCtl.Repo, repository, mergeRequest, checkedHash)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	if len(reason) > 0 {
+		w.String(reason, http.StatusForbidden)
+		return
+	}
+
 	var commit *models.Commit
 	err = mrCtl.Repo.Transaction(ctx, func(repo models.IRepo) error {
 		workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repo, mrCtl.PublicStorageConfig)
@@ -386,29 +460,64 @@ func (mrCtl MergeRequestController) Merge(ctx context.Context, w *api.JiaozifsRe
 			return err
 		}
 
-		sourceBranch, err := repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetID(mergeRequest.SourceBranchID))
+		targetBranch, err := repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetID(mergeRequest.TargetBranchID))
 		if err != nil {
 			return err
 		}
 
-		targetBranch, err := repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetID(mergeRequest.TargetBranchID))
+		//checks required by protection rules of target branch
+		reason, err := checkRequiredChecks(ctx, repo, repository.ID, targetBranch.Name, checkedHash)
 		if err != nil {
 			return err
 		}
+		if len(reason) > 0 {
+			return forbidden(reason)
+		}
 
 		err = workRepo.CheckOut(ctx, versionmgr.InBranch, targetBranch.Name)
 		if err != nil {
 			return err
 		}
 
-		commit, err = workRepo.Merge(ctx, sourceBranch.CommitHash, body.Msg, versionmgr.ResolveFromSelector(utils.Map(body.ConflictResolve)))
+		//paths selected by user first, then try to merge text files line by line, other conflicts must be selected by user
+		conflictResolve := utils.Map(body.ConflictResolve)
+		resolver := versionmgr.ResolveFromSelectorOr(conflictResolve, versionmgr.TextMergeResolver(ctx, workRepo, nil))
+		//merge the commit which approvals and required checks were verified on, even if source branch moved since then
+		switch mergeMethod {
+		case models.MergeMethodSquash:
+			commit, err = workRepo.SquashMerge(ctx, checkedHash, body.Msg, resolver, versionmgr.WithMetadata(metadata))
+		case models.MergeMethodFastForwardOnly:
+			commit, err = workRepo.FastForwardMerge(ctx, checkedHash)
+		default:
+			commit, err = workRepo.Merge(ctx, checkedHash, body.Msg, resolver, versionmgr.WithMetadata(metadata))
+		}
+		if err != nil {
+			return err
+		}
+
+		err = repo.MergeRequestRepo().UpdateByID(ctx, models.NewUpdateMergeRequestParams(repository.ID, mergeRequest.Sequence).SetState(models.MergeStateMerged).SetMergeMethod(mergeMethod))
 		if err != nil {
 			return err
 		}
 
-		return repo.MergeRequestRepo().UpdateByID(ctx, models.NewUpdateMergeRequestParams(repository.ID, mergeRequest.Sequence).SetState(models.MergeStateMerged))
+		err = emitEvent(ctx, repo, repository, webhook.EventMergeRequestMerge, webhook.MergeRequestData{
+			Sequence:     mergeRequest.Sequence,
+			Title:        mergeRequest.Title,
+			SourceBranch: sourceBranch.Name,
+			TargetBranch: targetBranch.Name,
+			State:        int(models.MergeStateMerged),
+			MergeCommit:  commit.Hash.Hex(),
+		})
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventCommit, commitEventData(targetBranch.Name, commit))
 	})
 	if err != nil {
+		if errors.Is(err, versionmgr.ErrNotFastForward) || errors.Is(err, versionmgr.ErrConflict) || errors.Is(err, versionmgr.ErrNotTextMergeable) {
+			w.String(err.Error(), http.StatusConflict)
+			return
+		}
 		w.Error(err)
 		return
 	}
@@ -416,6 +525,33 @@ func (mrCtl MergeRequestController) Merge(ctx context.Context, w *api.JiaozifsRe
 	w.JSON(commitToDto(commit))
 }
 
+func mergeRequestEventData(ctx context.Context, repo models.IRepo, mergeRequest *models.MergeRequest) (webhook.MergeRequestData, error) {
+	sourceBranch, err := repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetID(mergeRequest.SourceBranchID))
+	if err != nil {
+		return webhook.MergeRequestData{}, err
+	}
+
+	targetBranch, err := repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetID(mergeRequest.TargetBranchID))
+	if err != nil {
+		return webhook.MergeRequestData{}, err
+	}
+	return webhook.MergeRequestData{
+		Sequence:     mergeRequest.Sequence,
+		Title:        mergeRequest.Title,
+		SourceBranch: sourceBranch.Name,
+		TargetBranch: targetBranch.Name,
+		State:        int(mergeRequest.MergeState),
+	}, nil
+}
+
+func mergeMethodToDto(method models.MergeMethod) *api.MergeMethod {
+	if len(method) == 0 {
+		return nil
+	}
+	dto := api.MergeMethod(method)
+	return &dto
+}
+
 func changePairToDTO(pairs []*versionmgr.ChangePair) ([]api.ChangePair, error) {
 
 	var changes = make([]api.ChangePair, len(pairs))
@@ -430,6 +566,9 @@ func changePairToDTO(pairs []*versionmgr.ChangePair) ([]api.ChangePair, error) {
 			Path:       path,
 			IsConflict: ch.IsConflict,
 		}
+		if ch.MergeStrategy != versionmgr.MergeStrategyUnspecified {
+			pair.MergeStrategy = utils.String(string(ch.MergeStrategy))
+		}
 
 		if ch.Left != nil {
 			leftAction, err := ch.Left.Action()
@@ -0,0 +1,503 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/auth"
+	"github.com/GitDataAI/jiaozifs/auth/rbac"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/fx"
+)
+
+type MergeRequestReviewController struct {
+	fx.In
+	BaseController
+
+	Repo models.IRepo
+}
+
+// loadMergeRequest find merge request in repository after check operator has permission of action
+func (reviewCtl MergeRequestReviewController) loadMergeRequest(ctx context.Context, w *api.JiaozifsResponse, ownerName string, repositoryName string, mrSeq uint64, action string) (*models.User, *models.MergeRequest, bool) {
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		w.Error(err)
+		return nil, nil, false
+	}
+
+	owner, err := reviewCtl.Repo.UserRepo().Get(ctx, models.NewGetUserParams().SetName(ownerName))
+	if err != nil {
+		w.Error(err)
+		return nil, nil, false
+	}
+
+	repository, err := reviewCtl.Repo.RepositoryRepo().Get(ctx, models.NewGetRepoParams().SetOwnerID(owner.ID).SetName(repositoryName))
+	if err != nil {
+		w.Error(err)
+		return nil, nil, false
+	}
+
+	if !reviewCtl.authorizeMember(ctx, w, repository.ID, rbac.Node{
+		Permission: rbac.Permission{
+			Action:   action,
+			Resource: rbacmodel.RepoURArn(owner.ID.String(), repository.ID.String()),
+		},
+	}) {
+		return nil, nil, false
+	}
+
+	mergeRequest, err := reviewCtl.Repo.MergeRequestRepo().Get(ctx, models.NewGetMergeRequestParams().SetTargetRepo(repository.ID).SetNumber(mrSeq))
+	if err != nil {
+		w.Error(err)
+		return nil, nil, false
+	}
+	return operator, mergeRequest, true
+}
+
+func (reviewCtl MergeRequestReviewController) ListReviewers(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, mrSeq uint64) {
+	_, mergeRequest, ok := reviewCtl.loadMergeRequest(ctx, w, ownerName, repositoryName, mrSeq, rbacmodel.ReadMergeRequestAction)
+	if !ok {
+		return
+	}
+
+	reviewers, err := reviewCtl.Repo.MergeRequestReviewRepo().ListReviewers(ctx, mergeRequest.ID)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	results := make([]api.Reviewer, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		user, err := reviewCtl.Repo.UserRepo().Get(ctx, models.NewGetUserParams().SetID(reviewer.ReviewerID))
+		if err != nil {
+			w.Error(err)
+			return
+		}
+		results = append(results, api.Reviewer{
+			Reviewer:  user.Name,
+			CreatedAt: reviewer.CreatedAt.UnixMilli(),
+		})
+	}
+	w.JSON(results)
+}
+
+func (reviewCtl MergeRequestReviewController) AddReviewers(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.AddReviewersJSONRequestBody, ownerName string, repositoryName string, mrSeq uint64) {
+	if len(body.Reviewers) == 0 {
+		w.BadRequest("reviewers must be set")
+		return
+	}
+
+	_, mergeRequest, ok := reviewCtl.loadMergeRequest(ctx, w, ownerName, repositoryName, mrSeq, rbacmodel.UpdateMergeRequestAction)
+	if !ok {
+		return
+	}
+
+	err := reviewCtl.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		for _, reviewerName := range body.Reviewers {
+			reviewer, err := repo.UserRepo().Get(ctx, models.NewGetUserParams().SetName(reviewerName))
+			if err != nil {
+				return fmt.Errorf("get reviewer %s %w", reviewerName, err)
+			}
+			err = repo.MergeRequestReviewRepo().AddReviewer(ctx, &models.MergeRequestReviewer{
+				MergeRequestID: mergeRequest.ID,
+				ReviewerID:     reviewer.ID,
+				CreatedAt:      time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Code(http.StatusCreated)
+}
+
+func (reviewCtl MergeRequestReviewController) RemoveReviewer(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, mrSeq uint64, params api.RemoveReviewerParams) {
+	_, mergeRequest, ok := reviewCtl.loadMergeRequest(ctx, w, ownerName, repositoryName, mrSeq, rbacmodel.UpdateMergeRequestAction)
+	if !ok {
+		return
+	}
+
+	reviewer, err := reviewCtl.Repo.UserRepo().Get(ctx, models.NewGetUserParams().SetName(params.Reviewer))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	affectRows, err := reviewCtl.Repo.MergeRequestReviewRepo().RemoveReviewer(ctx, mergeRequest.ID, reviewer.ID)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	if affectRows == 0 {
+		w.NotFound()
+		return
+	}
+	w.OK()
+}
+
+func (reviewCtl MergeRequestReviewController) ListReviews(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, mrSeq uint64) {
+	_, mergeRequest, ok := reviewCtl.loadMergeRequest(ctx, w, ownerName, repositoryName, mrSeq, rbacmodel.ReadMergeRequestAction)
+	if !ok {
+		return
+	}
+
+	reviews, err := reviewCtl.Repo.MergeRequestReviewRepo().ListReviews(ctx, mergeRequest.ID)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	results := make([]api.Review, 0, len(reviews))
+	for _, review := range reviews {
+		reviewer, err := reviewCtl.Repo.UserRepo().Get(ctx, models.NewGetUserParams().SetID(review.ReviewerID))
+		if err != nil {
+			w.Error(err)
+			return
+		}
+		results = append(results, *reviewToDto(review, reviewer))
+	}
+	w.JSON(results)
+}
+
+func (reviewCtl MergeRequestReviewController) ReviewMergeRequest(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.ReviewMergeRequestJSONRequestBody, ownerName string, repositoryName string, mrSeq uint64) {
+	var state models.ReviewState
+	switch body.State {
+	case api.Approved:
+		state = models.ReviewStateApproved
+	case api.ChangesRequested:
+		state = models.ReviewStateChangesRequested
+	default:
+		w.BadRequest(fmt.Sprintf("unsupported review state %s", body.State))
+		return
+	}
+
+	//review counts toward approval rule or blocks merge, so reader of repository is not allowed to review
+	operator, mergeRequest, ok := reviewCtl.loadMergeRequest(ctx, w, ownerName, repositoryName, mrSeq, rbacmodel.UpdateMergeRequestAction)
+	if !ok {
+		return
+	}
+
+	if mergeRequest.MergeState != models.MergeStateInit {
+		w.BadRequest("merge request already merged or closed")
+		return
+	}
+	if mergeRequest.AuthorID == operator.ID && state == models.ReviewStateApproved {
+		w.BadRequest("can not approve your own merge request")
+		return
+	}
+
+	//review is made on current head of source branch
+	sourceBranch, err := reviewCtl.Repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetID(mergeRequest.SourceBranchID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	review, err := reviewCtl.Repo.MergeRequestReviewRepo().SaveReview(ctx, &models.MergeRequestReview{
+		MergeRequestID: mergeRequest.ID,
+		ReviewerID:     operator.ID,
+		State:          state,
+		Message:        body.Message,
+		CommitHash:     sourceBranch.CommitHash,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	})
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(reviewToDto(review, operator), http.StatusCreated)
+}
+
+func (reviewCtl MergeRequestReviewController) ListMergeRequestComments(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, mrSeq uint64) {
+	_, mergeRequest, ok := reviewCtl.loadMergeRequest(ctx, w, ownerName, repositoryName, mrSeq, rbacmodel.ReadMergeRequestAction)
+	if !ok {
+		return
+	}
+
+	comments, err := reviewCtl.Repo.MergeRequestReviewRepo().ListComments(ctx, mergeRequest.ID)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	authors := make(map[openapi_types.UUID]*models.User)
+	results := make([]api.ReviewComment, 0, len(comments))
+	for _, comment := range comments {
+		author, ok := authors[comment.AuthorID]
+		if !ok {
+			author, err = reviewCtl.Repo.UserRepo().Get(ctx, models.NewGetUserParams().SetID(comment.AuthorID))
+			if err != nil {
+				w.Error(err)
+				return
+			}
+			authors[comment.AuthorID] = author
+		}
+		results = append(results, *reviewCommentToDto(comment, author))
+	}
+	w.JSON(results)
+}
+
+func (reviewCtl MergeRequestReviewController) CreateMergeRequestComment(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.CreateMergeRequestCommentJSONRequestBody, ownerName string, repositoryName string, mrSeq uint64) {
+	if len(strings.TrimSpace(body.Content)) == 0 {
+		w.BadRequest("content must be set")
+		return
+	}
+
+	operator, mergeRequest, ok := reviewCtl.loadMergeRequest(ctx, w, ownerName, repositoryName, mrSeq, rbacmodel.ReadMergeRequestAction)
+	if !ok {
+		return
+	}
+
+	comment := &models.MergeRequestComment{
+		MergeRequestID: mergeRequest.ID,
+		AuthorID:       operator.ID,
+		Path:           body.Path,
+		Content:        body.Content,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	if body.ParentId != nil {
+		parent, err := reviewCtl.Repo.MergeRequestReviewRepo().GetComment(ctx, models.NewGetMergeRequestCommentParams().SetID(*body.ParentId).SetMergeRequestID(mergeRequest.ID))
+		if err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				w.BadRequest(fmt.Sprintf("comment %s to reply not found", body.ParentId.String()))
+				return
+			}
+			w.Error(err)
+			return
+		}
+		//replies are kept in thread of the top comment
+		comment.ParentID = &parent.ID
+		if parent.ParentID != nil {
+			comment.ParentID = parent.ParentID
+		}
+		if comment.Path == nil {
+			comment.Path = parent.Path
+		}
+	}
+
+	comment, err := reviewCtl.Repo.MergeRequestReviewRepo().InsertComment(ctx, comment)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(reviewCommentToDto(comment, operator), http.StatusCreated)
+}
+
+func (reviewCtl MergeRequestReviewController) DeleteMergeRequestComment(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, mrSeq uint64, commentID openapi_types.UUID) {
+	operator, mergeRequest, ok := reviewCtl.loadMergeRequest(ctx, w, ownerName, repositoryName, mrSeq, rbacmodel.ReadMergeRequestAction)
+	if !ok {
+		return
+	}
+
+	comment, err := reviewCtl.Repo.MergeRequestReviewRepo().GetComment(ctx, models.NewGetMergeRequestCommentParams().SetID(commentID).SetMergeRequestID(mergeRequest.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	if comment.AuthorID != operator.ID {
+		w.Forbidden()
+		return
+	}
+
+	_, err = reviewCtl.Repo.MergeRequestReviewRepo().DeleteComment(ctx, models.NewDeleteMergeRequestCommentParams().SetID(comment.ID).SetMergeRequestID(mergeRequest.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.OK()
+}
+
+func (reviewCtl MergeRequestReviewController) GetMergeApprovalRule(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string) {
+	repository, ok := reviewCtl.loadRepository(ctx, w, reviewCtl.Repo, ownerName, repositoryName, rbacmodel.ReadRepositoryAction)
+	if !ok {
+		return
+	}
+
+	rule, err := reviewCtl.Repo.MergeApprovalRuleRepo().Get(ctx, repository.ID)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(approvalRuleToDto(rule))
+}
+
+func (reviewCtl MergeRequestReviewController) SetMergeApprovalRule(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.SetMergeApprovalRuleJSONRequestBody, ownerName string, repositoryName string) {
+	if body.RequiredApprovals < 0 {
+		w.BadRequest("required approvals must not be negative")
+		return
+	}
+
+	repository, ok := reviewCtl.loadRepository(ctx, w, reviewCtl.Repo, ownerName, repositoryName, rbacmodel.UpdateRepositoryAction)
+	if !ok {
+		return
+	}
+
+	groupName := utils.StringValue(body.GroupName)
+	if len(groupName) > 0 {
+		_, err := reviewCtl.Repo.GroupRepo().Get(ctx, rbacmodel.NewGetGroupParams().SetName(groupName))
+		if err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				w.BadRequest(fmt.Sprintf("group %s not found", groupName))
+				return
+			}
+			w.Error(err)
+			return
+		}
+	}
+
+	rule, err := reviewCtl.Repo.MergeApprovalRuleRepo().Save(ctx, &models.MergeApprovalRule{
+		RepositoryID:      repository.ID,
+		RequiredApprovals: body.RequiredApprovals,
+		GroupName:         groupName,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	})
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(approvalRuleToDto(rule))
+}
+
+func (reviewCtl MergeRequestReviewController) DeleteMergeApprovalRule(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string) {
+	repository, ok := reviewCtl.loadRepository(ctx, w, reviewCtl.Repo, ownerName, repositoryName, rbacmodel.UpdateRepositoryAction)
+	if !ok {
+		return
+	}
+
+	affectRows, err := reviewCtl.Repo.MergeApprovalRuleRepo().Delete(ctx, repository.ID)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	if affectRows == 0 {
+		w.NotFound()
+		return
+	}
+	w.OK()
+}
+
+// checkApprovals check whether merge request satisfy approval rule of repository, return reason if not satisfied.
+// only reviews made on sourceHead are effective, reviews of older heads are dismissed
+func checkApprovals(ctx context.Context, repo models.IRepo, repository *models.Repository, mergeRequest *models.MergeRequest, sourceHead hash.Hash) (string, error) {
+	allReviews, err := repo.MergeRequestReviewRepo().ListReviews(ctx, mergeRequest.ID)
+	if err != nil {
+		return "", err
+	}
+	var reviews []*models.MergeRequestReview
+	for _, review := range allReviews {
+		if bytes.Equal(review.CommitHash, sourceHead) {
+			reviews = append(reviews, review)
+		}
+	}
+
+	for _, review := range reviews {
+		if review.State == models.ReviewStateChangesRequested {
+			reviewer, err := repo.UserRepo().Get(ctx, models.NewGetUserParams().SetID(review.ReviewerID))
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("changes requested by %s", reviewer.Name), nil
+		}
+	}
+
+	rule, err := repo.MergeApprovalRuleRepo().Get(ctx, repository.ID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	var group *rbacmodel.Group
+	if len(rule.GroupName) > 0 {
+		group, err = repo.GroupRepo().Get(ctx, rbacmodel.NewGetGroupParams().SetName(rule.GroupName))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	approvals := 0
+	for _, review := range reviews {
+		if review.State != models.ReviewStateApproved {
+			continue
+		}
+		//owner has all permission of repository, so counted as member of any group
+		if group != nil && review.ReviewerID != repository.OwnerID {
+			member, err := repo.MemberRepo().GetMember(ctx, models.NewGetMemberParams().SetRepoID(repository.ID).SetUserID(review.ReviewerID))
+			if err != nil {
+				if errors.Is(err, models.ErrNotFound) {
+					continue
+				}
+				return "", err
+			}
+			if member.GroupID != group.ID {
+				continue
+			}
+		}
+		approvals++
+	}
+
+	if approvals < rule.RequiredApprovals {
+		if group != nil {
+			return fmt.Sprintf("%d approvals from group %s required, but got %d", rule.RequiredApprovals, group.Name, approvals), nil
+		}
+		return fmt.Sprintf("%d approvals required, but got %d", rule.RequiredApprovals, approvals), nil
+	}
+	return "", nil
+}
+
+func reviewToDto(review *models.MergeRequestReview, reviewer *models.User) *api.Review {
+	state := api.Approved
+	if review.State == models.ReviewStateChangesRequested {
+		state = api.ChangesRequested
+	}
+	return &api.Review{
+		Id:         review.ID,
+		Reviewer:   reviewer.Name,
+		State:      state,
+		Message:    review.Message,
+		CommitHash: review.CommitHash.Hex(),
+		CreatedAt:  review.CreatedAt.UnixMilli(),
+		UpdatedAt:  review.UpdatedAt.UnixMilli(),
+	}
+}
+
+func reviewCommentToDto(comment *models.MergeRequestComment, author *models.User) *api.ReviewComment {
+	return &api.ReviewComment{
+		Id:        comment.ID,
+		Author:    author.Name,
+		ParentId:  comment.ParentID,
+		Path:      comment.Path,
+		Content:   comment.Content,
+		CreatedAt: comment.CreatedAt.UnixMilli(),
+		UpdatedAt: comment.UpdatedAt.UnixMilli(),
+	}
+}
+
+func approvalRuleToDto(rule *models.MergeApprovalRule) *api.MergeApprovalRule {
+	dto := &api.MergeApprovalRule{
+		RequiredApprovals: rule.RequiredApprovals,
+	}
+	if len(rule.GroupName) > 0 {
+		dto.GroupName = utils.String(rule.GroupName)
+	}
+	return dto
+}
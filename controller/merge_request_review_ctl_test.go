@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckApprovals(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	repo := models.NewRepo(db)
+	var users []*models.User
+	for _, name := range []string{"author", "reviewer1", "reviewer2"} {
+		user, err := repo.UserRepo().Insert(ctx, &models.User{
+			Name:              name,
+			Email:             name + "@gg.com",
+			EncryptedPassword: "123",
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+		})
+		require.NoError(t, err)
+		users = append(users, user)
+	}
+	repository := &models.Repository{ID: uuid.New(), OwnerID: users[0].ID}
+	mergeRequest := &models.MergeRequest{ID: uuid.New(), AuthorID: users[0].ID}
+
+	oldHead := hash.Hash("old head")
+	newHead := hash.Hash("new head")
+	review := func(reviewer *models.User, state models.ReviewState, head hash.Hash) {
+		_, err := repo.MergeRequestReviewRepo().SaveReview(ctx, &models.MergeRequestReview{
+			MergeRequestID: mergeRequest.ID,
+			ReviewerID:     reviewer.ID,
+			State:          state,
+			CommitHash:     head,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		})
+		require.NoError(t, err)
+	}
+
+	//no rule, merge request can be merged without approval
+	reason, err := checkApprovals(ctx, repo, repository, mergeRequest, newHead)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+
+	_, err = repo.MergeApprovalRuleRepo().Save(ctx, &models.MergeApprovalRule{
+		RepositoryID:      repository.ID,
+		RequiredApprovals: 2,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	})
+	require.NoError(t, err)
+
+	review(users[1], models.ReviewStateApproved, oldHead)
+	review(users[2], models.ReviewStateApproved, newHead)
+	reason, err = checkApprovals(ctx, repo, repository, mergeRequest, newHead)
+	require.NoError(t, err)
+	require.Equal(t, "2 approvals required, but got 1", reason)
+
+	review(users[1], models.ReviewStateApproved, newHead)
+	reason, err = checkApprovals(ctx, repo, repository, mergeRequest, newHead)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+
+	//approvals are dismissed when source branch moved
+	reason, err = checkApprovals(ctx, repo, repository, mergeRequest, hash.Hash("newer head"))
+	require.NoError(t, err)
+	require.Equal(t, "2 approvals required, but got 0", reason)
+
+	review(users[2], models.ReviewStateChangesRequested, newHead)
+	reason, err = checkApprovals(ctx, repo, repository, mergeRequest, newHead)
+	require.NoError(t, err)
+	require.Equal(t, "changes requested by reviewer2", reason)
+
+	//change request on old head is dismissed too
+	_, err = repo.MergeApprovalRuleRepo().Delete(ctx, repository.ID)
+	require.NoError(t, err)
+	reason, err = checkApprovals(ctx, repo, repository, mergeRequest, hash.Hash("newer head"))
+	require.NoError(t, err)
+	require.Empty(t, reason)
+}
@@ -0,0 +1,257 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/auth"
+	"github.com/GitDataAI/jiaozifs/auth/rbac"
+	"github.com/GitDataAI/jiaozifs/controller/validator"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/filemode"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/utils/httputil"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/go-openapi/swag"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// writeObjectWorkRepo check write permission of operator and return work repository, return false if response was written
+func (oct ObjectController) writeObjectWorkRepo(ctx context.Context, w *api.JiaozifsResponse, ownerName string, repositoryName string) (*versionmgr.WorkRepository, *models.User, bool) {
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		w.Error(err)
+		return nil, nil, false
+	}
+
+	owner, err := oct.Repo.UserRepo().Get(ctx, models.NewGetUserParams().SetName(ownerName))
+	if err != nil {
+		w.Error(err)
+		return nil, nil, false
+	}
+
+	repository, err := oct.Repo.RepositoryRepo().Get(ctx, models.NewGetRepoParams().SetOwnerID(owner.ID).SetName(repositoryName))
+	if err != nil {
+		w.Error(err)
+		return nil, nil, false
+	}
+
+	if !oct.authorizeMember(ctx, w, repository.ID, rbac.Node{
+		Permission: rbac.Permission{
+			Action:   rbacmodel.WriteObjectAction,
+			Resource: rbacmodel.RepoURArn(owner.ID.String(), repository.ID.String()),
+		},
+	}) {
+		return nil, nil, false
+	}
+
+	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, oct.Repo, oct.PublicStorageConfig)
+	if err != nil {
+		w.Error(err)
+		return nil, nil, false
+	}
+	return workRepo, operator, true
+}
+
+func (oct ObjectController) ListMultipartUploads(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, params api.ListMultipartUploadsParams) {
+	workRepo, operator, ok := oct.writeObjectWorkRepo(ctx, w, ownerName, repositoryName)
+	if !ok {
+		return
+	}
+
+	listParams := models.NewListMultipartUploadParams().SetRepositoryID(workRepo.RepositoryModel().ID).SetCreatorID(operator.ID)
+	if params.RefName != nil {
+		listParams.SetRefName(utils.StringValue(params.RefName))
+	}
+	if params.Path != nil {
+		listParams.SetPath(versionmgr.CleanPath(utils.StringValue(params.Path)))
+	}
+	uploads, err := oct.Repo.MultipartUploadRepo().List(ctx, listParams)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	results := make([]api.MultipartUpload, 0, len(uploads))
+	for _, upload := range uploads {
+		parts, err := oct.Repo.MultipartUploadRepo().ListParts(ctx, upload.ID)
+		if err != nil {
+			w.Error(err)
+			return
+		}
+		results = append(results, *multipartUploadToDto(upload, parts))
+	}
+	w.JSON(results)
+}
+
+func (oct ObjectController) CreateMultipartUpload(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, params api.CreateMultipartUploadParams) {
+	err := validator.ValidateObjectPath(params.Path)
+	if err != nil {
+		w.BadRequest("%s %s", params.Path, err.Error())
+		return
+	}
+
+	workRepo, _, ok := oct.writeObjectWorkRepo(ctx, w, ownerName, repositoryName)
+	if !ok {
+		return
+	}
+
+	//make sure ref exit and wip can be created when complete
+	_, err = oct.Repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetRepositoryID(workRepo.RepositoryModel().ID).SetName(params.RefName))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	upload, err := workRepo.CreateMultipartUpload(ctx, params.RefName, params.Path)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(multipartUploadToDto(upload, nil), http.StatusCreated)
+}
+
+func (oct ObjectController) GetMultipartUpload(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, uploadID openapi_types.UUID) {
+	workRepo, operator, ok := oct.writeObjectWorkRepo(ctx, w, ownerName, repositoryName)
+	if !ok {
+		return
+	}
+
+	upload, err := oct.Repo.MultipartUploadRepo().Get(ctx, models.NewGetMultipartUploadParams().SetID(uploadID).SetRepositoryID(workRepo.RepositoryModel().ID).SetCreatorID(operator.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	parts, err := oct.Repo.MultipartUploadRepo().ListParts(ctx, upload.ID)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(multipartUploadToDto(upload, parts))
+}
+
+func (oct ObjectController) AbortMultipartUpload(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, uploadID openapi_types.UUID) {
+	workRepo, operator, ok := oct.writeObjectWorkRepo(ctx, w, ownerName, repositoryName)
+	if !ok {
+		return
+	}
+
+	upload, err := oct.Repo.MultipartUploadRepo().Get(ctx, models.NewGetMultipartUploadParams().SetID(uploadID).SetRepositoryID(workRepo.RepositoryModel().ID).SetCreatorID(operator.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	err = workRepo.AbortMultipartUpload(ctx, upload)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.OK()
+}
+
+func (oct ObjectController) UploadMultipartPart(ctx context.Context, w *api.JiaozifsResponse, r *http.Request, ownerName string, repositoryName string, uploadID openapi_types.UUID, partNumber int) {
+	defer r.Body.Close() //nolint
+
+	workRepo, operator, ok := oct.writeObjectWorkRepo(ctx, w, ownerName, repositoryName)
+	if !ok {
+		return
+	}
+
+	upload, err := oct.Repo.MultipartUploadRepo().Get(ctx, models.NewGetMultipartUploadParams().SetID(uploadID).SetRepositoryID(workRepo.RepositoryModel().ID).SetCreatorID(operator.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	part, err := workRepo.UploadMultipartPart(ctx, upload, partNumber, r.ContentLength, r.Body)
+	if err != nil {
+		if errors.Is(err, versionmgr.ErrInvalidPart) {
+			w.BadRequest(err.Error())
+			return
+		}
+		w.Error(err)
+		return
+	}
+	w.JSON(multipartUploadPartToDto(part))
+}
+
+func (oct ObjectController) CompleteMultipartUpload(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, uploadID openapi_types.UUID, params api.CompleteMultipartUploadParams) {
+	workRepo, operator, ok := oct.writeObjectWorkRepo(ctx, w, ownerName, repositoryName)
+	if !ok {
+		return
+	}
+
+	upload, err := oct.Repo.MultipartUploadRepo().Get(ctx, models.NewGetMultipartUploadParams().SetID(uploadID).SetRepositoryID(workRepo.RepositoryModel().ID).SetCreatorID(operator.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	err = workRepo.CheckOut(ctx, versionmgr.InWip, upload.RefName)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	workTree, err := workRepo.RootTree(ctx)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	blob, err := workRepo.CompleteMultipartUpload(ctx, upload, models.DefaultLeafProperty())
+	if err != nil {
+		if errors.Is(err, versionmgr.ErrInvalidPart) {
+			w.BadRequest(err.Error())
+			return
+		}
+		w.Error(err)
+		return
+	}
+
+	err = oct.addBlobToWip(ctx, workRepo, workTree, upload.Path, blob, utils.BoolValue(params.IsReplace))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	w.JSON(api.ObjectStats{
+		Checksum:    blob.CheckSum.Hex(),
+		Mtime:       time.Now().Unix(),
+		Path:        upload.Path,
+		PathMode:    utils.Uint32(uint32(filemode.Regular)),
+		SizeBytes:   swag.Int64(blob.Size),
+		ContentType: utils.String(httputil.ExtensionsByType(upload.Path)),
+		Metadata:    &api.ObjectUserMetadata{},
+	}, http.StatusCreated)
+}
+
+func multipartUploadToDto(upload *models.MultipartUpload, parts []*models.MultipartUploadPart) *api.MultipartUpload {
+	partsDto := make([]api.MultipartUploadPart, 0, len(parts))
+	for _, part := range parts {
+		partsDto = append(partsDto, *multipartUploadPartToDto(part))
+	}
+	return &api.MultipartUpload{
+		Id:        upload.ID,
+		RefName:   upload.RefName,
+		Path:      upload.Path,
+		Parts:     partsDto,
+		CreatedAt: upload.CreatedAt.UnixMilli(),
+	}
+}
+
+func multipartUploadPartToDto(part *models.MultipartUploadPart) *api.MultipartUploadPart {
+	return &api.MultipartUploadPart{
+		PartNumber: part.PartNumber,
+		Etag:       part.ETag,
+		Size:       part.Size,
+		Checksum:   part.CheckSum.Hex(),
+		CreatedAt:  part.CreatedAt.UnixMilli(),
+	}
+}
@@ -12,11 +12,13 @@ import (
 	"time"
 
 	"github.com/GitDataAI/jiaozifs/auth/rbac"
+	"github.com/GitDataAI/jiaozifs/block"
 	"github.com/GitDataAI/jiaozifs/controller/validator"
 
 	"github.com/GitDataAI/jiaozifs/api"
 	"github.com/GitDataAI/jiaozifs/auth"
 	"github.com/GitDataAI/jiaozifs/block/params"
+	"github.com/GitDataAI/jiaozifs/config"
 	"github.com/GitDataAI/jiaozifs/models"
 	"github.com/GitDataAI/jiaozifs/models/filemode"
 	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
@@ -36,6 +38,7 @@ type ObjectController struct {
 	BaseController
 
 	PublicStorageConfig params.AdapterConfig
+	UploadConfig        *config.UploadConfig
 	Repo                models.IRepo
 }
 
@@ -138,9 +141,9 @@ func (oct ObjectController) GetObject(ctx context.Context, w *api.JiaozifsRespon
 		return
 	}
 
-	err = workRepo.CheckOut(ctx, versionmgr.WorkRepoState(params.Type), params.RefName)
+	err = workRepo.CheckOutRevision(ctx, versionmgr.WorkRepoState(params.Type), params.RefName)
 	if err != nil {
-		w.Error(err)
+		revisionError(w, err)
 		return
 	}
 
@@ -160,6 +163,21 @@ func (oct ObjectController) GetObject(ctx context.Context, w *api.JiaozifsRespon
 		return
 	}
 
+	if utils.BoolValue(params.Presign) {
+		url, _, err := workRepo.PresignBlobURL(ctx, blob)
+		if err != nil {
+			if errors.Is(err, block.ErrOperationNotSupported) {
+				w.String(err.Error(), http.StatusNotImplemented)
+				return
+			}
+			w.Error(err)
+			return
+		}
+		w.Header().Set("Location", url)
+		w.Code(http.StatusFound)
+		return
+	}
+
 	reader, err := workRepo.ReadBlob(ctx, blob, params.Range)
 	if err != nil {
 		w.Error(err)
@@ -237,9 +255,9 @@ func (oct ObjectController) HeadObject(ctx context.Context, w *api.JiaozifsRespo
 		return
 	}
 
-	err = workRepo.CheckOut(ctx, versionmgr.WorkRepoState(params.Type), params.RefName)
+	err = workRepo.CheckOutRevision(ctx, versionmgr.WorkRepoState(params.Type), params.RefName)
 	if err != nil {
-		w.Error(err)
+		revisionError(w, err)
 		return
 	}
 
@@ -316,7 +334,10 @@ func (oct ObjectController) UploadObject(ctx context.Context, w *api.JiaozifsRes
 	}
 
 	reader := r.Body
+	contentLength := r.ContentLength
 	if mediaType == "multipart/form-data" {
+		//size of content part is unknown
+		contentLength = -1
 		// handle multipart upload
 		boundary, ok := p["boundary"]
 		if !ok {
@@ -382,14 +403,33 @@ func (oct ObjectController) UploadObject(ctx context.Context, w *api.JiaozifsRes
 		return
 	}
 
-	blob, err := workRepo.WriteBlob(ctx, reader, r.ContentLength, models.DefaultLeafProperty())
+	blob, err := workRepo.SetStreamThreshold(oct.UploadConfig.StreamThreshold).WriteBlob(ctx, reader, contentLength, models.DefaultLeafProperty())
 	if err != nil {
 		w.Error(err)
 		return
 	}
 
 	path := versionmgr.CleanPath(params.Path)
-	err = oct.Repo.Transaction(ctx, func(dRepo models.IRepo) error {
+	err = oct.addBlobToWip(ctx, workRepo, workTree, path, blob, utils.BoolValue(params.IsReplace))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	w.JSON(api.ObjectStats{
+		Checksum:    blob.CheckSum.Hex(),
+		Mtime:       time.Now().Unix(),
+		Path:        path,
+		PathMode:    utils.Uint32(uint32(filemode.Regular)),
+		SizeBytes:   swag.Int64(blob.Size),
+		ContentType: &contentType,
+		Metadata:    &api.ObjectUserMetadata{},
+	}, http.StatusCreated)
+}
+
+// addBlobToWip add blob to path of wip, existing object with different content is replaced only if isReplace is true
+func (oct ObjectController) addBlobToWip(ctx context.Context, workRepo *versionmgr.WorkRepository, workTree *versionmgr.WorkTree, path string, blob *models.Blob, isReplace bool) error {
+	return oct.Repo.Transaction(ctx, func(dRepo models.IRepo) error {
 		oldData, _, err := workTree.FindBlob(ctx, path)
 		if err != nil && !errors.Is(err, versionmgr.ErrPathNotFound) {
 			return err
@@ -406,7 +446,7 @@ func (oct ObjectController) UploadObject(ctx context.Context, w *api.JiaozifsRes
 			return nil
 		}
 
-		if !utils.BoolValue(params.IsReplace) {
+		if !isReplace {
 			return fmt.Errorf("object exit %w", api.ErrCode(http.StatusConflict))
 		}
 
@@ -417,20 +457,6 @@ func (oct ObjectController) UploadObject(ctx context.Context, w *api.JiaozifsRes
 		}
 		return dRepo.WipRepo().UpdateByID(ctx, models.NewUpdateWipParams(workRepo.CurWip().ID).SetCurrentTree(workTree.Root().Hash()))
 	})
-	if err != nil {
-		w.Error(err)
-		return
-	}
-
-	w.JSON(api.ObjectStats{
-		Checksum:    blob.CheckSum.Hex(),
-		Mtime:       time.Now().Unix(),
-		Path:        path,
-		PathMode:    utils.Uint32(uint32(filemode.Regular)),
-		SizeBytes:   swag.Int64(blob.Size),
-		ContentType: &contentType,
-		Metadata:    &api.ObjectUserMetadata{},
-	}, http.StatusCreated)
 }
 
 func (oct ObjectController) GetFiles(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, params api.GetFilesParams) {
@@ -467,9 +493,9 @@ func (oct ObjectController) GetFiles(ctx context.Context, w *api.JiaozifsRespons
 		return
 	}
 
-	err = workRepo.CheckOut(ctx, versionmgr.WorkRepoState(params.Type), params.RefName)
+	err = workRepo.CheckOutRevision(ctx, versionmgr.WorkRepoState(params.Type), params.RefName)
 	if err != nil {
-		w.Error(err)
+		revisionError(w, err)
 		return
 	}
 
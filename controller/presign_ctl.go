@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/block"
+	"github.com/GitDataAI/jiaozifs/controller/validator"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/filemode"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/GitDataAI/jiaozifs/utils/httputil"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/go-openapi/swag"
+)
+
+func (oct ObjectController) GetPhysicalAddress(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, params api.GetPhysicalAddressParams) {
+	err := validator.ValidateObjectPath(params.Path)
+	if err != nil {
+		w.BadRequest("%s %s", params.Path, err.Error())
+		return
+	}
+
+	workRepo, _, ok := oct.writeObjectWorkRepo(ctx, w, ownerName, repositoryName)
+	if !ok {
+		return
+	}
+
+	_, err = oct.Repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetRepositoryID(workRepo.RepositoryModel().ID).SetName(params.RefName))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	physicalAddress, url, expiresAt, err := workRepo.PresignUpload(ctx)
+	if err != nil {
+		if errors.Is(err, block.ErrOperationNotSupported) {
+			w.String(err.Error(), http.StatusNotImplemented)
+			return
+		}
+		w.Error(err)
+		return
+	}
+
+	result := api.PhysicalAddress{
+		PhysicalAddress: physicalAddress,
+		PresignedUrl:    url,
+	}
+	if !expiresAt.IsZero() {
+		result.ExpiresAt = swag.Int64(expiresAt.Unix())
+	}
+	w.JSON(result)
+}
+
+func (oct ObjectController) LinkPhysicalAddress(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.LinkPhysicalAddressJSONRequestBody, ownerName string, repositoryName string, params api.LinkPhysicalAddressParams) {
+	err := validator.ValidateObjectPath(params.Path)
+	if err != nil {
+		w.BadRequest("%s %s", params.Path, err.Error())
+		return
+	}
+
+	checkSum, err := hash.FromHex(body.Checksum)
+	if err != nil {
+		w.BadRequest("invalid checksum %s", body.Checksum)
+		return
+	}
+
+	workRepo, _, ok := oct.writeObjectWorkRepo(ctx, w, ownerName, repositoryName)
+	if !ok {
+		return
+	}
+
+	err = workRepo.CheckOut(ctx, versionmgr.InWip, params.RefName)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	workTree, err := workRepo.RootTree(ctx)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	blob, err := workRepo.LinkStagingObject(ctx, body.PhysicalAddress, checkSum, body.SizeBytes, models.DefaultLeafProperty())
+	if err != nil {
+		if errors.Is(err, versionmgr.ErrContentMismatch) {
+			w.BadRequest(err.Error())
+			return
+		}
+		w.Error(err)
+		return
+	}
+
+	path := versionmgr.CleanPath(params.Path)
+	err = oct.addBlobToWip(ctx, workRepo, workTree, path, blob, utils.BoolValue(params.IsReplace))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	w.JSON(api.ObjectStats{
+		Checksum:    blob.CheckSum.Hex(),
+		Mtime:       time.Now().Unix(),
+		Path:        path,
+		PathMode:    utils.Uint32(uint32(filemode.Regular)),
+		SizeBytes:   swag.Int64(blob.Size),
+		ContentType: utils.String(httputil.ExtensionsByType(path)),
+		Metadata:    &api.ObjectUserMetadata{},
+	}, http.StatusCreated)
+}
@@ -3,9 +3,11 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/GitDataAI/jiaozifs/auth/rbac"
@@ -36,6 +38,7 @@ type RepositoryController struct {
 
 	Repo                models.IRepo
 	PublicStorageConfig params.AdapterConfig
+	UploadConfig        *config.UploadConfig
 }
 
 func (repositoryCtl RepositoryController) ListRepositoryOfAuthenticatedUser(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, params api.ListRepositoryOfAuthenticatedUserParams) {
@@ -239,6 +242,7 @@ func (repositoryCtl RepositoryController) CreateRepository(ctx context.Context,
 		Name:                 body.Name,
 		Visible:              utils.BoolValue(body.Visible),
 		UsePublicStorage:     usePublicStorage,
+		ChunkedStorage:       utils.BoolValue(body.ChunkedStorage),
 		StorageAdapterParams: &storageConfig,
 		StorageNamespace:     storageNamespace,
 		Description:          body.Description,
@@ -256,6 +260,19 @@ func (repositoryCtl RepositoryController) CreateRepository(ctx context.Context,
 		if err != nil {
 			return err
 		}
+		_, err = repo.BranchReflogRepo().Insert(ctx, &models.BranchReflog{
+			RepositoryID: repoID,
+			BranchID:     defaultRef.ID,
+			BranchName:   defaultRef.Name,
+			OldHash:      hash.Empty,
+			NewHash:      defaultRef.CommitHash,
+			Operation:    models.ReflogOperationCreate,
+			OperatorID:   operator.ID,
+			CreatedAt:    time.Now(),
+		})
+		if err != nil {
+			return err
+		}
 		createdRepo, err = repo.RepositoryRepo().Insert(ctx, repository)
 		return err
 	})
@@ -306,6 +323,12 @@ func (repositoryCtl RepositoryController) DeleteRepository(ctx context.Context,
 			return err
 		}
 
+		//delete branch reflog
+		_, err = repo.BranchReflogRepo().Delete(ctx, models.NewDeleteBranchReflogParams().SetRepositoryID(repository.ID))
+		if err != nil {
+			return err
+		}
+
 		//delete commit
 		_, err = repo.CommitRepo(repository.ID).Delete(ctx, models.NewDeleteParams())
 		if err != nil {
@@ -330,6 +353,12 @@ func (repositoryCtl RepositoryController) DeleteRepository(ctx context.Context,
 			return err
 		}
 
+		//delete webhooks and their deliveries
+		_, err = repo.WebhookRepo().Delete(ctx, models.NewDeleteWebhookParams().SetRepositoryID(repository.ID))
+		if err != nil {
+			return err
+		}
+
 		//delete all membership
 		_, err = repo.MemberRepo().DeleteMember(ctx, models.NewDeleteMemberParams().SetRepoID(repository.ID))
 		return err
@@ -435,6 +464,10 @@ func (repositoryCtl RepositoryController) UpdateRepository(ctx context.Context,
 		params.SetDescription(utils.StringValue(body.Description))
 	}
 
+	if body.ChunkedStorage != nil {
+		params.SetChunkedStorage(utils.BoolValue(body.ChunkedStorage))
+	}
+
 	err = repositoryCtl.Repo.RepositoryRepo().UpdateByID(ctx, params)
 	if err != nil {
 		w.Error(err)
@@ -465,6 +498,18 @@ func (repositoryCtl RepositoryController) GetCommitsInRef(ctx context.Context, w
 		return
 	}
 
+	metadataFilter := make(map[string]string)
+	if params.Metadata != nil {
+		for _, item := range *params.Metadata {
+			key, value, found := strings.Cut(item, "=")
+			if !found || len(key) == 0 {
+				w.BadRequest("metadata filter %s must be key=value", item)
+				return
+			}
+			metadataFilter[key] = value
+		}
+	}
+
 	refName := repository.HEAD
 	if params.RefName != nil {
 		refName = *params.RefName
@@ -486,9 +531,26 @@ func (repositoryCtl RepositoryController) GetCommitsInRef(ctx context.Context, w
 		return
 	}
 
+	var iter versionmgr.CommitIter
+	if params.Path != nil && len(versionmgr.CleanPath(*params.Path)) > 0 {
+		operator, err := auth.GetOperator(ctx)
+		if err != nil {
+			w.Error(err)
+			return
+		}
+
+		workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repositoryCtl.Repo, repositoryCtl.PublicStorageConfig)
+		if err != nil {
+			w.Error(err)
+			return
+		}
+		iter = workRepo.PathLog(ctx, commit, *params.Path, utils.BoolValue(params.Follow))
+	} else {
+		commitNode := versionmgr.NewWrapCommitNode(repositoryCtl.Repo.CommitRepo(repository.ID), commit)
+		iter = versionmgr.NewCommitPreorderIter(ctx, commitNode, nil, nil)
+	}
+
 	var commits []api.Commit
-	commitNode := versionmgr.NewWrapCommitNode(repositoryCtl.Repo.CommitRepo(repository.ID), commit)
-	iter := versionmgr.NewCommitPreorderIter(ctx, commitNode, nil, nil)
 	for {
 		commit, err := iter.Next()
 		if err == nil {
@@ -498,6 +560,9 @@ func (repositoryCtl RepositoryController) GetCommitsInRef(ctx context.Context, w
 					continue
 				}
 			}
+			if !commit.Commit().MatchMetadata(metadataFilter) {
+				continue
+			}
 			if params.Amount != nil && len(commits) == *params.Amount {
 				break
 			}
@@ -579,23 +644,26 @@ func (repositoryCtl RepositoryController) GetArchive(ctx context.Context, w *api
 		return
 	}
 
-	if string(params.RefType) != string(versionmgr.InBranch) && string(params.RefType) != string(versionmgr.InTag) {
-		w.BadRequest("archive ref type (%s) only allow branch and tag", params.RefType)
+	if params.RefType == api.RefTypeWip {
+		w.BadRequest("archive ref type (%s) only allow branch, tag and commit", params.RefType)
 		return
 	}
 
-	err = workRepo.CheckOut(ctx, versionmgr.WorkRepoState(params.RefType), params.RefName)
+	err = workRepo.CheckOutRevision(ctx, versionmgr.WorkRepoState(params.RefType), params.RefName)
 	if err != nil {
-		w.Error(err)
+		revisionError(w, err)
 		return
 	}
 
-	readeCloser, size, err := workRepo.Archive(ctx, versionmgr.ArchiveType(params.ArchiveType))
+	readeCloser, size, rootCid, err := workRepo.Archive(ctx, versionmgr.ArchiveType(params.ArchiveType))
 	if err != nil {
 		w.Error(err)
 		return
 	}
 	defer readeCloser.Close() //nolint
+	if rootCid.Defined() {
+		w.Header().Set("X-Root-Cid", rootCid.String())
+	}
 	w.Header().Set("Content-Length", fmt.Sprint(size))
 	w.Header().Set("Content-Type", "application/octet-stream")
 	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, fmt.Sprintf("%s.%s", repository.Name, params.ArchiveType)))
@@ -612,6 +680,73 @@ func (repositoryCtl RepositoryController) GetArchive(ctx context.Context, w *api
 	w.OK()
 }
 
+func (repositoryCtl RepositoryController) ImportArchive(ctx context.Context, w *api.JiaozifsResponse, r *http.Request, ownerName string, repositoryName string, params api.ImportArchiveParams) {
+	defer r.Body.Close() //nolint
+
+	owner, err := repositoryCtl.Repo.UserRepo().Get(ctx, models.NewGetUserParams().SetName(ownerName))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	repository, err := repositoryCtl.Repo.RepositoryRepo().Get(ctx, models.NewGetRepoParams().SetName(repositoryName).SetOwnerID(owner.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	if !repositoryCtl.authorizeMember(ctx, w, repository.ID, rbac.Node{
+		Permission: rbac.Permission{
+			Action:   rbacmodel.WriteObjectAction,
+			Resource: rbacmodel.RepoURArn(owner.ID.String(), repository.ID.String()),
+		},
+	}) {
+		return
+	}
+
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repositoryCtl.Repo, repositoryCtl.PublicStorageConfig)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	err = workRepo.CheckOut(ctx, versionmgr.InWip, params.RefName)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	result, err := workRepo.ImportCar(ctx, r.Body, repositoryCtl.UploadConfig.MaxCarSize, utils.StringValue(params.Path), utils.BoolValue(params.IsReplace))
+	if err != nil {
+		if errors.Is(err, versionmgr.ErrInvalidCar) {
+			w.BadRequest(err.Error())
+			return
+		}
+		if errors.Is(err, versionmgr.ErrCarTooLarge) {
+			w.String(err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		if errors.Is(err, versionmgr.ErrObjectExist) {
+			w.String(err.Error(), http.StatusConflict)
+			return
+		}
+		w.Error(err)
+		return
+	}
+
+	w.JSON(api.ArchiveImportResult{
+		RootCid:   result.RootCid.String(),
+		FileCount: result.FileCount,
+		SizeBytes: result.SizeBytes,
+	}, http.StatusCreated)
+}
+
 func repositoryToDto(repository *models.Repository) *api.Repository {
 	return &api.Repository{
 		CreatedAt:            repository.CreatedAt.UnixMilli(),
@@ -626,5 +761,6 @@ func repositoryToDto(repository *models.Repository) *api.Repository {
 		StorageAdapterParams: repository.StorageAdapterParams,
 		StorageNamespace:     repository.StorageNamespace,
 		UsePublicStorage:     repository.UsePublicStorage,
+		ChunkedStorage:       &repository.ChunkedStorage,
 	}
 }
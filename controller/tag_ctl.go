@@ -12,6 +12,7 @@ import (
 	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
 	"github.com/GitDataAI/jiaozifs/utils"
 	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/GitDataAI/jiaozifs/webhook"
 
 	"github.com/GitDataAI/jiaozifs/api"
 	"github.com/GitDataAI/jiaozifs/block/params"
@@ -61,27 +62,32 @@ func (tagCtl TagController) CreateTag(ctx context.Context, w *api.JiaozifsRespon
 		return
 	}
 
-	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, tagCtl.Repo, tagCtl.PublicStorageConfig)
-	if err != nil {
-		w.Error(err)
-		return
-	}
-
-	//check target is branch
-	_, err = tagCtl.Repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetRepositoryID(repository.ID).SetName(body.Target))
-	if err == nil {
-		// branch
-		err = workRepo.CheckOut(ctx, versionmgr.InBranch, body.Target)
-	} else if errors.Is(err, models.ErrNotFound) {
-		// commit
-		err = workRepo.CheckOut(ctx, versionmgr.InCommit, body.Target)
-	}
-	if err != nil {
-		w.Error(err)
-		return
-	}
-
-	newTag, err := workRepo.CreateTag(ctx, body.Name, body.Message)
+	var newTag *models.Tag
+	err = tagCtl.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repo, tagCtl.PublicStorageConfig)
+		if err != nil {
+			return err
+		}
+
+		//check target is branch
+		_, err = repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetRepositoryID(repository.ID).SetName(body.Target))
+		if err == nil {
+			// branch
+			err = workRepo.CheckOut(ctx, versionmgr.InBranch, body.Target)
+		} else if errors.Is(err, models.ErrNotFound) {
+			// commit
+			err = workRepo.CheckOut(ctx, versionmgr.InCommit, body.Target)
+		}
+		if err != nil {
+			return err
+		}
+
+		newTag, err = workRepo.CreateTag(ctx, body.Name, body.Message)
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventTagCreate, webhook.RefData{Ref: newTag.Name, Commit: newTag.Target.Hex()})
+	})
 	if err != nil {
 		w.Error(err)
 		return
@@ -119,19 +125,28 @@ func (tagCtl TagController) DeleteTag(ctx context.Context, w *api.JiaozifsRespon
 		return
 	}
 
-	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, tagCtl.Repo, tagCtl.PublicStorageConfig)
-	if err != nil {
-		w.Error(err)
-		return
-	}
+	err = tagCtl.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repo, tagCtl.PublicStorageConfig)
+		if err != nil {
+			return err
+		}
 
-	err = workRepo.CheckOut(ctx, versionmgr.InTag, params.RefName)
-	if err != nil {
-		w.Error(err)
-		return
-	}
+		err = workRepo.CheckOut(ctx, versionmgr.InTag, params.RefName)
+		if err != nil {
+			return err
+		}
 
-	err = workRepo.DeleteTag(ctx)
+		deletedTag, err := repo.TagRepo().Get(ctx, models.NewGetTagParams().SetRepositoryID(repository.ID).SetName(params.RefName))
+		if err != nil {
+			return err
+		}
+
+		err = workRepo.DeleteTag(ctx)
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventTagDelete, webhook.RefData{Ref: deletedTag.Name, Commit: deletedTag.Target.Hex()})
+	})
 	if err != nil {
 		w.Error(err)
 		return
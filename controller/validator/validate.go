@@ -9,12 +9,17 @@ import (
 var (
 	MaxBranchNameLength = 40
 
+	MaxCommitMetadataCount       = 64
+	MaxCommitMetadataValueLength = 1024
+
 	ReValidRef  = regexp.MustCompile(`^\w+/?\w+$`)
 	ReValidRepo = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_\-]{1,61}[a-zA-Z0-9]$`)
 	ReValidTag  = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.\-]{1,61}[a-zA-Z0-9]$`)
 	ReValidUser = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{1,28}[a-zA-Z0-9]$`)
 	ReValidPath = regexp.MustCompile(`^[^\x00/:*?"<>|]*/?([^/\s\x00:*?"<>|]+/)*[^/\s\x00:*?"<>|]+(?:\.[a-zA-Z0-9]+)?$`)
 
+	ReValidMetaKey = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.\-]{0,127}$`)
+
 	// RepoNameBlackList forbid repo name, reserve for routes
 	RepoNameBlackList = []string{"repository", "repositories", "wip", "wips", "object", "objects", "tags", "tag", "commit", "commits", "ref", "refs", "repo", "repos", "user", "users"}
 )
@@ -28,6 +33,9 @@ var (
 	ErrInvalidTagName    = errors.New("tag name must start with a number or letter, can only contain numbers, letters, dot, or hyphens, and must be between 3 and 63 characters in length")
 	ErrInvalidUsername   = errors.New("invalid username: it must start and end with a letter or digit, can contain letters, digits, hyphens, and cannot start or end with a hyphen; the length must be between 3 and 30 characters")
 	ErrInvalidObjectPath = errors.New("invalid object path: it must not contain null characters or NTFS forbidden characters")
+	ErrTooManyMetadata   = errors.New("too many commit metadata")
+	ErrInvalidMetaKey    = errors.New("invalid metadata key: it must start with a letter, digit or underscore, can only contain letters, digits, underscores, dots or hyphens, and must be at most 128 characters")
+	ErrMetaValueTooLong  = errors.New("metadata value too long")
 )
 
 func ValidateBranchName(name string) error {
@@ -90,3 +98,18 @@ func ValidateObjectPath(path string) error {
 	}
 	return nil
 }
+
+func ValidateCommitMetadata(metadata map[string]string) error {
+	if len(metadata) > MaxCommitMetadataCount {
+		return ErrTooManyMetadata
+	}
+	for key, value := range metadata {
+		if !ReValidMetaKey.MatchString(key) {
+			return ErrInvalidMetaKey
+		}
+		if len(value) > MaxCommitMetadataValueLength {
+			return ErrMetaValueTooLong
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package validator
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -153,3 +155,37 @@ func TestValidateObjectPath(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateCommitMetadata(t *testing.T) {
+	validMetadata := []map[string]string{
+		nil,
+		{"pipeline_run_id": "42", "source_uri": "s3://bucket/data.csv"},
+		{"model.version": "1.0", "git-sha": "", "_private": "x"},
+	}
+	for _, metadata := range validMetadata {
+		if err := ValidateCommitMetadata(metadata); err != nil {
+			t.Errorf("Expected no error for metadata %v, but got: %s", metadata, err)
+		}
+	}
+
+	tooMany := map[string]string{}
+	for i := 0; i <= MaxCommitMetadataCount; i++ {
+		tooMany[fmt.Sprintf("key%d", i)] = "v"
+	}
+	invalidMetadata := []struct {
+		metadata map[string]string
+		err      error
+	}{
+		{tooMany, ErrTooManyMetadata},
+		{map[string]string{"": "v"}, ErrInvalidMetaKey},
+		{map[string]string{"a=b": "v"}, ErrInvalidMetaKey},
+		{map[string]string{".hidden": "v"}, ErrInvalidMetaKey},
+		{map[string]string{strings.Repeat("k", 129): "v"}, ErrInvalidMetaKey},
+		{map[string]string{"key": strings.Repeat("v", MaxCommitMetadataValueLength+1)}, ErrMetaValueTooLong},
+	}
+	for _, testCase := range invalidMetadata {
+		if err := ValidateCommitMetadata(testCase.metadata); err != testCase.err {
+			t.Errorf("Expected error '%s' for metadata, but got: %v", testCase.err, err)
+		}
+	}
+}
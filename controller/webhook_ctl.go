@@ -0,0 +1,305 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	"github.com/GitDataAI/jiaozifs/auth"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/webhook"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/fx"
+)
+
+const defaultDeliveryAmount = 50
+
+type WebhookController struct {
+	fx.In
+	BaseController
+
+	Repo models.IRepo
+}
+
+func (hookCtl WebhookController) ListWebhooks(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string) {
+	repository, ok := hookCtl.loadRepository(ctx, w, hookCtl.Repo, ownerName, repositoryName, rbacmodel.ReadRepositoryAction)
+	if !ok {
+		return
+	}
+
+	hooks, err := hookCtl.Repo.WebhookRepo().List(ctx, repository.ID)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	results := make([]api.Webhook, 0, len(hooks))
+	for _, hook := range hooks {
+		hookDto, err := hookCtl.webhookToDto(ctx, hook)
+		if err != nil {
+			w.Error(err)
+			return
+		}
+		results = append(results, *hookDto)
+	}
+	w.JSON(results)
+}
+
+func (hookCtl WebhookController) CreateWebhook(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.CreateWebhookJSONRequestBody, ownerName string, repositoryName string) {
+	if err := validateWebhook(&body.Url, &body.Events); err != nil {
+		w.BadRequest(err.Error())
+		return
+	}
+	if len(body.Secret) == 0 {
+		w.BadRequest("secret must be set")
+		return
+	}
+
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	repository, ok := hookCtl.loadRepository(ctx, w, hookCtl.Repo, ownerName, repositoryName, rbacmodel.UpdateRepositoryAction)
+	if !ok {
+		return
+	}
+
+	active := true
+	if body.Active != nil {
+		active = *body.Active
+	}
+	hook, err := hookCtl.Repo.WebhookRepo().Insert(ctx, &models.Webhook{
+		RepositoryID: repository.ID,
+		URL:          body.Url,
+		Secret:       body.Secret,
+		Events:       body.Events,
+		Active:       active,
+		CreatorID:    operator.ID,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	})
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	hookDto, err := hookCtl.webhookToDto(ctx, hook)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(hookDto, http.StatusCreated)
+}
+
+func (hookCtl WebhookController) GetWebhook(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, hookID openapi_types.UUID) {
+	repository, ok := hookCtl.loadRepository(ctx, w, hookCtl.Repo, ownerName, repositoryName, rbacmodel.ReadRepositoryAction)
+	if !ok {
+		return
+	}
+
+	hook, err := hookCtl.Repo.WebhookRepo().Get(ctx, models.NewGetWebhookParams().SetID(hookID).SetRepositoryID(repository.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	hookDto, err := hookCtl.webhookToDto(ctx, hook)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(hookDto)
+}
+
+func (hookCtl WebhookController) UpdateWebhook(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.UpdateWebhookJSONRequestBody, ownerName string, repositoryName string, hookID openapi_types.UUID) {
+	if err := validateWebhook(body.Url, body.Events); err != nil {
+		w.BadRequest(err.Error())
+		return
+	}
+	if body.Secret != nil && len(*body.Secret) == 0 {
+		w.BadRequest("secret must not be empty")
+		return
+	}
+
+	repository, ok := hookCtl.loadRepository(ctx, w, hookCtl.Repo, ownerName, repositoryName, rbacmodel.UpdateRepositoryAction)
+	if !ok {
+		return
+	}
+
+	hook, err := hookCtl.Repo.WebhookRepo().Get(ctx, models.NewGetWebhookParams().SetID(hookID).SetRepositoryID(repository.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	updateParams := models.NewUpdateWebhookParams(hook.ID)
+	if body.Url != nil {
+		updateParams.SetURL(*body.Url)
+	}
+	if body.Secret != nil {
+		updateParams.SetSecret(*body.Secret)
+	}
+	if body.Events != nil {
+		updateParams.SetEvents(*body.Events)
+	}
+	if body.Active != nil {
+		updateParams.SetActive(*body.Active)
+	}
+	err = hookCtl.Repo.WebhookRepo().UpdateByID(ctx, updateParams)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	hook, err = hookCtl.Repo.WebhookRepo().Get(ctx, models.NewGetWebhookParams().SetID(hook.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	hookDto, err := hookCtl.webhookToDto(ctx, hook)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.JSON(hookDto)
+}
+
+func (hookCtl WebhookController) DeleteWebhook(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, hookID openapi_types.UUID) {
+	repository, ok := hookCtl.loadRepository(ctx, w, hookCtl.Repo, ownerName, repositoryName, rbacmodel.UpdateRepositoryAction)
+	if !ok {
+		return
+	}
+
+	affectRows, err := hookCtl.Repo.WebhookRepo().Delete(ctx, models.NewDeleteWebhookParams().SetID(hookID).SetRepositoryID(repository.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	if affectRows == 0 {
+		w.NotFound()
+		return
+	}
+	w.OK()
+}
+
+func (hookCtl WebhookController) ListWebhookDeliveries(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName string, repositoryName string, hookID openapi_types.UUID, params api.ListWebhookDeliveriesParams) {
+	repository, ok := hookCtl.loadRepository(ctx, w, hookCtl.Repo, ownerName, repositoryName, rbacmodel.ReadRepositoryAction)
+	if !ok {
+		return
+	}
+
+	hook, err := hookCtl.Repo.WebhookRepo().Get(ctx, models.NewGetWebhookParams().SetID(hookID).SetRepositoryID(repository.ID))
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	amount := defaultDeliveryAmount
+	if params.Amount != nil && *params.Amount > 0 {
+		amount = *params.Amount
+	}
+	deliveries, err := hookCtl.Repo.WebhookRepo().ListDeliveries(ctx, hook.ID, amount)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+
+	results := make([]api.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		results = append(results, *webhookDeliveryToDto(delivery))
+	}
+	w.JSON(results)
+}
+
+func (hookCtl WebhookController) webhookToDto(ctx context.Context, hook *models.Webhook) (*api.Webhook, error) {
+	creator, err := hookCtl.Repo.UserRepo().Get(ctx, models.NewGetUserParams().SetID(hook.CreatorID))
+	if err != nil {
+		return nil, err
+	}
+
+	events := hook.Events
+	if events == nil {
+		events = []string{}
+	}
+	return &api.Webhook{
+		Id:        hook.ID,
+		Url:       hook.URL,
+		Events:    events,
+		Active:    hook.Active,
+		Creator:   creator.Name,
+		CreatedAt: hook.CreatedAt.UnixMilli(),
+		UpdatedAt: hook.UpdatedAt.UnixMilli(),
+	}, nil
+}
+
+// validateWebhook check url and events of webhook if set
+func validateWebhook(hookURL *string, events *[]string) error {
+	if hookURL != nil {
+		u, err := url.Parse(*hookURL)
+		if err != nil {
+			return fmt.Errorf("invalid url %s %w", *hookURL, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return fmt.Errorf("url %s must be http or https", *hookURL)
+		}
+	}
+
+	if events != nil {
+		if len(*events) == 0 {
+			return fmt.Errorf("events must be set")
+		}
+		for _, event := range *events {
+			if !webhook.ValidEvent(event) {
+				return fmt.Errorf("unsupported event %s, must be one of %v", event, webhook.Events)
+			}
+		}
+	}
+	return nil
+}
+
+func webhookDeliveryToDto(delivery *models.WebhookDelivery) *api.WebhookDelivery {
+	state := api.DeliveryStatePending
+	switch delivery.State {
+	case models.DeliveryStateSuccess:
+		state = api.DeliveryStateSuccess
+	case models.DeliveryStateFailed:
+		state = api.DeliveryStateFailed
+	}
+	return &api.WebhookDelivery{
+		Id:            delivery.ID,
+		Event:         delivery.Event,
+		State:         state,
+		Attempts:      delivery.Attempts,
+		ResponseCode:  delivery.ResponseCode,
+		Error:         delivery.Error,
+		Payload:       string(delivery.Payload),
+		NextAttemptAt: delivery.NextAttemptAt.UnixMilli(),
+		CreatedAt:     delivery.CreatedAt.UnixMilli(),
+		UpdatedAt:     delivery.UpdatedAt.UnixMilli(),
+	}
+}
+
+func commitEventData(branchName string, commit *models.Commit) webhook.CommitData {
+	return webhook.CommitData{
+		Branch:  branchName,
+		Commit:  commit.Hash.Hex(),
+		Message: commit.Message,
+		Author:  commit.Author.Name,
+	}
+}
+
+// emitEvent queue event to webhooks of repository, repo must be the transaction of the operation
+func emitEvent(ctx context.Context, repo models.IRepo, repository *models.Repository, event string, data any) error {
+	sender := ""
+	if operator, err := auth.GetOperator(ctx); err == nil {
+		sender = operator.Name
+	}
+	return webhook.Emit(ctx, repo, repository, sender, event, data)
+}
@@ -10,11 +10,13 @@ import (
 	"github.com/GitDataAI/jiaozifs/auth"
 	"github.com/GitDataAI/jiaozifs/auth/rbac"
 	"github.com/GitDataAI/jiaozifs/block/params"
+	"github.com/GitDataAI/jiaozifs/controller/validator"
 	"github.com/GitDataAI/jiaozifs/models"
 	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
 	"github.com/GitDataAI/jiaozifs/utils"
 	"github.com/GitDataAI/jiaozifs/utils/hash"
 	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/GitDataAI/jiaozifs/webhook"
 	"go.uber.org/fx"
 )
 
@@ -74,7 +76,7 @@ func (wipCtl WipController) GetWip(ctx context.Context, w *api.JiaozifsResponse,
 
 	err = workRepo.CheckOut(ctx, versionmgr.InBranch, params.RefName)
 	if err != nil {
-		w.Error(err)
+		revisionError(w, err)
 		return
 	}
 
@@ -133,7 +135,13 @@ func (wipCtl WipController) ListWip(ctx context.Context, w *api.JiaozifsResponse
 }
 
 // CommitWip commit wip to branch, operator only could operator himself wip
-func (wipCtl WipController) CommitWip(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, ownerName, repositoryName string, params api.CommitWipParams) {
+func (wipCtl WipController) CommitWip(ctx context.Context, w *api.JiaozifsResponse, _ *http.Request, body api.CommitWipJSONRequestBody, ownerName, repositoryName string, params api.CommitWipParams) {
+	metadata := utils.Map(body.Metadata)
+	if err := validator.ValidateCommitMetadata(metadata); err != nil {
+		w.BadRequest(err.Error())
+		return
+	}
+
 	operator, err := auth.GetOperator(ctx)
 	if err != nil {
 		w.Error(err)
@@ -178,19 +186,29 @@ func (wipCtl WipController) CommitWip(ctx context.Context, w *api.JiaozifsRespon
 		return
 	}
 
-	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, wipCtl.Repo, wipCtl.PublicStorageConfig)
-	if err != nil {
-		w.Error(err)
-		return
-	}
+	var workRepo *versionmgr.WorkRepository
+	err = wipCtl.Repo.Transaction(ctx, func(repo models.IRepo) error {
+		err := checkBranchProtection(ctx, repo, repository.ID, params.RefName, branchOperationCommit)
+		if err != nil {
+			return err
+		}
 
-	err = workRepo.CheckOut(ctx, versionmgr.InWip, params.RefName)
-	if err != nil {
-		w.Error(err)
-		return
-	}
+		workRepo, err = versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, repo, wipCtl.PublicStorageConfig)
+		if err != nil {
+			return err
+		}
 
-	_, err = workRepo.CommitChanges(ctx, params.Msg)
+		err = workRepo.CheckOut(ctx, versionmgr.InWip, params.RefName)
+		if err != nil {
+			return err
+		}
+
+		commit, err := workRepo.CommitChanges(ctx, params.Msg, versionmgr.WithMetadata(metadata))
+		if err != nil {
+			return err
+		}
+		return emitEvent(ctx, repo, repository, webhook.EventCommit, commitEventData(params.RefName, commit))
+	})
 	if err != nil {
 		w.Error(err)
 		return
@@ -318,7 +336,7 @@ func (wipCtl WipController) DeleteWip(ctx context.Context, w *api.JiaozifsRespon
 
 	err = workRepo.CheckOut(ctx, versionmgr.InBranch, params.RefName)
 	if err != nil {
-		w.Error(err)
+		revisionError(w, err)
 		return
 	}
 
@@ -0,0 +1,300 @@
+package dav
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/httputil"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"golang.org/x/net/webdav"
+)
+
+var (
+	_ webdav.File         = (*dirFile)(nil)
+	_ webdav.File         = (*readFile)(nil)
+	_ webdav.File         = (*writeFile)(nil)
+	_ webdav.ETager       = (*fileInfo)(nil)
+	_ webdav.ContentTyper = (*fileInfo)(nil)
+)
+
+// fileInfo file info of tree entry, etag is md5 of content same as object api
+type fileInfo struct {
+	name     string
+	size     int64
+	isDir    bool
+	modTime  time.Time
+	checkSum string
+}
+
+func newFileInfo(entry versionmgr.FullTreeEntry) *fileInfo {
+	return &fileInfo{
+		name:     entry.Name,
+		size:     entry.Size,
+		isDir:    entry.IsDir,
+		modTime:  entry.UpdatedAt,
+		checkSum: entry.CheckSum.Hex(),
+	}
+}
+
+func (info *fileInfo) Name() string {
+	return info.name
+}
+
+func (info *fileInfo) Size() int64 {
+	return info.size
+}
+
+func (info *fileInfo) Mode() fs.FileMode {
+	if info.isDir {
+		return fs.ModeDir | 0755
+	}
+	return 0644
+}
+
+func (info *fileInfo) ModTime() time.Time {
+	return info.modTime
+}
+
+func (info *fileInfo) IsDir() bool {
+	return info.isDir
+}
+
+func (info *fileInfo) Sys() any {
+	return nil
+}
+
+func (info *fileInfo) ETag(_ context.Context) (string, error) {
+	if info.isDir || len(info.checkSum) == 0 {
+		return "", webdav.ErrNotImplemented
+	}
+	return httputil.ETag(info.checkSum), nil
+}
+
+func (info *fileInfo) ContentType(_ context.Context) (string, error) {
+	if info.isDir {
+		return "", webdav.ErrNotImplemented
+	}
+	return httputil.ExtensionsByType(info.name), nil
+}
+
+// dirFile directory opened for read
+type dirFile struct {
+	ctx  context.Context
+	fs   *workTreeFS
+	name string
+	info *fileInfo
+
+	entries []os.FileInfo
+	listed  bool
+}
+
+func (f *dirFile) Close() error {
+	return nil
+}
+
+func (f *dirFile) Read(_ []byte) (int, error) {
+	return 0, fmt.Errorf("%s is a directory %w", f.name, os.ErrInvalid)
+}
+
+func (f *dirFile) Seek(_ int64, _ int) (int64, error) {
+	return 0, nil
+}
+
+func (f *dirFile) Readdir(count int) ([]fs.FileInfo, error) {
+	if !f.listed {
+		entries, err := f.fs.readDir(f.ctx, f.name)
+		if err != nil {
+			return nil, err
+		}
+		f.entries = entries
+		f.listed = true
+	}
+
+	if count <= 0 {
+		entries := f.entries
+		f.entries = nil
+		return entries, nil
+	}
+	if len(f.entries) == 0 {
+		return nil, io.EOF
+	}
+	count = min(count, len(f.entries))
+	entries := f.entries[:count]
+	f.entries = f.entries[count:]
+	return entries, nil
+}
+
+func (f *dirFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *dirFile) Write(_ []byte) (int, error) {
+	return 0, os.ErrPermission
+}
+
+// readFile blob opened for read, content is read by range from offset so that seek is cheap
+type readFile struct {
+	ctx      context.Context
+	workRepo *versionmgr.WorkRepository
+	blob     *models.Blob
+	info     *fileInfo
+
+	offset int64
+	reader io.ReadCloser
+}
+
+func (f *readFile) Read(p []byte) (int, error) {
+	if f.offset >= f.blob.Size {
+		return 0, io.EOF
+	}
+	if f.reader == nil {
+		rangeSpec := fmt.Sprintf("bytes=%d-", f.offset)
+		reader, err := f.workRepo.ReadBlob(f.ctx, f.blob, &rangeSpec)
+		if err != nil {
+			return 0, err
+		}
+		f.reader = reader
+	}
+	n, err := f.reader.Read(p)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *readFile) Seek(offset int64, whence int) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = f.offset + offset
+	case io.SeekEnd:
+		newOffset = f.blob.Size + offset
+	default:
+		return 0, os.ErrInvalid
+	}
+	if newOffset < 0 {
+		return 0, os.ErrInvalid
+	}
+	if newOffset != f.offset && f.reader != nil {
+		_ = f.reader.Close()
+		f.reader = nil
+	}
+	f.offset = newOffset
+	return newOffset, nil
+}
+
+func (f *readFile) Close() error {
+	if f.reader != nil {
+		return f.reader.Close()
+	}
+	return nil
+}
+
+func (f *readFile) Readdir(_ int) ([]fs.FileInfo, error) {
+	return nil, fmt.Errorf("%s is not a directory %w", f.info.name, os.ErrInvalid)
+}
+
+func (f *readFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *readFile) Write(_ []byte) (int, error) {
+	return 0, os.ErrPermission
+}
+
+type writeResult struct {
+	blob *models.Blob
+	err  error
+}
+
+// writeFile content written is streamed to storage, blob is added to wip when file closed
+type writeFile struct {
+	ctx  context.Context
+	fs   *workTreeFS
+	name string
+
+	pipeWriter *io.PipeWriter
+	result     chan writeResult
+	md5        hash.Hash
+	size       int64
+}
+
+func newWriteFile(ctx context.Context, fs *workTreeFS, name string) *writeFile {
+	pipeReader, pipeWriter := io.Pipe()
+	f := &writeFile{
+		ctx:        ctx,
+		fs:         fs,
+		name:       name,
+		pipeWriter: pipeWriter,
+		result:     make(chan writeResult, 1),
+		md5:        md5.New(),
+	}
+	go func() {
+		blob, err := fs.workRepo.WriteBlob(ctx, pipeReader, -1, models.DefaultLeafProperty())
+		_ = pipeReader.CloseWithError(err)
+		f.result <- writeResult{blob: blob, err: err}
+	}()
+	return f
+}
+
+func (f *writeFile) Write(p []byte) (int, error) {
+	n, err := f.pipeWriter.Write(p)
+	f.md5.Write(p[:n])
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *writeFile) Close() error {
+	if f.fs.body != nil {
+		if err := f.fs.body.Incomplete(); err != nil {
+			_ = f.pipeWriter.CloseWithError(err)
+			<-f.result
+			return err
+		}
+	}
+
+	_ = f.pipeWriter.Close()
+	result := <-f.result
+	if result.err != nil {
+		return result.err
+	}
+
+	return f.fs.workRepo.ChangeInWip(f.ctx, func(root *versionmgr.WorkTree) error {
+		return putBlob(f.ctx, root, f.name, result.blob)
+	})
+}
+
+func (f *writeFile) Read(_ []byte) (int, error) {
+	return 0, errors.New("file opened for write only")
+}
+
+func (f *writeFile) Seek(offset int64, whence int) (int64, error) {
+	// webdav seek to end to find out size of written content
+	if offset == 0 && (whence == io.SeekCurrent || whence == io.SeekEnd) {
+		return f.size, nil
+	}
+	return 0, os.ErrInvalid
+}
+
+func (f *writeFile) Readdir(_ int) ([]fs.FileInfo, error) {
+	return nil, fmt.Errorf("%s is not a directory %w", f.name, os.ErrInvalid)
+}
+
+func (f *writeFile) Stat() (fs.FileInfo, error) {
+	return &fileInfo{
+		name:     path.Base(f.name),
+		size:     f.size,
+		modTime:  time.Now(),
+		checkSum: hex.EncodeToString(f.md5.Sum(nil)),
+	}, nil
+}
@@ -0,0 +1,343 @@
+package dav
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"golang.org/x/net/webdav"
+)
+
+var _ webdav.FileSystem = (*workTreeFS)(nil)
+
+// workTreeFS webdav file system on tree of work repository, names are relative to ref
+type workTreeFS struct {
+	workRepo *versionmgr.WorkRepository
+	readOnly bool
+	dirs     *emptyDirs
+	// body request body of put, content is not added to wip if body is incomplete
+	body *bodyReader
+}
+
+// cleanName convert webdav name to path in tree, root is empty string
+func cleanName(name string) string {
+	return strings.Trim(path.Clean("/"+name), "/")
+}
+
+func (fs *workTreeFS) Mkdir(ctx context.Context, name string, _ os.FileMode) error {
+	if fs.readOnly {
+		return os.ErrPermission
+	}
+	name = cleanName(name)
+	if len(name) == 0 {
+		return os.ErrExist
+	}
+
+	_, err := fs.stat(ctx, name)
+	if err == nil {
+		return os.ErrExist
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	parent := path.Dir(name)
+	if parent != "." {
+		parentInfo, err := fs.stat(ctx, parent)
+		if err != nil {
+			return err
+		}
+		if !parentInfo.IsDir() {
+			return os.ErrNotExist
+		}
+	}
+	fs.dirs.add(name)
+	return nil
+}
+
+func (fs *workTreeFS) OpenFile(ctx context.Context, name string, flag int, _ os.FileMode) (webdav.File, error) {
+	name = cleanName(name)
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0 {
+		if fs.readOnly {
+			return nil, os.ErrPermission
+		}
+		if len(name) == 0 {
+			return nil, os.ErrInvalid
+		}
+		info, err := fs.stat(ctx, name)
+		if err == nil && info.IsDir() {
+			return nil, os.ErrInvalid
+		}
+		if flag&os.O_CREATE == 0 && err != nil {
+			return nil, err
+		}
+		//content is always replaced, tree blob can not be changed in place
+		return newWriteFile(ctx, fs, name), nil
+	}
+
+	info, err := fs.stat(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return &dirFile{ctx: ctx, fs: fs, name: name, info: info}, nil
+	}
+
+	workTree, err := fs.workRepo.RootTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blob, _, err := workTree.FindBlob(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &readFile{ctx: ctx, workRepo: fs.workRepo, blob: blob, info: info}, nil
+}
+
+func (fs *workTreeFS) RemoveAll(ctx context.Context, name string) error {
+	if fs.readOnly {
+		return os.ErrPermission
+	}
+	name = cleanName(name)
+	if len(name) == 0 {
+		return os.ErrPermission
+	}
+
+	info, err := fs.statTree(ctx, name)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	removedDirs := fs.dirs.removeAll(name)
+	if info == nil {
+		if removedDirs {
+			return nil
+		}
+		return os.ErrNotExist
+	}
+
+	return fs.workRepo.ChangeInWip(ctx, func(root *versionmgr.WorkTree) error {
+		return root.RemoveEntry(ctx, name)
+	})
+}
+
+func (fs *workTreeFS) Rename(ctx context.Context, oldName, newName string) error {
+	if fs.readOnly {
+		return os.ErrPermission
+	}
+	oldName, newName = cleanName(oldName), cleanName(newName)
+	if len(oldName) == 0 || len(newName) == 0 || strings.HasPrefix(newName+"/", oldName+"/") {
+		return os.ErrInvalid
+	}
+
+	info, err := fs.statTree(ctx, oldName)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	movedDirs := fs.dirs.rename(oldName, newName)
+	if info == nil {
+		if movedDirs {
+			return nil
+		}
+		return os.ErrNotExist
+	}
+
+	return fs.workRepo.ChangeInWip(ctx, func(root *versionmgr.WorkTree) error {
+		files := []string{""}
+		if info.IsDir() {
+			files, err = listFiles(ctx, root, oldName)
+			if err != nil {
+				return err
+			}
+		}
+
+		blobs := make([]*models.Blob, len(files))
+		for index, file := range files {
+			blobs[index], _, err = root.FindBlob(ctx, path.Join(oldName, file))
+			if err != nil {
+				return err
+			}
+		}
+
+		err = root.RemoveEntry(ctx, oldName)
+		if err != nil {
+			return err
+		}
+		for index, file := range files {
+			err = putBlob(ctx, root, path.Join(newName, file), blobs[index])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (fs *workTreeFS) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	return fs.stat(ctx, cleanName(name))
+}
+
+// stat find entry in tree, then directories created by mkdir
+func (fs *workTreeFS) stat(ctx context.Context, name string) (*fileInfo, error) {
+	info, err := fs.statTree(ctx, name)
+	if errors.Is(err, os.ErrNotExist) && fs.dirs.contains(name) {
+		return &fileInfo{name: path.Base(name), isDir: true}, nil
+	}
+	return info, err
+}
+
+func (fs *workTreeFS) statTree(ctx context.Context, name string) (*fileInfo, error) {
+	if len(name) == 0 {
+		return &fileInfo{name: "/", isDir: true}, nil
+	}
+
+	workTree, err := fs.workRepo.RootTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dir, base := path.Split(name)
+	entries, err := workTree.Ls(ctx, dir)
+	if err != nil {
+		if errors.Is(err, versionmgr.ErrPathNotFound) || errors.Is(err, versionmgr.ErrNotDirectory) {
+			return nil, os.ErrNotExist
+		}
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.Name == base {
+			return newFileInfo(entry), nil
+		}
+	}
+	return nil, os.ErrNotExist
+}
+
+// readDir list entries in tree and directories created by mkdir
+func (fs *workTreeFS) readDir(ctx context.Context, name string) ([]os.FileInfo, error) {
+	workTree, err := fs.workRepo.RootTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []os.FileInfo
+	seen := make(map[string]struct{})
+	entries, err := workTree.Ls(ctx, name)
+	if err != nil && !errors.Is(err, versionmgr.ErrPathNotFound) {
+		return nil, err
+	}
+	for _, entry := range entries {
+		seen[entry.Name] = struct{}{}
+		infos = append(infos, newFileInfo(entry))
+	}
+	for _, dirName := range fs.dirs.children(name) {
+		if _, ok := seen[dirName]; !ok {
+			infos = append(infos, &fileInfo{name: dirName, isDir: true})
+		}
+	}
+	return infos, nil
+}
+
+// listFiles list path of all files in directory, path is relative to the directory
+func listFiles(ctx context.Context, workTree *versionmgr.WorkTree, dir string) ([]string, error) {
+	entries, err := workTree.Ls(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir {
+			files = append(files, entry.Name)
+			continue
+		}
+		subFiles, err := listFiles(ctx, workTree, path.Join(dir, entry.Name))
+		if err != nil {
+			return nil, err
+		}
+		for _, subFile := range subFiles {
+			files = append(files, path.Join(entry.Name, subFile))
+		}
+	}
+	return files, nil
+}
+
+// putBlob add blob to tree, existing file is replaced
+func putBlob(ctx context.Context, root *versionmgr.WorkTree, name string, blob *models.Blob) error {
+	oldBlob, _, err := root.FindBlob(ctx, name)
+	if err != nil {
+		if errors.Is(err, versionmgr.ErrPathNotFound) {
+			return root.AddLeaf(ctx, name, blob)
+		}
+		return err
+	}
+	if bytes.Equal(oldBlob.CheckSum, blob.CheckSum) {
+		return nil
+	}
+	return root.ReplaceLeaf(ctx, name, blob)
+}
+
+// emptyDirs directories created by mkdir of one wip
+type emptyDirs struct {
+	lk   *sync.Mutex
+	dirs map[string]struct{}
+}
+
+func (d *emptyDirs) add(name string) {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	d.dirs[name] = struct{}{}
+}
+
+func (d *emptyDirs) contains(name string) bool {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	_, ok := d.dirs[name]
+	return ok
+}
+
+// children return names of directories directly in parent
+func (d *emptyDirs) children(parent string) []string {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	var names []string
+	for name := range d.dirs {
+		dir, base := path.Split(name)
+		if strings.TrimSuffix(dir, "/") == parent {
+			names = append(names, base)
+		}
+	}
+	return names
+}
+
+// removeAll remove directory and its sub directories, return whether any was removed
+func (d *emptyDirs) removeAll(name string) bool {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	removed := false
+	for dir := range d.dirs {
+		if dir == name || strings.HasPrefix(dir, name+"/") {
+			delete(d.dirs, dir)
+			removed = true
+		}
+	}
+	return removed
+}
+
+// rename move directory and its sub directories, return whether any was moved
+func (d *emptyDirs) rename(oldName, newName string) bool {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	moved := false
+	for dir := range d.dirs {
+		if dir == oldName || strings.HasPrefix(dir, oldName+"/") {
+			delete(d.dirs, dir)
+			d.dirs[newName+strings.TrimPrefix(dir, oldName)] = struct{}{}
+			moved = true
+		}
+	}
+	return moved
+}
@@ -0,0 +1,45 @@
+package dav
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanName(t *testing.T) {
+	require.Equal(t, "", cleanName("/"))
+	require.Equal(t, "", cleanName(""))
+	require.Equal(t, "a/b.txt", cleanName("/a/b.txt"))
+	require.Equal(t, "a/b", cleanName("a/b/"))
+	require.Equal(t, "b", cleanName("/../a/../b"))
+}
+
+func TestEmptyDirs(t *testing.T) {
+	dirs := &emptyDirs{lk: &sync.Mutex{}, dirs: map[string]struct{}{}}
+	dirs.add("a")
+	dirs.add("a/b")
+	dirs.add("a/c")
+	dirs.add("d")
+
+	require.True(t, dirs.contains("a/b"))
+	require.False(t, dirs.contains("a/b/c"))
+
+	children := dirs.children("a")
+	sort.Strings(children)
+	require.Equal(t, []string{"b", "c"}, children)
+	rootChildren := dirs.children("")
+	sort.Strings(rootChildren)
+	require.Equal(t, []string{"a", "d"}, rootChildren)
+
+	require.True(t, dirs.rename("a", "e"))
+	require.False(t, dirs.contains("a"))
+	require.True(t, dirs.contains("e/b"))
+	require.True(t, dirs.contains("e/c"))
+
+	require.True(t, dirs.removeAll("e"))
+	require.False(t, dirs.contains("e/c"))
+	require.False(t, dirs.removeAll("e"))
+	require.True(t, dirs.contains("d"))
+}
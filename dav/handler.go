@@ -0,0 +1,263 @@
+package dav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/auth"
+	"github.com/GitDataAI/jiaozifs/auth/rbac"
+	"github.com/GitDataAI/jiaozifs/block/params"
+	"github.com/GitDataAI/jiaozifs/config"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	lru "github.com/hnlq715/golang-lru"
+	logging "github.com/ipfs/go-log/v2"
+	"golang.org/x/net/webdav"
+)
+
+var log = logging.Logger("dav")
+
+// Prefix path prefix of webdav handler in api server
+const Prefix = "/dav/"
+
+const (
+	// maxCachedRefs max number of refs whose locks and empty directories are kept, least recently used one is dropped
+	maxCachedRefs = 4096
+	// refIdleTimeout locks and empty directories of ref not accessed for this duration are dropped
+	refIdleTimeout = time.Hour
+)
+
+// Methods webdav methods beyond standard http methods, router must know them
+var Methods = []string{"PROPFIND", "PROPPATCH", "MKCOL", "COPY", "MOVE", "LOCK", "UNLOCK"}
+
+// Handler serve webdav of repository tree at /dav/{owner}/{repository}/{ref}/.
+// branch is served from wip of operator and changes are applied to wip, tag and commit are read only
+type Handler struct {
+	repo                models.IRepo
+	permissionCheck     rbac.PermissionCheck
+	publicAdapterConfig params.AdapterConfig
+	uploadConfig        *config.UploadConfig
+
+	lk sync.Mutex
+	// lockSystems webdav lock of each ref, lock names are relative to ref
+	lockSystems *lru.Cache
+	// emptyDirs directories created by MKCOL, tree can not hold empty directory so they are kept here until a file is
+	// written in. both caches drop idle refs, so locks and empty directories of them are lost
+	emptyDirs *lru.Cache
+}
+
+func NewHandler(repo models.IRepo, permissionCheck rbac.PermissionCheck, publicAdapterConfig params.AdapterConfig, uploadConfig *config.UploadConfig) (*Handler, error) {
+	lockSystems, err := lru.NewWithExpire(maxCachedRefs, refIdleTimeout)
+	if err != nil {
+		return nil, err
+	}
+	emptyDirs, err := lru.NewWithExpire(maxCachedRefs, refIdleTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{
+		repo:                repo,
+		permissionCheck:     permissionCheck,
+		publicAdapterConfig: publicAdapterConfig,
+		uploadConfig:        uploadConfig,
+		lockSystems:         lockSystems,
+		emptyDirs:           emptyDirs,
+	}, nil
+}
+
+// requiredActions return actions needed by webdav method, nil means the method change nothing in tree
+func requiredActions(method string) ([]string, bool) {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, "PROPFIND", "PROPPATCH", "UNLOCK":
+		return []string{rbacmodel.ReadObjectAction}, false
+	case http.MethodPut, "MKCOL", "COPY", "LOCK":
+		return []string{rbacmodel.WriteObjectAction}, true
+	case "MOVE":
+		return []string{rbacmodel.WriteObjectAction, rbacmodel.DeleteObjectAction}, true
+	case http.MethodDelete:
+		return []string{rbacmodel.DeleteObjectAction}, true
+	}
+	return nil, false
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	operator, err := auth.GetOperator(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	actions, isWrite := requiredActions(r.Method)
+	if actions == nil {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	segments := strings.SplitN(strings.TrimPrefix(r.URL.Path, Prefix), "/", 3)
+	if len(segments) < 3 || len(segments[2]) == 0 {
+		http.Error(w, "path must be /dav/{owner}/{repository}/{ref}/", http.StatusNotFound)
+		return
+	}
+	ownerName, repositoryName := segments[0], segments[1]
+
+	owner, err := h.repo.UserRepo().Get(ctx, models.NewGetUserParams().SetName(ownerName))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	repository, err := h.repo.RepositoryRepo().Get(ctx, models.NewGetRepoParams().SetOwnerID(owner.ID).SetName(repositoryName))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	for _, action := range actions {
+		if !h.authorize(ctx, w, operator, repository, action) {
+			return
+		}
+	}
+
+	refPath, err := versionmgr.ResolveRefPath(ctx, h.repo, repository.ID, segments[2])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if isWrite && refPath.RefType != versionmgr.InBranch {
+		http.Error(w, fmt.Sprintf("%s is read only, only branch can be changed", refPath.Name), http.StatusForbidden)
+		return
+	}
+
+	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, operator, repository, h.repo, h.publicAdapterConfig)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if isWrite {
+		err = workRepo.CheckOutOrCreateWip(ctx, refPath.Name)
+	} else {
+		err = workRepo.CheckOutWipOrRef(ctx, refPath.RefType, refPath.Name)
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	workRepo.SetStreamThreshold(h.uploadConfig.StreamThreshold)
+
+	prefix := Prefix + ownerName + "/" + repositoryName + "/" + refPath.Name
+	fs := &workTreeFS{
+		workRepo: workRepo,
+		readOnly: refPath.RefType != versionmgr.InBranch,
+		dirs:     h.emptyDirsOf(fmt.Sprintf("%s/%s/%s", repository.ID, operator.ID, refPath.Name)),
+	}
+	if r.Method == http.MethodPut {
+		fs.body = &bodyReader{ReadCloser: r.Body, contentLength: r.ContentLength}
+		r.Body = fs.body
+	}
+
+	davHandler := &webdav.Handler{
+		Prefix:     prefix,
+		FileSystem: fs,
+		LockSystem: h.lockSystemOf(repository.ID.String() + "/" + refPath.Name),
+		Logger: func(r *http.Request, err error) {
+			if err != nil {
+				log.Debugf("%s %s failed %v", r.Method, r.URL.Path, err)
+			}
+		},
+	}
+	davHandler.ServeHTTP(w, r)
+}
+
+func (h *Handler) authorize(ctx context.Context, w http.ResponseWriter, operator *models.User, repository *models.Repository, action string) bool {
+	resp, err := h.permissionCheck.AuthorizeMember(ctx, repository.ID, &rbac.AuthorizationRequest{
+		OperatorID: operator.ID,
+		RequiredPermissions: rbac.Node{
+			Permission: rbac.Permission{
+				Action:   action,
+				Resource: rbacmodel.RepoURArn(repository.OwnerID.String(), repository.ID.String()),
+			},
+		},
+	})
+	if err != nil {
+		writeError(w, err)
+		return false
+	}
+	if resp.Error != nil {
+		http.Error(w, resp.Error.Error(), http.StatusUnauthorized)
+		return false
+	}
+	if !resp.Allowed {
+		http.Error(w, "insufficient permissions", http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+func (h *Handler) lockSystemOf(key string) webdav.LockSystem {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+	ls, ok := h.lockSystems.Get(key)
+	if !ok {
+		ls = webdav.NewMemLS()
+	}
+	//add again to refresh idle timeout
+	h.lockSystems.Add(key, ls)
+	return ls.(webdav.LockSystem)
+}
+
+func (h *Handler) emptyDirsOf(key string) *emptyDirs {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+	dirs, ok := h.emptyDirs.Get(key)
+	if !ok {
+		dirs = make(map[string]struct{})
+	}
+	h.emptyDirs.Add(key, dirs)
+	return &emptyDirs{lk: &h.lk, dirs: dirs.(map[string]struct{})}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, models.ErrNotFound), errors.Is(err, versionmgr.ErrRefNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		log.Errorf("serve webdav failed %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// bodyReader record whether request body was read completely, so that incomplete upload is not added to wip
+type bodyReader struct {
+	io.ReadCloser
+	contentLength int64
+	n             int64
+	err           error
+}
+
+func (r *bodyReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+	if err != nil && !errors.Is(err, io.EOF) {
+		r.err = err
+	}
+	return n, err
+}
+
+// Incomplete return error if body was not read completely
+func (r *bodyReader) Incomplete() error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.contentLength >= 0 && r.n != r.contentLength {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
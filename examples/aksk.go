@@ -90,7 +90,7 @@ func main() {
 		resp, err = cli.CommitWip(ctx, userInfo.JSON200.Name, repo.JSON201.Name, &api.CommitWipParams{
 			RefName: branchName,
 			Msg:     "test",
-		})
+		}, api.CommitWipJSONRequestBody{})
 		if err != nil {
 			return err
 		}
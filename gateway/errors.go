@@ -0,0 +1,47 @@
+package gateway
+
+import (
+	"encoding/xml"
+	"net/http"
+)
+
+// APIError s3 error code and http status
+type APIError struct {
+	Code       string
+	Message    string
+	StatusCode int
+}
+
+func (err APIError) Error() string {
+	return err.Code + ": " + err.Message
+}
+
+var (
+	ErrAccessDenied        = APIError{Code: "AccessDenied", Message: "Access Denied", StatusCode: http.StatusForbidden}
+	ErrInvalidAccessKeyID  = APIError{Code: "InvalidAccessKeyId", Message: "The access key ID you provided does not exist in our records.", StatusCode: http.StatusForbidden}
+	ErrSignatureDoesNotFit = APIError{Code: "SignatureDoesNotMatch", Message: "The request signature we calculated does not match the signature you provided.", StatusCode: http.StatusForbidden}
+	ErrExpiredRequest      = APIError{Code: "RequestTimeTooSkewed", Message: "The difference between the request time and the server's time is too large.", StatusCode: http.StatusForbidden}
+	ErrAuthorizationHeader = APIError{Code: "AuthorizationHeaderMalformed", Message: "The authorization header is malformed.", StatusCode: http.StatusBadRequest}
+	ErrBadDigest           = APIError{Code: "XAmzContentSHA256Mismatch", Message: "The provided 'x-amz-content-sha256' header does not match what was computed.", StatusCode: http.StatusBadRequest}
+	ErrNoSuchBucket        = APIError{Code: "NoSuchBucket", Message: "The specified bucket does not exist.", StatusCode: http.StatusNotFound}
+	ErrNoSuchKey           = APIError{Code: "NoSuchKey", Message: "The specified key does not exist.", StatusCode: http.StatusNotFound}
+	ErrNoSuchUpload        = APIError{Code: "NoSuchUpload", Message: "The specified multipart upload does not exist.", StatusCode: http.StatusNotFound}
+	ErrInvalidPart         = APIError{Code: "InvalidPart", Message: "One or more of the specified parts could not be found.", StatusCode: http.StatusBadRequest}
+	ErrInvalidArgument     = APIError{Code: "InvalidArgument", Message: "Invalid Argument", StatusCode: http.StatusBadRequest}
+	ErrInvalidRange        = APIError{Code: "InvalidRange", Message: "The requested range is not satisfiable", StatusCode: http.StatusRequestedRangeNotSatisfiable}
+	ErrMalformedXML        = APIError{Code: "MalformedXML", Message: "The XML you provided was not well-formed.", StatusCode: http.StatusBadRequest}
+	ErrIncompleteBody      = APIError{Code: "IncompleteBody", Message: "You did not provide the number of bytes specified by the Content-Length HTTP header.", StatusCode: http.StatusBadRequest}
+	ErrReadOnlyRef         = APIError{Code: "AccessDenied", Message: "Only branch can be written.", StatusCode: http.StatusForbidden}
+	ErrMethodNotAllowed    = APIError{Code: "MethodNotAllowed", Message: "The specified method is not allowed against this resource.", StatusCode: http.StatusMethodNotAllowed}
+	ErrNotImplemented      = APIError{Code: "NotImplemented", Message: "A header you provided implies functionality that is not implemented.", StatusCode: http.StatusNotImplemented}
+	ErrInternalError       = APIError{Code: "InternalError", Message: "We encountered an internal error. Please try again.", StatusCode: http.StatusInternalServerError}
+	ErrInvalidObjectName   = APIError{Code: "InvalidObjectName", Message: "The specified object name is not valid.", StatusCode: http.StatusBadRequest}
+)
+
+type errorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	Resource  string   `xml:"Resource"`
+	RequestID string   `xml:"RequestId"`
+}
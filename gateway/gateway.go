@@ -0,0 +1,358 @@
+package gateway
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/auth/rbac"
+	"github.com/GitDataAI/jiaozifs/block/params"
+	"github.com/GitDataAI/jiaozifs/config"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/google/uuid"
+	logging "github.com/ipfs/go-log/v2"
+	"go.uber.org/fx"
+)
+
+var log = logging.Logger("gateway")
+
+const (
+	// DefaultReadHeaderTimeout used if read header timeout not config
+	DefaultReadHeaderTimeout = 10 * time.Second
+	// DefaultReadTimeout used if read timeout not config
+	DefaultReadTimeout = time.Hour
+	// DefaultIdleTimeout used if idle timeout not config
+	DefaultIdleTimeout = 2 * time.Minute
+)
+
+// Gateway serve s3 protocol in path style, bucket is repository and the first segment of key is branch/tag/commit.
+// bucket named <repository> refer to repository of caller, <owner>.<repository> refer to repository of other user.
+// reads of branch see the wip of caller if exist, writes go to wip of caller and are not committed
+type Gateway struct {
+	repo                models.IRepo
+	permissionCheck     rbac.PermissionCheck
+	publicAdapterConfig params.AdapterConfig
+	uploadConfig        *config.UploadConfig
+}
+
+func NewGateway(repo models.IRepo, permissionCheck rbac.PermissionCheck, publicAdapterConfig params.AdapterConfig, uploadConfig *config.UploadConfig) *Gateway {
+	return &Gateway{
+		repo:                repo,
+		permissionCheck:     permissionCheck,
+		publicAdapterConfig: publicAdapterConfig,
+		uploadConfig:        uploadConfig,
+	}
+}
+
+// SetupGateway start s3 gateway if listen address configured
+func SetupGateway(lc fx.Lifecycle, gatewayConfig *config.GatewayConfig, repo models.IRepo, permissionCheck rbac.PermissionCheck, publicAdapterConfig params.AdapterConfig, uploadConfig *config.UploadConfig) error {
+	if len(gatewayConfig.Listen) == 0 {
+		return nil
+	}
+
+	listenURL, err := url.Parse(gatewayConfig.Listen)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", listenURL.Host)
+	if err != nil {
+		return err
+	}
+	log.Infof("Start listen s3 gateway %s", listener.Addr())
+	server := &http.Server{
+		Handler:           NewGateway(repo, permissionCheck, publicAdapterConfig, uploadConfig),
+		ReadHeaderTimeout: durationOrDefault(gatewayConfig.ReadHeaderTimeout, DefaultReadHeaderTimeout),
+		ReadTimeout:       durationOrDefault(gatewayConfig.ReadTimeout, DefaultReadTimeout),
+		IdleTimeout:       durationOrDefault(gatewayConfig.IdleTimeout, DefaultIdleTimeout),
+	}
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) && !errors.Is(err, net.ErrClosed) {
+			log.Errorf("listen address fail %s", err)
+		}
+	}()
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return server.Shutdown(ctx)
+		},
+	})
+	return nil
+}
+
+func durationOrDefault(duration, defaultDuration time.Duration) time.Duration {
+	if duration <= 0 {
+		return defaultDuration
+	}
+	return duration
+}
+
+// operation state of one s3 request
+type operation struct {
+	w         http.ResponseWriter
+	r         *http.Request
+	requestID string
+
+	operator      *models.User
+	body          io.ReadCloser
+	contentLength int64
+
+	bucket     string
+	repository *models.Repository
+	// key object key include ref
+	key string
+}
+
+func (gw *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	o := &operation{
+		w:         w,
+		r:         r,
+		requestID: uuid.NewString(),
+	}
+	w.Header().Set("X-Amz-Request-Id", o.requestID)
+	w.Header().Set("Server", "JiaoziFS")
+	defer r.Body.Close() //nolint
+
+	ctx := r.Context()
+	err := gw.authenticate(ctx, o)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	o.bucket, o.key, _ = strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if len(o.bucket) == 0 {
+		if r.Method != http.MethodGet {
+			o.writeError(ErrMethodNotAllowed)
+			return
+		}
+		gw.listBuckets(ctx, o)
+		return
+	}
+
+	o.repository, err = gw.resolveBucket(ctx, o.operator, o.bucket)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	query := r.URL.Query()
+	if len(o.key) == 0 {
+		switch {
+		case r.Method == http.MethodHead:
+			err = gw.authorize(ctx, o, rbacmodel.ReadRepositoryAction)
+			if err != nil {
+				o.writeError(err)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodGet && query.Has("location"):
+			err = gw.authorize(ctx, o, rbacmodel.ReadRepositoryAction)
+			if err != nil {
+				o.writeError(err)
+				return
+			}
+			o.writeXML(http.StatusOK, &locationConstraint{Xmlns: s3XMLNamespace})
+		case r.Method == http.MethodGet:
+			gw.listObjects(ctx, o, query.Get("list-type") == "2")
+		case r.Method == http.MethodPost && query.Has("delete"):
+			gw.deleteObjects(ctx, o)
+		default:
+			o.writeError(ErrNotImplemented)
+		}
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && query.Has("uploadId"):
+		gw.listParts(ctx, o)
+	case r.Method == http.MethodGet:
+		gw.getObject(ctx, o, false)
+	case r.Method == http.MethodHead:
+		gw.getObject(ctx, o, true)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		gw.uploadPart(ctx, o)
+	case r.Method == http.MethodPut:
+		gw.putObject(ctx, o)
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		gw.createMultipartUpload(ctx, o)
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		gw.completeMultipartUpload(ctx, o)
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		gw.abortMultipartUpload(ctx, o)
+	case r.Method == http.MethodDelete:
+		gw.deleteObject(ctx, o)
+	default:
+		o.writeError(ErrNotImplemented)
+	}
+}
+
+// authenticate verify signature with secret key in aksk table, the owner of access key is the operator
+func (gw *Gateway) authenticate(ctx context.Context, o *operation) error {
+	var akSk *models.AkSk
+	result, err := VerifySigV4(o.r, func(accessKey string) (string, error) {
+		var err error
+		akSk, err = gw.repo.AkskRepo().Get(ctx, models.NewGetAkSkParams().SetAccessKey(accessKey))
+		if err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				return "", ErrInvalidAccessKeyID
+			}
+			return "", err
+		}
+		return akSk.SecretKey, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	o.operator, err = gw.repo.UserRepo().Get(ctx, models.NewGetUserParams().SetID(akSk.UserID))
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return ErrInvalidAccessKeyID
+		}
+		return err
+	}
+	o.body = result.Body
+	o.contentLength = result.ContentLength
+	return nil
+}
+
+func (gw *Gateway) authorize(ctx context.Context, o *operation, action string) error {
+	resp, err := gw.permissionCheck.AuthorizeMember(ctx, o.repository.ID, &rbac.AuthorizationRequest{
+		OperatorID: o.operator.ID,
+		RequiredPermissions: rbac.Node{
+			Permission: rbac.Permission{
+				Action:   action,
+				Resource: rbacmodel.RepoURArn(o.repository.OwnerID.String(), o.repository.ID.String()),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil || !resp.Allowed {
+		return ErrAccessDenied
+	}
+	return nil
+}
+
+// resolveBucket find repository by bucket name <repository> or <owner>.<repository>
+func (gw *Gateway) resolveBucket(ctx context.Context, operator *models.User, bucketName string) (*models.Repository, error) {
+	ownerID := operator.ID
+	repositoryName := bucketName
+	if ownerName, name, found := strings.Cut(bucketName, "."); found {
+		owner, err := gw.repo.UserRepo().Get(ctx, models.NewGetUserParams().SetName(ownerName))
+		if err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				return nil, ErrNoSuchBucket
+			}
+			return nil, err
+		}
+		ownerID = owner.ID
+		repositoryName = name
+	}
+
+	repository, err := gw.repo.RepositoryRepo().Get(ctx, models.NewGetRepoParams().SetOwnerID(ownerID).SetName(repositoryName))
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, ErrNoSuchBucket
+		}
+		return nil, err
+	}
+	return repository, nil
+}
+
+// resolveRef split key into ref and path in ref
+func (gw *Gateway) resolveRef(ctx context.Context, repository *models.Repository, key string) (*versionmgr.RefPath, error) {
+	target, err := versionmgr.ResolveRefPath(ctx, gw.repo, repository.ID, key)
+	if errors.Is(err, versionmgr.ErrRefNotFound) {
+		return nil, ErrNoSuchKey
+	}
+	return target, err
+}
+
+// checkoutForRead checkout wip of caller if target is a branch and wip exist, otherwise checkout target
+func (gw *Gateway) checkoutForRead(ctx context.Context, o *operation, target *versionmgr.RefPath) (*versionmgr.WorkRepository, error) {
+	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, o.operator, o.repository, gw.repo, gw.publicAdapterConfig)
+	if err != nil {
+		return nil, err
+	}
+	return workRepo, workRepo.CheckOutWipOrRef(ctx, target.RefType, target.Name)
+}
+
+// checkoutForWrite checkout wip of caller, wip is created if not exist
+func (gw *Gateway) checkoutForWrite(ctx context.Context, o *operation, target *versionmgr.RefPath) (*versionmgr.WorkRepository, error) {
+	if target.RefType != versionmgr.InBranch {
+		return nil, ErrReadOnlyRef
+	}
+
+	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, o.operator, o.repository, gw.repo, gw.publicAdapterConfig)
+	if err != nil {
+		return nil, err
+	}
+	return workRepo, workRepo.CheckOutOrCreateWip(ctx, target.Name)
+}
+
+func (o *operation) writeXML(statusCode int, v any) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+	o.w.Header().Set("Content-Type", "application/xml")
+	o.w.WriteHeader(statusCode)
+	_, _ = o.w.Write([]byte(xml.Header))
+	_, _ = o.w.Write(data)
+}
+
+func (o *operation) writeError(err error) {
+	apiErr := toAPIError(err)
+	if apiErr.StatusCode == http.StatusInternalServerError {
+		log.Errorf("request %s %s %s failed %v", o.requestID, o.r.Method, o.r.URL.Path, err)
+	}
+
+	if o.r.Method == http.MethodHead {
+		o.w.WriteHeader(apiErr.StatusCode)
+		return
+	}
+	o.writeXML(apiErr.StatusCode, &errorResponse{
+		Code:      apiErr.Code,
+		Message:   apiErr.Message,
+		Resource:  o.r.URL.Path,
+		RequestID: o.requestID,
+	})
+}
+
+func toAPIError(err error) APIError {
+	var apiErr APIError
+	switch {
+	case errors.As(err, &apiErr):
+		return apiErr
+	case errors.Is(err, ErrMissingAuth):
+		return ErrAccessDenied
+	case errors.Is(err, ErrMalformedAuth):
+		return ErrAuthorizationHeader
+	case errors.Is(err, ErrSignatureMismatch):
+		return ErrSignatureDoesNotFit
+	case errors.Is(err, ErrRequestExpired):
+		return ErrExpiredRequest
+	case errors.Is(err, ErrContentSHA256Mismatch):
+		return ErrBadDigest
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return ErrIncompleteBody
+	case errors.Is(err, versionmgr.ErrPathNotFound), errors.Is(err, versionmgr.ErrNotDirectory):
+		return ErrNoSuchKey
+	case errors.Is(err, versionmgr.ErrInvalidPart):
+		return ErrInvalidPart
+	}
+	return ErrInternalError
+}
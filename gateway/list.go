@@ -0,0 +1,283 @@
+package gateway
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/utils/httputil"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+)
+
+const (
+	maxListKeys = 1000
+	// iso8601Format time format used in s3 xml response
+	iso8601Format = "2006-01-02T15:04:05.000Z"
+)
+
+// listItem object or common prefix in list result
+type listItem struct {
+	key      string
+	isPrefix bool
+	object   object
+}
+
+func (gw *Gateway) listBuckets(ctx context.Context, o *operation) {
+	repositories, _, err := gw.repo.RepositoryRepo().List(ctx, models.NewListRepoParams().SetOwnerID(o.operator.ID))
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	result := &listAllMyBucketsResult{
+		Xmlns: s3XMLNamespace,
+		Owner: owner{
+			ID:          o.operator.ID.String(),
+			DisplayName: o.operator.Name,
+		},
+		Buckets: make([]bucket, 0, len(repositories)),
+	}
+	for _, repository := range repositories {
+		result.Buckets = append(result.Buckets, bucket{
+			Name:         repository.Name,
+			CreationDate: repository.CreatedAt.UTC().Format(iso8601Format),
+		})
+	}
+	o.writeXML(http.StatusOK, result)
+}
+
+func (gw *Gateway) listObjects(ctx context.Context, o *operation, v2 bool) {
+	err := gw.authorize(ctx, o, rbacmodel.ListObjectsAction)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	query := o.r.URL.Query()
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	maxKeys := maxListKeys
+	if query.Has("max-keys") {
+		maxKeys, err = strconv.Atoi(query.Get("max-keys"))
+		if err != nil || maxKeys < 0 {
+			o.writeError(ErrInvalidArgument)
+			return
+		}
+		maxKeys = min(maxKeys, maxListKeys)
+	}
+
+	result := &listBucketResult{
+		Xmlns:     s3XMLNamespace,
+		Name:      o.bucket,
+		Prefix:    prefix,
+		Delimiter: delimiter,
+		MaxKeys:   maxKeys,
+	}
+
+	var after string
+	if v2 {
+		result.StartAfter = query.Get("start-after")
+		result.ContinuationToken = query.Get("continuation-token")
+		after = result.StartAfter
+		if len(result.ContinuationToken) > 0 {
+			token, err := base64.URLEncoding.DecodeString(result.ContinuationToken)
+			if err != nil {
+				o.writeError(ErrInvalidArgument)
+				return
+			}
+			after = string(token)
+		}
+	} else {
+		marker := query.Get("marker")
+		result.Marker = &marker
+		after = marker
+	}
+
+	items, err := gw.listItems(ctx, o, prefix, delimiter)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].key < items[j].key
+	})
+	start := sort.Search(len(items), func(i int) bool {
+		return items[i].key > after
+	})
+	items = items[start:]
+	if len(items) > maxKeys {
+		items = items[:maxKeys]
+		result.IsTruncated = true
+	}
+
+	for _, item := range items {
+		if item.isPrefix {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: item.key})
+		} else {
+			result.Contents = append(result.Contents, item.object)
+		}
+	}
+
+	if result.IsTruncated {
+		lastKey := items[len(items)-1].key
+		if v2 {
+			result.NextContinuationToken = base64.URLEncoding.EncodeToString([]byte(lastKey))
+		} else {
+			result.NextMarker = lastKey
+		}
+	}
+	if v2 {
+		keyCount := len(items)
+		result.KeyCount = &keyCount
+	}
+	o.writeXML(http.StatusOK, result)
+}
+
+// listItems collect objects and common prefixes match prefix, refs are listed as common prefixes if prefix not contains ref
+func (gw *Gateway) listItems(ctx context.Context, o *operation, prefix string, delimiter string) ([]listItem, error) {
+	if !strings.Contains(prefix, "/") {
+		return gw.listRefs(ctx, o, prefix, delimiter)
+	}
+
+	target, err := gw.resolveRef(ctx, o.repository, prefix)
+	if err != nil {
+		if errors.Is(err, ErrNoSuchKey) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	workRepo, err := gw.checkoutForRead(ctx, o, target)
+	if err != nil {
+		return nil, err
+	}
+
+	workTree, err := workRepo.RootTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refPrefix := objectKey(target.Name, "")
+	if delimiter == "/" {
+		// only entries in the directory of prefix are needed
+		dir, _ := path.Split(target.Path)
+		entries, err := workTree.Ls(ctx, dir)
+		if err != nil {
+			if errors.Is(err, versionmgr.ErrPathNotFound) || errors.Is(err, versionmgr.ErrNotDirectory) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		items := make([]listItem, 0, len(entries))
+		for _, entry := range entries {
+			fullPath := dir + entry.Name
+			if !strings.HasPrefix(fullPath, target.Path) {
+				continue
+			}
+			if entry.IsDir {
+				items = append(items, listItem{key: refPrefix + fullPath + "/", isPrefix: true})
+				continue
+			}
+			items = append(items, listItem{
+				key:    refPrefix + fullPath,
+				object: newObject(refPrefix+fullPath, entry.CheckSum.Hex(), entry.Size, entry.UpdatedAt.UTC().Format(iso8601Format)),
+			})
+		}
+		return items, nil
+	}
+
+	var items []listItem
+	seenPrefix := make(map[string]struct{})
+	err = versionmgr.NewFileWalk(gw.repo.FileTreeRepo(o.repository.ID), workTree.Root()).Walk(ctx, func(entry *models.TreeEntry, blob *models.Blob, fullPath string) error {
+		if entry.IsDir || !strings.HasPrefix(fullPath, target.Path) {
+			return nil
+		}
+		key := refPrefix + fullPath
+		if commonPrefix, ok := groupByDelimiter(prefix, delimiter, key); ok {
+			if _, seen := seenPrefix[commonPrefix]; !seen {
+				seenPrefix[commonPrefix] = struct{}{}
+				items = append(items, listItem{key: commonPrefix, isPrefix: true})
+			}
+			return nil
+		}
+		items = append(items, listItem{
+			key:    key,
+			object: newObject(key, blob.CheckSum.Hex(), blob.Size, blob.CreatedAt.UTC().Format(iso8601Format)),
+		})
+		return nil
+	})
+	return items, err
+}
+
+// listRefs list branches and tags as common prefixes, objects in refs are not listed without delimiter
+func (gw *Gateway) listRefs(ctx context.Context, o *operation, prefix string, delimiter string) ([]listItem, error) {
+	if len(delimiter) == 0 {
+		return nil, nil
+	}
+
+	branches, _, err := gw.repo.BranchRepo().List(ctx, models.NewListBranchParams().SetRepositoryID(o.repository.ID))
+	if err != nil {
+		return nil, err
+	}
+	tags, _, err := gw.repo.TagRepo().List(ctx, models.NewListTagParams().SetRepositoryID(o.repository.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	refNames := make([]string, 0, len(branches)+len(tags))
+	for _, branch := range branches {
+		refNames = append(refNames, branch.Name)
+	}
+	for _, tag := range tags {
+		refNames = append(refNames, tag.Name)
+	}
+
+	var items []listItem
+	seenPrefix := make(map[string]struct{})
+	for _, refName := range refNames {
+		key := objectKey(refName, "")
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		commonPrefix, ok := groupByDelimiter(prefix, delimiter, key)
+		if !ok {
+			continue
+		}
+		if _, seen := seenPrefix[commonPrefix]; !seen {
+			seenPrefix[commonPrefix] = struct{}{}
+			items = append(items, listItem{key: commonPrefix, isPrefix: true})
+		}
+	}
+	return items, nil
+}
+
+// groupByDelimiter return common prefix of key if delimiter occur in key after prefix
+func groupByDelimiter(prefix string, delimiter string, key string) (string, bool) {
+	if len(delimiter) == 0 {
+		return "", false
+	}
+	idx := strings.Index(key[len(prefix):], delimiter)
+	if idx < 0 {
+		return "", false
+	}
+	return key[:len(prefix)+idx+len(delimiter)], true
+}
+
+func newObject(key string, checkSum string, size int64, lastModified string) object {
+	return object{
+		Key:          key,
+		LastModified: lastModified,
+		ETag:         httputil.ETag(checkSum),
+		Size:         size,
+		StorageClass: "STANDARD",
+	}
+}
@@ -0,0 +1,237 @@
+package gateway
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/GitDataAI/jiaozifs/controller/validator"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/utils/httputil"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+	"github.com/google/uuid"
+)
+
+func (gw *Gateway) createMultipartUpload(ctx context.Context, o *operation) {
+	err := gw.authorize(ctx, o, rbacmodel.WriteObjectAction)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	target, err := gw.resolveRef(ctx, o.repository, o.key)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+	if target.RefType != versionmgr.InBranch {
+		o.writeError(ErrReadOnlyRef)
+		return
+	}
+	if err = validator.ValidateObjectPath(target.Path); err != nil {
+		o.writeError(ErrInvalidObjectName)
+		return
+	}
+
+	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, o.operator, o.repository, gw.repo, gw.publicAdapterConfig)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	upload, err := workRepo.CreateMultipartUpload(ctx, target.Name, target.Path)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+	o.writeXML(http.StatusOK, &initiateMultipartUploadResult{
+		Xmlns:    s3XMLNamespace,
+		Bucket:   o.bucket,
+		Key:      o.key,
+		UploadID: upload.ID.String(),
+	})
+}
+
+// getMultipartUpload find upload session of operator by upload id, key of request must be the same as the session
+func (gw *Gateway) getMultipartUpload(ctx context.Context, o *operation) (*models.MultipartUpload, error) {
+	uploadID, err := uuid.Parse(o.r.URL.Query().Get("uploadId"))
+	if err != nil {
+		return nil, ErrNoSuchUpload
+	}
+
+	upload, err := gw.repo.MultipartUploadRepo().Get(ctx, models.NewGetMultipartUploadParams().SetID(uploadID).SetRepositoryID(o.repository.ID).SetCreatorID(o.operator.ID))
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, ErrNoSuchUpload
+		}
+		return nil, err
+	}
+	if objectKey(upload.RefName, upload.Path) != o.key {
+		return nil, ErrNoSuchUpload
+	}
+	return upload, nil
+}
+
+func (gw *Gateway) uploadPart(ctx context.Context, o *operation) {
+	err := gw.authorize(ctx, o, rbacmodel.WriteObjectAction)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	partNumber, err := strconv.Atoi(o.r.URL.Query().Get("partNumber"))
+	if err != nil {
+		o.writeError(ErrInvalidArgument)
+		return
+	}
+
+	upload, err := gw.getMultipartUpload(ctx, o)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, o.operator, o.repository, gw.repo, gw.publicAdapterConfig)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	part, err := workRepo.UploadMultipartPart(ctx, upload, partNumber, o.contentLength, o.body)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+	o.w.Header().Set("ETag", httputil.ETag(part.ETag))
+	o.w.WriteHeader(http.StatusOK)
+}
+
+func (gw *Gateway) completeMultipartUpload(ctx context.Context, o *operation) {
+	err := gw.authorize(ctx, o, rbacmodel.WriteObjectAction)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	upload, err := gw.getMultipartUpload(ctx, o)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	var req completeMultipartUploadRequest
+	err = xml.NewDecoder(o.body).Decode(&req)
+	if err != nil {
+		o.writeError(ErrMalformedXML)
+		return
+	}
+
+	// parts in request must be exactly the uploaded parts, unused parts is not supported
+	parts, err := gw.repo.MultipartUploadRepo().ListParts(ctx, upload.ID)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+	if len(parts) != len(req.Parts) {
+		o.writeError(ErrInvalidPart)
+		return
+	}
+	for index, part := range parts {
+		if part.PartNumber != req.Parts[index].PartNumber || strings.Trim(part.ETag, `"`) != strings.Trim(req.Parts[index].ETag, `"`) {
+			o.writeError(ErrInvalidPart)
+			return
+		}
+	}
+
+	workRepo, err := gw.checkoutForWrite(ctx, o, &versionmgr.RefPath{Name: upload.RefName, RefType: versionmgr.InBranch, Path: upload.Path})
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	blob, err := workRepo.CompleteMultipartUpload(ctx, upload, models.DefaultLeafProperty())
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	err = putBlob(ctx, workRepo, upload.Path, blob)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+	o.writeXML(http.StatusOK, &completeMultipartUploadResult{
+		Xmlns:    s3XMLNamespace,
+		Location: o.r.URL.Path,
+		Bucket:   o.bucket,
+		Key:      o.key,
+		ETag:     httputil.ETag(blob.CheckSum.Hex()),
+	})
+}
+
+func (gw *Gateway) abortMultipartUpload(ctx context.Context, o *operation) {
+	err := gw.authorize(ctx, o, rbacmodel.WriteObjectAction)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	upload, err := gw.getMultipartUpload(ctx, o)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	workRepo, err := versionmgr.NewWorkRepositoryFromConfig(ctx, o.operator, o.repository, gw.repo, gw.publicAdapterConfig)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	err = workRepo.AbortMultipartUpload(ctx, upload)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+	o.w.WriteHeader(http.StatusNoContent)
+}
+
+func (gw *Gateway) listParts(ctx context.Context, o *operation) {
+	err := gw.authorize(ctx, o, rbacmodel.WriteObjectAction)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	upload, err := gw.getMultipartUpload(ctx, o)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	parts, err := gw.repo.MultipartUploadRepo().ListParts(ctx, upload.ID)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	result := &listPartsResult{
+		Xmlns:    s3XMLNamespace,
+		Bucket:   o.bucket,
+		Key:      o.key,
+		UploadID: upload.ID.String(),
+	}
+	for _, p := range parts {
+		result.Parts = append(result.Parts, part{
+			PartNumber:   p.PartNumber,
+			LastModified: p.CreatedAt.UTC().Format(iso8601Format),
+			ETag:         httputil.ETag(p.ETag),
+			Size:         p.Size,
+		})
+	}
+	o.writeXML(http.StatusOK, result)
+}
@@ -0,0 +1,312 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/GitDataAI/jiaozifs/controller/validator"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/models/rbacmodel"
+	"github.com/GitDataAI/jiaozifs/utils/httputil"
+	"github.com/GitDataAI/jiaozifs/versionmgr"
+)
+
+const maxDeleteObjects = 1000
+
+type copyObjectResult struct {
+	XMLName      xml.Name `xml:"CopyObjectResult"`
+	Xmlns        string   `xml:"xmlns,attr"`
+	LastModified string   `xml:"LastModified"`
+	ETag         string   `xml:"ETag"`
+}
+
+func (gw *Gateway) getObject(ctx context.Context, o *operation, headOnly bool) {
+	err := gw.authorize(ctx, o, rbacmodel.ReadObjectAction)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	target, err := gw.resolveRef(ctx, o.repository, o.key)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	workRepo, err := gw.checkoutForRead(ctx, o, target)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	workTree, err := workRepo.RootTree(ctx)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	blob, name, err := workTree.FindBlob(ctx, target.Path)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	header := o.w.Header()
+	header.Set("ETag", httputil.ETag(blob.CheckSum.Hex()))
+	header.Set("Last-Modified", httputil.HeaderTimestamp(blob.CreatedAt))
+	header.Set("Content-Type", httputil.ExtensionsByType(name))
+	header.Set("Accept-Ranges", "bytes")
+
+	statusCode := http.StatusOK
+	var rangeSpec *string
+	if rangeHeader := o.r.Header.Get("Range"); len(rangeHeader) > 0 {
+		rng, err := httputil.ParseRange(rangeHeader, blob.Size)
+		if err != nil {
+			o.writeError(ErrInvalidRange)
+			return
+		}
+		rangeSpec = &rangeHeader
+		statusCode = http.StatusPartialContent
+		header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", rng.StartOffset, rng.EndOffset, blob.Size))
+		header.Set("Content-Length", fmt.Sprint(rng.EndOffset-rng.StartOffset+1))
+	} else {
+		header.Set("Content-Length", fmt.Sprint(blob.Size))
+	}
+
+	if headOnly {
+		o.w.WriteHeader(statusCode)
+		return
+	}
+
+	reader, err := workRepo.ReadBlob(ctx, blob, rangeSpec)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+	defer reader.Close() //nolint
+
+	o.w.WriteHeader(statusCode)
+	_, err = io.Copy(o.w, reader)
+	if err != nil {
+		log.Debugf("request %s copy object %s failed %v", o.requestID, o.key, err)
+	}
+}
+
+func (gw *Gateway) putObject(ctx context.Context, o *operation) {
+	err := gw.authorize(ctx, o, rbacmodel.WriteObjectAction)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	target, err := gw.resolveRef(ctx, o.repository, o.key)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	// s3 clients create "directory" by put empty object end with slash, directory exist implicitly here
+	if strings.HasSuffix(target.Path, "/") && o.contentLength == 0 {
+		o.w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err = validator.ValidateObjectPath(target.Path); err != nil {
+		o.writeError(ErrInvalidObjectName)
+		return
+	}
+
+	workRepo, err := gw.checkoutForWrite(ctx, o, target)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	var blob *models.Blob
+	if copySource := o.r.Header.Get("X-Amz-Copy-Source"); len(copySource) > 0 {
+		blob, err = gw.copySourceBlob(ctx, o, copySource)
+	} else {
+		blob, err = workRepo.SetStreamThreshold(gw.uploadConfig.StreamThreshold).WriteBlob(ctx, o.body, o.contentLength, models.DefaultLeafProperty())
+	}
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	err = putBlob(ctx, workRepo, target.Path, blob)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	etag := httputil.ETag(blob.CheckSum.Hex())
+	if len(o.r.Header.Get("X-Amz-Copy-Source")) > 0 {
+		o.writeXML(http.StatusOK, &copyObjectResult{
+			Xmlns:        s3XMLNamespace,
+			LastModified: blob.CreatedAt.UTC().Format(iso8601Format),
+			ETag:         etag,
+		})
+		return
+	}
+	o.w.Header().Set("ETag", etag)
+	o.w.WriteHeader(http.StatusOK)
+}
+
+// copySourceBlob find blob of copy source, only object in the same repository can be copied
+func (gw *Gateway) copySourceBlob(ctx context.Context, o *operation, copySource string) (*models.Blob, error) {
+	source, err := url.PathUnescape(copySource)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+	source, _, _ = strings.Cut(source, "?") //version id is not supported
+	bucketName, key, _ := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+	if bucketName != o.bucket {
+		return nil, ErrNotImplemented
+	}
+	//operation was authorized to write, source must be readable by operator too
+	err = gw.authorize(ctx, o, rbacmodel.ReadObjectAction)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := gw.resolveRef(ctx, o.repository, key)
+	if err != nil {
+		return nil, err
+	}
+
+	workRepo, err := gw.checkoutForRead(ctx, o, target)
+	if err != nil {
+		return nil, err
+	}
+
+	workTree, err := workRepo.RootTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blob, _, err := workTree.FindBlob(ctx, target.Path)
+	return blob, err
+}
+
+// putBlob add blob to wip, existing object is replaced
+func putBlob(ctx context.Context, workRepo *versionmgr.WorkRepository, path string, blob *models.Blob) error {
+	return workRepo.ChangeInWip(ctx, func(root *versionmgr.WorkTree) error {
+		oldBlob, _, err := root.FindBlob(ctx, path)
+		if err != nil {
+			if errors.Is(err, versionmgr.ErrPathNotFound) {
+				return root.AddLeaf(ctx, path, blob)
+			}
+			return err
+		}
+		if bytes.Equal(oldBlob.CheckSum, blob.CheckSum) {
+			return nil
+		}
+		return root.ReplaceLeaf(ctx, path, blob)
+	})
+}
+
+// removeObject remove file from wip, missing file is not an error in s3
+func removeObject(ctx context.Context, workRepo *versionmgr.WorkRepository, path string) error {
+	return workRepo.ChangeInWip(ctx, func(root *versionmgr.WorkTree) error {
+		_, _, err := root.FindBlob(ctx, path)
+		if err != nil {
+			if errors.Is(err, versionmgr.ErrPathNotFound) {
+				return nil
+			}
+			return err
+		}
+		return root.RemoveEntry(ctx, path)
+	})
+}
+
+func (gw *Gateway) deleteObject(ctx context.Context, o *operation) {
+	err := gw.authorize(ctx, o, rbacmodel.DeleteObjectAction)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	target, err := gw.resolveRef(ctx, o.repository, o.key)
+	if err != nil {
+		if errors.Is(err, ErrNoSuchKey) {
+			o.w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		o.writeError(err)
+		return
+	}
+
+	workRepo, err := gw.checkoutForWrite(ctx, o, target)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	err = removeObject(ctx, workRepo, target.Path)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+	o.w.WriteHeader(http.StatusNoContent)
+}
+
+func (gw *Gateway) deleteObjects(ctx context.Context, o *operation) {
+	err := gw.authorize(ctx, o, rbacmodel.DeleteObjectAction)
+	if err != nil {
+		o.writeError(err)
+		return
+	}
+
+	var req deleteObjectsRequest
+	err = xml.NewDecoder(o.body).Decode(&req)
+	if err != nil || len(req.Objects) > maxDeleteObjects {
+		o.writeError(ErrMalformedXML)
+		return
+	}
+
+	result := &deleteObjectsResult{Xmlns: s3XMLNamespace}
+	// objects may be in different refs, keep one work repository for each ref
+	workRepos := make(map[string]*versionmgr.WorkRepository)
+	for _, obj := range req.Objects {
+		err = func() error {
+			target, err := gw.resolveRef(ctx, o.repository, obj.Key)
+			if err != nil {
+				return err
+			}
+
+			workRepo, ok := workRepos[target.Name]
+			if !ok {
+				workRepo, err = gw.checkoutForWrite(ctx, o, target)
+				if err != nil {
+					return err
+				}
+				workRepos[target.Name] = workRepo
+			}
+			return removeObject(ctx, workRepo, target.Path)
+		}()
+		if err != nil && !errors.Is(err, ErrNoSuchKey) {
+			apiErr := toAPIError(err)
+			if apiErr.StatusCode == http.StatusInternalServerError {
+				log.Errorf("request %s delete object %s failed %v", o.requestID, obj.Key, err)
+			}
+			result.Errors = append(result.Errors, deleteError{Key: obj.Key, Code: apiErr.Code, Message: apiErr.Message})
+			continue
+		}
+		if !req.Quiet {
+			result.Deleted = append(result.Deleted, deletedObject{Key: obj.Key})
+		}
+	}
+	o.writeXML(http.StatusOK, result)
+}
+
+// objectKey join ref and path to s3 key
+func objectKey(refName string, path string) string {
+	return refName + "/" + path
+}
@@ -0,0 +1,547 @@
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	sigV4Algorithm        = "AWS4-HMAC-SHA256"
+	sigV4ChunkAlgorithm   = "AWS4-HMAC-SHA256-PAYLOAD"
+	sigV4TrailerAlgorithm = "AWS4-HMAC-SHA256-TRAILER"
+	amzDateFormat         = "20060102T150405Z"
+	scopeDateFormat       = "20060102"
+
+	unsignedPayload                 = "UNSIGNED-PAYLOAD"
+	streamingPayload                = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	streamingPayloadTrailer         = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD-TRAILER"
+	streamingUnsignedPayloadTrailer = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+
+	maxClockSkew     = 15 * time.Minute
+	maxPresignExpiry = 7 * 24 * time.Hour
+	// maxAWSChunkSize chunk is buffered until its signature verified, sdks send chunks of 64KB to 1MB
+	maxAWSChunkSize = 8 << 20
+	// maxAWSChunkLineSize chunk header and trailer lines are short, longer line is rejected instead of buffered
+	maxAWSChunkLineSize = 4096
+	// maxAWSTrailers trailers are kept in memory until signature verified
+	maxAWSTrailers = 16
+
+	trailerSignatureHeader = "x-amz-trailer-signature"
+)
+
+var (
+	ErrMissingAuth           = errors.New("missing authentication")
+	ErrMalformedAuth         = errors.New("malformed authentication")
+	ErrSignatureMismatch     = errors.New("signature does not match")
+	ErrRequestExpired        = errors.New("request expired")
+	ErrContentSHA256Mismatch = errors.New("content sha256 does not match")
+)
+
+var emptySHA256 = hex.EncodeToString(sha256.New().Sum(nil))
+
+// sigV4Auth result of verified request
+type sigV4Auth struct {
+	AccessKey string
+	// Body content of request, payload signature or hash is verified while reading
+	Body io.ReadCloser
+	// ContentLength length of decoded body, -1 if unknown
+	ContentLength int64
+}
+
+type sigV4Credential struct {
+	accessKey     string
+	date          string
+	region        string
+	service       string
+	signedHeaders []string
+	signature     string
+	amzDate       time.Time
+}
+
+func (cred *sigV4Credential) scope() string {
+	return strings.Join([]string{cred.date, cred.region, cred.service, "aws4_request"}, "/")
+}
+
+// VerifySigV4 verify aws signature v4 of request in authorization header or presigned query,
+// getSecret return secret key of access key
+func VerifySigV4(r *http.Request, getSecret func(accessKey string) (string, error)) (*sigV4Auth, error) {
+	query := r.URL.Query()
+	var (
+		cred        *sigV4Credential
+		payloadHash string
+		err         error
+	)
+	if authorization := r.Header.Get("Authorization"); len(authorization) > 0 {
+		cred, err = parseAuthorizationHeader(authorization)
+		if err != nil {
+			return nil, err
+		}
+		cred.amzDate, err = parseAmzDate(r)
+		if err != nil {
+			return nil, err
+		}
+		if skew := time.Since(cred.amzDate); skew > maxClockSkew || skew < -maxClockSkew {
+			return nil, fmt.Errorf("request time %s too skewed %w", cred.amzDate, ErrRequestExpired)
+		}
+		payloadHash = r.Header.Get("X-Amz-Content-Sha256")
+		if len(payloadHash) == 0 {
+			return nil, fmt.Errorf("missing x-amz-content-sha256 %w", ErrMalformedAuth)
+		}
+	} else if query.Get("X-Amz-Algorithm") == sigV4Algorithm {
+		cred, err = parsePresignQuery(query)
+		if err != nil {
+			return nil, err
+		}
+		query.Del("X-Amz-Signature")
+		payloadHash = unsignedPayload
+	} else {
+		return nil, ErrMissingAuth
+	}
+
+	//host must be signed, otherwise request can be replayed to other server share the same credential
+	if !slices.Contains(cred.signedHeaders, "host") {
+		return nil, fmt.Errorf("host is not signed %w", ErrMalformedAuth)
+	}
+	if cred.amzDate.UTC().Format(scopeDateFormat) != cred.date {
+		return nil, fmt.Errorf("credential date %s mismatch request date %w", cred.date, ErrMalformedAuth)
+	}
+
+	secretKey, err := getSecret(cred.accessKey)
+	if err != nil {
+		return nil, err
+	}
+	signingKey := deriveSigningKey(secretKey, cred)
+
+	canonicalRequest := buildCanonicalRequest(r, query, cred.signedHeaders, payloadHash)
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		cred.amzDate.UTC().Format(amzDateFormat),
+		cred.scope(),
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+	expectSignature := hex.EncodeToString(hmacSHA256(signingKey, []byte(stringToSign)))
+	if !hmac.Equal([]byte(expectSignature), []byte(cred.signature)) {
+		return nil, ErrSignatureMismatch
+	}
+
+	result := &sigV4Auth{
+		AccessKey:     cred.accessKey,
+		Body:          r.Body,
+		ContentLength: r.ContentLength,
+	}
+	switch payloadHash {
+	case unsignedPayload:
+	case streamingPayload, streamingPayloadTrailer, streamingUnsignedPayloadTrailer:
+		result.ContentLength, err = decodedContentLength(r)
+		if err != nil {
+			return nil, err
+		}
+		chunkReader := &awsChunkedReader{
+			body:   r.Body,
+			reader: bufio.NewReaderSize(r.Body, maxAWSChunkLineSize),
+		}
+		if payloadHash != streamingUnsignedPayloadTrailer {
+			chunkReader.signingKey = signingKey
+			chunkReader.amzDate = cred.amzDate.UTC().Format(amzDateFormat)
+			chunkReader.scope = cred.scope()
+			chunkReader.prevSignature = cred.signature
+			chunkReader.signedTrailer = payloadHash == streamingPayloadTrailer
+		}
+		result.Body = chunkReader
+	default:
+		if len(payloadHash) != sha256.Size*2 {
+			return nil, fmt.Errorf("unsupported x-amz-content-sha256 %s %w", payloadHash, ErrMalformedAuth)
+		}
+		result.Body = &sha256Reader{body: r.Body, hasher: sha256.New(), expect: payloadHash}
+	}
+	return result, nil
+}
+
+func parseAuthorizationHeader(authorization string) (*sigV4Credential, error) {
+	algorithm, fields, found := strings.Cut(authorization, " ")
+	if !found || algorithm != sigV4Algorithm {
+		return nil, fmt.Errorf("unsupported authorization %w", ErrMalformedAuth)
+	}
+
+	values := map[string]string{}
+	for _, field := range strings.Split(fields, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(field), "=")
+		if !found {
+			return nil, fmt.Errorf("authorization field %s %w", field, ErrMalformedAuth)
+		}
+		values[key] = value
+	}
+
+	cred, err := parseCredential(values["Credential"])
+	if err != nil {
+		return nil, err
+	}
+	cred.signedHeaders = strings.Split(values["SignedHeaders"], ";")
+	cred.signature = values["Signature"]
+	if len(values["SignedHeaders"]) == 0 || len(cred.signature) == 0 {
+		return nil, fmt.Errorf("missing signed headers or signature %w", ErrMalformedAuth)
+	}
+	return cred, nil
+}
+
+func parsePresignQuery(query url.Values) (*sigV4Credential, error) {
+	cred, err := parseCredential(query.Get("X-Amz-Credential"))
+	if err != nil {
+		return nil, err
+	}
+	cred.signedHeaders = strings.Split(query.Get("X-Amz-SignedHeaders"), ";")
+	cred.signature = query.Get("X-Amz-Signature")
+	if len(query.Get("X-Amz-SignedHeaders")) == 0 || len(cred.signature) == 0 {
+		return nil, fmt.Errorf("missing signed headers or signature %w", ErrMalformedAuth)
+	}
+
+	cred.amzDate, err = time.Parse(amzDateFormat, query.Get("X-Amz-Date"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid X-Amz-Date %w", ErrMalformedAuth)
+	}
+	expires, err := strconv.ParseInt(query.Get("X-Amz-Expires"), 10, 64)
+	if err != nil || expires < 0 || time.Duration(expires)*time.Second > maxPresignExpiry {
+		return nil, fmt.Errorf("invalid X-Amz-Expires %w", ErrMalformedAuth)
+	}
+	now := time.Now()
+	if now.Before(cred.amzDate.Add(-maxClockSkew)) || now.After(cred.amzDate.Add(time.Duration(expires)*time.Second)) {
+		return nil, ErrRequestExpired
+	}
+	return cred, nil
+}
+
+// parseCredential parse credential in format of <access key>/<date>/<region>/<service>/aws4_request
+func parseCredential(credential string) (*sigV4Credential, error) {
+	parts := strings.Split(credential, "/")
+	if len(parts) != 5 || parts[4] != "aws4_request" || len(parts[0]) == 0 {
+		return nil, fmt.Errorf("invalid credential %s %w", credential, ErrMalformedAuth)
+	}
+	return &sigV4Credential{
+		accessKey: parts[0],
+		date:      parts[1],
+		region:    parts[2],
+		service:   parts[3],
+	}, nil
+}
+
+func parseAmzDate(r *http.Request) (time.Time, error) {
+	if amzDate := r.Header.Get("X-Amz-Date"); len(amzDate) > 0 {
+		t, err := time.Parse(amzDateFormat, amzDate)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid X-Amz-Date %w", ErrMalformedAuth)
+		}
+		return t, nil
+	}
+	if date := r.Header.Get("Date"); len(date) > 0 {
+		t, err := http.ParseTime(date)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid Date %w", ErrMalformedAuth)
+		}
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("missing request date %w", ErrMalformedAuth)
+}
+
+func decodedContentLength(r *http.Request) (int64, error) {
+	decodedLength := r.Header.Get("X-Amz-Decoded-Content-Length")
+	if len(decodedLength) == 0 {
+		return -1, nil
+	}
+	length, err := strconv.ParseInt(decodedLength, 10, 64)
+	if err != nil || length < 0 {
+		return 0, fmt.Errorf("invalid x-amz-decoded-content-length %w", ErrMalformedAuth)
+	}
+	return length, nil
+}
+
+func deriveSigningKey(secretKey string, cred *sigV4Credential) []byte {
+	key := hmacSHA256([]byte("AWS4"+secretKey), []byte(cred.date))
+	key = hmacSHA256(key, []byte(cred.region))
+	key = hmacSHA256(key, []byte(cred.service))
+	return hmacSHA256(key, []byte("aws4_request"))
+}
+
+func buildCanonicalRequest(r *http.Request, query url.Values, signedHeaders []string, payloadHash string) string {
+	uri := r.URL.EscapedPath()
+	if len(uri) == 0 {
+		uri = "/"
+	}
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	queryParts := make([]string, 0, len(query))
+	for _, key := range keys {
+		values := append([]string{}, query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			queryParts = append(queryParts, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+
+	headerLines := make([]string, 0, len(signedHeaders))
+	for _, header := range signedHeaders {
+		headerLines = append(headerLines, header+":"+canonicalHeaderValue(r, header))
+	}
+
+	return strings.Join([]string{
+		r.Method,
+		uri,
+		strings.Join(queryParts, "&"),
+		strings.Join(headerLines, "\n") + "\n",
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+}
+
+func canonicalHeaderValue(r *http.Request, header string) string {
+	switch header {
+	case "host":
+		return r.Host
+	case "content-length":
+		//net/http may move content-length out of header
+		if values := r.Header.Values(header); len(values) == 0 && r.ContentLength >= 0 {
+			return strconv.FormatInt(r.ContentLength, 10)
+		}
+	case "transfer-encoding":
+		if values := r.Header.Values(header); len(values) == 0 && len(r.TransferEncoding) > 0 {
+			return strings.Join(r.TransferEncoding, ",")
+		}
+	}
+
+	values := r.Header.Values(header)
+	trimmed := make([]string, 0, len(values))
+	for _, value := range values {
+		trimmed = append(trimmed, strings.Join(strings.Fields(value), " "))
+	}
+	return strings.Join(trimmed, ",")
+}
+
+// uriEncode encode string as aws required, unreserved characters are kept and slash is kept if encodeSlash is false
+func uriEncode(s string, encodeSlash bool) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			buf.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&buf, "%%%02X", c)
+	}
+	return buf.String()
+}
+
+func hmacSHA256(key []byte, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data) //nolint:errcheck
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// sha256Reader verify sha256 of body when reach EOF
+type sha256Reader struct {
+	body   io.ReadCloser
+	hasher hash.Hash
+	expect string
+}
+
+func (r *sha256Reader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	r.hasher.Write(p[:n]) //nolint:errcheck
+	if errors.Is(err, io.EOF) && hex.EncodeToString(r.hasher.Sum(nil)) != r.expect {
+		return n, ErrContentSHA256Mismatch
+	}
+	return n, err
+}
+
+func (r *sha256Reader) Close() error {
+	return r.body.Close()
+}
+
+// awsChunkedReader decode body in aws-chunked encoding, signature of each chunk and trailer is verified if signingKey
+// is set. chunk is released to reader only after its signature verified, so size of chunk is limited.
+// see https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-streaming.html
+type awsChunkedReader struct {
+	body   io.Closer
+	reader *bufio.Reader
+
+	signingKey    []byte
+	amzDate       string
+	scope         string
+	prevSignature string
+	signedTrailer bool
+
+	buf   bytes.Buffer
+	chunk []byte
+	done  bool
+}
+
+func (r *awsChunkedReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		err := r.readChunk()
+		if err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func (r *awsChunkedReader) readChunk() error {
+	header, err := r.readLine()
+	if err != nil {
+		return err
+	}
+	sizeStr, extension, _ := strings.Cut(header, ";")
+	size, err := strconv.ParseInt(strings.TrimSpace(sizeStr), 16, 64)
+	if err != nil || size < 0 {
+		return fmt.Errorf("invalid chunk size %s %w", sizeStr, ErrMalformedAuth)
+	}
+	if size > maxAWSChunkSize {
+		return fmt.Errorf("chunk size %d exceed limit %d %w", size, maxAWSChunkSize, ErrMalformedAuth)
+	}
+
+	//buffer is reused, previous chunk has been consumed
+	r.buf.Reset()
+	hasher := sha256.New()
+	_, err = io.CopyN(io.MultiWriter(&r.buf, hasher), r.reader, size)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	if r.signingKey != nil {
+		signature, found := strings.CutPrefix(extension, "chunk-signature=")
+		if !found {
+			return fmt.Errorf("missing chunk signature %w", ErrMalformedAuth)
+		}
+		stringToSign := strings.Join([]string{
+			sigV4ChunkAlgorithm,
+			r.amzDate,
+			r.scope,
+			r.prevSignature,
+			emptySHA256,
+			hex.EncodeToString(hasher.Sum(nil)),
+		}, "\n")
+		expectSignature := hex.EncodeToString(hmacSHA256(r.signingKey, []byte(stringToSign)))
+		if !hmac.Equal([]byte(expectSignature), []byte(signature)) {
+			return ErrSignatureMismatch
+		}
+		r.prevSignature = signature
+	}
+
+	if size == 0 {
+		err = r.readTrailer()
+		if err != nil {
+			return err
+		}
+		r.done = true
+		return nil
+	}
+
+	line, err := r.readLine()
+	if err != nil {
+		return err
+	}
+	if len(line) > 0 {
+		return fmt.Errorf("chunk not end with crlf %w", ErrMalformedAuth)
+	}
+	r.chunk = r.buf.Bytes()
+	return nil
+}
+
+// readTrailer read trailing headers until empty line, signature of trailers is verified for signed trailer payload
+func (r *awsChunkedReader) readTrailer() error {
+	var trailers strings.Builder
+	var signature string
+	for count := 0; ; count++ {
+		if count > maxAWSTrailers {
+			return fmt.Errorf("trailers exceed limit %d %w", maxAWSTrailers, ErrMalformedAuth)
+		}
+		line, err := r.readLine()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(line) == 0 {
+			break
+		}
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			return fmt.Errorf("invalid trailer %s %w", line, ErrMalformedAuth)
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == trailerSignatureHeader {
+			signature = strings.TrimSpace(value)
+			continue
+		}
+		trailers.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	if !r.signedTrailer {
+		return nil
+	}
+	if len(signature) == 0 {
+		return fmt.Errorf("missing trailer signature %w", ErrMalformedAuth)
+	}
+	stringToSign := strings.Join([]string{
+		sigV4TrailerAlgorithm,
+		r.amzDate,
+		r.scope,
+		r.prevSignature,
+		hexSHA256([]byte(trailers.String())),
+	}, "\n")
+	expectSignature := hex.EncodeToString(hmacSHA256(r.signingKey, []byte(stringToSign)))
+	if !hmac.Equal([]byte(expectSignature), []byte(signature)) {
+		return ErrSignatureMismatch
+	}
+	return nil
+}
+
+// readLine read line no longer than size of buffer of reader, maxAWSChunkLineSize
+func (r *awsChunkedReader) readLine() (string, error) {
+	line, err := r.reader.ReadSlice('\n')
+	if err != nil {
+		if errors.Is(err, bufio.ErrBufferFull) {
+			return "", fmt.Errorf("line exceed limit %d %w", maxAWSChunkLineSize, ErrMalformedAuth)
+		}
+		if errors.Is(err, io.EOF) && len(line) > 0 {
+			return "", io.ErrUnexpectedEOF
+		}
+		return "", err
+	}
+	return strings.TrimRight(string(line), "\r\n"), nil
+}
+
+func (r *awsChunkedReader) Close() error {
+	return r.body.Close()
+}
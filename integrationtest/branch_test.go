@@ -266,6 +266,12 @@ func BranchSpec(ctx context.Context, urlStr string) func(c convey.C) {
 				convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusBadRequest)
 			})
 
+			c.Convey("fail to delete revision expression", func() {
+				resp, err := client.DeleteBranch(ctx, userName, repoName, &api.DeleteBranchParams{RefName: "main~1"})
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusBadRequest)
+			})
+
 			c.Convey("delete branch successful", func() {
 				createWip(ctx, client, userName, repoName, "feat/sec_branch")
 				resp, err := client.DeleteBranch(ctx, userName, repoName, &api.DeleteBranchParams{RefName: "feat/sec_branch"})
@@ -0,0 +1,56 @@
+package integrationtest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	apiimpl "github.com/GitDataAI/jiaozifs/api/api_impl"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func CommitStatusSpec(ctx context.Context, urlStr string) func(c convey.C) {
+	client, _ := api.NewClient(urlStr + apiimpl.APIV1Prefix)
+	var commitHash string
+	return func(c convey.C) {
+		userName := "nelly"
+		repoName := "statusrepo"
+
+		c.Convey("init", func(_ convey.C) {
+			_ = createUser(ctx, client, userName)
+			loginAndSwitch(ctx, client, userName, false)
+			_ = createRepo(ctx, client, repoName, false)
+			_ = createWip(ctx, client, userName, repoName, "main")
+			_ = uploadObject(ctx, client, userName, repoName, "main", "a.txt", true)
+			_ = commitWip(ctx, client, userName, repoName, "main", "add a.txt")
+			commitHash = getBranch(ctx, client, userName, repoName, "main").CommitHash
+		})
+
+		c.Convey("create status by abbreviated hash", func() {
+			resp, err := client.CreateCommitStatus(ctx, userName, repoName, commitHash[:8], api.CreateCommitStatusJSONRequestBody{
+				Context: "ci/build",
+				State:   api.CommitStatusStateSuccess,
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusCreated)
+		})
+
+		c.Convey("get combined status by full hash", func() {
+			resp, err := client.GetCombinedCommitStatus(ctx, userName, repoName, commitHash)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusOK)
+
+			result, err := api.ParseGetCombinedCommitStatusResponse(resp)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(result.JSON200.Commit, convey.ShouldEqual, commitHash)
+			convey.So(result.JSON200.State, convey.ShouldEqual, api.CommitStatusStateSuccess)
+			convey.So(result.JSON200.Statuses, convey.ShouldHaveLength, 1)
+		})
+
+		c.Convey("fail to get status of invalid hash", func() {
+			resp, err := client.GetCombinedCommitStatus(ctx, userName, repoName, "zzzz")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusBadRequest)
+		})
+	}
+}
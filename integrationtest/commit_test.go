@@ -332,7 +332,7 @@ func GetEntriesInRefSpec(ctx context.Context, urlStr string) func(c convey.C) {
 				headBranch, err := api.ParseGetBranchResponse(resp)
 				convey.So(err, convey.ShouldBeNil)
 
-				baseHead = utils.String(baseBranch.JSON200.CommitHash + "..." + headBranch.JSON200.CommitHash)
+				baseHead = utils.String(baseBranch.JSON200.CommitHash + ".." + headBranch.JSON200.CommitHash)
 			})
 			c.Convey("no auth", func() {
 				re := client.RequestEditors
@@ -223,7 +223,7 @@ func commitWip(ctx context.Context, client *api.Client, user string, repoName st
 	resp, err := client.CommitWip(ctx, user, repoName, &api.CommitWipParams{
 		RefName: refName,
 		Msg:     msg,
-	})
+	}, api.CommitWipJSONRequestBody{})
 
 	convey.So(err, convey.ShouldBeNil)
 	convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusCreated)
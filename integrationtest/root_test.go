@@ -29,4 +29,6 @@ func TestSpec(t *testing.T) {
 	convey.Convey("group test", t, GroupSpec(ctx, urlStr))
 	convey.Convey("member test", t, MemberSpec(ctx, urlStr))
 	convey.Convey("public repo test", t, PublicRepoSpec(ctx, urlStr))
+	convey.Convey("work copy test", t, WorkCopySpec(ctx, urlStr))
+	convey.Convey("commit status test", t, CommitStatusSpec(ctx, urlStr))
 }
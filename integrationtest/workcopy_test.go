@@ -0,0 +1,102 @@
+package integrationtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/GitDataAI/jiaozifs/api"
+	apiimpl "github.com/GitDataAI/jiaozifs/api/api_impl"
+	"github.com/GitDataAI/jiaozifs/cmd"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func WorkCopySpec(ctx context.Context, urlStr string) func(c convey.C) {
+	client, _ := api.NewClient(urlStr + apiimpl.APIV1Prefix)
+	var tmpDir, dest string
+	return func(c convey.C) {
+		userName := "ginny"
+		repoName := "workcopy"
+		branchName := "feat/workcopy"
+
+		runCmd := func(args ...string) error {
+			args = append(args, "--url", urlStr, "--user", userName, "--password", "12345678")
+			cmd.RootCmd().SetArgs(args)
+			return cmd.RootCmd().ExecuteContext(ctx)
+		}
+		inDir := func(dir string, fn func()) {
+			wd, err := os.Getwd()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(os.Chdir(dir), convey.ShouldBeNil)
+			defer os.Chdir(wd) //nolint
+			fn()
+		}
+
+		c.Convey("init", func(_ convey.C) {
+			var err error
+			tmpDir, err = os.MkdirTemp(os.TempDir(), "*")
+			convey.So(err, convey.ShouldBeNil)
+			dest = filepath.Join(tmpDir, repoName)
+
+			_ = createUser(ctx, client, userName)
+			loginAndSwitch(ctx, client, userName, false)
+			_ = createRepo(ctx, client, repoName, false)
+			_ = createWip(ctx, client, userName, repoName, "main")
+			_ = uploadObject(ctx, client, userName, repoName, "main", "a.dat", true)
+			_ = commitWip(ctx, client, userName, repoName, "main", "add a.dat")
+			//branch without wip, same as the one cloned by new user
+			_ = createBranch(ctx, client, userName, repoName, "main", branchName)
+		})
+
+		c.Convey("clone", func() {
+			err := runCmd("clone", "--owner", userName, "--repo", repoName, "--branch", branchName, "--dest", dest)
+			convey.So(err, convey.ShouldBeNil)
+
+			_, err = os.Stat(filepath.Join(dest, "a.dat"))
+			convey.So(err, convey.ShouldBeNil)
+		})
+
+		c.Convey("add and commit in fresh clone", func() {
+			convey.So(os.WriteFile(filepath.Join(dest, "b.txt"), []byte("added by working copy"), 0644), convey.ShouldBeNil)
+			inDir(dest, func() {
+				convey.So(runCmd("add", "b.txt"), convey.ShouldBeNil)
+				convey.So(runCmd("commit", "-m", "add b.txt"), convey.ShouldBeNil)
+			})
+
+			resp, err := client.GetObject(ctx, userName, repoName, &api.GetObjectParams{
+				RefName: branchName,
+				Path:    "b.txt",
+				Type:    api.RefTypeBranch,
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusOK)
+			defer resp.Body.Close() //nolint
+			content, err := io.ReadAll(resp.Body)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(string(content), convey.ShouldEqual, "added by working copy")
+		})
+
+		c.Convey("rm and commit", func() {
+			inDir(dest, func() {
+				convey.So(runCmd("rm", "a.dat"), convey.ShouldBeNil)
+				convey.So(runCmd("commit", "-m", "remove a.dat"), convey.ShouldBeNil)
+			})
+			_, err := os.Stat(filepath.Join(dest, "a.dat"))
+			convey.So(os.IsNotExist(err), convey.ShouldBeTrue)
+
+			resp, err := client.GetObject(ctx, userName, repoName, &api.GetObjectParams{
+				RefName: branchName,
+				Path:    "a.dat",
+				Type:    api.RefTypeBranch,
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusNotFound)
+		})
+
+		c.Convey("clean", func() {
+			convey.So(os.RemoveAll(tmpDir), convey.ShouldBeNil)
+		})
+	}
+}
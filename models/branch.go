@@ -2,6 +2,7 @@ package models
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/GitDataAI/jiaozifs/utils/hash"
@@ -9,6 +10,9 @@ import (
 	"github.com/uptrace/bun"
 )
 
+// ErrBranchHeadChanged branch head is not the expected one when update it
+var ErrBranchHeadChanged = errors.New("branch head changed")
+
 type Branch struct {
 	bun.BaseModel `bun:"table:branches"`
 	ID            uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
@@ -76,8 +80,9 @@ func (gup *DeleteBranchParams) SetName(name string) *DeleteBranchParams {
 }
 
 type UpdateBranchParams struct {
-	id         uuid.UUID
-	commitHash hash.Hash
+	id               uuid.UUID
+	commitHash       hash.Hash
+	expectCommitHash *hash.Hash
 }
 
 func NewUpdateBranchParams(id uuid.UUID) *UpdateBranchParams {
@@ -89,6 +94,16 @@ func (up *UpdateBranchParams) SetCommitHash(commitHash hash.Hash) *UpdateBranchP
 	return up
 }
 
+// SetExpectCommitHash only update branch whose head is still expectCommitHash, ErrBranchHeadChanged is returned if not
+func (up *UpdateBranchParams) SetExpectCommitHash(expectCommitHash hash.Hash) *UpdateBranchParams {
+	if expectCommitHash == nil {
+		//head of empty branch is stored as empty bytes, not null
+		expectCommitHash = hash.Empty
+	}
+	up.expectCommitHash = &expectCommitHash
+	return up
+}
+
 type ListBranchParams struct {
 	RepositoryID uuid.UUID
 	Name         *string
@@ -233,6 +248,21 @@ func (r BranchRepo) UpdateByID(ctx context.Context, updateModel *UpdateBranchPar
 	if updateModel.commitHash != nil {
 		updateQuery.Set("commit_hash = ?", updateModel.commitHash)
 	}
-	_, err := updateQuery.Exec(ctx)
-	return err
+	if updateModel.expectCommitHash != nil {
+		updateQuery.Where("commit_hash = ?", *updateModel.expectCommitHash)
+	}
+	result, err := updateQuery.Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if updateModel.expectCommitHash != nil {
+		affectedRows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affectedRows == 0 {
+			return ErrBranchHeadChanged
+		}
+	}
+	return nil
 }
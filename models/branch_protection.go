@@ -0,0 +1,168 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/gobwas/glob"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// BranchProtectionRule protect branches whose name match the pattern
+type BranchProtectionRule struct {
+	bun.BaseModel `bun:"table:branch_protection_rules"`
+	ID            uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+	RepositoryID  uuid.UUID `bun:"repository_id,type:uuid,unique:repo_pattern,notnull" json:"repository_id"`
+	// Pattern glob of branch name, eg. main, release/*
+	Pattern string `bun:"pattern,unique:repo_pattern,notnull" json:"pattern"`
+	// RequireMergeRequest forbid commit to branch directly, changes can only be merged by merge request
+	RequireMergeRequest bool `bun:"require_merge_request,notnull,default:false" json:"require_merge_request"`
+	// ForbidDeletion forbid to delete branch
+	ForbidDeletion bool `bun:"forbid_deletion,notnull,default:false" json:"forbid_deletion"`
+	// ForbidForceUpdate forbid to move branch to commit which is not descendant of current head
+	ForbidForceUpdate bool `bun:"forbid_force_update,notnull,default:false" json:"forbid_force_update"`
+	// RequiredChecks checks must success on source commit before merge request can be merged into branch
+	RequiredChecks []string `bun:"required_checks,array" json:"required_checks"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at,type:timestamp,notnull" json:"updated_at"`
+}
+
+// Match check whether branch name match the pattern of rule, invalid pattern match nothing
+func (rule *BranchProtectionRule) Match(branchName string) bool {
+	matcher, err := glob.Compile(rule.Pattern, '/')
+	if err != nil {
+		return false
+	}
+	return matcher.Match(branchName)
+}
+
+type GetBranchProtectionRuleParams struct {
+	id           uuid.UUID
+	repositoryID uuid.UUID
+}
+
+func NewGetBranchProtectionRuleParams() *GetBranchProtectionRuleParams {
+	return &GetBranchProtectionRuleParams{}
+}
+
+func (gbp *GetBranchProtectionRuleParams) SetID(id uuid.UUID) *GetBranchProtectionRuleParams {
+	gbp.id = id
+	return gbp
+}
+
+func (gbp *GetBranchProtectionRuleParams) SetRepositoryID(repositoryID uuid.UUID) *GetBranchProtectionRuleParams {
+	gbp.repositoryID = repositoryID
+	return gbp
+}
+
+type DeleteBranchProtectionRuleParams struct {
+	id           uuid.UUID
+	repositoryID uuid.UUID
+}
+
+func NewDeleteBranchProtectionRuleParams() *DeleteBranchProtectionRuleParams {
+	return &DeleteBranchProtectionRuleParams{}
+}
+
+func (dbp *DeleteBranchProtectionRuleParams) SetID(id uuid.UUID) *DeleteBranchProtectionRuleParams {
+	dbp.id = id
+	return dbp
+}
+
+func (dbp *DeleteBranchProtectionRuleParams) SetRepositoryID(repositoryID uuid.UUID) *DeleteBranchProtectionRuleParams {
+	dbp.repositoryID = repositoryID
+	return dbp
+}
+
+type IBranchProtectionRuleRepo interface {
+	// Save insert rule or replace rule with the same pattern in repository
+	Save(ctx context.Context, rule *BranchProtectionRule) (*BranchProtectionRule, error)
+	Get(ctx context.Context, params *GetBranchProtectionRuleParams) (*BranchProtectionRule, error)
+	List(ctx context.Context, repositoryID uuid.UUID) ([]*BranchProtectionRule, error)
+	// Match return rules in repository which match the branch name
+	Match(ctx context.Context, repositoryID uuid.UUID, branchName string) ([]*BranchProtectionRule, error)
+	Delete(ctx context.Context, params *DeleteBranchProtectionRuleParams) (int64, error)
+}
+
+var _ IBranchProtectionRuleRepo = (*BranchProtectionRuleRepo)(nil)
+
+type BranchProtectionRuleRepo struct {
+	db bun.IDB
+}
+
+func NewBranchProtectionRuleRepo(db bun.IDB) IBranchProtectionRuleRepo {
+	return &BranchProtectionRuleRepo{db: db}
+}
+
+func (b BranchProtectionRuleRepo) Save(ctx context.Context, rule *BranchProtectionRule) (*BranchProtectionRule, error) {
+	_, err := b.db.NewInsert().Model(rule).
+		On("CONFLICT (repository_id, pattern) DO UPDATE").
+		Set("require_merge_request = EXCLUDED.require_merge_request").
+		Set("forbid_deletion = EXCLUDED.forbid_deletion").
+		Set("forbid_force_update = EXCLUDED.forbid_force_update").
+		Set("required_checks = EXCLUDED.required_checks").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (b BranchProtectionRuleRepo) Get(ctx context.Context, params *GetBranchProtectionRuleParams) (*BranchProtectionRule, error) {
+	rule := &BranchProtectionRule{}
+	query := b.db.NewSelect().Model(rule)
+
+	if uuid.Nil != params.id {
+		query = query.Where("id = ?", params.id)
+	}
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+	return rule, query.Limit(1).Scan(ctx)
+}
+
+func (b BranchProtectionRuleRepo) List(ctx context.Context, repositoryID uuid.UUID) ([]*BranchProtectionRule, error) {
+	var rules []*BranchProtectionRule
+	err := b.db.NewSelect().Model(&rules).
+		Where("repository_id = ?", repositoryID).
+		Order("created_at ASC").
+		Scan(ctx)
+	return rules, err
+}
+
+func (b BranchProtectionRuleRepo) Match(ctx context.Context, repositoryID uuid.UUID, branchName string) ([]*BranchProtectionRule, error) {
+	rules, err := b.List(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*BranchProtectionRule
+	for _, rule := range rules {
+		if rule.Match(branchName) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched, nil
+}
+
+func (b BranchProtectionRuleRepo) Delete(ctx context.Context, params *DeleteBranchProtectionRuleParams) (int64, error) {
+	query := b.db.NewDelete().Model((*BranchProtectionRule)(nil))
+
+	if uuid.Nil != params.id {
+		query = query.Where("id = ?", params.id)
+	}
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+	sqlResult, err := query.Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return sqlResult.RowsAffected()
+}
@@ -0,0 +1,92 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+)
+
+func TestBranchProtectionRuleRepo(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	ruleRepo := models.NewBranchProtectionRuleRepo(db)
+	repoID := uuid.New()
+
+	mainRule, err := ruleRepo.Save(ctx, &models.BranchProtectionRule{
+		RepositoryID:        repoID,
+		Pattern:             "main",
+		RequireMergeRequest: true,
+		CreatedAt:           time.Now(),
+		UpdatedAt:           time.Now(),
+	})
+	require.NoError(t, err)
+
+	_, err = ruleRepo.Save(ctx, &models.BranchProtectionRule{
+		RepositoryID:   repoID,
+		Pattern:        "release/*",
+		ForbidDeletion: true,
+		RequiredChecks: []string{"ci/build"},
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	})
+	require.NoError(t, err)
+
+	//replace rule with the same pattern
+	_, err = ruleRepo.Save(ctx, &models.BranchProtectionRule{
+		RepositoryID:      repoID,
+		Pattern:           "main",
+		ForbidForceUpdate: true,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	})
+	require.NoError(t, err)
+
+	rules, err := ruleRepo.List(ctx, repoID)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+
+	actualMainRule, err := ruleRepo.Get(ctx, models.NewGetBranchProtectionRuleParams().SetID(mainRule.ID).SetRepositoryID(repoID))
+	require.NoError(t, err)
+	require.False(t, actualMainRule.RequireMergeRequest)
+	require.True(t, actualMainRule.ForbidForceUpdate)
+
+	matched, err := ruleRepo.Match(ctx, repoID, "release/v1")
+	require.NoError(t, err)
+	require.Len(t, matched, 1)
+	require.Equal(t, []string{"ci/build"}, matched[0].RequiredChecks)
+
+	matched, err = ruleRepo.Match(ctx, repoID, "feat/a")
+	require.NoError(t, err)
+	require.Len(t, matched, 0)
+
+	affectRows, err := ruleRepo.Delete(ctx, models.NewDeleteBranchProtectionRuleParams().SetID(mainRule.ID).SetRepositoryID(repoID))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affectRows)
+}
+
+func TestBranchProtectionRuleMatch(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		branch  string
+		match   bool
+	}{
+		{"main", "main", true},
+		{"main", "main2", false},
+		{"release/*", "release/v1", true},
+		{"release/*", "release/v1/hotfix", false},
+		{"release/**", "release/v1/hotfix", true},
+		{"[", "[", false},
+	}
+	for _, tc := range testCases {
+		rule := &models.BranchProtectionRule{Pattern: tc.pattern}
+		require.Equal(t, tc.match, rule.Match(tc.branch), "pattern %s branch %s", tc.pattern, tc.branch)
+	}
+}
@@ -0,0 +1,196 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ReflogOperation string
+
+const (
+	ReflogOperationCreate      ReflogOperation = "create"
+	ReflogOperationCommit      ReflogOperation = "commit"
+	ReflogOperationMerge       ReflogOperation = "merge"
+	ReflogOperationSquash      ReflogOperation = "squash"
+	ReflogOperationFastForward ReflogOperation = "fast_forward"
+	ReflogOperationCherryPick  ReflogOperation = "cherry_pick"
+	ReflogOperationRevert      ReflogOperation = "revert"
+	ReflogOperationDelete      ReflogOperation = "delete"
+	ReflogOperationRestore     ReflogOperation = "restore"
+	ReflogOperationUndelete    ReflogOperation = "undelete"
+)
+
+// BranchReflog record one movement of branch head, entries are kept after branch deleted so that the branch could be restored
+type BranchReflog struct {
+	bun.BaseModel `bun:"table:branch_reflogs"`
+	ID            uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+	RepositoryID  uuid.UUID `bun:"repository_id,type:uuid,notnull" json:"repository_id"`
+	// BranchID id of branch when movement happened, branch recreated with same name has a new id
+	BranchID   uuid.UUID `bun:"branch_id,type:uuid,notnull" json:"branch_id"`
+	BranchName string    `bun:"branch_name,notnull" json:"branch_name"`
+	// OldHash head before movement, empty for created branch
+	OldHash hash.Hash `bun:"old_hash,type:bytea" json:"old_hash"`
+	// NewHash head after movement, empty for deleted branch
+	NewHash    hash.Hash       `bun:"new_hash,type:bytea" json:"new_hash"`
+	Operation  ReflogOperation `bun:"operation,notnull" json:"operation"`
+	Message    string          `bun:"message" json:"message"`
+	OperatorID uuid.UUID       `bun:"operator_id,type:uuid,notnull" json:"operator_id"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+}
+
+type GetBranchReflogParams struct {
+	id           uuid.UUID
+	repositoryID uuid.UUID
+}
+
+func NewGetBranchReflogParams() *GetBranchReflogParams {
+	return &GetBranchReflogParams{}
+}
+
+func (gbp *GetBranchReflogParams) SetID(id uuid.UUID) *GetBranchReflogParams {
+	gbp.id = id
+	return gbp
+}
+
+func (gbp *GetBranchReflogParams) SetRepositoryID(repositoryID uuid.UUID) *GetBranchReflogParams {
+	gbp.repositoryID = repositoryID
+	return gbp
+}
+
+type ListBranchReflogParams struct {
+	repositoryID uuid.UUID
+	branchName   *string
+	operation    *ReflogOperation
+	after        *time.Time
+	amount       int
+}
+
+func NewListBranchReflogParams() *ListBranchReflogParams {
+	return &ListBranchReflogParams{}
+}
+
+func (lbp *ListBranchReflogParams) SetRepositoryID(repositoryID uuid.UUID) *ListBranchReflogParams {
+	lbp.repositoryID = repositoryID
+	return lbp
+}
+
+func (lbp *ListBranchReflogParams) SetBranchName(branchName string) *ListBranchReflogParams {
+	lbp.branchName = &branchName
+	return lbp
+}
+
+func (lbp *ListBranchReflogParams) SetOperation(operation ReflogOperation) *ListBranchReflogParams {
+	lbp.operation = &operation
+	return lbp
+}
+
+// SetAfter only return entries created before the time, entries are listed from newest to oldest
+func (lbp *ListBranchReflogParams) SetAfter(after time.Time) *ListBranchReflogParams {
+	lbp.after = &after
+	return lbp
+}
+
+func (lbp *ListBranchReflogParams) SetAmount(amount int) *ListBranchReflogParams {
+	lbp.amount = amount
+	return lbp
+}
+
+type DeleteBranchReflogParams struct {
+	repositoryID uuid.UUID
+}
+
+func NewDeleteBranchReflogParams() *DeleteBranchReflogParams {
+	return &DeleteBranchReflogParams{}
+}
+
+func (dbp *DeleteBranchReflogParams) SetRepositoryID(repositoryID uuid.UUID) *DeleteBranchReflogParams {
+	dbp.repositoryID = repositoryID
+	return dbp
+}
+
+type IBranchReflogRepo interface {
+	Insert(ctx context.Context, reflog *BranchReflog) (*BranchReflog, error)
+	Get(ctx context.Context, params *GetBranchReflogParams) (*BranchReflog, error)
+	// List return entries from newest to oldest, has more is true if amount entries returned
+	List(ctx context.Context, params *ListBranchReflogParams) ([]*BranchReflog, bool, error)
+	Delete(ctx context.Context, params *DeleteBranchReflogParams) (int64, error)
+}
+
+var _ IBranchReflogRepo = (*BranchReflogRepo)(nil)
+
+type BranchReflogRepo struct {
+	db bun.IDB
+}
+
+func NewBranchReflogRepo(db bun.IDB) IBranchReflogRepo {
+	return &BranchReflogRepo{db: db}
+}
+
+func (r BranchReflogRepo) Insert(ctx context.Context, reflog *BranchReflog) (*BranchReflog, error) {
+	_, err := r.db.NewInsert().Model(reflog).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return reflog, nil
+}
+
+func (r BranchReflogRepo) Get(ctx context.Context, params *GetBranchReflogParams) (*BranchReflog, error) {
+	reflog := &BranchReflog{}
+	query := r.db.NewSelect().Model(reflog)
+
+	if uuid.Nil != params.id {
+		query = query.Where("id = ?", params.id)
+	}
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+	return reflog, query.Limit(1).Scan(ctx)
+}
+
+func (r BranchReflogRepo) List(ctx context.Context, params *ListBranchReflogParams) ([]*BranchReflog, bool, error) {
+	var reflogs []*BranchReflog
+	query := r.db.NewSelect().Model(&reflogs)
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+
+	if params.branchName != nil {
+		query = query.Where("branch_name = ?", *params.branchName)
+	}
+
+	if params.operation != nil {
+		query = query.Where("operation = ?", *params.operation)
+	}
+
+	if params.after != nil {
+		query = query.Where("created_at < ?", *params.after)
+	}
+
+	query = query.Order("created_at DESC")
+	if params.amount > 0 {
+		query = query.Limit(params.amount)
+	}
+	err := query.Scan(ctx)
+	return reflogs, params.amount > 0 && len(reflogs) == params.amount, err
+}
+
+func (r BranchReflogRepo) Delete(ctx context.Context, params *DeleteBranchReflogParams) (int64, error) {
+	query := r.db.NewDelete().Model((*BranchReflog)(nil))
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+
+	sqlResult, err := query.Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return sqlResult.RowsAffected()
+}
@@ -0,0 +1,84 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+)
+
+func TestBranchReflogRepo(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	reflogRepo := models.NewBranchReflogRepo(db)
+	repoID := uuid.New()
+	branchID := uuid.New()
+
+	now := time.Now()
+	entries := []struct {
+		operation models.ReflogOperation
+		old       string
+		new       string
+	}{
+		{models.ReflogOperationCreate, "", "aa"},
+		{models.ReflogOperationCommit, "aa", "bb"},
+		{models.ReflogOperationDelete, "bb", ""},
+	}
+	var inserted []*models.BranchReflog
+	for i, entry := range entries {
+		oldHash, err := hash.FromHex(entry.old)
+		require.NoError(t, err)
+		newHash, err := hash.FromHex(entry.new)
+		require.NoError(t, err)
+		reflog, err := reflogRepo.Insert(ctx, &models.BranchReflog{
+			RepositoryID: repoID,
+			BranchID:     branchID,
+			BranchName:   "feat",
+			OldHash:      oldHash,
+			NewHash:      newHash,
+			Operation:    entry.operation,
+			OperatorID:   uuid.New(),
+			CreatedAt:    now.Add(time.Duration(i) * time.Second),
+		})
+		require.NoError(t, err)
+		inserted = append(inserted, reflog)
+	}
+
+	actual, err := reflogRepo.Get(ctx, models.NewGetBranchReflogParams().SetID(inserted[1].ID).SetRepositoryID(repoID))
+	require.NoError(t, err)
+	require.Equal(t, "bb", actual.NewHash.Hex())
+	require.Equal(t, models.ReflogOperationCommit, actual.Operation)
+
+	_, err = reflogRepo.Get(ctx, models.NewGetBranchReflogParams().SetID(inserted[1].ID).SetRepositoryID(uuid.New()))
+	require.ErrorIs(t, err, models.ErrNotFound)
+
+	reflogs, hasMore, err := reflogRepo.List(ctx, models.NewListBranchReflogParams().SetRepositoryID(repoID).SetBranchName("feat").SetAmount(2))
+	require.NoError(t, err)
+	require.True(t, hasMore)
+	require.Len(t, reflogs, 2)
+	require.Equal(t, inserted[2].ID, reflogs[0].ID)
+	require.Equal(t, inserted[1].ID, reflogs[1].ID)
+
+	reflogs, hasMore, err = reflogRepo.List(ctx, models.NewListBranchReflogParams().SetRepositoryID(repoID).SetBranchName("feat").SetAfter(reflogs[1].CreatedAt).SetAmount(2))
+	require.NoError(t, err)
+	require.False(t, hasMore)
+	require.Len(t, reflogs, 1)
+	require.Equal(t, inserted[0].ID, reflogs[0].ID)
+
+	reflogs, _, err = reflogRepo.List(ctx, models.NewListBranchReflogParams().SetRepositoryID(repoID).SetOperation(models.ReflogOperationDelete))
+	require.NoError(t, err)
+	require.Len(t, reflogs, 1)
+	require.True(t, reflogs[0].NewHash.IsEmpty())
+
+	deleted, err := reflogRepo.Delete(ctx, models.NewDeleteBranchReflogParams().SetRepositoryID(repoID))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), deleted)
+}
@@ -44,6 +44,11 @@ func TestRefRepoInsert(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, mockHash, branchAfterUpdated.CommitHash)
 
+	err = repo.UpdateByID(ctx, models.NewUpdateBranchParams(newBranch.ID).SetCommitHash(hash.Hash("other hash")).SetExpectCommitHash(hash.Hash("stale hash")))
+	require.ErrorIs(t, err, models.ErrBranchHeadChanged)
+	err = repo.UpdateByID(ctx, models.NewUpdateBranchParams(newBranch.ID).SetCommitHash(mockHash).SetExpectCommitHash(mockHash))
+	require.NoError(t, err)
+
 	list, _, err := repo.List(ctx, models.NewListBranchParams().SetRepositoryID(branch.RepositoryID))
 	require.NoError(t, err)
 	require.Len(t, list, 1)
@@ -2,6 +2,7 @@ package models
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/GitDataAI/jiaozifs/utils/hash"
@@ -38,6 +39,8 @@ type Commit struct {
 	TreeHash hash.Hash `bun:"tree_hash,type:bytea,notnull" json:"tree_hash"`
 	// ParentHashes are the hashes of the parent commits of the commit.
 	ParentHashes []hash.Hash `bun:"parent_hashes,type:bytea[]" json:"parent_hashes"`
+	// Metadata arbitrary key values to track lineage of commit, eg. pipeline_run_id, source_uri
+	Metadata map[string]string `bun:"metadata,type:jsonb" json:"metadata,omitempty"`
 
 	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
 	UpdatedAt time.Time `bun:"updated_at,type:timestamp,notnull" json:"updated_at"`
@@ -101,15 +104,88 @@ func (commit *Commit) GetHash() (hash.Hash, error) {
 		}
 	}
 
+	//hash metadata only if present to keep hash of commit without metadata unchanged, keys are sorted and each
+	//key and value is prefixed with its length so that pairs could not be shifted
+	if len(commit.Metadata) > 0 {
+		keys := make([]string, 0, len(commit.Metadata))
+		for key := range commit.Metadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		err = hasher.WriteUint32(uint32(len(keys)))
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			for _, value := range []string{key, commit.Metadata[key]} {
+				err = hasher.WriteUint32(uint32(len(value)))
+				if err != nil {
+					return nil, err
+				}
+				err = hasher.WriteString(value)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
 	return hasher.Md5.Sum(nil), nil
 }
 
+// MatchMetadata check whether commit has all key values of filter
+func (commit *Commit) MatchMetadata(filter map[string]string) bool {
+	for key, value := range filter {
+		actual, ok := commit.Metadata[key]
+		if !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
 func (commit *Commit) NumParents() int {
 	return len(commit.ParentHashes)
 }
 
+type ListCommitParams struct {
+	hashes       []hash.Hash
+	after        hash.Hash
+	updatedAfter *time.Time
+	amount       int
+}
+
+func NewListCommitParams() *ListCommitParams {
+	return &ListCommitParams{}
+}
+
+func (params *ListCommitParams) SetHashes(hashes ...hash.Hash) *ListCommitParams {
+	params.hashes = hashes
+	return params
+}
+
+// SetAfter only return commits whose hash is greater than after, commits are ordered by hash
+func (params *ListCommitParams) SetAfter(after hash.Hash) *ListCommitParams {
+	params.after = after
+	return params
+}
+
+// SetUpdatedAfter only return commits updated at or after the time
+func (params *ListCommitParams) SetUpdatedAfter(updatedAfter time.Time) *ListCommitParams {
+	params.updatedAfter = &updatedAfter
+	return params
+}
+
+func (params *ListCommitParams) SetAmount(amount int) *ListCommitParams {
+	params.amount = amount
+	return params
+}
+
 type DeleteParams struct {
-	hash hash.Hash
+	hash          hash.Hash
+	hashes        []hash.Hash
+	updatedBefore *time.Time
 }
 
 func NewDeleteParams() *DeleteParams {
@@ -121,10 +197,25 @@ func (params *DeleteParams) SetHash(hash hash.Hash) *DeleteParams {
 	return params
 }
 
+func (params *DeleteParams) SetHashes(hashes ...hash.Hash) *DeleteParams {
+	params.hashes = hashes
+	return params
+}
+
+// SetUpdatedBefore only delete commits updated before the time
+func (params *DeleteParams) SetUpdatedBefore(updatedBefore time.Time) *DeleteParams {
+	params.updatedBefore = &updatedBefore
+	return params
+}
+
 type ICommitRepo interface {
 	RepositoryID() uuid.UUID
 	Commit(ctx context.Context, hash hash.Hash) (*Commit, error)
 	Insert(ctx context.Context, commit *Commit) (*Commit, error)
+	// ListPage return commits ordered by hash, pass hash of last commit to SetAfter to get next page
+	ListPage(ctx context.Context, params *ListCommitParams) ([]*Commit, error)
+	// ListByPrefix return commits whose hex hash start with prefix, prefix must be lower case hex
+	ListByPrefix(ctx context.Context, prefix string, limit int) ([]*Commit, error)
 	Delete(ctx context.Context, params *DeleteParams) (int64, error)
 }
 type CommitRepo struct {
@@ -165,11 +256,53 @@ func (cr CommitRepo) Insert(ctx context.Context, commit *Commit) (*Commit, error
 	return commit, nil
 }
 
+func (cr CommitRepo) ListPage(ctx context.Context, params *ListCommitParams) ([]*Commit, error) {
+	var commits []*Commit
+	query := cr.db.NewSelect().Model(&commits).Where("repository_id = ?", cr.repositoryID)
+	if len(params.hashes) > 0 {
+		query = query.Where("hash IN (?)", bun.In(params.hashes))
+	}
+	if params.after != nil {
+		query = query.Where("hash > ?", params.after)
+	}
+	if params.updatedAfter != nil {
+		query = query.Where("updated_at >= ?", *params.updatedAfter)
+	}
+	query = query.Order("hash ASC")
+	if params.amount > 0 {
+		query = query.Limit(params.amount)
+	}
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return commits, nil
+}
+
+func (cr CommitRepo) ListByPrefix(ctx context.Context, prefix string, limit int) ([]*Commit, error) {
+	var commits []*Commit
+	err := cr.db.NewSelect().Model(&commits).
+		Where("repository_id = ?", cr.repositoryID).
+		Where("encode(hash, 'hex') LIKE ?", prefix+"%").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return commits, nil
+}
+
 func (cr CommitRepo) Delete(ctx context.Context, params *DeleteParams) (int64, error) {
 	query := cr.db.NewDelete().Model((*Commit)(nil)).Where("repository_id = ?", cr.repositoryID)
 	if params.hash != nil {
 		query = query.Where("hash = ?", params.hash)
 	}
+	if len(params.hashes) > 0 {
+		query = query.Where("hash IN (?)", bun.In(params.hashes))
+	}
+	if params.updatedBefore != nil {
+		query = query.Where("updated_at < ?", *params.updatedBefore)
+	}
 
 	sqlResult, err := query.Exec(ctx)
 	if err != nil {
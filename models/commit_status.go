@@ -0,0 +1,99 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type CommitStatusState int
+
+const (
+	CommitStatusPending CommitStatusState = 1
+	CommitStatusSuccess CommitStatusState = 2
+	CommitStatusFailure CommitStatusState = 3
+	CommitStatusError   CommitStatusState = 4
+)
+
+// CommitStatus result reported by external system(eg. ci, data validation job) on commit, identified by context,
+// report again with the same context replace the old one
+type CommitStatus struct {
+	bun.BaseModel `bun:"table:commit_statuses"`
+	ID            uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+	RepositoryID  uuid.UUID `bun:"repository_id,type:uuid,unique:repo_commit_context,notnull" json:"repository_id"`
+	CommitHash    hash.Hash `bun:"commit_hash,type:bytea,unique:repo_commit_context,notnull" json:"commit_hash"`
+	// Context identify the system reported the status, eg. ci/build, data/schema-check
+	Context     string            `bun:"context,unique:repo_commit_context,notnull" json:"context"`
+	State       CommitStatusState `bun:"state,notnull" json:"state"`
+	Description *string           `bun:"description" json:"description"`
+	TargetURL   *string           `bun:"target_url" json:"target_url"`
+	CreatorID   uuid.UUID         `bun:"creator_id,type:uuid,notnull" json:"creator_id"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at,type:timestamp,notnull" json:"updated_at"`
+}
+
+// CombineCommitStatus aggregate statuses of commit, failure if any status failed or errored, pending if any status is
+// pending or no status reported, otherwise success
+func CombineCommitStatus(statuses []*CommitStatus) CommitStatusState {
+	if len(statuses) == 0 {
+		return CommitStatusPending
+	}
+
+	state := CommitStatusSuccess
+	for _, status := range statuses {
+		switch status.State {
+		case CommitStatusFailure, CommitStatusError:
+			return CommitStatusFailure
+		case CommitStatusPending:
+			state = CommitStatusPending
+		}
+	}
+	return state
+}
+
+type ICommitStatusRepo interface {
+	// Save insert status or replace status with the same context on commit
+	Save(ctx context.Context, status *CommitStatus) (*CommitStatus, error)
+	// List return statuses of commit order by context
+	List(ctx context.Context, repositoryID uuid.UUID, commitHash hash.Hash) ([]*CommitStatus, error)
+}
+
+var _ ICommitStatusRepo = (*CommitStatusRepo)(nil)
+
+type CommitStatusRepo struct {
+	db bun.IDB
+}
+
+func NewCommitStatusRepo(db bun.IDB) ICommitStatusRepo {
+	return &CommitStatusRepo{db: db}
+}
+
+func (c CommitStatusRepo) Save(ctx context.Context, status *CommitStatus) (*CommitStatus, error) {
+	_, err := c.db.NewInsert().Model(status).
+		On("CONFLICT (repository_id, commit_hash, context) DO UPDATE").
+		Set("state = EXCLUDED.state").
+		Set("description = EXCLUDED.description").
+		Set("target_url = EXCLUDED.target_url").
+		Set("creator_id = EXCLUDED.creator_id").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (c CommitStatusRepo) List(ctx context.Context, repositoryID uuid.UUID, commitHash hash.Hash) ([]*CommitStatus, error) {
+	var statuses []*CommitStatus
+	err := c.db.NewSelect().Model(&statuses).
+		Where("repository_id = ?", repositoryID).
+		Where("commit_hash = ?", commitHash).
+		Order("context ASC").
+		Scan(ctx)
+	return statuses, err
+}
@@ -0,0 +1,70 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+)
+
+func TestCommitStatusRepo(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	statusRepo := models.NewCommitStatusRepo(db)
+	repoID := uuid.New()
+	commitHash := hash.Hash("commit")
+
+	for _, state := range []models.CommitStatusState{models.CommitStatusPending, models.CommitStatusSuccess} {
+		_, err := statusRepo.Save(ctx, &models.CommitStatus{
+			RepositoryID: repoID,
+			CommitHash:   commitHash,
+			Context:      "ci/build",
+			State:        state,
+			CreatorID:    uuid.New(),
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := statusRepo.Save(ctx, &models.CommitStatus{
+		RepositoryID: repoID,
+		CommitHash:   commitHash,
+		Context:      "data/schema",
+		State:        models.CommitStatusFailure,
+		Description:  utils.String("column missing"),
+		CreatorID:    uuid.New(),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	})
+	require.NoError(t, err)
+
+	statuses, err := statusRepo.List(ctx, repoID, commitHash)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	require.Equal(t, "ci/build", statuses[0].Context)
+	require.Equal(t, models.CommitStatusSuccess, statuses[0].State)
+
+	statuses, err = statusRepo.List(ctx, repoID, hash.Hash("other"))
+	require.NoError(t, err)
+	require.Len(t, statuses, 0)
+}
+
+func TestCombineCommitStatus(t *testing.T) {
+	status := func(state models.CommitStatusState) *models.CommitStatus {
+		return &models.CommitStatus{State: state}
+	}
+	require.Equal(t, models.CommitStatusPending, models.CombineCommitStatus(nil))
+	require.Equal(t, models.CommitStatusSuccess, models.CombineCommitStatus([]*models.CommitStatus{status(models.CommitStatusSuccess)}))
+	require.Equal(t, models.CommitStatusPending, models.CombineCommitStatus([]*models.CommitStatus{status(models.CommitStatusSuccess), status(models.CommitStatusPending)}))
+	require.Equal(t, models.CommitStatusFailure, models.CombineCommitStatus([]*models.CommitStatus{status(models.CommitStatusPending), status(models.CommitStatusError)}))
+}
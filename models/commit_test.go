@@ -31,6 +31,21 @@ func TestCommitRepo(t *testing.T) {
 
 	require.True(t, cmp.Equal(commitModel, newCommitModel, testhelper.DBTimeCmpOpt))
 
+	t.Run("list by prefix", func(t *testing.T) {
+		prefix := commitModel.Hash.Hex()
+		if len(prefix) > 6 {
+			prefix = prefix[:6]
+		}
+		commits, err := commitRepo.ListByPrefix(ctx, prefix, 2)
+		require.NoError(t, err)
+		require.Len(t, commits, 1)
+		require.Equal(t, commitModel.Hash.Hex(), commits[0].Hash.Hex())
+
+		commits, err = commitRepo.ListByPrefix(ctx, "zz", 2)
+		require.NoError(t, err)
+		require.Len(t, commits, 0)
+	})
+
 	t.Run("mis match repo id", func(t *testing.T) {
 		mistMatchModel := &models.Commit{}
 		require.NoError(t, gofakeit.Struct(mistMatchModel))
@@ -75,3 +90,38 @@ func TestDeleteCommit(t *testing.T) {
 		require.Equal(t, int64(5), affectRows)
 	})
 }
+
+func TestCommitMetadataHash(t *testing.T) {
+	commit := &models.Commit{Message: "train model"}
+	plainHash, err := commit.GetHash()
+	require.NoError(t, err)
+
+	commit.Metadata = map[string]string{}
+	emptyHash, err := commit.GetHash()
+	require.NoError(t, err)
+	require.Equal(t, plainHash.Hex(), emptyHash.Hex())
+
+	commit.Metadata = map[string]string{"pipeline_run_id": "42", "source_uri": "s3://bucket/a"}
+	metaHash, err := commit.GetHash()
+	require.NoError(t, err)
+	require.NotEqual(t, plainHash.Hex(), metaHash.Hex())
+
+	commit.Metadata = map[string]string{"pipeline_run_id": "42", "source_uri": "s3://bucket/b"}
+	changedHash, err := commit.GetHash()
+	require.NoError(t, err)
+	require.NotEqual(t, metaHash.Hex(), changedHash.Hex())
+
+	//shifting bytes between key and value must change hash
+	commit.Metadata = map[string]string{"ab": "c"}
+	shiftHash1, err := commit.GetHash()
+	require.NoError(t, err)
+	commit.Metadata = map[string]string{"a": "bc"}
+	shiftHash2, err := commit.GetHash()
+	require.NoError(t, err)
+	require.NotEqual(t, shiftHash1.Hex(), shiftHash2.Hex())
+
+	require.True(t, commit.MatchMetadata(map[string]string{"a": "bc"}))
+	require.True(t, commit.MatchMetadata(nil))
+	require.False(t, commit.MatchMetadata(map[string]string{"a": "b"}))
+	require.False(t, commit.MatchMetadata(map[string]string{"x": ""}))
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// MergeApprovalRule approvals required before merge request of repository can be merged
+type MergeApprovalRule struct {
+	bun.BaseModel `bun:"table:merge_approval_rules"`
+	RepositoryID  uuid.UUID `bun:"repository_id,pk,type:uuid,notnull" json:"repository_id"`
+	// RequiredApprovals number of approvals required
+	RequiredApprovals int `bun:"required_approvals,notnull" json:"required_approvals"`
+	// GroupName only approvals from members of the group are counted, approvals from any reviewer are counted if empty
+	GroupName string `bun:"group_name" json:"group_name"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at,type:timestamp,notnull" json:"updated_at"`
+}
+
+type IMergeApprovalRuleRepo interface {
+	Get(ctx context.Context, repositoryID uuid.UUID) (*MergeApprovalRule, error)
+	// Save insert rule or replace rule of the same repository
+	Save(ctx context.Context, rule *MergeApprovalRule) (*MergeApprovalRule, error)
+	Delete(ctx context.Context, repositoryID uuid.UUID) (int64, error)
+}
+
+var _ IMergeApprovalRuleRepo = (*MergeApprovalRuleRepo)(nil)
+
+type MergeApprovalRuleRepo struct {
+	db bun.IDB
+}
+
+func NewMergeApprovalRuleRepo(db bun.IDB) IMergeApprovalRuleRepo {
+	return &MergeApprovalRuleRepo{db: db}
+}
+
+func (m MergeApprovalRuleRepo) Get(ctx context.Context, repositoryID uuid.UUID) (*MergeApprovalRule, error) {
+	rule := &MergeApprovalRule{}
+	return rule, m.db.NewSelect().Model(rule).Where("repository_id = ?", repositoryID).Limit(1).Scan(ctx)
+}
+
+func (m MergeApprovalRuleRepo) Save(ctx context.Context, rule *MergeApprovalRule) (*MergeApprovalRule, error) {
+	_, err := m.db.NewInsert().Model(rule).
+		On("CONFLICT (repository_id) DO UPDATE").
+		Set("required_approvals = EXCLUDED.required_approvals").
+		Set("group_name = EXCLUDED.group_name").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (m MergeApprovalRuleRepo) Delete(ctx context.Context, repositoryID uuid.UUID) (int64, error) {
+	sqlResult, err := m.db.NewDelete().Model((*MergeApprovalRule)(nil)).Where("repository_id = ?", repositoryID).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return sqlResult.RowsAffected()
+}
@@ -16,6 +16,22 @@ const (
 	MergeStateClosed MergeState = 3
 )
 
+// MergeMethod how changes of source branch are merged into target branch
+type MergeMethod string
+
+const (
+	// MergeMethodMerge create merge commit with parents of both branches
+	MergeMethodMerge MergeMethod = "merge"
+	// MergeMethodSquash create one commit with merged tree and target branch as the only parent
+	MergeMethodSquash MergeMethod = "squash"
+	// MergeMethodFastForwardOnly move target branch to source branch, refuse if target branch is not ancestor of source
+	MergeMethodFastForwardOnly MergeMethod = "fast-forward-only"
+)
+
+func (method MergeMethod) Valid() bool {
+	return method == MergeMethodMerge || method == MergeMethodSquash || method == MergeMethodFastForwardOnly
+}
+
 type MergeRequest struct {
 	bun.BaseModel `bun:"table:merge_requests"`
 	ID            uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
@@ -28,6 +44,8 @@ type MergeRequest struct {
 	Title          string     `bun:"title,notnull" json:"title"`
 	MergeState     MergeState `bun:"merge_state,notnull" json:"merge_state"`
 	Description    *string    `bun:"description" json:"description"`
+	// MergeMethod method used to merge, empty before merged
+	MergeMethod MergeMethod `bun:"merge_method" json:"merge_method"`
 
 	AuthorID uuid.UUID `bun:"author_id,type:bytea,notnull" json:"author_id"`
 
@@ -104,6 +122,7 @@ type UpdateMergeRequestParams struct {
 	title       *string
 	description *string
 	state       *MergeState
+	mergeMethod *MergeMethod
 }
 
 func NewUpdateMergeRequestParams(targetRepoID uuid.UUID, sequence uint64) *UpdateMergeRequestParams {
@@ -129,6 +148,11 @@ func (u *UpdateMergeRequestParams) SetState(state MergeState) *UpdateMergeReques
 	return u
 }
 
+func (u *UpdateMergeRequestParams) SetMergeMethod(mergeMethod MergeMethod) *UpdateMergeRequestParams {
+	u.mergeMethod = &mergeMethod
+	return u
+}
+
 type ListMergeRequestParams struct {
 	after        *time.Time
 	amount       int
@@ -280,6 +304,9 @@ func (m MergeRequestRepo) UpdateByID(ctx context.Context, updateModel *UpdateMer
 	if updateModel.state != nil {
 		updateQuery.Set("merge_state = ?", *updateModel.state)
 	}
+	if updateModel.mergeMethod != nil {
+		updateQuery.Set("merge_method = ?", *updateModel.mergeMethod)
+	}
 	_, err := updateQuery.Exec(ctx)
 	return err
 }
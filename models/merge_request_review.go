@@ -0,0 +1,217 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ReviewState int
+
+const (
+	ReviewStateApproved         ReviewState = 1
+	ReviewStateChangesRequested ReviewState = 2
+)
+
+// MergeRequestReviewer user requested to review merge request
+type MergeRequestReviewer struct {
+	bun.BaseModel  `bun:"table:merge_request_reviewers"`
+	MergeRequestID uuid.UUID `bun:"merge_request_id,pk,type:uuid,notnull" json:"merge_request_id"`
+	ReviewerID     uuid.UUID `bun:"reviewer_id,pk,type:uuid,notnull" json:"reviewer_id"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+}
+
+// MergeRequestReview latest review of reviewer on merge request, review again replace the old one
+type MergeRequestReview struct {
+	bun.BaseModel  `bun:"table:merge_request_reviews"`
+	ID             uuid.UUID   `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+	MergeRequestID uuid.UUID   `bun:"merge_request_id,type:uuid,unique:mr_reviewer,notnull" json:"merge_request_id"`
+	ReviewerID     uuid.UUID   `bun:"reviewer_id,type:uuid,unique:mr_reviewer,notnull" json:"reviewer_id"`
+	State          ReviewState `bun:"state,notnull" json:"state"`
+	Message        *string     `bun:"message" json:"message"`
+	// CommitHash head of source branch when reviewed, review is dismissed once source branch moved to other commit
+	CommitHash hash.Hash `bun:"commit_hash,type:bytea" json:"commit_hash"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at,type:timestamp,notnull" json:"updated_at"`
+}
+
+// MergeRequestComment comment of merge request, comment with parent is a reply in thread of parent,
+// comment with path is anchored to the path in diff of merge request
+type MergeRequestComment struct {
+	bun.BaseModel  `bun:"table:merge_request_comments"`
+	ID             uuid.UUID  `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+	MergeRequestID uuid.UUID  `bun:"merge_request_id,type:uuid,notnull" json:"merge_request_id"`
+	AuthorID       uuid.UUID  `bun:"author_id,type:uuid,notnull" json:"author_id"`
+	ParentID       *uuid.UUID `bun:"parent_id,type:uuid" json:"parent_id"`
+	Path           *string    `bun:"path" json:"path"`
+	Content        string     `bun:"content,notnull" json:"content"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at,type:timestamp,notnull" json:"updated_at"`
+}
+
+type GetMergeRequestCommentParams struct {
+	id             uuid.UUID
+	mergeRequestID uuid.UUID
+}
+
+func NewGetMergeRequestCommentParams() *GetMergeRequestCommentParams {
+	return &GetMergeRequestCommentParams{}
+}
+
+func (gmc *GetMergeRequestCommentParams) SetID(id uuid.UUID) *GetMergeRequestCommentParams {
+	gmc.id = id
+	return gmc
+}
+
+func (gmc *GetMergeRequestCommentParams) SetMergeRequestID(mergeRequestID uuid.UUID) *GetMergeRequestCommentParams {
+	gmc.mergeRequestID = mergeRequestID
+	return gmc
+}
+
+type DeleteMergeRequestCommentParams struct {
+	id             uuid.UUID
+	mergeRequestID uuid.UUID
+}
+
+func NewDeleteMergeRequestCommentParams() *DeleteMergeRequestCommentParams {
+	return &DeleteMergeRequestCommentParams{}
+}
+
+func (dmc *DeleteMergeRequestCommentParams) SetID(id uuid.UUID) *DeleteMergeRequestCommentParams {
+	dmc.id = id
+	return dmc
+}
+
+func (dmc *DeleteMergeRequestCommentParams) SetMergeRequestID(mergeRequestID uuid.UUID) *DeleteMergeRequestCommentParams {
+	dmc.mergeRequestID = mergeRequestID
+	return dmc
+}
+
+type IMergeRequestReviewRepo interface {
+	// AddReviewer add reviewer to merge request, do nothing if reviewer already added
+	AddReviewer(ctx context.Context, reviewer *MergeRequestReviewer) error
+	RemoveReviewer(ctx context.Context, mergeRequestID uuid.UUID, reviewerID uuid.UUID) (int64, error)
+	ListReviewers(ctx context.Context, mergeRequestID uuid.UUID) ([]*MergeRequestReviewer, error)
+
+	// SaveReview insert review or replace review of the same reviewer
+	SaveReview(ctx context.Context, review *MergeRequestReview) (*MergeRequestReview, error)
+	ListReviews(ctx context.Context, mergeRequestID uuid.UUID) ([]*MergeRequestReview, error)
+
+	InsertComment(ctx context.Context, comment *MergeRequestComment) (*MergeRequestComment, error)
+	GetComment(ctx context.Context, params *GetMergeRequestCommentParams) (*MergeRequestComment, error)
+	// ListComments return comments of merge request order by created time
+	ListComments(ctx context.Context, mergeRequestID uuid.UUID) ([]*MergeRequestComment, error)
+	// DeleteComment remove comment and replies of it
+	DeleteComment(ctx context.Context, params *DeleteMergeRequestCommentParams) (int64, error)
+}
+
+var _ IMergeRequestReviewRepo = (*MergeRequestReviewRepo)(nil)
+
+type MergeRequestReviewRepo struct {
+	db bun.IDB
+}
+
+func NewMergeRequestReviewRepo(db bun.IDB) IMergeRequestReviewRepo {
+	return &MergeRequestReviewRepo{db: db}
+}
+
+func (m MergeRequestReviewRepo) AddReviewer(ctx context.Context, reviewer *MergeRequestReviewer) error {
+	_, err := m.db.NewInsert().Model(reviewer).On("CONFLICT (merge_request_id, reviewer_id) DO NOTHING").Exec(ctx)
+	return err
+}
+
+func (m MergeRequestReviewRepo) RemoveReviewer(ctx context.Context, mergeRequestID uuid.UUID, reviewerID uuid.UUID) (int64, error) {
+	sqlResult, err := m.db.NewDelete().Model((*MergeRequestReviewer)(nil)).
+		Where("merge_request_id = ?", mergeRequestID).
+		Where("reviewer_id = ?", reviewerID).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return sqlResult.RowsAffected()
+}
+
+func (m MergeRequestReviewRepo) ListReviewers(ctx context.Context, mergeRequestID uuid.UUID) ([]*MergeRequestReviewer, error) {
+	var reviewers []*MergeRequestReviewer
+	err := m.db.NewSelect().Model(&reviewers).
+		Where("merge_request_id = ?", mergeRequestID).
+		Order("created_at ASC").
+		Scan(ctx)
+	return reviewers, err
+}
+
+func (m MergeRequestReviewRepo) SaveReview(ctx context.Context, review *MergeRequestReview) (*MergeRequestReview, error) {
+	_, err := m.db.NewInsert().Model(review).
+		On("CONFLICT (merge_request_id, reviewer_id) DO UPDATE").
+		Set("state = EXCLUDED.state").
+		Set("message = EXCLUDED.message").
+		Set("commit_hash = EXCLUDED.commit_hash").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func (m MergeRequestReviewRepo) ListReviews(ctx context.Context, mergeRequestID uuid.UUID) ([]*MergeRequestReview, error) {
+	var reviews []*MergeRequestReview
+	err := m.db.NewSelect().Model(&reviews).
+		Where("merge_request_id = ?", mergeRequestID).
+		Order("updated_at ASC").
+		Scan(ctx)
+	return reviews, err
+}
+
+func (m MergeRequestReviewRepo) InsertComment(ctx context.Context, comment *MergeRequestComment) (*MergeRequestComment, error) {
+	_, err := m.db.NewInsert().Model(comment).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+func (m MergeRequestReviewRepo) GetComment(ctx context.Context, params *GetMergeRequestCommentParams) (*MergeRequestComment, error) {
+	comment := &MergeRequestComment{}
+	query := m.db.NewSelect().Model(comment)
+
+	if uuid.Nil != params.id {
+		query = query.Where("id = ?", params.id)
+	}
+
+	if uuid.Nil != params.mergeRequestID {
+		query = query.Where("merge_request_id = ?", params.mergeRequestID)
+	}
+	return comment, query.Limit(1).Scan(ctx)
+}
+
+func (m MergeRequestReviewRepo) ListComments(ctx context.Context, mergeRequestID uuid.UUID) ([]*MergeRequestComment, error) {
+	var comments []*MergeRequestComment
+	err := m.db.NewSelect().Model(&comments).
+		Where("merge_request_id = ?", mergeRequestID).
+		Order("created_at ASC").
+		Scan(ctx)
+	return comments, err
+}
+
+func (m MergeRequestReviewRepo) DeleteComment(ctx context.Context, params *DeleteMergeRequestCommentParams) (int64, error) {
+	query := m.db.NewDelete().Model((*MergeRequestComment)(nil)).
+		WhereGroup(" AND ", func(query *bun.DeleteQuery) *bun.DeleteQuery {
+			return query.Where("id = ?", params.id).WhereOr("parent_id = ?", params.id)
+		})
+	if uuid.Nil != params.mergeRequestID {
+		query = query.Where("merge_request_id = ?", params.mergeRequestID)
+	}
+	sqlResult, err := query.Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return sqlResult.RowsAffected()
+}
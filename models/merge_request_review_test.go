@@ -0,0 +1,136 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+)
+
+func TestMergeRequestReviewRepo(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	reviewRepo := models.NewMergeRequestReviewRepo(db)
+	mrID := uuid.New()
+
+	t.Run("reviewers", func(t *testing.T) {
+		reviewerID := uuid.New()
+		for i := 0; i < 2; i++ {
+			err := reviewRepo.AddReviewer(ctx, &models.MergeRequestReviewer{MergeRequestID: mrID, ReviewerID: reviewerID, CreatedAt: time.Now()})
+			require.NoError(t, err)
+		}
+		reviewers, err := reviewRepo.ListReviewers(ctx, mrID)
+		require.NoError(t, err)
+		require.Len(t, reviewers, 1)
+
+		affectRows, err := reviewRepo.RemoveReviewer(ctx, mrID, reviewerID)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), affectRows)
+	})
+
+	t.Run("save review", func(t *testing.T) {
+		reviewerID := uuid.New()
+		_, err := reviewRepo.SaveReview(ctx, &models.MergeRequestReview{
+			MergeRequestID: mrID,
+			ReviewerID:     reviewerID,
+			State:          models.ReviewStateChangesRequested,
+			Message:        utils.String("need fix"),
+			CommitHash:     hash.Hash("aa"),
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		})
+		require.NoError(t, err)
+
+		_, err = reviewRepo.SaveReview(ctx, &models.MergeRequestReview{
+			MergeRequestID: mrID,
+			ReviewerID:     reviewerID,
+			State:          models.ReviewStateApproved,
+			CommitHash:     hash.Hash("bb"),
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		})
+		require.NoError(t, err)
+
+		reviews, err := reviewRepo.ListReviews(ctx, mrID)
+		require.NoError(t, err)
+		require.Len(t, reviews, 1)
+		require.Equal(t, models.ReviewStateApproved, reviews[0].State)
+		require.Nil(t, reviews[0].Message)
+		require.Equal(t, hash.Hash("bb"), reviews[0].CommitHash)
+	})
+
+	t.Run("comments", func(t *testing.T) {
+		comment, err := reviewRepo.InsertComment(ctx, &models.MergeRequestComment{
+			MergeRequestID: mrID,
+			AuthorID:       uuid.New(),
+			Path:           utils.String("a/b.txt"),
+			Content:        "why change this",
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		})
+		require.NoError(t, err)
+		require.NotEqual(t, uuid.Nil, comment.ID)
+
+		_, err = reviewRepo.InsertComment(ctx, &models.MergeRequestComment{
+			MergeRequestID: mrID,
+			AuthorID:       uuid.New(),
+			ParentID:       &comment.ID,
+			Content:        "fix typo",
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		})
+		require.NoError(t, err)
+
+		actualComment, err := reviewRepo.GetComment(ctx, models.NewGetMergeRequestCommentParams().SetID(comment.ID).SetMergeRequestID(mrID))
+		require.NoError(t, err)
+		require.Equal(t, "a/b.txt", utils.StringValue(actualComment.Path))
+
+		comments, err := reviewRepo.ListComments(ctx, mrID)
+		require.NoError(t, err)
+		require.Len(t, comments, 2)
+
+		affectRows, err := reviewRepo.DeleteComment(ctx, models.NewDeleteMergeRequestCommentParams().SetID(comment.ID).SetMergeRequestID(mrID))
+		require.NoError(t, err)
+		require.Equal(t, int64(2), affectRows)
+	})
+}
+
+func TestMergeApprovalRuleRepo(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	ruleRepo := models.NewMergeApprovalRuleRepo(db)
+	repoID := uuid.New()
+
+	_, err := ruleRepo.Get(ctx, repoID)
+	require.ErrorIs(t, err, models.ErrNotFound)
+
+	for _, approvals := range []int{1, 2} {
+		_, err = ruleRepo.Save(ctx, &models.MergeApprovalRule{
+			RepositoryID:      repoID,
+			RequiredApprovals: approvals,
+			GroupName:         "RepoAdmin",
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+		})
+		require.NoError(t, err)
+	}
+
+	rule, err := ruleRepo.Get(ctx, repoID)
+	require.NoError(t, err)
+	require.Equal(t, 2, rule.RequiredApprovals)
+
+	affectRows, err := ruleRepo.Delete(ctx, repoID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affectRows)
+}
@@ -94,7 +94,8 @@ func TestMergeRequestRepoInsert(t *testing.T) {
 		updateMrParams := models.NewUpdateMergeRequestParams(newMrModel.TargetRepoID, newMrModel.Sequence).
 			SetTitle("Merge: xxxx").
 			SetDescription("test update").
-			SetState(models.MergeStateClosed)
+			SetState(models.MergeStateClosed).
+			SetMergeMethod(models.MergeMethodSquash)
 
 		err = mrRepo.UpdateByID(ctx, updateMrParams)
 		require.NoError(t, err)
@@ -108,5 +109,6 @@ func TestMergeRequestRepoInsert(t *testing.T) {
 		require.Equal(t, "test update", *mrModel.Description)
 
 		require.Equal(t, models.MergeStateClosed, mrModel.MergeState)
+		require.Equal(t, models.MergeMethodSquash, mrModel.MergeMethod)
 	})
 }
@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		//tables created by init migration already contain these columns
+		_, err := db.ExecContext(ctx, `ALTER TABLE repositories ADD COLUMN IF NOT EXISTS chunked_storage BOOLEAN NOT NULL DEFAULT FALSE`)
+		if err != nil {
+			return err
+		}
+		_, err = db.ExecContext(ctx, `ALTER TABLE trees ADD COLUMN IF NOT EXISTS chunks JSONB`)
+		return err
+	}, nil)
+}
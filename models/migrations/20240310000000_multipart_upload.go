@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*models.MultipartUpload)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.NewCreateTable().
+			Model((*models.MultipartUploadPart)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		return err
+	}, nil)
+}
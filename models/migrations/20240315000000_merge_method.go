@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewAddColumn().
+			Model((*models.MergeRequest)(nil)).
+			IfNotExists().
+			ColumnExpr("merge_method TEXT").
+			Exec(ctx)
+		return err
+	}, nil)
+}
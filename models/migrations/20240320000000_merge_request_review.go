@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*models.MergeRequestReviewer)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.NewCreateTable().
+			Model((*models.MergeRequestReview)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.NewCreateTable().
+			Model((*models.MergeRequestComment)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.NewCreateIndex().
+			Model((*models.MergeRequestComment)(nil)).
+			Index("merge_request_comment_mr_idx").
+			IfNotExists().
+			Column("merge_request_id").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.NewCreateTable().
+			Model((*models.MergeApprovalRule)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		return err
+	}, nil)
+}
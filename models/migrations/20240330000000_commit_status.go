@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*models.CommitStatus)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		return err
+	}, nil)
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*models.Webhook)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.NewCreateIndex().
+			Model((*models.Webhook)(nil)).
+			Index("webhook_repository_idx").
+			IfNotExists().
+			Column("repository_id").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.NewCreateTable().
+			Model((*models.WebhookDelivery)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.NewCreateIndex().
+			Model((*models.WebhookDelivery)(nil)).
+			Index("webhook_delivery_webhook_idx").
+			IfNotExists().
+			Column("webhook_id").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.NewCreateIndex().
+			Model((*models.WebhookDelivery)(nil)).
+			Index("webhook_delivery_pending_idx").
+			IfNotExists().
+			Column("state", "next_attempt_at").
+			Exec(ctx)
+		return err
+	}, nil)
+}
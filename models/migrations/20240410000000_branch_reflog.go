@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*models.BranchReflog)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.NewCreateIndex().
+			Model((*models.BranchReflog)(nil)).
+			Index("branch_reflog_branch_idx").
+			IfNotExists().
+			Column("repository_id", "branch_name", "created_at").
+			Exec(ctx)
+		return err
+	}, nil)
+}
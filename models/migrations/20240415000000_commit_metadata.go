@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewAddColumn().
+			Model((*models.Commit)(nil)).
+			IfNotExists().
+			ColumnExpr("metadata JSONB").
+			Exec(ctx)
+		return err
+	}, nil)
+}
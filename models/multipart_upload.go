@@ -0,0 +1,236 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// MultipartUpload session of resumable upload, parts are uploaded to a staging key of repository storage,
+// the object is added to wip of ref when upload completed
+type MultipartUpload struct {
+	bun.BaseModel `bun:"table:multipart_uploads"`
+	ID            uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+	RepositoryID  uuid.UUID `bun:"repository_id,type:uuid,notnull" json:"repository_id"`
+	CreatorID     uuid.UUID `bun:"creator_id,type:uuid,notnull" json:"creator_id"`
+	// RefName branch which object will be added to
+	RefName string `bun:"ref_name,notnull" json:"ref_name"`
+	// Path of object relative to the ref
+	Path string `bun:"path,notnull" json:"path"`
+	// Key staging identifier relative to storage namespace of repository
+	Key string `bun:"key,notnull" json:"key"`
+	// UploadID id of multipart upload return by storage adapter
+	UploadID string `bun:"upload_id,notnull" json:"upload_id"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at,type:timestamp,notnull" json:"updated_at"`
+}
+
+// MultipartUploadPart uploaded part of multipart upload, upload the same part number again replace the old one
+type MultipartUploadPart struct {
+	bun.BaseModel     `bun:"table:multipart_upload_parts"`
+	MultipartUploadID uuid.UUID `bun:"multipart_upload_id,pk,type:uuid,notnull" json:"multipart_upload_id"`
+	PartNumber        int       `bun:"part_number,pk,notnull" json:"part_number"`
+	ETag              string    `bun:"etag,notnull" json:"etag"`
+	Size              int64     `bun:"size,notnull" json:"size"`
+	// CheckSum md5 of part content, used by client to verify part before resuming upload
+	CheckSum hash.Hash `bun:"check_sum,type:bytea" json:"check_sum"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+}
+
+type GetMultipartUploadParams struct {
+	id           uuid.UUID
+	repositoryID uuid.UUID
+	creatorID    uuid.UUID
+}
+
+func NewGetMultipartUploadParams() *GetMultipartUploadParams {
+	return &GetMultipartUploadParams{}
+}
+
+func (gmp *GetMultipartUploadParams) SetID(id uuid.UUID) *GetMultipartUploadParams {
+	gmp.id = id
+	return gmp
+}
+
+func (gmp *GetMultipartUploadParams) SetRepositoryID(repositoryID uuid.UUID) *GetMultipartUploadParams {
+	gmp.repositoryID = repositoryID
+	return gmp
+}
+
+func (gmp *GetMultipartUploadParams) SetCreatorID(creatorID uuid.UUID) *GetMultipartUploadParams {
+	gmp.creatorID = creatorID
+	return gmp
+}
+
+type ListMultipartUploadParams struct {
+	repositoryID uuid.UUID
+	creatorID    uuid.UUID
+	refName      *string
+	path         *string
+}
+
+func NewListMultipartUploadParams() *ListMultipartUploadParams {
+	return &ListMultipartUploadParams{}
+}
+
+func (lmp *ListMultipartUploadParams) SetRepositoryID(repositoryID uuid.UUID) *ListMultipartUploadParams {
+	lmp.repositoryID = repositoryID
+	return lmp
+}
+
+func (lmp *ListMultipartUploadParams) SetCreatorID(creatorID uuid.UUID) *ListMultipartUploadParams {
+	lmp.creatorID = creatorID
+	return lmp
+}
+
+func (lmp *ListMultipartUploadParams) SetRefName(refName string) *ListMultipartUploadParams {
+	lmp.refName = &refName
+	return lmp
+}
+
+func (lmp *ListMultipartUploadParams) SetPath(path string) *ListMultipartUploadParams {
+	lmp.path = &path
+	return lmp
+}
+
+type DeleteMultipartUploadParams struct {
+	id uuid.UUID
+}
+
+func NewDeleteMultipartUploadParams() *DeleteMultipartUploadParams {
+	return &DeleteMultipartUploadParams{}
+}
+
+func (dmp *DeleteMultipartUploadParams) SetID(id uuid.UUID) *DeleteMultipartUploadParams {
+	dmp.id = id
+	return dmp
+}
+
+type IMultipartUploadRepo interface {
+	Insert(ctx context.Context, upload *MultipartUpload) (*MultipartUpload, error)
+	Get(ctx context.Context, params *GetMultipartUploadParams) (*MultipartUpload, error)
+	List(ctx context.Context, params *ListMultipartUploadParams) ([]*MultipartUpload, error)
+	// Delete remove upload and its parts
+	Delete(ctx context.Context, params *DeleteMultipartUploadParams) (int64, error)
+
+	// SavePart insert part or replace part with the same part number
+	SavePart(ctx context.Context, part *MultipartUploadPart) (*MultipartUploadPart, error)
+	// ListParts return parts of upload order by part number
+	ListParts(ctx context.Context, uploadID uuid.UUID) ([]*MultipartUploadPart, error)
+}
+
+var _ IMultipartUploadRepo = (*MultipartUploadRepo)(nil)
+
+type MultipartUploadRepo struct {
+	db bun.IDB
+}
+
+func NewMultipartUploadRepo(db bun.IDB) IMultipartUploadRepo {
+	return &MultipartUploadRepo{db: db}
+}
+
+func (m MultipartUploadRepo) Insert(ctx context.Context, upload *MultipartUpload) (*MultipartUpload, error) {
+	_, err := m.db.NewInsert().Model(upload).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+func (m MultipartUploadRepo) Get(ctx context.Context, params *GetMultipartUploadParams) (*MultipartUpload, error) {
+	upload := &MultipartUpload{}
+	query := m.db.NewSelect().Model(upload)
+
+	if uuid.Nil != params.id {
+		query = query.Where("id = ?", params.id)
+	}
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+
+	if uuid.Nil != params.creatorID {
+		query = query.Where("creator_id = ?", params.creatorID)
+	}
+
+	err := query.Limit(1).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+func (m MultipartUploadRepo) List(ctx context.Context, params *ListMultipartUploadParams) ([]*MultipartUpload, error) {
+	var uploads []*MultipartUpload
+	query := m.db.NewSelect().Model(&uploads)
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+
+	if uuid.Nil != params.creatorID {
+		query = query.Where("creator_id = ?", params.creatorID)
+	}
+
+	if params.refName != nil {
+		query = query.Where("ref_name = ?", *params.refName)
+	}
+
+	if params.path != nil {
+		query = query.Where("path = ?", *params.path)
+	}
+
+	err := query.Order("created_at DESC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uploads, nil
+}
+
+func (m MultipartUploadRepo) Delete(ctx context.Context, params *DeleteMultipartUploadParams) (int64, error) {
+	_, err := m.db.NewDelete().Model((*MultipartUploadPart)(nil)).Where("multipart_upload_id = ?", params.id).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sqlResult, err := m.db.NewDelete().Model((*MultipartUpload)(nil)).Where("id = ?", params.id).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	affectedRows, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return affectedRows, err
+}
+
+func (m MultipartUploadRepo) SavePart(ctx context.Context, part *MultipartUploadPart) (*MultipartUploadPart, error) {
+	_, err := m.db.NewInsert().Model(part).
+		On("CONFLICT (multipart_upload_id, part_number) DO UPDATE").
+		Set("etag = EXCLUDED.etag").
+		Set("size = EXCLUDED.size").
+		Set("check_sum = EXCLUDED.check_sum").
+		Set("created_at = EXCLUDED.created_at").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return part, nil
+}
+
+func (m MultipartUploadRepo) ListParts(ctx context.Context, uploadID uuid.UUID) ([]*MultipartUploadPart, error) {
+	var parts []*MultipartUploadPart
+	err := m.db.NewSelect().Model(&parts).
+		Where("multipart_upload_id = ?", uploadID).
+		Order("part_number ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
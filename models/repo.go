@@ -30,13 +30,21 @@ type IRepo interface {
 	Transaction(ctx context.Context, fn func(repo IRepo) error, opts ...TxOption) error
 	UserRepo() IUserRepo
 	MergeRequestRepo() IMergeRequestRepo
+	MergeRequestReviewRepo() IMergeRequestReviewRepo
+	MergeApprovalRuleRepo() IMergeApprovalRuleRepo
+	BranchProtectionRuleRepo() IBranchProtectionRuleRepo
+	CommitStatusRepo() ICommitStatusRepo
+	WebhookRepo() IWebhookRepo
 	FileTreeRepo(repoID uuid.UUID) IFileTreeRepo
 	CommitRepo(repoID uuid.UUID) ICommitRepo
 	TagRepo() ITagRepo
 	BranchRepo() IBranchRepo
+	BranchReflogRepo() IBranchReflogRepo
 	RepositoryRepo() IRepositoryRepo
 	WipRepo() IWipRepo
 	AkskRepo() IAkskRepo
+	StagingObjectRepo() IStagingObjectRepo
+	MultipartUploadRepo() IMultipartUploadRepo
 
 	MemberRepo() IMemberRepo
 	GroupRepo() rbacmodel.IGroupRepo
@@ -76,6 +84,26 @@ func (repo *PgRepo) MergeRequestRepo() IMergeRequestRepo {
 	return NewMergeRequestRepo(repo.db)
 }
 
+func (repo *PgRepo) MergeRequestReviewRepo() IMergeRequestReviewRepo {
+	return NewMergeRequestReviewRepo(repo.db)
+}
+
+func (repo *PgRepo) MergeApprovalRuleRepo() IMergeApprovalRuleRepo {
+	return NewMergeApprovalRuleRepo(repo.db)
+}
+
+func (repo *PgRepo) BranchProtectionRuleRepo() IBranchProtectionRuleRepo {
+	return NewBranchProtectionRuleRepo(repo.db)
+}
+
+func (repo *PgRepo) CommitStatusRepo() ICommitStatusRepo {
+	return NewCommitStatusRepo(repo.db)
+}
+
+func (repo *PgRepo) WebhookRepo() IWebhookRepo {
+	return NewWebhookRepo(repo.db)
+}
+
 func (repo *PgRepo) FileTreeRepo(repoID uuid.UUID) IFileTreeRepo {
 	return NewFileTree(repo.db, repoID)
 }
@@ -92,6 +120,10 @@ func (repo *PgRepo) BranchRepo() IBranchRepo {
 	return NewBranchRepo(repo.db)
 }
 
+func (repo *PgRepo) BranchReflogRepo() IBranchReflogRepo {
+	return NewBranchReflogRepo(repo.db)
+}
+
 func (repo *PgRepo) RepositoryRepo() IRepositoryRepo {
 	return NewRepositoryRepo(repo.db)
 }
@@ -104,6 +136,14 @@ func (repo *PgRepo) AkskRepo() IAkskRepo {
 	return NewAkskRepo(repo.db)
 }
 
+func (repo *PgRepo) StagingObjectRepo() IStagingObjectRepo {
+	return NewStagingObjectRepo(repo.db)
+}
+
+func (repo *PgRepo) MultipartUploadRepo() IMultipartUploadRepo {
+	return NewMultipartUploadRepo(repo.db)
+}
+
 func (repo *PgRepo) MemberRepo() IMemberRepo {
 	return NewMemberRepo(repo.db)
 }
@@ -21,6 +21,8 @@ type Repository struct {
 	StorageNamespace *string `bun:"storage_namespace" json:"storage_namespace,omitempty"`
 
 	StorageAdapterParams *string `bun:"storage_adapter_params" json:"storage_adapter_params,omitempty"`
+	// ChunkedStorage split content of new blobs into content defined chunks, so that chunks can be shared between versions
+	ChunkedStorage bool `bun:"chunked_storage,notnull,default:false" json:"chunked_storage"`
 
 	Description *string `bun:"description" json:"description,omitempty"`
 
@@ -142,6 +144,7 @@ type UpdateRepoParams struct {
 	description *string
 	visible     *bool
 	head        *string
+	chunked     *bool
 }
 
 func NewUpdateRepoParams(id uuid.UUID) *UpdateRepoParams {
@@ -165,6 +168,11 @@ func (up *UpdateRepoParams) SetVisible(visible bool) *UpdateRepoParams {
 	return up
 }
 
+func (up *UpdateRepoParams) SetChunkedStorage(chunked bool) *UpdateRepoParams {
+	up.chunked = &chunked
+	return up
+}
+
 type IRepositoryRepo interface {
 	Insert(ctx context.Context, repo *Repository) (*Repository, error)
 	Get(ctx context.Context, params *GetRepoParams) (*Repository, error)
@@ -297,6 +305,10 @@ func (r *RepositoryRepo) UpdateByID(ctx context.Context, updateModel *UpdateRepo
 		updateQuery.Set("visible = ?", *updateModel.visible)
 	}
 
+	if updateModel.chunked != nil {
+		updateQuery.Set("chunked_storage = ?", *updateModel.chunked)
+	}
+
 	_, err := updateQuery.Exec(ctx)
 	return err
 }
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// StagingObject record of content uploading to a temporary key of repository storage, content is moved to its
+// content address once hash is known. record left in table means the upload was interrupted and the key should be removed
+type StagingObject struct {
+	bun.BaseModel `bun:"table:staging_objects"`
+	ID            uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+	RepositoryID  uuid.UUID `bun:"repository_id,type:uuid,notnull" json:"repository_id"`
+	// Key identifier relative to storage namespace of repository
+	Key string `bun:"key,notnull" json:"key"`
+	// UploadID id of multipart upload, empty if not uploaded by multipart
+	UploadID string `bun:"upload_id" json:"upload_id"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+}
+
+type GetStagingObjectParams struct {
+	repositoryID uuid.UUID
+	key          *string
+}
+
+func NewGetStagingObjectParams() *GetStagingObjectParams {
+	return &GetStagingObjectParams{}
+}
+
+func (gsp *GetStagingObjectParams) SetRepositoryID(repositoryID uuid.UUID) *GetStagingObjectParams {
+	gsp.repositoryID = repositoryID
+	return gsp
+}
+
+func (gsp *GetStagingObjectParams) SetKey(key string) *GetStagingObjectParams {
+	gsp.key = &key
+	return gsp
+}
+
+type ListStagingObjectParams struct {
+	repositoryID uuid.UUID
+	before       *time.Time
+}
+
+func NewListStagingObjectParams() *ListStagingObjectParams {
+	return &ListStagingObjectParams{}
+}
+
+func (lsp *ListStagingObjectParams) SetRepositoryID(repositoryID uuid.UUID) *ListStagingObjectParams {
+	lsp.repositoryID = repositoryID
+	return lsp
+}
+
+func (lsp *ListStagingObjectParams) SetBefore(before time.Time) *ListStagingObjectParams {
+	lsp.before = &before
+	return lsp
+}
+
+type DeleteStagingObjectParams struct {
+	id uuid.UUID
+}
+
+func NewDeleteStagingObjectParams() *DeleteStagingObjectParams {
+	return &DeleteStagingObjectParams{}
+}
+
+func (dsp *DeleteStagingObjectParams) SetID(id uuid.UUID) *DeleteStagingObjectParams {
+	dsp.id = id
+	return dsp
+}
+
+type IStagingObjectRepo interface {
+	Insert(ctx context.Context, stagingObject *StagingObject) (*StagingObject, error)
+	Get(ctx context.Context, params *GetStagingObjectParams) (*StagingObject, error)
+	List(ctx context.Context, params *ListStagingObjectParams) ([]*StagingObject, error)
+	Delete(ctx context.Context, params *DeleteStagingObjectParams) (int64, error)
+}
+
+var _ IStagingObjectRepo = (*StagingObjectRepo)(nil)
+
+type StagingObjectRepo struct {
+	db bun.IDB
+}
+
+func NewStagingObjectRepo(db bun.IDB) IStagingObjectRepo {
+	return &StagingObjectRepo{db: db}
+}
+
+func (s StagingObjectRepo) Insert(ctx context.Context, stagingObject *StagingObject) (*StagingObject, error) {
+	_, err := s.db.NewInsert().Model(stagingObject).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stagingObject, nil
+}
+
+func (s StagingObjectRepo) Get(ctx context.Context, params *GetStagingObjectParams) (*StagingObject, error) {
+	stagingObject := &StagingObject{}
+	query := s.db.NewSelect().Model(stagingObject)
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+
+	if params.key != nil {
+		query = query.Where("key = ?", *params.key)
+	}
+
+	err := query.Limit(1).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stagingObject, nil
+}
+
+func (s StagingObjectRepo) List(ctx context.Context, params *ListStagingObjectParams) ([]*StagingObject, error) {
+	var stagingObjects []*StagingObject
+	query := s.db.NewSelect().Model(&stagingObjects)
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+
+	if params.before != nil {
+		query = query.Where("created_at < ?", *params.before)
+	}
+
+	err := query.Order("created_at ASC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stagingObjects, nil
+}
+
+func (s StagingObjectRepo) Delete(ctx context.Context, params *DeleteStagingObjectParams) (int64, error) {
+	query := s.db.NewDelete().Model((*StagingObject)(nil))
+
+	if uuid.Nil != params.id {
+		query = query.Where("id = ?", params.id)
+	}
+
+	sqlResult, err := query.Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	affectedRows, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return affectedRows, err
+}
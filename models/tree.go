@@ -3,6 +3,7 @@ package models
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"sort"
 	"time"
@@ -74,6 +75,12 @@ func (props Property) ToMap() map[string]string {
 	}
 }
 
+// Chunk part of blob content, stored in address of its hash
+type Chunk struct {
+	Hash hash.Hash `json:"hash"`
+	Size int64     `json:"size"`
+}
+
 type Blob struct {
 	bun.BaseModel `bun:"table:trees"`
 	Hash          hash.Hash  `bun:"hash,pk,type:bytea"`
@@ -82,6 +89,8 @@ type Blob struct {
 	Type          ObjectType `bun:"type,notnull"`
 	Size          int64      `bun:"size"`
 	Properties    Property   `bun:"properties,type:jsonb,notnull"`
+	// Chunks content of chunked blob in order, empty if content stored as one object
+	Chunks []Chunk `bun:"chunks,type:jsonb"`
 
 	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull"`
 	UpdatedAt time.Time `bun:"updated_at,type:timestamp,notnull"`
@@ -140,6 +149,7 @@ func (blob *Blob) FileTree() *FileTree {
 		Size:         blob.Size,
 		CheckSum:     blob.CheckSum,
 		Properties:   blob.Properties,
+		Chunks:       blob.Chunks,
 		CreatedAt:    blob.CreatedAt,
 		UpdatedAt:    blob.UpdatedAt,
 	}
@@ -229,6 +239,8 @@ type FileTree struct {
 	Type          ObjectType `bun:"type,notnull"`
 	Size          int64      `bun:"size"`
 	Properties    Property   `bun:"properties,type:jsonb,notnull"`
+	//blob
+	Chunks []Chunk `bun:"chunks,type:jsonb" json:"chunks,omitempty"`
 	//tree
 	SubObjects []TreeEntry `bun:"sub_objects,type:jsonb,notnull" json:"sub_objects"`
 
@@ -244,6 +256,7 @@ func (fileTree *FileTree) Blob() *Blob {
 		Size:         fileTree.Size,
 		Properties:   fileTree.Properties,
 		CheckSum:     fileTree.CheckSum,
+		Chunks:       fileTree.Chunks,
 		CreatedAt:    fileTree.CreatedAt,
 		UpdatedAt:    fileTree.UpdatedAt,
 	}
@@ -274,8 +287,43 @@ func (gop *GetObjParams) SetHash(hash hash.Hash) *GetObjParams {
 	return gop
 }
 
+type ListTreeParams struct {
+	hashes       []hash.Hash
+	after        hash.Hash
+	updatedAfter *time.Time
+	amount       int
+}
+
+func NewListTreeParams() *ListTreeParams {
+	return &ListTreeParams{}
+}
+
+func (ltp *ListTreeParams) SetHashes(hashes ...hash.Hash) *ListTreeParams {
+	ltp.hashes = hashes
+	return ltp
+}
+
+// SetAfter only return objects whose hash is greater than after, objects are ordered by hash
+func (ltp *ListTreeParams) SetAfter(after hash.Hash) *ListTreeParams {
+	ltp.after = after
+	return ltp
+}
+
+// SetUpdatedAfter only return objects updated at or after the time
+func (ltp *ListTreeParams) SetUpdatedAfter(updatedAfter time.Time) *ListTreeParams {
+	ltp.updatedAfter = &updatedAfter
+	return ltp
+}
+
+func (ltp *ListTreeParams) SetAmount(amount int) *ListTreeParams {
+	ltp.amount = amount
+	return ltp
+}
+
 type DeleteTreeParams struct {
-	hash hash.Hash
+	hash          hash.Hash
+	hashes        []hash.Hash
+	updatedBefore *time.Time
 }
 
 func NewDeleteTreeParams() *DeleteTreeParams {
@@ -287,12 +335,30 @@ func (dtp *DeleteTreeParams) SetHash(hash hash.Hash) *DeleteTreeParams {
 	return dtp
 }
 
+func (dtp *DeleteTreeParams) SetHashes(hashes ...hash.Hash) *DeleteTreeParams {
+	dtp.hashes = hashes
+	return dtp
+}
+
+// SetUpdatedBefore only delete objects updated before the time, objects referenced again are refreshed by Insert
+func (dtp *DeleteTreeParams) SetUpdatedBefore(updatedBefore time.Time) *DeleteTreeParams {
+	dtp.updatedBefore = &updatedBefore
+	return dtp
+}
+
 type IFileTreeRepo interface {
 	RepositoryID() uuid.UUID
 	Insert(ctx context.Context, repo *FileTree) (*FileTree, error)
 	Get(ctx context.Context, params *GetObjParams) (*FileTree, error)
 	Count(ctx context.Context) (int, error)
 	List(ctx context.Context) ([]FileTree, error)
+	// ListPage return objects ordered by hash, pass hash of last object to SetAfter to get next page
+	ListPage(ctx context.Context, params *ListTreeParams) ([]FileTree, error)
+	// ContentReferenced check whether any blob store its content or one of its chunks in content address
+	ContentReferenced(ctx context.Context, content hash.Hash) (bool, error)
+	// LockContents take transaction level lock of content addresses of repository, writers share the lock while
+	// registering blob and gc hold it exclusively while removing content. must be called in transaction
+	LockContents(ctx context.Context, exclusive bool) error
 	Blob(ctx context.Context, hash hash.Hash) (*Blob, error)
 	TreeNode(ctx context.Context, hash hash.Hash) (*TreeNode, error)
 	Delete(ctx context.Context, params *DeleteTreeParams) (int64, error)
@@ -320,7 +386,11 @@ func (o FileTreeRepo) Insert(ctx context.Context, obj *FileTree) (*FileTree, err
 	if obj.RepositoryID != o.repositoryID {
 		return nil, ErrRepoIDMisMatch
 	}
-	_, err := o.db.NewInsert().Model(obj).Ignore().Exec(ctx)
+	//object may be referenced again after created, refresh updated_at to protect it from gc
+	_, err := o.db.NewInsert().Model(obj).
+		On("CONFLICT (hash, repository_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -386,11 +456,66 @@ func (o FileTreeRepo) List(ctx context.Context) ([]FileTree, error) {
 	return obj, nil
 }
 
+func (o FileTreeRepo) ListPage(ctx context.Context, params *ListTreeParams) ([]FileTree, error) {
+	var obj []FileTree
+	query := o.db.NewSelect().Model(&obj).Where("repository_id = ?", o.repositoryID)
+	if len(params.hashes) > 0 {
+		query = query.Where("hash IN (?)", bun.In(params.hashes))
+	}
+	if params.after != nil {
+		query = query.Where("hash > ?", params.after)
+	}
+	if params.updatedAfter != nil {
+		query = query.Where("updated_at >= ?", *params.updatedAfter)
+	}
+	query = query.Order("hash ASC")
+	if params.amount > 0 {
+		query = query.Limit(params.amount)
+	}
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (o FileTreeRepo) ContentReferenced(ctx context.Context, content hash.Hash) (bool, error) {
+	//match chunk by hash only, size is not part of content address
+	chunkRef, err := json.Marshal([]map[string]string{{"hash": content.Hex()}})
+	if err != nil {
+		return false, err
+	}
+	return o.db.NewSelect().
+		Model((*FileTree)(nil)).
+		Where("repository_id = ?", o.repositoryID).
+		Where("type = ?", BlobObject).
+		WhereGroup(" AND ", func(query *bun.SelectQuery) *bun.SelectQuery {
+			return query.Where("check_sum = ?", content).
+				WhereOr("chunks @> ?::jsonb", string(chunkRef))
+		}).
+		Exists(ctx)
+}
+
+func (o FileTreeRepo) LockContents(ctx context.Context, exclusive bool) error {
+	lockFunc := "pg_advisory_xact_lock_shared"
+	if exclusive {
+		lockFunc = "pg_advisory_xact_lock"
+	}
+	_, err := o.db.ExecContext(ctx, "SELECT "+lockFunc+"(hashtext(?))", "contents:"+o.repositoryID.String())
+	return err
+}
+
 func (o FileTreeRepo) Delete(ctx context.Context, params *DeleteTreeParams) (int64, error) {
 	query := o.db.NewDelete().Model((*TreeNode)(nil)).Where("repository_id = ?", o.repositoryID)
 	if params.hash != nil {
 		query = query.Where("hash = ?", params.hash)
 	}
+	if len(params.hashes) > 0 {
+		query = query.Where("hash IN (?)", bun.In(params.hashes))
+	}
+	if params.updatedBefore != nil {
+		query = query.Where("updated_at < ?", *params.updatedBefore)
+	}
 
 	sqlResult, err := query.Exec(ctx)
 	if err != nil {
@@ -3,6 +3,7 @@ package models_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/GitDataAI/jiaozifs/utils/hash"
 
@@ -126,3 +127,56 @@ func TestFileTreeRepo_Delete(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(4), affectRows)
 }
+
+func TestFileTreeRepo_GCQueries(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	repoID := uuid.New()
+	repo := models.NewFileTree(db, repoID)
+
+	old := time.Now().Add(-time.Hour)
+	chunkHash := hash.Hash("chunk")
+	blobs := []*models.FileTree{
+		{Hash: hash.Hash("b1"), RepositoryID: repoID, Type: models.BlobObject, CheckSum: hash.Hash("sum1"), CreatedAt: old, UpdatedAt: old},
+		{Hash: hash.Hash("b2"), RepositoryID: repoID, Type: models.BlobObject, CheckSum: hash.Hash("sum2"), Chunks: []models.Chunk{{Hash: chunkHash, Size: 5}}, CreatedAt: old, UpdatedAt: old},
+		{Hash: hash.Hash("b3"), RepositoryID: repoID, Type: models.BlobObject, CheckSum: hash.Hash("sum3"), CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+	for _, blob := range blobs {
+		_, err := repo.Insert(ctx, blob)
+		require.NoError(t, err)
+	}
+
+	page, err := repo.ListPage(ctx, models.NewListTreeParams().SetAmount(2))
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	page, err = repo.ListPage(ctx, models.NewListTreeParams().SetAfter(page[1].Hash).SetAmount(2))
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, "b3", string(page[0].Hash))
+
+	page, err = repo.ListPage(ctx, models.NewListTreeParams().SetUpdatedAfter(old.Add(time.Minute)))
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+
+	referenced, err := repo.ContentReferenced(ctx, chunkHash)
+	require.NoError(t, err)
+	require.True(t, referenced)
+	referenced, err = repo.ContentReferenced(ctx, hash.Hash("sum1"))
+	require.NoError(t, err)
+	require.True(t, referenced)
+
+	//objects updated after deadline are kept
+	deleted, err := repo.Delete(ctx, models.NewDeleteTreeParams().SetHashes(blobs[0].Hash, blobs[1].Hash, blobs[2].Hash).SetUpdatedBefore(old.Add(time.Minute)))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	referenced, err = repo.ContentReferenced(ctx, chunkHash)
+	require.NoError(t, err)
+	require.False(t, referenced)
+
+	require.NoError(t, models.NewRepo(db).Transaction(ctx, func(repo models.IRepo) error {
+		return repo.FileTreeRepo(repoID).LockContents(ctx, true)
+	}))
+}
@@ -0,0 +1,313 @@
+package models
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+// Webhook post event of repository to url, body is signed by secret
+type Webhook struct {
+	bun.BaseModel `bun:"table:webhooks"`
+	ID            uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+	RepositoryID  uuid.UUID `bun:"repository_id,type:uuid,notnull" json:"repository_id"`
+	URL           string    `bun:"url,notnull" json:"url"`
+	// Secret key to sign body of request by hmac sha256
+	Secret string `bun:"secret,notnull" json:"-"`
+	// Events subscribed by webhook
+	Events    []string  `bun:"events,array" json:"events"`
+	Active    bool      `bun:"active,notnull" json:"active"`
+	CreatorID uuid.UUID `bun:"creator_id,type:uuid,notnull" json:"creator_id"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at,type:timestamp,notnull" json:"updated_at"`
+}
+
+// Subscribe check whether webhook is active and subscribe the event
+func (hook *Webhook) Subscribe(event string) bool {
+	if !hook.Active {
+		return false
+	}
+	for _, e := range hook.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+type DeliveryState int
+
+const (
+	DeliveryStatePending DeliveryState = 1
+	DeliveryStateSuccess DeliveryState = 2
+	DeliveryStateFailed  DeliveryState = 3
+)
+
+// WebhookDelivery event to deliver to webhook, pending delivery is a persistent queue consumed by daemon,
+// finished delivery is kept as log
+type WebhookDelivery struct {
+	bun.BaseModel `bun:"table:webhook_deliveries"`
+	ID            uuid.UUID     `bun:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+	WebhookID     uuid.UUID     `bun:"webhook_id,type:uuid,notnull" json:"webhook_id"`
+	Event         string        `bun:"event,notnull" json:"event"`
+	Payload       []byte        `bun:"payload,type:bytea,notnull" json:"payload"`
+	State         DeliveryState `bun:"state,notnull" json:"state"`
+	Attempts      int           `bun:"attempts,notnull" json:"attempts"`
+	ResponseCode  *int          `bun:"response_code" json:"response_code"`
+	Error         *string       `bun:"error" json:"error"`
+	NextAttemptAt time.Time     `bun:"next_attempt_at,type:timestamp,notnull" json:"next_attempt_at"`
+
+	CreatedAt time.Time `bun:"created_at,type:timestamp,notnull" json:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at,type:timestamp,notnull" json:"updated_at"`
+}
+
+type GetWebhookParams struct {
+	id           uuid.UUID
+	repositoryID uuid.UUID
+}
+
+func NewGetWebhookParams() *GetWebhookParams {
+	return &GetWebhookParams{}
+}
+
+func (gwp *GetWebhookParams) SetID(id uuid.UUID) *GetWebhookParams {
+	gwp.id = id
+	return gwp
+}
+
+func (gwp *GetWebhookParams) SetRepositoryID(repositoryID uuid.UUID) *GetWebhookParams {
+	gwp.repositoryID = repositoryID
+	return gwp
+}
+
+type UpdateWebhookParams struct {
+	id     uuid.UUID
+	url    *string
+	secret *string
+	events []string
+	active *bool
+}
+
+func NewUpdateWebhookParams(id uuid.UUID) *UpdateWebhookParams {
+	return &UpdateWebhookParams{id: id}
+}
+
+func (uwp *UpdateWebhookParams) SetURL(url string) *UpdateWebhookParams {
+	uwp.url = &url
+	return uwp
+}
+
+func (uwp *UpdateWebhookParams) SetSecret(secret string) *UpdateWebhookParams {
+	uwp.secret = &secret
+	return uwp
+}
+
+func (uwp *UpdateWebhookParams) SetEvents(events []string) *UpdateWebhookParams {
+	uwp.events = events
+	return uwp
+}
+
+func (uwp *UpdateWebhookParams) SetActive(active bool) *UpdateWebhookParams {
+	uwp.active = &active
+	return uwp
+}
+
+type DeleteWebhookParams struct {
+	id           uuid.UUID
+	repositoryID uuid.UUID
+}
+
+func NewDeleteWebhookParams() *DeleteWebhookParams {
+	return &DeleteWebhookParams{}
+}
+
+func (dwp *DeleteWebhookParams) SetID(id uuid.UUID) *DeleteWebhookParams {
+	dwp.id = id
+	return dwp
+}
+
+func (dwp *DeleteWebhookParams) SetRepositoryID(repositoryID uuid.UUID) *DeleteWebhookParams {
+	dwp.repositoryID = repositoryID
+	return dwp
+}
+
+type UpdateDeliveryParams struct {
+	id            uuid.UUID
+	state         DeliveryState
+	attempts      int
+	responseCode  *int
+	err           *string
+	nextAttemptAt time.Time
+}
+
+func NewUpdateDeliveryParams(id uuid.UUID, state DeliveryState, attempts int, nextAttemptAt time.Time) *UpdateDeliveryParams {
+	return &UpdateDeliveryParams{id: id, state: state, attempts: attempts, nextAttemptAt: nextAttemptAt}
+}
+
+func (udp *UpdateDeliveryParams) SetResponseCode(code int) *UpdateDeliveryParams {
+	udp.responseCode = &code
+	return udp
+}
+
+func (udp *UpdateDeliveryParams) SetError(err string) *UpdateDeliveryParams {
+	udp.err = &err
+	return udp
+}
+
+type IWebhookRepo interface {
+	Insert(ctx context.Context, hook *Webhook) (*Webhook, error)
+	Get(ctx context.Context, params *GetWebhookParams) (*Webhook, error)
+	List(ctx context.Context, repositoryID uuid.UUID) ([]*Webhook, error)
+	UpdateByID(ctx context.Context, params *UpdateWebhookParams) error
+	// Delete remove webhook and deliveries of it
+	Delete(ctx context.Context, params *DeleteWebhookParams) (int64, error)
+
+	InsertDelivery(ctx context.Context, delivery *WebhookDelivery) (*WebhookDelivery, error)
+	// ListDeliveries return latest deliveries of webhook
+	ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*WebhookDelivery, error)
+	// ClaimDueDeliveries return pending deliveries which should be attempted before the time, next attempt time of them
+	// is moved to leaseUntil in the same statement, so deliveries are claimed by only one caller
+	ClaimDueDeliveries(ctx context.Context, before time.Time, leaseUntil time.Time, limit int) ([]*WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, params *UpdateDeliveryParams) error
+}
+
+var _ IWebhookRepo = (*WebhookRepo)(nil)
+
+type WebhookRepo struct {
+	db bun.IDB
+}
+
+func NewWebhookRepo(db bun.IDB) IWebhookRepo {
+	return &WebhookRepo{db: db}
+}
+
+func (w WebhookRepo) Insert(ctx context.Context, hook *Webhook) (*Webhook, error) {
+	_, err := w.db.NewInsert().Model(hook).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (w WebhookRepo) Get(ctx context.Context, params *GetWebhookParams) (*Webhook, error) {
+	hook := &Webhook{}
+	query := w.db.NewSelect().Model(hook)
+
+	if uuid.Nil != params.id {
+		query = query.Where("id = ?", params.id)
+	}
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+	return hook, query.Limit(1).Scan(ctx)
+}
+
+func (w WebhookRepo) List(ctx context.Context, repositoryID uuid.UUID) ([]*Webhook, error) {
+	var hooks []*Webhook
+	err := w.db.NewSelect().Model(&hooks).
+		Where("repository_id = ?", repositoryID).
+		Order("created_at ASC").
+		Scan(ctx)
+	return hooks, err
+}
+
+func (w WebhookRepo) UpdateByID(ctx context.Context, params *UpdateWebhookParams) error {
+	updateQuery := w.db.NewUpdate().Model((*Webhook)(nil)).Where("id = ?", params.id)
+	if params.url != nil {
+		updateQuery.Set("url = ?", *params.url)
+	}
+	if params.secret != nil {
+		updateQuery.Set("secret = ?", *params.secret)
+	}
+	if params.events != nil {
+		updateQuery.Set("events = ?", pgdialect.Array(params.events))
+	}
+	if params.active != nil {
+		updateQuery.Set("active = ?", *params.active)
+	}
+	_, err := updateQuery.Set("updated_at = ?", time.Now()).Exec(ctx)
+	return err
+}
+
+func (w WebhookRepo) Delete(ctx context.Context, params *DeleteWebhookParams) (int64, error) {
+	var hookIDs []uuid.UUID
+	query := w.db.NewDelete().Model((*Webhook)(nil))
+
+	if uuid.Nil != params.id {
+		query = query.Where("id = ?", params.id)
+	}
+
+	if uuid.Nil != params.repositoryID {
+		query = query.Where("repository_id = ?", params.repositoryID)
+	}
+	err := query.Returning("id").Scan(ctx, &hookIDs)
+	if err != nil {
+		return 0, err
+	}
+	if len(hookIDs) > 0 {
+		_, err = w.db.NewDelete().Model((*WebhookDelivery)(nil)).Where("webhook_id IN (?)", bun.In(hookIDs)).Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(hookIDs)), nil
+}
+
+func (w WebhookRepo) InsertDelivery(ctx context.Context, delivery *WebhookDelivery) (*WebhookDelivery, error) {
+	_, err := w.db.NewInsert().Model(delivery).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (w WebhookRepo) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*WebhookDelivery, error) {
+	var deliveries []*WebhookDelivery
+	err := w.db.NewSelect().Model(&deliveries).
+		Where("webhook_id = ?", webhookID).
+		Order("created_at DESC").
+		Limit(limit).
+		Scan(ctx)
+	return deliveries, err
+}
+
+func (w WebhookRepo) ClaimDueDeliveries(ctx context.Context, before time.Time, leaseUntil time.Time, limit int) ([]*WebhookDelivery, error) {
+	dueQuery := w.db.NewSelect().Model((*WebhookDelivery)(nil)).
+		Column("id").
+		Where("state = ?", DeliveryStatePending).
+		Where("next_attempt_at <= ?", before).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	var deliveries []*WebhookDelivery
+	err := w.db.NewUpdate().Model(&deliveries).
+		Set("next_attempt_at = ?", leaseUntil).
+		Where("id IN (?)", dueQuery).
+		Returning("*").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+	})
+	return deliveries, nil
+}
+
+func (w WebhookRepo) UpdateDelivery(ctx context.Context, params *UpdateDeliveryParams) error {
+	updateQuery := w.db.NewUpdate().Model((*WebhookDelivery)(nil)).Where("id = ?", params.id).
+		Set("state = ?", params.state).
+		Set("attempts = ?", params.attempts).
+		Set("next_attempt_at = ?", params.nextAttemptAt).
+		Set("response_code = ?", params.responseCode).
+		Set("error = ?", params.err)
+	_, err := updateQuery.Set("updated_at = ?", time.Now()).Exec(ctx)
+	return err
+}
@@ -0,0 +1,111 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+)
+
+func TestWebhookRepo(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	hookRepo := models.NewWebhookRepo(db)
+	repoID := uuid.New()
+
+	hook, err := hookRepo.Insert(ctx, &models.Webhook{
+		RepositoryID: repoID,
+		URL:          "http://127.0.0.1/hook",
+		Secret:       "secret",
+		Events:       []string{"commit"},
+		Active:       true,
+		CreatorID:    uuid.New(),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	})
+	require.NoError(t, err)
+
+	err = hookRepo.UpdateByID(ctx, models.NewUpdateWebhookParams(hook.ID).SetEvents([]string{"commit", "tag_create"}).SetActive(false))
+	require.NoError(t, err)
+
+	actualHook, err := hookRepo.Get(ctx, models.NewGetWebhookParams().SetID(hook.ID).SetRepositoryID(repoID))
+	require.NoError(t, err)
+	require.Equal(t, []string{"commit", "tag_create"}, actualHook.Events)
+	require.False(t, actualHook.Subscribe("commit"))
+
+	hooks, err := hookRepo.List(ctx, repoID)
+	require.NoError(t, err)
+	require.Len(t, hooks, 1)
+
+	delivery, err := hookRepo.InsertDelivery(ctx, &models.WebhookDelivery{
+		WebhookID:     hook.ID,
+		Event:         "commit",
+		Payload:       []byte("{}"),
+		State:         models.DeliveryStatePending,
+		NextAttemptAt: time.Now(),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	})
+	require.NoError(t, err)
+
+	dueDeliveries, err := hookRepo.ClaimDueDeliveries(ctx, time.Now(), time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, dueDeliveries, 1)
+
+	//claimed delivery is leased
+	dueDeliveries, err = hookRepo.ClaimDueDeliveries(ctx, time.Now(), time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, dueDeliveries, 0)
+
+	err = hookRepo.UpdateDelivery(ctx, models.NewUpdateDeliveryParams(delivery.ID, models.DeliveryStatePending, 1, time.Now().Add(time.Hour)).SetResponseCode(500))
+	require.NoError(t, err)
+
+	dueDeliveries, err = hookRepo.ClaimDueDeliveries(ctx, time.Now(), time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, dueDeliveries, 0)
+
+	affectRows, err := hookRepo.Delete(ctx, models.NewDeleteWebhookParams().SetID(hook.ID).SetRepositoryID(repoID))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affectRows)
+
+	deliveries, err := hookRepo.ListDeliveries(ctx, hook.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 0)
+
+	//delete by repository remove deliveries of all webhooks
+	hook, err = hookRepo.Insert(ctx, &models.Webhook{
+		RepositoryID: repoID,
+		URL:          "http://example.com/hook",
+		Events:       []string{"commit"},
+		Active:       true,
+		CreatorID:    uuid.New(),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	})
+	require.NoError(t, err)
+	_, err = hookRepo.InsertDelivery(ctx, &models.WebhookDelivery{
+		WebhookID:     hook.ID,
+		Event:         "commit",
+		Payload:       []byte("{}"),
+		State:         models.DeliveryStatePending,
+		NextAttemptAt: time.Now(),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	})
+	require.NoError(t, err)
+
+	affectRows, err = hookRepo.Delete(ctx, models.NewDeleteWebhookParams().SetRepositoryID(repoID))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affectRows)
+
+	deliveries, err = hookRepo.ListDeliveries(ctx, hook.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 0)
+}
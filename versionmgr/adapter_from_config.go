@@ -3,10 +3,13 @@ package versionmgr
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/GitDataAI/jiaozifs/block"
 	"github.com/GitDataAI/jiaozifs/block/factory"
+	"github.com/GitDataAI/jiaozifs/block/params"
 	"github.com/GitDataAI/jiaozifs/config"
+	"github.com/GitDataAI/jiaozifs/models"
 )
 
 func AdapterFromConfig(ctx context.Context, jsonParams string) (block.Adapter, error) {
@@ -21,3 +24,47 @@ func AdapterFromConfig(ctx context.Context, jsonParams string) (block.Adapter, e
 	}
 	return adapter, err
 }
+
+// adapterConfigOfRepository return storage config of repository, public config is used if repository use public storage
+func adapterConfigOfRepository(repoModel *models.Repository, publicAdapterConfig params.AdapterConfig) (params.AdapterConfig, error) {
+	if repoModel.UsePublicStorage {
+		return publicAdapterConfig, nil
+	}
+	var cfg = config.BlockStoreConfig{}
+	err := json.Unmarshal([]byte(*repoModel.StorageAdapterParams), &cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// preSignedExpiry return how long pre-signed url of adapter is valid, zero if adapter not support pre-sign
+func preSignedExpiry(adapterConfig params.AdapterConfig) (time.Duration, error) {
+	var expiry time.Duration
+	switch adapterConfig.BlockstoreType() {
+	case block.BlockstoreTypeS3:
+		s3Params, err := adapterConfig.BlockstoreS3Params()
+		if err != nil {
+			return 0, err
+		}
+		expiry = s3Params.PreSignedExpiry
+	case block.BlockstoreTypeGS:
+		gsParams, err := adapterConfig.BlockstoreGSParams()
+		if err != nil {
+			return 0, err
+		}
+		expiry = gsParams.PreSignedExpiry
+	case block.BlockstoreTypeAzure:
+		azureParams, err := adapterConfig.BlockstoreAzureParams()
+		if err != nil {
+			return 0, err
+		}
+		expiry = azureParams.PreSignedExpiry
+	default:
+		return 0, nil
+	}
+	if expiry <= 0 {
+		return block.DefaultPreSignExpiryDuration, nil
+	}
+	return expiry, nil
+}
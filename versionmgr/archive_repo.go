@@ -18,17 +18,25 @@ import (
 	"github.com/GitDataAI/jiaozifs/models"
 	bserv "github.com/ipfs/boxo/blockservice"
 	bstore "github.com/ipfs/boxo/blockstore"
+	"github.com/ipfs/boxo/files"
 	dag "github.com/ipfs/boxo/ipld/merkledag"
 	ft "github.com/ipfs/boxo/ipld/unixfs"
+	unixfile "github.com/ipfs/boxo/ipld/unixfs/file"
 	"github.com/ipfs/boxo/mfs"
 	ds "github.com/ipfs/go-datastore"
 	dssync "github.com/ipfs/go-datastore/sync"
 	offline "github.com/ipfs/go-ipfs-exchange-offline"
 	car "github.com/ipld/go-car"
+	carbs "github.com/ipld/go-car/v2/blockstore"
 
 	importer "github.com/ipfs/boxo/ipld/unixfs/importer"
 )
 
+var ErrInvalidCar = errors.New("invalid car")
+
+// ErrCarTooLarge car is larger than the size could be buffered
+var ErrCarTooLarge = errors.New("car too large")
+
 type RepoArchiver struct {
 	rootPath  string
 	walker    IWalk
@@ -81,7 +89,8 @@ func (repo *RepoArchiver) ArchiveZip(ctx context.Context, dest string) error {
 	})
 }
 
-func (repo *RepoArchiver) ArchiveCar(ctx context.Context, dest string) error {
+// ArchiveCar write files to a car file as unixfs dag, return cid of root directory
+func (repo *RepoArchiver) ArchiveCar(ctx context.Context, dest string) (cid.Cid, error) {
 	db := dssync.MutexWrap(ds.NewMapDatastore()) //todo use disk to cache data
 	bs := bstore.NewBlockstore(db)
 	blockSrv := bserv.New(bs, offline.Exchange(bs))
@@ -90,7 +99,7 @@ func (repo *RepoArchiver) ArchiveCar(ctx context.Context, dest string) error {
 
 	root, err := mfs.NewRoot(ctx, dagSrv, rootNode, nil)
 	if err != nil {
-		return err
+		return cid.Undef, err
 	}
 	defer root.Close() //nolint:errcheck
 
@@ -122,24 +131,83 @@ func (repo *RepoArchiver) ArchiveCar(ctx context.Context, dest string) error {
 		return dirNd.(*mfs.Directory).AddChild(base, nd)
 	})
 	if err != nil {
-		return err
+		return cid.Undef, err
 	}
 	err = root.Flush()
 	if err != nil {
-		return err
+		return cid.Undef, err
 	}
 
 	ipldNode, err := rootDir.GetNode()
 	if err != nil {
-		return err
+		return cid.Undef, err
 	}
 
 	carFile, err := os.Create(dest)
 	if err != nil {
-		return err
+		return cid.Undef, err
 	}
 	defer carFile.Close() //nolint:errcheck
-	return car.WriteCar(ctx, dagSrv, []cid.Cid{ipldNode.Cid()}, carFile)
+	return ipldNode.Cid(), car.WriteCar(ctx, dagSrv, []cid.Cid{ipldNode.Cid()}, carFile)
+}
+
+// WalkCar load car and call fn for each file of unixfs dag under the first root, path of file is relative to root.
+// if root is a single file, fn is called once with empty path. symlinks are skipped. car is spooled to temp file and
+// blocks are read from it while walking, ErrCarTooLarge is returned if car is larger than maxSize
+func WalkCar(ctx context.Context, reader io.Reader, maxSize int64, fn func(path string, file files.File) error) (cid.Cid, error) {
+	carFile, err := os.CreateTemp("", "jiaozifs-import-*.car")
+	if err != nil {
+		return cid.Undef, err
+	}
+	defer os.Remove(carFile.Name()) //nolint:errcheck
+
+	size, err := io.Copy(carFile, io.LimitReader(reader, maxSize+1))
+	closeErr := carFile.Close()
+	if err != nil {
+		return cid.Undef, err
+	}
+	if closeErr != nil {
+		return cid.Undef, closeErr
+	}
+	if size > maxSize {
+		return cid.Undef, fmt.Errorf("car exceed %d bytes %w", maxSize, ErrCarTooLarge)
+	}
+
+	bs, err := carbs.OpenReadOnly(carFile.Name())
+	if err != nil {
+		return cid.Undef, fmt.Errorf("load car %v %w", err, ErrInvalidCar)
+	}
+	defer bs.Close() //nolint:errcheck
+	roots, err := bs.Roots()
+	if err != nil {
+		return cid.Undef, fmt.Errorf("load car %v %w", err, ErrInvalidCar)
+	}
+	if len(roots) == 0 {
+		return cid.Undef, fmt.Errorf("car has no root %w", ErrInvalidCar)
+	}
+
+	dagSrv := dag.NewDAGService(bserv.New(bs, offline.Exchange(bs)))
+	rootNode, err := dagSrv.Get(ctx, roots[0])
+	if err != nil {
+		return cid.Undef, fmt.Errorf("get root %s %v %w", roots[0], err, ErrInvalidCar)
+	}
+
+	node, err := unixfile.NewUnixfsFile(ctx, dagSrv, rootNode)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("root %s is not unixfs %v %w", roots[0], err, ErrInvalidCar)
+	}
+	defer node.Close() //nolint:errcheck
+
+	return roots[0], files.Walk(node, func(filePath string, nd files.Node) error {
+		if _, isLink := nd.(*files.Symlink); isLink {
+			return nil
+		}
+		file, ok := nd.(files.File)
+		if !ok {
+			return nil
+		}
+		return fn(filepath.ToSlash(filePath), file)
+	})
 }
 
 func mkdirP(root *mfs.Directory, pth string) (*mfs.Directory, error) {
@@ -11,6 +11,7 @@ import (
 
 	bserv "github.com/ipfs/boxo/blockservice"
 	bstore "github.com/ipfs/boxo/blockstore"
+	"github.com/ipfs/boxo/files"
 	dag "github.com/ipfs/boxo/ipld/merkledag"
 	"github.com/ipfs/boxo/mfs"
 	ds "github.com/ipfs/go-datastore"
@@ -123,7 +124,7 @@ func TestRepoArchiver_ArchiveCar(t *testing.T) {
 	require.NoError(t, err)
 	tmpFile := path.Join(tmpDir, "test.car")
 
-	err = archiver.ArchiveCar(ctx, tmpFile)
+	rootCid, err := archiver.ArchiveCar(ctx, tmpFile)
 	require.NoError(t, err)
 
 	//check data in car
@@ -134,6 +135,7 @@ func TestRepoArchiver_ArchiveCar(t *testing.T) {
 	bs := bstore.NewBlockstore(db)
 	header, err := car.LoadCar(ctx, bs, fs)
 	require.NoError(t, err)
+	require.Equal(t, rootCid, header.Roots[0])
 	blockserv := bserv.New(bs, offline.Exchange(bs))
 	dagSrv := dag.NewDAGService(blockserv)
 
@@ -151,6 +153,67 @@ func TestRepoArchiver_ArchiveCar(t *testing.T) {
 	}
 }
 
+func TestWalkCar(t *testing.T) {
+	ctx := context.Background()
+	wk := &mockWalker{
+		dirs: []string{
+			"a",
+			"a/b",
+		},
+		files: map[string][]byte{
+			"1.txt":     []byte("111111111111111111111111"),
+			"a/2.txt":   []byte("222222222222222222222222"),
+			"a/b/3.txt": []byte("3333333333333333333333333"),
+		},
+	}
+	archiver := NewRepoArchiver(
+		"testdir",
+		wk,
+		func(ctx context.Context, _ *models.Blob, s string) (io.ReadCloser, error) {
+			return utils.CloserWraper{Reader: bytes.NewReader(wk.files[s])}, nil
+		},
+	)
+
+	tmpFile := path.Join(t.TempDir(), "test.car")
+	rootCid, err := archiver.ArchiveCar(ctx, tmpFile)
+	require.NoError(t, err)
+
+	fs, err := os.Open(tmpFile)
+	require.NoError(t, err)
+	defer fs.Close() //nolint
+
+	walked := map[string][]byte{}
+	walkCid, err := WalkCar(ctx, fs, DefaultMaxCarSize, func(filePath string, file files.File) error {
+		data, err := io.ReadAll(file)
+		if err != nil {
+			return err
+		}
+		walked[filePath] = data
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, rootCid, walkCid)
+	require.Len(t, walked, len(wk.files))
+	for file, data := range wk.files {
+		require.Equal(t, data, walked[path.Join(archiver.rootPath, file)])
+	}
+
+	_, err = WalkCar(ctx, bytes.NewReader([]byte("not a car")), DefaultMaxCarSize, func(string, files.File) error { return nil })
+	require.ErrorIs(t, err, ErrInvalidCar)
+
+	_, err = fs.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	_, err = WalkCar(ctx, fs, 16, func(string, files.File) error { return nil })
+	require.ErrorIs(t, err, ErrCarTooLarge)
+}
+
+func TestValidateImportPath(t *testing.T) {
+	require.NoError(t, validateImportPath("a/b.txt", "prefix/a/b.txt"))
+	require.ErrorIs(t, validateImportPath("/etc/passwd", "etc/passwd"), ErrInvalidCar)
+	require.ErrorIs(t, validateImportPath("../b.txt", "b.txt"), ErrInvalidCar)
+	require.ErrorIs(t, validateImportPath("a/b:c.txt", "a/b:c.txt"), ErrInvalidCar)
+}
+
 func readFile(rt *mfs.Root, path string, offset int64) ([]byte, error) {
 	n, err := mfs.Lookup(rt, path)
 	if err != nil {
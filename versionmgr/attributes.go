@@ -0,0 +1,219 @@
+package versionmgr
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/gobwas/glob"
+)
+
+// AttributesFileName file in the root of repository to config attributes of path, like .gitattributes
+const AttributesFileName = ".jzattributes"
+
+// MaxAttributesFileSize attributes file larger than this size was rejected
+const MaxAttributesFileSize = 1 << 20
+
+// MergeStrategy used to resolve conflict of path when merge
+type MergeStrategy string
+
+const (
+	// MergeStrategyUnspecified use the resolver of merge request
+	MergeStrategyUnspecified MergeStrategy = ""
+	// MergeStrategyOurs always keep version of target branch
+	MergeStrategyOurs MergeStrategy = "ours"
+	// MergeStrategyTheirs always keep version of source branch
+	MergeStrategyTheirs MergeStrategy = "theirs"
+	// MergeStrategyUnion merge line by line, keep lines of both side for conflict hunks
+	MergeStrategyUnion MergeStrategy = "union"
+	// MergeStrategyBinary never merge content, conflict must be resolved by user
+	MergeStrategyBinary MergeStrategy = "binary"
+	// MergeStrategyText3Way merge line by line, conflict hunks must be resolved by user
+	MergeStrategyText3Way MergeStrategy = "text3way"
+)
+
+// ParseMergeStrategy parse merge strategy from string
+func ParseMergeStrategy(str string) (MergeStrategy, error) {
+	switch strategy := MergeStrategy(str); strategy {
+	case MergeStrategyOurs, MergeStrategyTheirs, MergeStrategyUnion, MergeStrategyBinary, MergeStrategyText3Way:
+		return strategy, nil
+	default:
+		return MergeStrategyUnspecified, fmt.Errorf("unknown merge strategy %s", str)
+	}
+}
+
+type attributeRule struct {
+	matcher   glob.Glob
+	matchBase bool
+	strategy  MergeStrategy
+}
+
+// Attributes rules load from attributes file, each line contains a pattern and attributes, for example
+//
+//	# comment
+//	*.csv      merge=union
+//	/data/*.db merge=ours
+//	*.bin      binary
+//
+// pattern without slash match the file name, otherwise match the full path from root of repository.
+// when more than one line match a path, the later line wins.
+type Attributes struct {
+	rules []attributeRule
+}
+
+// ParseAttributes parse attributes file
+func ParseAttributes(reader io.Reader) (*Attributes, error) {
+	attrs := &Attributes{}
+	scanner := bufio.NewScanner(reader)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		pattern := fields[0]
+		strategy := MergeStrategyUnspecified
+		for _, field := range fields[1:] {
+			var err error
+			switch {
+			case field == "binary":
+				strategy = MergeStrategyBinary
+			case strings.HasPrefix(field, "merge="):
+				strategy, err = ParseMergeStrategy(strings.TrimPrefix(field, "merge="))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+		}
+		if strategy == MergeStrategyUnspecified {
+			continue
+		}
+
+		matchBase := !strings.Contains(pattern, "/")
+		matcher, err := glob.Compile(strings.TrimPrefix(pattern, "/"), '/')
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid pattern %s %w", lineNum, pattern, err)
+		}
+		attrs.rules = append(attrs.rules, attributeRule{
+			matcher:   matcher,
+			matchBase: matchBase,
+			strategy:  strategy,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+// MergeStrategy return merge strategy of path, return MergeStrategyUnspecified if no rule match
+func (attrs *Attributes) MergeStrategy(fullPath string) MergeStrategy {
+	if attrs == nil {
+		return MergeStrategyUnspecified
+	}
+
+	fullPath = CleanPath(fullPath)
+	name := fullPath
+	if index := strings.LastIndex(fullPath, "/"); index > -1 {
+		name = fullPath[index+1:]
+	}
+	for i := len(attrs.rules) - 1; i >= 0; i-- {
+		rule := attrs.rules[i]
+		if rule.matchBase && rule.matcher.Match(name) {
+			return rule.strategy
+		}
+		if !rule.matchBase && rule.matcher.Match(fullPath) {
+			return rule.strategy
+		}
+	}
+	return MergeStrategyUnspecified
+}
+
+// Attributes load attributes file of current head tree, return empty attributes if file not exit
+func (repository *WorkRepository) Attributes(ctx context.Context) (*Attributes, error) {
+	if repository.headTree == nil {
+		return &Attributes{}, nil
+	}
+	if repository.attributes != nil && bytes.Equal(repository.attributesTree, *repository.headTree) {
+		return repository.attributes, nil
+	}
+
+	attrs, err := repository.loadAttributes(ctx, *repository.headTree)
+	if err != nil {
+		return nil, err
+	}
+	repository.attributes = attrs
+	repository.attributesTree = *repository.headTree
+	return attrs, nil
+}
+
+func (repository *WorkRepository) loadAttributes(ctx context.Context, treeHash hash.Hash) (*Attributes, error) {
+	if treeHash.IsEmpty() {
+		return &Attributes{}, nil
+	}
+	workTree, err := NewWorkTree(ctx, repository.repo.FileTreeRepo(repository.repoModel.ID), models.NewRootTreeEntry(treeHash))
+	if err != nil {
+		return nil, err
+	}
+	blob, _, err := workTree.FindBlob(ctx, AttributesFileName)
+	if err != nil {
+		if errors.Is(err, ErrPathNotFound) {
+			return &Attributes{}, nil
+		}
+		return nil, err
+	}
+	if blob.Size > MaxAttributesFileSize {
+		return nil, fmt.Errorf("attributes file too large")
+	}
+
+	reader, err := repository.ReadBlob(ctx, blob, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close() //nolint
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttributes(bytes.NewReader(content))
+}
+
+// AttributesResolver resolve conflict with merge strategy config in attributes file, paths without strategy
+// or marked as binary are resolved by resolver. if resolver is nil, return error for these paths.
+func AttributesResolver(ctx context.Context, repository *WorkRepository, attrs *Attributes, resolver ConflictResolver) ConflictResolver {
+	fallback := func(left IChange, right IChange) (IChange, error) {
+		if resolver == nil {
+			return nil, fmt.Errorf("path %s confilict %w", left.Path(), ErrConflict)
+		}
+		return resolver(left, right)
+	}
+
+	return func(left IChange, right IChange) (IChange, error) {
+		switch attrs.MergeStrategy(left.Path()) {
+		case MergeStrategyOurs:
+			return right, nil
+		case MergeStrategyTheirs:
+			return left, nil
+		case MergeStrategyUnion:
+			change, err := repository.mergeTextChange(ctx, left, right, true)
+			if errors.Is(err, ErrNotTextMergeable) {
+				return fallback(left, right)
+			}
+			return change, err
+		case MergeStrategyText3Way:
+			return TextMergeResolver(ctx, repository, fallback)(left, right)
+		default:
+			return fallback(left, right)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package versionmgr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAttributes(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		attrs, err := ParseAttributes(strings.NewReader(`
+# comment line
+*.csv         merge=union
+/data/*.csv   merge=ours
+data/b.csv    merge=theirs
+*.bin         binary
+**/*.json     merge=text3way
+*.txt         text
+`))
+		require.NoError(t, err)
+		require.Equal(t, MergeStrategyUnion, attrs.MergeStrategy("a.csv"))
+		require.Equal(t, MergeStrategyUnion, attrs.MergeStrategy("x/y/a.csv"))
+		require.Equal(t, MergeStrategyOurs, attrs.MergeStrategy("data/a.csv"))
+		require.Equal(t, MergeStrategyTheirs, attrs.MergeStrategy("/data/b.csv"))
+		require.Equal(t, MergeStrategyUnion, attrs.MergeStrategy("data/x/a.csv"))
+		require.Equal(t, MergeStrategyBinary, attrs.MergeStrategy("m/model.bin"))
+		require.Equal(t, MergeStrategyText3Way, attrs.MergeStrategy("a/b/c.json"))
+		require.Equal(t, MergeStrategyUnspecified, attrs.MergeStrategy("a.txt"))
+		require.Equal(t, MergeStrategyUnspecified, attrs.MergeStrategy("README.md"))
+	})
+	t.Run("unknown strategy", func(t *testing.T) {
+		_, err := ParseAttributes(strings.NewReader("*.csv merge=unknown\n"))
+		require.Error(t, err)
+	})
+	t.Run("nil attributes", func(t *testing.T) {
+		var attrs *Attributes
+		require.Equal(t, MergeStrategyUnspecified, attrs.MergeStrategy("a.csv"))
+	})
+}
@@ -0,0 +1,182 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+)
+
+// BlameHunk consecutive lines of file introduced by the same commit, line numbers start from 1
+type BlameHunk struct {
+	StartLine int
+	LineCount int
+	Commit    *models.Commit
+}
+
+// blameTarget lines of file in one commit which are not attributed yet
+type blameTarget struct {
+	blobHash hash.Hash
+	lines    []int
+	// origins line index in blamed file of each line in this version, -1 if line is not tracked
+	origins []int
+}
+
+// Blame attribute each line of text file of path in current commit to the commit introduced it, history is walked
+// backwards in committer time order, a line is passed to parent if it matches a line of the same file in parent,
+// lines not matched in any parent are introduced by the commit. hunks are passed to fn once they are attributed,
+// so they are not ordered by line number. only supported in branch, tag or commit.
+func (repository *WorkRepository) Blame(ctx context.Context, path string, fn func(*BlameHunk) error) error {
+	if repository.state == InWip {
+		return fmt.Errorf("blame is not supported in wip")
+	}
+	if repository.commit == nil {
+		return ErrPathNotFound
+	}
+	path = CleanPath(path)
+
+	commitRepo := repository.repo.CommitRepo(repository.repoModel.ID)
+	fileTreeRepo := repository.repo.FileTreeRepo(repository.repoModel.ID)
+
+	workTree, err := repository.RootTree(ctx)
+	if err != nil {
+		return err
+	}
+	blob, _, err := workTree.FindBlob(ctx, path)
+	if err != nil {
+		return err
+	}
+	content, _, err := repository.readTextBlob(ctx, fileTreeRepo, blob.Hash)
+	if err != nil {
+		return err
+	}
+
+	lineIDMap := make(map[string]int)
+	lines := lineIDs(lineIDMap, splitLines(content))
+	if len(lines) == 0 {
+		return nil
+	}
+	origins := make([]int, len(lines))
+	for i := range origins {
+		origins[i] = i
+	}
+
+	pending := map[string]*blameTarget{
+		repository.commit.Hash.Hex(): {blobHash: blob.Hash, lines: lines, origins: origins},
+	}
+	visited := make(map[string]struct{})
+	iter := NewCommitIterCTime(ctx, NewWrapCommitNode(commitRepo, repository.commit), nil, nil)
+	return iter.ForEach(func(commit *WrapCommitNode) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		visited[commit.Hash().Hex()] = struct{}{}
+		target, ok := pending[commit.Hash().Hex()]
+		if !ok {
+			return nil
+		}
+		delete(pending, commit.Hash().Hex())
+
+		remain := make([]int, len(target.origins))
+		copy(remain, target.origins)
+
+		parents, err := commit.Parents(ctx)
+		if err != nil {
+			return err
+		}
+		for _, parent := range parents {
+			if _, ok := visited[parent.Hash().Hex()]; ok {
+				//parent committed later than child, keep lines in this commit
+				continue
+			}
+
+			parentBlobHash, err := pathEntryHash(ctx, fileTreeRepo, parent.TreeHash(), path)
+			if err != nil {
+				return err
+			}
+			if parentBlobHash.IsEmpty() {
+				continue
+			}
+
+			parentTarget, ok := pending[parent.Hash().Hex()]
+			if !ok {
+				parentTarget = &blameTarget{blobHash: parentBlobHash}
+				if bytes.Equal(parentBlobHash, target.blobHash) {
+					parentTarget.lines = target.lines
+				} else {
+					parentContent, _, err := repository.readTextBlob(ctx, fileTreeRepo, parentBlobHash)
+					if err != nil {
+						if errors.Is(err, ErrNotTextMergeable) {
+							continue
+						}
+						return err
+					}
+					parentTarget.lines = lineIDs(lineIDMap, splitLines(parentContent))
+				}
+				parentTarget.origins = make([]int, len(parentTarget.lines))
+				for i := range parentTarget.origins {
+					parentTarget.origins[i] = -1
+				}
+			}
+
+			var match []int
+			if bytes.Equal(parentBlobHash, target.blobHash) {
+				//file not changed, all lines are passed to parent
+				match = make([]int, len(target.lines))
+				for i := range match {
+					match[i] = i
+				}
+			} else {
+				match = matchLines(target.lines, parentTarget.lines)
+			}
+
+			passed := false
+			for i, origin := range remain {
+				if origin >= 0 && match[i] >= 0 {
+					parentTarget.origins[match[i]] = origin
+					remain[i] = -1
+					passed = true
+				}
+			}
+			if passed {
+				pending[parent.Hash().Hex()] = parentTarget
+			}
+		}
+
+		err = emitBlameHunks(commit.Commit(), remain, fn)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return ErrStop
+		}
+		return nil
+	})
+}
+
+// emitBlameHunks group lines attributed to commit into hunks of consecutive lines
+func emitBlameHunks(commit *models.Commit, origins []int, fn func(*BlameHunk) error) error {
+	var hunk *BlameHunk
+	for _, origin := range origins {
+		if origin < 0 {
+			continue
+		}
+		if hunk != nil && hunk.StartLine+hunk.LineCount == origin+1 {
+			hunk.LineCount++
+			continue
+		}
+		if hunk != nil {
+			if err := fn(hunk); err != nil {
+				return err
+			}
+		}
+		hunk = &BlameHunk{StartLine: origin + 1, LineCount: 1, Commit: commit}
+	}
+	if hunk != nil {
+		return fn(hunk)
+	}
+	return nil
+}
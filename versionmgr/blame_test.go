@@ -0,0 +1,92 @@
+package versionmgr
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/GitDataAI/jiaozifs/block/mem"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlame(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	adapter := mem.New(ctx)
+	repo := models.NewRepo(db)
+
+	user, err := makeUser(ctx, repo.UserRepo(), "admin")
+	require.NoError(t, err)
+
+	project, err := makeRepository(ctx, repo, user, "testproject")
+	require.NoError(t, err)
+
+	workRepo := NewWorkRepositoryFromAdapter(ctx, user, project, repo, adapter)
+
+	writeText := func(msg string, path string, content string) *models.Commit {
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		_, _, err := workRepo.GetOrCreateWip(ctx)
+		require.NoError(t, err)
+		require.NoError(t, workRepo.CheckOut(ctx, InWip, "main"))
+		commit, err := workRepo.ChangeAndCommit(ctx, msg, func(workTree *WorkTree) error {
+			blob, err := workRepo.WriteBlob(ctx, strings.NewReader(content), int64(len(content)), models.Property{})
+			if err != nil {
+				return err
+			}
+			err = workTree.ReplaceLeaf(ctx, path, blob)
+			if errors.Is(err, ErrPathNotFound) {
+				return workTree.AddLeaf(ctx, path, blob)
+			}
+			return err
+		})
+		require.NoError(t, err)
+		return commit
+	}
+
+	commit1 := writeText("add labels", "labels.txt", "a\nb\nc\nd\n")
+	_ = writeText("add other", "other.txt", "x\n")
+	commit3 := writeText("modify labels", "labels.txt", "a\nB\nC\nd\ne\n")
+
+	blame := func(path string) ([]BlameHunk, error) {
+		var hunks []BlameHunk
+		err := workRepo.Blame(ctx, path, func(hunk *BlameHunk) error {
+			hunks = append(hunks, *hunk)
+			return nil
+		})
+		sort.Slice(hunks, func(i, j int) bool {
+			return hunks[i].StartLine < hunks[j].StartLine
+		})
+		return hunks, err
+	}
+
+	require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+	hunks, err := blame("labels.txt")
+	require.NoError(t, err)
+	require.Len(t, hunks, 4)
+	require.Equal(t, 1, hunks[0].StartLine)
+	require.Equal(t, 1, hunks[0].LineCount)
+	require.Equal(t, commit1.Hash.Hex(), hunks[0].Commit.Hash.Hex())
+	require.Equal(t, 2, hunks[1].StartLine)
+	require.Equal(t, 2, hunks[1].LineCount)
+	require.Equal(t, commit3.Hash.Hex(), hunks[1].Commit.Hash.Hex())
+	require.Equal(t, 4, hunks[2].StartLine)
+	require.Equal(t, commit1.Hash.Hex(), hunks[2].Commit.Hash.Hex())
+	require.Equal(t, 5, hunks[3].StartLine)
+	require.Equal(t, commit3.Hash.Hex(), hunks[3].Commit.Hash.Hex())
+
+	_, err = blame("not_exist.txt")
+	require.ErrorIs(t, err, ErrPathNotFound)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	err = workRepo.Blame(cancelCtx, "labels.txt", func(_ *BlameHunk) error {
+		cancel()
+		return nil
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,120 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	path2 "path"
+	"strings"
+
+	"github.com/GitDataAI/jiaozifs/controller/validator"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/ipfs/boxo/files"
+	"github.com/ipfs/go-cid"
+)
+
+var ErrObjectExist = errors.New("object exist")
+
+// DefaultMaxCarSize max size of car to import if not configured
+const DefaultMaxCarSize int64 = 1 << 30
+
+// CarImportResult summary of files imported from car
+type CarImportResult struct {
+	RootCid   cid.Cid
+	FileCount int
+	SizeBytes int64
+}
+
+// ImportCar add files of unixfs dag in car to wip under prefix. content is written to storage before wip changed,
+// existing object with different content is replaced only if isReplace is set, ErrCarTooLarge is returned if car is
+// larger than maxSize
+func (repository *WorkRepository) ImportCar(ctx context.Context, reader io.Reader, maxSize int64, prefix string, isReplace bool) (*CarImportResult, error) {
+	if repository.state != InWip {
+		return nil, errors.New("must import car to wip")
+	}
+	if maxSize <= 0 {
+		maxSize = DefaultMaxCarSize
+	}
+
+	type importedFile struct {
+		path string
+		blob *models.Blob
+	}
+	var importedFiles []importedFile
+	result := &CarImportResult{}
+	rootCid, err := WalkCar(ctx, reader, maxSize, func(filePath string, file files.File) error {
+		fullPath := CleanPath(path2.Join(prefix, filePath))
+		if len(fullPath) == 0 {
+			return fmt.Errorf("root of car is a file, path is required %w", ErrInvalidCar)
+		}
+		if err := validateImportPath(filePath, fullPath); err != nil {
+			return err
+		}
+		size, err := file.Size()
+		if err != nil {
+			return err
+		}
+		blob, err := repository.WriteBlob(ctx, file, size, models.DefaultLeafProperty())
+		if err != nil {
+			return fmt.Errorf("write %s %w", fullPath, err)
+		}
+		importedFiles = append(importedFiles, importedFile{path: fullPath, blob: blob})
+		result.FileCount++
+		result.SizeBytes += blob.Size
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.RootCid = rootCid
+
+	err = repository.ChangeInWip(ctx, func(root *WorkTree) error {
+		for _, file := range importedFiles {
+			oldBlob, _, err := root.FindBlob(ctx, file.path)
+			if err != nil && !errors.Is(err, ErrPathNotFound) {
+				return err
+			}
+			if oldBlob == nil {
+				err = root.AddLeaf(ctx, file.path, file.blob)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			if bytes.Equal(oldBlob.CheckSum, file.blob.CheckSum) {
+				continue
+			}
+			if !isReplace {
+				return fmt.Errorf("%s %w", file.path, ErrObjectExist)
+			}
+			err = root.ReplaceLeaf(ctx, file.path, file.blob)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// validateImportPath reject file name of car which is absolute, escape the prefix or not a valid object path
+func validateImportPath(filePath, fullPath string) error {
+	if path2.IsAbs(filePath) {
+		return fmt.Errorf("file %s has absolute path %w", filePath, ErrInvalidCar)
+	}
+	for _, name := range strings.Split(filePath, "/") {
+		if name == ".." {
+			return fmt.Errorf("file %s escape root of car %w", filePath, ErrInvalidCar)
+		}
+	}
+	if err := validator.ValidateObjectPath(fullPath); err != nil {
+		return fmt.Errorf("file %s %v %w", fullPath, err, ErrInvalidCar)
+	}
+	return nil
+}
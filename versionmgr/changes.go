@@ -126,9 +126,10 @@ func newChanges(mChanges merkletrie.Changes) *Changes {
 }
 
 type ChangePair struct {
-	Left       IChange
-	Right      IChange
-	IsConflict bool
+	Left          IChange
+	Right         IChange
+	IsConflict    bool
+	MergeStrategy MergeStrategy
 }
 
 func (changePair ChangePair) Path() string {
@@ -0,0 +1,130 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+)
+
+// ErrNothingToApply changes of commit already exist in branch, no commit created
+var ErrNothingToApply = errors.New("nothing to apply, changes of commit already in branch")
+
+// CherryPick apply changes of commit against its first parent to current branch and create a new commit.
+// conflict is resolved by resolver, left is change of the commit and right is change of branch, same as Merge
+func (repository *WorkRepository) CherryPick(ctx context.Context, commitHash hash.Hash, resolver ConflictResolver) (*models.Commit, error) {
+	return repository.applyCommit(ctx, commitHash, false, resolver)
+}
+
+// RevertCommit undo changes of commit against its first parent in current branch and create a new commit.
+// conflict is resolved by resolver, left is inverted change of the commit and right is change of branch
+func (repository *WorkRepository) RevertCommit(ctx context.Context, commitHash hash.Hash, resolver ConflictResolver) (*models.Commit, error) {
+	return repository.applyCommit(ctx, commitHash, true, resolver)
+}
+
+// applyCommit three-way merge commit tree and branch head with parent of commit as base, reverting swap commit and its parent
+func (repository *WorkRepository) applyCommit(ctx context.Context, commitHash hash.Hash, revert bool, resolver ConflictResolver) (*models.Commit, error) {
+	if repository.state != InBranch {
+		return nil, errors.New("must cherry-pick or revert on branch")
+	}
+
+	commitRepo := repository.repo.CommitRepo(repository.repoModel.ID)
+	commit, err := commitRepo.Commit(ctx, commitHash)
+	if err != nil {
+		return nil, err
+	}
+
+	parentTree := hash.Empty
+	if len(commit.ParentHashes) > 0 {
+		parent, err := commitRepo.Commit(ctx, commit.ParentHashes[0])
+		if err != nil {
+			return nil, err
+		}
+		parentTree = parent.TreeHash
+	}
+
+	headTree := hash.Empty
+	if !repository.branch.CommitHash.IsEmpty() {
+		head, err := commitRepo.Commit(ctx, repository.branch.CommitHash)
+		if err != nil {
+			return nil, err
+		}
+		headTree = head.TreeHash
+	}
+
+	baseTree, applyTree := parentTree, commit.TreeHash
+	author := commit.Author
+	msg := fmt.Sprintf("%s\n\n(cherry picked from commit %s)", commit.Message, commit.Hash.Hex())
+	if revert {
+		baseTree, applyTree = commit.TreeHash, parentTree
+		author = models.Signature{
+			Name:  repository.operator.Name,
+			Email: repository.operator.Email,
+			When:  time.Now(),
+		}
+		title, _, _ := strings.Cut(commit.Message, "\n")
+		msg = fmt.Sprintf("Revert \"%s\"\n\nThis reverts commit %s.", title, commit.Hash.Hex())
+	}
+
+	attributes, err := repository.Attributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolver = AttributesResolver(ctx, repository, attributes, resolver)
+
+	var newCommit *models.Commit
+	err = repository.repo.Transaction(ctx, func(repo models.IRepo) error {
+		fileTreeRepo := repo.FileTreeRepo(repository.repoModel.ID)
+		baseWorkTree, err := NewWorkTree(ctx, fileTreeRepo, models.NewRootTreeEntry(baseTree))
+		if err != nil {
+			return err
+		}
+
+		applyDiff, err := baseWorkTree.Diff(ctx, applyTree, "")
+		if err != nil {
+			return err
+		}
+		headDiff, err := baseWorkTree.Diff(ctx, headTree, "")
+		if err != nil {
+			return err
+		}
+		applyDiff, headDiff, err = detectAndFollowRenames(ctx, fileTreeRepo, repository.RenameDetector(), applyDiff, headDiff)
+		if err != nil {
+			return err
+		}
+
+		cmw := NewChangesMergeIter(applyDiff, headDiff, resolver)
+		for cmw.Has() {
+			change, err := cmw.Next()
+			if err != nil {
+				return err
+			}
+			err = baseWorkTree.ApplyOneChange(ctx, change)
+			if err != nil {
+				return err
+			}
+		}
+
+		if bytes.Equal(baseWorkTree.Root().Hash(), headTree) {
+			return ErrNothingToApply
+		}
+		operation := models.ReflogOperationCherryPick
+		if revert {
+			operation = models.ReflogOperationRevert
+		}
+		newCommit, err = repository.commitChangeRoot(ctx, repo, author, baseWorkTree.Root().Hash(), msg, operation)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	repository.branch.CommitHash = newCommit.Hash
+	repository.headTree = &newCommit.TreeHash
+	return newCommit, nil
+}
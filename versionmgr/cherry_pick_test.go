@@ -0,0 +1,126 @@
+package versionmgr
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/GitDataAI/jiaozifs/block/mem"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCherryPickAndRevert
+//
+// example
+//
+//	root --- C1 --- C2    main
+//	   \
+//	    B1                feat
+func TestCherryPickAndRevert(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	adapter := mem.New(ctx)
+	repo := models.NewRepo(db)
+
+	user, err := makeUser(ctx, repo.UserRepo(), "admin")
+	require.NoError(t, err)
+
+	project, err := makeRepository(ctx, repo, user, "testproject")
+	require.NoError(t, err)
+
+	workRepo := NewWorkRepositoryFromAdapter(ctx, user, project, repo, adapter)
+
+	rootCommit, err := addChangesToWip(ctx, workRepo, "main", "root commit", `
+1|a.txt	|a1
+1|b/c.txt	|c1
+`)
+	require.NoError(t, err)
+
+	commit1, err := addChangesToWip(ctx, workRepo, "main", "add d", `
+1|d.txt	|d1
+`)
+	require.NoError(t, err)
+
+	commit2, err := addChangesToWip(ctx, workRepo, "main", "modify a", `
+3|a.txt	|a2
+`)
+	require.NoError(t, err)
+
+	require.NoError(t, workRepo.CheckOut(ctx, InCommit, rootCommit.Hash.Hex()))
+	_, err = workRepo.CreateBranch(ctx, "feat")
+	require.NoError(t, err)
+	_, err = addChangesToWip(ctx, workRepo, "feat", "modify c", `
+3|b/c.txt	|c2
+`)
+	require.NoError(t, err)
+
+	readFile := func(path string) string {
+		workTree, err := workRepo.RootTree(ctx)
+		require.NoError(t, err)
+		blob, _, err := workTree.FindBlob(ctx, path)
+		require.NoError(t, err)
+		reader, err := workRepo.ReadBlob(ctx, blob, nil)
+		require.NoError(t, err)
+		defer reader.Close() //nolint
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("cherry pick", func(t *testing.T) {
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "feat"))
+		newCommit, err := workRepo.CherryPick(ctx, commit2.Hash, ForbidResolver)
+		require.NoError(t, err)
+		require.Len(t, newCommit.ParentHashes, 1)
+		require.Equal(t, commit2.Author.Name, newCommit.Author.Name)
+
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "feat"))
+		require.Equal(t, newCommit.Hash.Hex(), workRepo.CurBranch().CommitHash.Hex())
+		require.Equal(t, "a2", readFile("a.txt"))
+		require.Equal(t, "c2", readFile("b/c.txt"))
+		workTree, err := workRepo.RootTree(ctx)
+		require.NoError(t, err)
+		_, _, err = workTree.FindBlob(ctx, "d.txt")
+		require.ErrorIs(t, err, ErrPathNotFound)
+
+		//apply again
+		_, err = workRepo.CherryPick(ctx, commit2.Hash, ForbidResolver)
+		require.ErrorIs(t, err, ErrNothingToApply)
+	})
+
+	t.Run("revert commit", func(t *testing.T) {
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		newCommit, err := workRepo.RevertCommit(ctx, commit1.Hash, ForbidResolver)
+		require.NoError(t, err)
+		require.Equal(t, []hash.Hash{commit2.Hash}, newCommit.ParentHashes)
+
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		require.Equal(t, "a2", readFile("a.txt"))
+		workTree, err := workRepo.RootTree(ctx)
+		require.NoError(t, err)
+		_, _, err = workTree.FindBlob(ctx, "d.txt")
+		require.ErrorIs(t, err, ErrPathNotFound)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		_, err = addChangesToWip(ctx, workRepo, "main", "modify a again", `
+3|a.txt	|a3
+`)
+		require.NoError(t, err)
+
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		_, err = workRepo.RevertCommit(ctx, commit2.Hash, ForbidResolver)
+		require.Error(t, err)
+
+		newCommit, err := workRepo.RevertCommit(ctx, commit2.Hash, OneSideResolver(true))
+		require.NoError(t, err)
+		require.NotNil(t, newCommit)
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		require.Equal(t, "a1", readFile("a.txt"))
+	})
+}
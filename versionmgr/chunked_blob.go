@@ -0,0 +1,172 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec
+	"errors"
+	"io"
+
+	"github.com/GitDataAI/jiaozifs/block"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/GitDataAI/jiaozifs/utils/pathutil"
+	chunker "github.com/ipfs/boxo/chunker"
+)
+
+// size of content defined chunks, boundaries are decided by rabin fingerprint, so insert or append data
+// only change chunks around the modified position
+const (
+	ChunkMinSize = 256 << 10
+	ChunkAvgSize = 1 << 20
+	ChunkMaxSize = 4 << 20
+)
+
+// contentPointer return pointer of content address in storage namespace of repository
+func (repository *WorkRepository) contentPointer(checkSum hash.Hash) block.ObjectPointer {
+	return block.ObjectPointer{
+		StorageNamespace: utils.StringValue(repository.repoModel.StorageNamespace),
+		IdentifierType:   block.IdentifierTypeRelative,
+		Identifier:       pathutil.PathOfHash(checkSum),
+	}
+}
+
+// writeChunkedBlob split content into chunks and store each chunk by its hash, chunks already exit in storage
+// are not written again
+func (repository *WorkRepository) writeChunkedBlob(ctx context.Context, body io.Reader, properties models.Property) (*models.Blob, error) {
+	hashReader := hash.NewHashingReader(body, hash.Md5)
+	splitter := chunker.NewRabinMinMax(hashReader, ChunkMinSize, ChunkAvgSize, ChunkMaxSize)
+
+	var chunks []models.Chunk
+	for {
+		data, err := splitter.NextBytes()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		chunk, err := repository.writeChunk(ctx, data)
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, chunk)
+	}
+	//empty content is stored as one empty chunk
+	if len(chunks) == 0 {
+		chunk, err := repository.writeChunk(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	checkSum := hash.Hash(hashReader.Md5.Sum(nil))
+	blob, err := models.NewBlob(properties, repository.repoModel.ID, checkSum, hashReader.CopiedSize)
+	if err != nil {
+		return nil, err
+	}
+	blob.Chunks = chunks
+	return blob, nil
+}
+
+func (repository *WorkRepository) writeChunk(ctx context.Context, data []byte) (models.Chunk, error) {
+	sum := md5.Sum(data) //nolint:gosec
+	chunk := models.Chunk{Hash: sum[:], Size: int64(len(data))}
+	pointer := repository.contentPointer(chunk.Hash)
+	exist, err := repository.adapter.Exists(ctx, pointer)
+	if err != nil {
+		return models.Chunk{}, err
+	}
+	if exist {
+		return chunk, nil
+	}
+	return chunk, repository.adapter.Put(ctx, pointer, chunk.Size, bytes.NewReader(data), block.PutOpts{})
+}
+
+// chunkedReader read range [start, end] of chunked blob, chunks are opened one by one when read
+type chunkedReader struct {
+	ctx        context.Context
+	repository *WorkRepository
+	chunks     []models.Chunk
+
+	pos     int64
+	end     int64
+	current io.ReadCloser
+	//offset of chunk which current reader belong to
+	chunkIndex  int
+	chunkOffset int64
+}
+
+func newChunkedReader(ctx context.Context, repository *WorkRepository, chunks []models.Chunk, start, end int64) *chunkedReader {
+	return &chunkedReader{
+		ctx:        ctx,
+		repository: repository,
+		chunks:     chunks,
+		pos:        start,
+		end:        end,
+	}
+}
+
+func (reader *chunkedReader) Read(p []byte) (int, error) {
+	for {
+		if reader.pos > reader.end {
+			return 0, io.EOF
+		}
+		if reader.current == nil {
+			err := reader.openChunk()
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		if remain := reader.end - reader.pos + 1; int64(len(p)) > remain {
+			p = p[:remain]
+		}
+		n, err := reader.current.Read(p)
+		reader.pos += int64(n)
+		if errors.Is(err, io.EOF) {
+			_ = reader.current.Close()
+			reader.current = nil
+			if reader.pos < reader.chunkOffset+reader.chunks[reader.chunkIndex].Size && reader.pos <= reader.end {
+				return n, io.ErrUnexpectedEOF
+			}
+			err = nil
+		}
+		if n > 0 || err != nil {
+			return n, err
+		}
+	}
+}
+
+// openChunk open reader of chunk which contains current position
+func (reader *chunkedReader) openChunk() error {
+	var offset int64
+	for index, chunk := range reader.chunks {
+		if reader.pos < offset+chunk.Size {
+			chunkEnd := offset + chunk.Size - 1
+			if chunkEnd > reader.end {
+				chunkEnd = reader.end
+			}
+			current, err := reader.repository.adapter.GetRange(reader.ctx, reader.repository.contentPointer(chunk.Hash), reader.pos-offset, chunkEnd-offset)
+			if err != nil {
+				return err
+			}
+			reader.current = current
+			reader.chunkIndex = index
+			reader.chunkOffset = offset
+			return nil
+		}
+		offset += chunk.Size
+	}
+	return io.ErrUnexpectedEOF
+}
+
+func (reader *chunkedReader) Close() error {
+	if reader.current != nil {
+		return reader.current.Close()
+	}
+	return nil
+}
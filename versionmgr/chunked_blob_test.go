@@ -0,0 +1,80 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/GitDataAI/jiaozifs/block/local"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkedBlob(t *testing.T) {
+	ctx := context.Background()
+	adapter, err := local.NewAdapter(t.TempDir())
+	require.NoError(t, err)
+
+	repoModel := &models.Repository{
+		ID:               uuid.New(),
+		ChunkedStorage:   true,
+		StorageNamespace: utils.String("local://chunked"),
+	}
+	repository := NewWorkRepositoryFromAdapter(ctx, nil, repoModel, nil, adapter)
+
+	data := make([]byte, 6*ChunkAvgSize)
+	_, err = rand.New(rand.NewSource(1)).Read(data)
+	require.NoError(t, err)
+
+	blob, err := repository.writeBlobContent(ctx, bytes.NewReader(data), int64(len(data)), models.DefaultLeafProperty())
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), blob.Size)
+	require.Greater(t, len(blob.Chunks), 1)
+
+	readAll := func(blob *models.Blob, rangeSpec *string) []byte {
+		reader, err := repository.ReadBlob(ctx, blob, rangeSpec)
+		require.NoError(t, err)
+		defer reader.Close() //nolint
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		return content
+	}
+
+	t.Run("read all", func(t *testing.T) {
+		require.Equal(t, data, readAll(blob, nil))
+	})
+
+	t.Run("read range across chunks", func(t *testing.T) {
+		start := blob.Chunks[0].Size - 10
+		end := blob.Chunks[0].Size + blob.Chunks[1].Size + 10
+		require.Equal(t, data[start:end+1], readAll(blob, utils.String(fmt.Sprintf("bytes=%d-%d", start, end))))
+		require.Equal(t, data[len(data)-100:], readAll(blob, utils.String("bytes=-100")))
+	})
+
+	t.Run("append share chunks", func(t *testing.T) {
+		appended := append(append([]byte{}, data...), []byte("new row\n")...)
+		appendedBlob, err := repository.writeBlobContent(ctx, bytes.NewReader(appended), int64(len(appended)), models.DefaultLeafProperty())
+		require.NoError(t, err)
+
+		shared := 0
+		for i := 0; i < len(blob.Chunks)-1; i++ {
+			if bytes.Equal(blob.Chunks[i].Hash, appendedBlob.Chunks[i].Hash) {
+				shared++
+			}
+		}
+		require.Equal(t, len(blob.Chunks)-1, shared)
+		require.Equal(t, appended, readAll(appendedBlob, nil))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		emptyBlob, err := repository.writeBlobContent(ctx, bytes.NewReader(nil), 0, models.DefaultLeafProperty())
+		require.NoError(t, err)
+		require.Len(t, emptyBlob.Chunks, 1)
+		require.Len(t, readAll(emptyBlob, nil), 0)
+	})
+}
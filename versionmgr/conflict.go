@@ -68,3 +68,14 @@ func ResolveFromSelector(resolveMsg map[string]string) ConflictResolver {
 		return right, nil
 	}
 }
+
+// ResolveFromSelectorOr use the selection of user if path was selected, otherwise resolve conflict with resolver
+func ResolveFromSelectorOr(resolveMsg map[string]string, resolver ConflictResolver) ConflictResolver {
+	selector := ResolveFromSelector(resolveMsg)
+	return func(left IChange, right IChange) (IChange, error) {
+		if _, ok := resolveMsg[left.Path()]; ok {
+			return selector(left, right)
+		}
+		return resolver(left, right)
+	}
+}
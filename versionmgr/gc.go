@@ -0,0 +1,430 @@
+package versionmgr
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+	logging "github.com/ipfs/go-log/v2"
+)
+
+var gcLog = logging.Logger("gc")
+
+// DefaultGCGracePeriod objects created or referenced again within this period are never collected,
+// this protect objects of in-flight uploads and commits which are not referenced by any ref yet
+const DefaultGCGracePeriod = 24 * time.Hour
+
+// ErrContentRemoved content of blob was removed by gc before blob registered
+var ErrContentRemoved = errors.New("content removed")
+
+// gcPageSize max number of rows loaded or deleted in one sql
+const gcPageSize = 500
+
+// GCOptions options of garbage collection
+type GCOptions struct {
+	// DryRun only report unreachable objects, nothing was deleted
+	DryRun bool
+	// GracePeriod objects newer than this period are treated as reachable
+	GracePeriod time.Duration
+}
+
+// GCReport result of garbage collection
+type GCReport struct {
+	RepositoryID uuid.UUID
+	DryRun       bool
+
+	ReachableCommits int
+	ReachableTrees   int
+	ReachableBlobs   int
+
+	SweptCommits int
+	SweptTrees   int
+	SweptBlobs   int
+	// RemovedContents number of content addresses removed from storage
+	RemovedContents int
+	// FreedBytes size of removed contents
+	FreedBytes int64
+}
+
+// GC collect commits, trees and blobs of repository which can not be reached from branches, tags, wips and reflogs,
+// content in storage is removed once no blob reference it. merge requests reference source and target branch,
+// so objects of open merge requests are reachable from branches.
+// reachable objects are marked by loading them from roots in batches, then commits and trees are swept page by page,
+// so only hashes of reachable objects are kept in memory.
+func (repository *WorkRepository) GC(ctx context.Context, opts GCOptions) (*GCReport, error) {
+	repoID := repository.repoModel.ID
+	deadline := time.Now().Add(-opts.GracePeriod)
+
+	commitRoots, treeRoots, err := repository.gcRoots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	//objects in grace period are roots too
+	graceCommits, graceTrees, err := repository.gcGraceRoots(ctx, deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	marker := newGCMarker(repository.repo.CommitRepo(repoID), repository.repo.FileTreeRepo(repoID))
+	err = marker.mark(ctx, append(commitRoots, graceCommits...), append(treeRoots, graceTrees...))
+	if err != nil {
+		return nil, err
+	}
+
+	report := &GCReport{
+		RepositoryID:     repoID,
+		DryRun:           opts.DryRun,
+		ReachableCommits: len(marker.reachableCommits),
+		ReachableTrees:   marker.treeCount,
+		ReachableBlobs:   marker.blobCount,
+	}
+
+	if opts.DryRun {
+		//sweep in one transaction which is rolled back at last, contents shared by swept blobs are counted as real run
+		err = repository.repo.Transaction(ctx, func(repo models.IRepo) error {
+			err := repository.gcSweep(ctx, marker, deadline, report, false, func(fn func(repo models.IRepo) error) error {
+				return fn(repo)
+			})
+			if err != nil {
+				return err
+			}
+			return errGCDryRun
+		})
+		if !errors.Is(err, errGCDryRun) {
+			return nil, err
+		}
+		return report, nil
+	}
+
+	err = repository.gcSweep(ctx, marker, deadline, report, true, func(fn func(repo models.IRepo) error) error {
+		return repository.repo.Transaction(ctx, fn)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// errGCDryRun rollback transaction of dry run
+var errGCDryRun = errors.New("gc dry run")
+
+// gcSweep delete unreachable commits and objects not updated since deadline page by page, runInTx run each page in
+// a transaction. rows are deleted only if they are still not updated since deadline, so objects referenced again
+// during gc are kept. content of swept blobs is rechecked in the transaction and removed only if no blob reference it.
+func (repository *WorkRepository) gcSweep(ctx context.Context, marker *gcMarker, deadline time.Time, report *GCReport, removeContent bool, runInTx func(fn func(repo models.IRepo) error) error) error {
+	repoID := repository.repoModel.ID
+
+	var after hash.Hash
+	for {
+		commits, err := repository.repo.CommitRepo(repoID).ListPage(ctx, models.NewListCommitParams().SetAfter(after).SetAmount(gcPageSize))
+		if err != nil {
+			return err
+		}
+		if len(commits) == 0 {
+			break
+		}
+		after = commits[len(commits)-1].Hash
+
+		var sweptCommits []hash.Hash
+		for _, commit := range commits {
+			if _, ok := marker.reachableCommits[commit.Hash.Hex()]; ok || !commit.UpdatedAt.Before(deadline) {
+				continue
+			}
+			sweptCommits = append(sweptCommits, commit.Hash)
+		}
+		if len(sweptCommits) > 0 {
+			err = runInTx(func(repo models.IRepo) error {
+				deleted, err := repo.CommitRepo(repoID).Delete(ctx, models.NewDeleteParams().SetHashes(sweptCommits...).SetUpdatedBefore(deadline))
+				if err != nil {
+					return err
+				}
+				report.SweptCommits += int(deleted)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(commits) < gcPageSize {
+			break
+		}
+	}
+
+	//content address is shared by blobs with same checksum or same chunk, remember removed ones in case of
+	//they are referenced by blobs in later pages
+	removedContents := make(map[string]struct{})
+	after = nil
+	for {
+		objects, err := repository.repo.FileTreeRepo(repoID).ListPage(ctx, models.NewListTreeParams().SetAfter(after).SetAmount(gcPageSize))
+		if err != nil {
+			return err
+		}
+		if len(objects) == 0 {
+			break
+		}
+		after = objects[len(objects)-1].Hash
+
+		var sweptTrees, sweptBlobs []hash.Hash
+		var deadContents []models.Chunk
+		for index := range objects {
+			object := &objects[index]
+			if _, ok := marker.reachableTrees[object.Hash.Hex()]; ok || !object.UpdatedAt.Before(deadline) {
+				continue
+			}
+			if object.Type != models.BlobObject {
+				sweptTrees = append(sweptTrees, object.Hash)
+				continue
+			}
+			sweptBlobs = append(sweptBlobs, object.Hash)
+			deadContents = append(deadContents, blobContents(object)...)
+		}
+		if len(sweptTrees)+len(sweptBlobs) > 0 {
+			err = runInTx(func(repo models.IRepo) error {
+				return repository.gcSweepObjects(ctx, repo, sweptTrees, sweptBlobs, deadContents, removedContents, deadline, report, removeContent)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(objects) < gcPageSize {
+			break
+		}
+	}
+	return nil
+}
+
+// gcSweepObjects delete trees and blobs of one page, then remove contents which are not referenced by any blob.
+// contents are removed while holding the contents lock exclusively, writers check content under the shared lock
+// before registering blob, so blob registered after gc always find its content missing and fail.
+// if transaction fails after contents removed, only unreachable rows which will be swept next time are restored.
+func (repository *WorkRepository) gcSweepObjects(ctx context.Context, repo models.IRepo, sweptTrees, sweptBlobs []hash.Hash, deadContents []models.Chunk, removedContents map[string]struct{}, deadline time.Time, report *GCReport, removeContent bool) error {
+	fileTreeRepo := repo.FileTreeRepo(repository.repoModel.ID)
+	if removeContent {
+		err := fileTreeRepo.LockContents(ctx, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(sweptTrees) > 0 {
+		deleted, err := fileTreeRepo.Delete(ctx, models.NewDeleteTreeParams().SetHashes(sweptTrees...).SetUpdatedBefore(deadline))
+		if err != nil {
+			return err
+		}
+		report.SweptTrees += int(deleted)
+	}
+	if len(sweptBlobs) > 0 {
+		deleted, err := fileTreeRepo.Delete(ctx, models.NewDeleteTreeParams().SetHashes(sweptBlobs...).SetUpdatedBefore(deadline))
+		if err != nil {
+			return err
+		}
+		report.SweptBlobs += int(deleted)
+	}
+
+	for _, content := range deadContents {
+		if _, ok := removedContents[content.Hash.Hex()]; ok {
+			continue
+		}
+		referenced, err := fileTreeRepo.ContentReferenced(ctx, content.Hash)
+		if err != nil {
+			return err
+		}
+		if referenced {
+			continue
+		}
+		removedContents[content.Hash.Hex()] = struct{}{}
+		if removeContent {
+			err = repository.adapter.Remove(ctx, repository.contentPointer(content.Hash))
+			if err != nil {
+				//failure of removing content only leave some garbage in storage
+				gcLog.Warnf("remove content %s of repository %s failed %v", content.Hash.Hex(), repository.repoModel.ID, err)
+				continue
+			}
+		}
+		report.RemovedContents++
+		report.FreedBytes += content.Size
+	}
+	return nil
+}
+
+// blobContents return content addresses referenced by blob, chunks for chunked blob, otherwise the checksum
+func blobContents(object *models.FileTree) []models.Chunk {
+	if len(object.Chunks) > 0 {
+		return object.Chunks
+	}
+	return []models.Chunk{{Hash: object.CheckSum, Size: object.Size}}
+}
+
+// gcGraceRoots return commits and objects updated within grace period
+func (repository *WorkRepository) gcGraceRoots(ctx context.Context, deadline time.Time) ([]hash.Hash, []hash.Hash, error) {
+	repoID := repository.repoModel.ID
+	var commitRoots, treeRoots []hash.Hash
+
+	var after hash.Hash
+	for {
+		commits, err := repository.repo.CommitRepo(repoID).ListPage(ctx, models.NewListCommitParams().SetUpdatedAfter(deadline).SetAfter(after).SetAmount(gcPageSize))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, commit := range commits {
+			commitRoots = append(commitRoots, commit.Hash)
+		}
+		if len(commits) < gcPageSize {
+			break
+		}
+		after = commits[len(commits)-1].Hash
+	}
+
+	after = nil
+	for {
+		objects, err := repository.repo.FileTreeRepo(repoID).ListPage(ctx, models.NewListTreeParams().SetUpdatedAfter(deadline).SetAfter(after).SetAmount(gcPageSize))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, object := range objects {
+			treeRoots = append(treeRoots, object.Hash)
+		}
+		if len(objects) < gcPageSize {
+			break
+		}
+		after = objects[len(objects)-1].Hash
+	}
+	return commitRoots, treeRoots, nil
+}
+
+// gcRoots return commits and trees referenced by branches, tags, wips and branch reflogs
+func (repository *WorkRepository) gcRoots(ctx context.Context) ([]hash.Hash, []hash.Hash, error) {
+	repoID := repository.repoModel.ID
+	var commitRoots, treeRoots []hash.Hash
+
+	branches, _, err := repository.repo.BranchRepo().List(ctx, models.NewListBranchParams().SetRepositoryID(repoID))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, branch := range branches {
+		commitRoots = append(commitRoots, branch.CommitHash)
+	}
+
+	tags, _, err := repository.repo.TagRepo().List(ctx, models.NewListTagParams().SetRepositoryID(repoID))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, tag := range tags {
+		commitRoots = append(commitRoots, tag.Target)
+	}
+
+	wips, err := repository.repo.WipRepo().List(ctx, models.NewListWipParams().SetRepositoryID(repoID))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, wip := range wips {
+		commitRoots = append(commitRoots, wip.BaseCommit)
+		treeRoots = append(treeRoots, wip.CurrentTree)
+	}
+
+	//reflog entries are kept forever, commits they recorded must stay restorable
+	reflogs, _, err := repository.repo.BranchReflogRepo().List(ctx, models.NewListBranchReflogParams().SetRepositoryID(repoID))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, reflog := range reflogs {
+		commitRoots = append(commitRoots, reflog.OldHash, reflog.NewHash)
+	}
+	return commitRoots, treeRoots, nil
+}
+
+// gcMarker mark commits and objects reachable from roots, they are loaded from database in batches while walking,
+// only hashes of reachable ones are kept
+type gcMarker struct {
+	loadCommits func(ctx context.Context, hashes []hash.Hash) ([]*models.Commit, error)
+	loadObjects func(ctx context.Context, hashes []hash.Hash) ([]models.FileTree, error)
+
+	reachableCommits map[string]struct{}
+	reachableTrees   map[string]struct{}
+	treeCount        int
+	blobCount        int
+}
+
+func newGCMarker(commitRepo models.ICommitRepo, fileTreeRepo models.IFileTreeRepo) *gcMarker {
+	return &gcMarker{
+		loadCommits: func(ctx context.Context, hashes []hash.Hash) ([]*models.Commit, error) {
+			return commitRepo.ListPage(ctx, models.NewListCommitParams().SetHashes(hashes...))
+		},
+		loadObjects: func(ctx context.Context, hashes []hash.Hash) ([]models.FileTree, error) {
+			return fileTreeRepo.ListPage(ctx, models.NewListTreeParams().SetHashes(hashes...))
+		},
+		reachableCommits: make(map[string]struct{}),
+		reachableTrees:   make(map[string]struct{}),
+	}
+}
+
+// mark walk history of commit roots and sub objects of tree roots, missing objects are ignored
+func (marker *gcMarker) mark(ctx context.Context, commitRoots []hash.Hash, treeRoots []hash.Hash) error {
+	commitQueue := append([]hash.Hash{}, commitRoots...)
+	treeQueue := append([]hash.Hash{}, treeRoots...)
+
+	requested := make(map[string]struct{})
+	for len(commitQueue) > 0 {
+		var batch []hash.Hash
+		batch, commitQueue = nextGCBatch(commitQueue, requested, gcPageSize)
+		if len(batch) == 0 {
+			continue
+		}
+		commits, err := marker.loadCommits(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for _, commit := range commits {
+			marker.reachableCommits[commit.Hash.Hex()] = struct{}{}
+			treeQueue = append(treeQueue, commit.TreeHash)
+			commitQueue = append(commitQueue, commit.ParentHashes...)
+		}
+	}
+
+	requested = make(map[string]struct{})
+	for len(treeQueue) > 0 {
+		var batch []hash.Hash
+		batch, treeQueue = nextGCBatch(treeQueue, requested, gcPageSize)
+		if len(batch) == 0 {
+			continue
+		}
+		objects, err := marker.loadObjects(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for index := range objects {
+			object := &objects[index]
+			marker.reachableTrees[object.Hash.Hex()] = struct{}{}
+			if object.Type == models.BlobObject {
+				marker.blobCount++
+				continue
+			}
+			marker.treeCount++
+			for _, entry := range object.SubObjects {
+				treeQueue = append(treeQueue, entry.Hash)
+			}
+		}
+	}
+	return nil
+}
+
+// nextGCBatch pop at most size hashes which have not been requested from tail of queue, empty hashes are dropped
+func nextGCBatch(queue []hash.Hash, requested map[string]struct{}, size int) ([]hash.Hash, []hash.Hash) {
+	var batch []hash.Hash
+	for len(queue) > 0 && len(batch) < size {
+		next := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if next.IsEmpty() {
+			continue
+		}
+		if _, ok := requested[next.Hex()]; ok {
+			continue
+		}
+		requested[next.Hex()] = struct{}{}
+		batch = append(batch, next)
+	}
+	return batch, queue
+}
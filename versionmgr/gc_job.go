@@ -0,0 +1,90 @@
+package versionmgr
+
+import (
+	"context"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/block/params"
+	"github.com/GitDataAI/jiaozifs/config"
+	"github.com/GitDataAI/jiaozifs/models"
+	"go.uber.org/fx"
+)
+
+// DefaultGCInterval interval of background garbage collection if not config
+const DefaultGCInterval = 24 * time.Hour
+
+// GCRepositories run garbage collection on repositories match listParams one by one, failure of one repository
+// was logged and skipped
+func GCRepositories(ctx context.Context, repo models.IRepo, publicAdapterConfig params.AdapterConfig, listParams *models.ListRepoParams, opts GCOptions) ([]*GCReport, error) {
+	repositories, _, err := repo.RepositoryRepo().List(ctx, listParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []*GCReport
+	for _, repository := range repositories {
+		workRepo, err := NewWorkRepositoryFromConfig(ctx, nil, repository, repo, publicAdapterConfig)
+		if err != nil {
+			gcLog.Errorf("create work repository %s failed %v", repository.Name, err)
+			continue
+		}
+		report, err := workRepo.GC(ctx, opts)
+		if err != nil {
+			gcLog.Errorf("gc repository %s failed %v", repository.Name, err)
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// RunBackgroundGC run garbage collection of all repositories periodically if enabled in config
+func RunBackgroundGC(lc fx.Lifecycle, gcConfig *config.GCConfig, repo models.IRepo, publicAdapterConfig params.AdapterConfig) {
+	if !gcConfig.Enable {
+		return
+	}
+
+	interval := gcConfig.Interval
+	if interval <= 0 {
+		interval = DefaultGCInterval
+	}
+	opts := GCOptions{GracePeriod: gcConfig.GracePeriod}
+	if opts.GracePeriod <= 0 {
+		opts.GracePeriod = DefaultGCGracePeriod
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+
+					reports, err := GCRepositories(ctx, repo, publicAdapterConfig, models.NewListRepoParams(), opts)
+					if err != nil {
+						gcLog.Errorf("background gc failed %v", err)
+						continue
+					}
+					for _, report := range reports {
+						gcLog.Infof("gc repository %s swept %d commits %d trees %d blobs, freed %d bytes", report.RepositoryID,
+							report.SweptCommits, report.SweptTrees, report.SweptBlobs, report.FreedBytes)
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			<-done
+			return nil
+		},
+	})
+}
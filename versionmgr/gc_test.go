@@ -0,0 +1,97 @@
+package versionmgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGCGraphMark(t *testing.T) {
+	h := func(str string) hash.Hash {
+		return hash.Hash(str)
+	}
+	commits := []*models.Commit{
+		{Hash: h("c1"), TreeHash: h("t1")},
+		{Hash: h("c2"), TreeHash: h("t2"), ParentHashes: []hash.Hash{h("c1")}},
+		{Hash: h("c3"), TreeHash: h("t3"), ParentHashes: []hash.Hash{h("c1")}}, //deleted branch
+	}
+	objects := []models.FileTree{
+		{Hash: h("t1"), Type: models.TreeObject, SubObjects: []models.TreeEntry{{Name: "a", Hash: h("b1")}}},
+		{Hash: h("t2"), Type: models.TreeObject, SubObjects: []models.TreeEntry{{Name: "a", Hash: h("b1")}, {Name: "d", IsDir: true, Hash: h("t4")}}},
+		{Hash: h("t3"), Type: models.TreeObject, SubObjects: []models.TreeEntry{{Name: "a", Hash: h("b3")}}},
+		{Hash: h("t4"), Type: models.TreeObject, SubObjects: []models.TreeEntry{{Name: "b", Hash: h("b2")}}},
+		{Hash: h("t5"), Type: models.TreeObject, SubObjects: []models.TreeEntry{{Name: "c", Hash: h("b4")}}}, //wip tree
+		{Hash: h("b1"), Type: models.BlobObject},
+		{Hash: h("b2"), Type: models.BlobObject},
+		{Hash: h("b3"), Type: models.BlobObject},
+		{Hash: h("b4"), Type: models.BlobObject},
+		{Hash: h("b5"), Type: models.BlobObject}, //uploaded but never committed
+	}
+
+	graph := &gcMarker{
+		loadCommits: func(_ context.Context, hashes []hash.Hash) ([]*models.Commit, error) {
+			require.LessOrEqual(t, len(hashes), gcPageSize)
+			var found []*models.Commit
+			for _, commit := range commits {
+				for _, want := range hashes {
+					if string(commit.Hash) == string(want) {
+						found = append(found, commit)
+					}
+				}
+			}
+			return found, nil
+		},
+		loadObjects: func(_ context.Context, hashes []hash.Hash) ([]models.FileTree, error) {
+			var found []models.FileTree
+			for _, object := range objects {
+				for _, want := range hashes {
+					if string(object.Hash) == string(want) {
+						found = append(found, object)
+					}
+				}
+			}
+			return found, nil
+		},
+		reachableCommits: make(map[string]struct{}),
+		reachableTrees:   make(map[string]struct{}),
+	}
+	require.NoError(t, graph.mark(context.Background(), []hash.Hash{h("c2"), h("missing"), nil}, []hash.Hash{h("t5")}))
+
+	require.Len(t, graph.reachableCommits, 2)
+	require.Contains(t, graph.reachableCommits, h("c1").Hex())
+	require.Contains(t, graph.reachableCommits, h("c2").Hex())
+
+	var reachable []string
+	for _, object := range objects {
+		if _, ok := graph.reachableTrees[object.Hash.Hex()]; ok {
+			reachable = append(reachable, string(object.Hash))
+		}
+	}
+	require.Equal(t, []string{"t1", "t2", "t4", "t5", "b1", "b2", "b4"}, reachable)
+	require.Equal(t, 4, graph.treeCount)
+	require.Equal(t, 3, graph.blobCount)
+}
+
+func TestNextGCBatch(t *testing.T) {
+	var queue []hash.Hash
+	for i := 0; i < 5; i++ {
+		queue = append(queue, hash.Hash{byte(i)})
+	}
+	queue = append(queue, nil, hash.Hash{4})
+
+	requested := make(map[string]struct{})
+	batch, queue := nextGCBatch(queue, requested, 2)
+	//empty and requested hashes are skipped
+	require.Equal(t, []hash.Hash{{4}, {3}}, batch)
+	require.Len(t, queue, 3)
+
+	batch, queue = nextGCBatch(queue, requested, 5)
+	require.Equal(t, []hash.Hash{{2}, {1}, {0}}, batch)
+	require.Len(t, queue, 0)
+
+	batch, _ = nextGCBatch([]hash.Hash{{1}}, requested, 5)
+	require.Len(t, batch, 0)
+}
@@ -0,0 +1,175 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+)
+
+// ErrNotFastForward branch head is not ancestor of the commit to merge
+var ErrNotFastForward = errors.New("not possible to fast-forward")
+
+// SquashMerge merge changes of commit into current branch as one new commit, branch head is the only parent of new commit.
+// commits squashed are listed in message of the new commit
+func (repository *WorkRepository) SquashMerge(ctx context.Context, toMergeCommitHash hash.Hash, msg string, resolver ConflictResolver, opts ...CommitOption) (*models.Commit, error) {
+	if repository.state != InBranch {
+		return nil, errors.New("must merge on branch")
+	}
+	commitRepo := repository.repo.CommitRepo(repository.repoModel.ID)
+	sourceCommit, err := commitRepo.Commit(ctx, toMergeCommitHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var targetCommit *models.Commit
+	if !repository.branch.CommitHash.IsEmpty() {
+		targetCommit, err = commitRepo.Commit(ctx, repository.branch.CommitHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	attributes, err := repository.Attributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolver = AttributesResolver(ctx, repository, attributes, resolver)
+
+	var newCommit *models.Commit
+	err = repository.repo.Transaction(ctx, func(repo models.IRepo) error {
+		commitRepo := repo.CommitRepo(repository.repoModel.ID)
+		fileTreeRepo := repo.FileTreeRepo(repository.repoModel.ID)
+
+		squashedCommits, err := commitsNotInHistory(ctx, commitRepo, sourceCommit, targetCommit)
+		if err != nil {
+			return err
+		}
+		if len(squashedCommits) == 0 {
+			//source already merged, do nothing like merge
+			newCommit = targetCommit
+			return nil
+		}
+
+		treeHash := sourceCommit.TreeHash
+		if targetCommit != nil {
+			isAncestor, err := NewWrapCommitNode(commitRepo, targetCommit).IsAncestor(ctx, NewWrapCommitNode(commitRepo, sourceCommit))
+			if err != nil {
+				return err
+			}
+			if !isAncestor {
+				bestAncestor, err := findBestAncestor(ctx, commitRepo, fileTreeRepo, repository.operator, repository.repoModel, sourceCommit, targetCommit)
+				if err != nil {
+					return err
+				}
+				mergedTree, err := mergeTree(ctx, fileTreeRepo, bestAncestor, sourceCommit, targetCommit, resolver, repository.RenameDetector())
+				if err != nil {
+					return err
+				}
+				treeHash = mergedTree.Root().Hash()
+			}
+		}
+
+		author := models.Signature{
+			Name:  repository.operator.Name,
+			Email: repository.operator.Email,
+			When:  time.Now(),
+		}
+		newCommit, err = repository.commitChangeRoot(ctx, repo, author, treeHash, squashMessage(msg, squashedCommits), models.ReflogOperationSquash, opts...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	repository.branch.CommitHash = newCommit.Hash
+	repository.headTree = &newCommit.TreeHash
+	return newCommit, nil
+}
+
+// FastForwardMerge move current branch to commit, ErrNotFastForward is returned if branch head is not ancestor of the commit
+func (repository *WorkRepository) FastForwardMerge(ctx context.Context, toMergeCommitHash hash.Hash) (*models.Commit, error) {
+	if repository.state != InBranch {
+		return nil, errors.New("must merge on branch")
+	}
+	commitRepo := repository.repo.CommitRepo(repository.repoModel.ID)
+	sourceCommit, err := commitRepo.Commit(ctx, toMergeCommitHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !repository.branch.CommitHash.IsEmpty() {
+		targetCommit, err := commitRepo.Commit(ctx, repository.branch.CommitHash)
+		if err != nil {
+			return nil, err
+		}
+		isAncestor, err := NewWrapCommitNode(commitRepo, targetCommit).IsAncestor(ctx, NewWrapCommitNode(commitRepo, sourceCommit))
+		if err != nil {
+			return nil, err
+		}
+		if !isAncestor {
+			return nil, fmt.Errorf("branch %s at %s is not ancestor of %s %w", repository.branch.Name, targetCommit.Hash.Hex(), sourceCommit.Hash.Hex(), ErrNotFastForward)
+		}
+	}
+
+	if bytes.Equal(repository.branch.CommitHash, sourceCommit.Hash) {
+		return sourceCommit, nil
+	}
+	err = repository.repo.Transaction(ctx, func(repo models.IRepo) error {
+		return repository.moveBranchHead(ctx, repo, sourceCommit.Hash, models.ReflogOperationFastForward, fmt.Sprintf("fast-forward to %s", sourceCommit.Hash.Hex()))
+	})
+	if err != nil {
+		return nil, err
+	}
+	repository.branch.CommitHash = sourceCommit.Hash
+	repository.headTree = &sourceCommit.TreeHash
+	return sourceCommit, nil
+}
+
+// commitsNotInHistory find commits reachable from source but not from target, newer commit first
+func commitsNotInHistory(ctx context.Context, commitRepo models.ICommitRepo, sourceCommit, targetCommit *models.Commit) ([]*models.Commit, error) {
+	targetHistory := map[string]struct{}{}
+	if targetCommit != nil {
+		err := NewCommitIterBSF(ctx, NewWrapCommitNode(commitRepo, targetCommit), nil, nil).ForEach(func(commit *WrapCommitNode) error {
+			targetHistory[commit.Hash().Hex()] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inTargetHistory := isInIndexCommitFilter(targetHistory)
+	notInTargetHistory := CommitFilter(func(commit *WrapCommitNode) bool {
+		return !inTargetHistory(commit)
+	})
+
+	var commits []*models.Commit
+	err := NewFilterCommitIter(ctx, NewWrapCommitNode(commitRepo, sourceCommit), &notInTargetHistory, &inTargetHistory).ForEach(func(commit *WrapCommitNode) error {
+		commits = append(commits, commit.Commit())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return commits, nil
+}
+
+// squashMessage append title of squashed commits to message
+func squashMessage(msg string, commits []*models.Commit) string {
+	var sb strings.Builder
+	if len(msg) > 0 {
+		sb.WriteString(msg)
+		sb.WriteString("\n\n")
+	}
+	sb.WriteString(fmt.Sprintf("Squashed %d commits:\n", len(commits)))
+	for _, commit := range commits {
+		title, _, _ := strings.Cut(commit.Message, "\n")
+		sb.WriteString(fmt.Sprintf("\n* %s %s", commit.Hash.Hex(), title))
+	}
+	return sb.String()
+}
@@ -0,0 +1,106 @@
+package versionmgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GitDataAI/jiaozifs/block/mem"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSquashAndFastForwardMerge
+//
+// example
+//
+//	root --- M1               main
+//	   \
+//	    F1 --- F2             feat
+func TestSquashAndFastForwardMerge(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	adapter := mem.New(ctx)
+	repo := models.NewRepo(db)
+
+	user, err := makeUser(ctx, repo.UserRepo(), "admin")
+	require.NoError(t, err)
+
+	project, err := makeRepository(ctx, repo, user, "testproject")
+	require.NoError(t, err)
+
+	workRepo := NewWorkRepositoryFromAdapter(ctx, user, project, repo, adapter)
+
+	rootCommit, err := addChangesToWip(ctx, workRepo, "main", "root commit", `
+1|a.txt	|a1
+`)
+	require.NoError(t, err)
+
+	require.NoError(t, workRepo.CheckOut(ctx, InCommit, rootCommit.Hash.Hex()))
+	_, err = workRepo.CreateBranch(ctx, "feat")
+	require.NoError(t, err)
+	_, err = addChangesToWip(ctx, workRepo, "feat", "add b", `
+1|b.txt	|b1
+`)
+	require.NoError(t, err)
+	commitF2, err := addChangesToWip(ctx, workRepo, "feat", "add c", `
+1|c.txt	|c1
+`)
+	require.NoError(t, err)
+
+	t.Run("fast forward", func(t *testing.T) {
+		require.NoError(t, workRepo.CheckOut(ctx, InCommit, rootCommit.Hash.Hex()))
+		_, err = workRepo.CreateBranch(ctx, "ff")
+		require.NoError(t, err)
+
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "ff"))
+		commit, err := workRepo.FastForwardMerge(ctx, commitF2.Hash)
+		require.NoError(t, err)
+		require.Equal(t, commitF2.Hash.Hex(), commit.Hash.Hex())
+
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "ff"))
+		require.Equal(t, commitF2.Hash.Hex(), workRepo.CurBranch().CommitHash.Hex())
+	})
+
+	commitM1, err := addChangesToWip(ctx, workRepo, "main", "modify a", `
+3|a.txt	|a2
+`)
+	require.NoError(t, err)
+
+	t.Run("fast forward refused", func(t *testing.T) {
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		_, err = workRepo.FastForwardMerge(ctx, commitF2.Hash)
+		require.ErrorIs(t, err, ErrNotFastForward)
+	})
+
+	t.Run("squash", func(t *testing.T) {
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		commit, err := workRepo.SquashMerge(ctx, commitF2.Hash, "squash feat", ForbidResolver)
+		require.NoError(t, err)
+		require.Equal(t, []hash.Hash{commitM1.Hash}, commit.ParentHashes)
+		require.Contains(t, commit.Message, "squash feat")
+		require.Contains(t, commit.Message, "add b")
+		require.Contains(t, commit.Message, "add c")
+		require.NotContains(t, commit.Message, "root commit")
+
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		workTree, err := workRepo.RootTree(ctx)
+		require.NoError(t, err)
+		for _, path := range []string{"a.txt", "b.txt", "c.txt"} {
+			_, _, err = workTree.FindBlob(ctx, path)
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestSquashMessage(t *testing.T) {
+	commits := []*models.Commit{
+		{Hash: hash.Hash{1}, Message: "second\n\ndetail"},
+		{Hash: hash.Hash{2}, Message: "first"},
+	}
+	require.Equal(t, "merge feat\n\nSquashed 2 commits:\n\n* 01 second\n* 02 first", squashMessage("merge feat", commits))
+	require.Equal(t, "Squashed 0 commits:\n", squashMessage("", nil))
+}
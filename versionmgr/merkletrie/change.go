@@ -12,11 +12,14 @@ import (
 type Action int
 
 // The set of possible actions in a change.
+// Rename and Copy never returned by DiffTree, they are produced by rename detection.
 const (
 	_ Action = iota
 	Insert
 	Delete
 	Modify
+	Rename
+	Copy
 )
 
 // String returns the action as a human readable text.
@@ -28,6 +31,10 @@ func (a Action) String() string {
 		return "Delete"
 	case Modify:
 		return "Modify"
+	case Rename:
+		return "Rename"
+	case Copy:
+		return "Copy"
 	default:
 		panic(fmt.Sprintf("unsupported action: %d", a))
 	}
@@ -0,0 +1,188 @@
+package versionmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/block"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+)
+
+const (
+	// MinMultipartPartSize all parts except the last one must be at least this size, same as s3
+	MinMultipartPartSize = 5 << 20
+	// MaxMultipartPartNumber max part number of multipart upload
+	MaxMultipartPartNumber = 10000
+)
+
+var ErrInvalidPart = errors.New("invalid part")
+
+func (repository *WorkRepository) multipartPointer(upload *models.MultipartUpload) block.ObjectPointer {
+	return block.ObjectPointer{
+		StorageNamespace: utils.StringValue(repository.repoModel.StorageNamespace),
+		IdentifierType:   block.IdentifierTypeRelative,
+		Identifier:       upload.Key,
+	}
+}
+
+// CreateMultipartUpload start a resumable upload of path in ref, parts are uploaded to a staging key
+func (repository *WorkRepository) CreateMultipartUpload(ctx context.Context, refName string, path string) (*models.MultipartUpload, error) {
+	upload := &models.MultipartUpload{
+		RepositoryID: repository.repoModel.ID,
+		CreatorID:    repository.operator.ID,
+		RefName:      refName,
+		Path:         CleanPath(path),
+		Key:          stagingPrefix + uuid.NewString(),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	uploadResp, err := repository.adapter.CreateMultiPartUpload(ctx, repository.multipartPointer(upload), nil, block.CreateMultiPartUploadOpts{})
+	if err != nil {
+		return nil, err
+	}
+	upload.UploadID = uploadResp.UploadID
+
+	upload, err = repository.repo.MultipartUploadRepo().Insert(ctx, upload)
+	if err != nil {
+		_ = repository.adapter.AbortMultiPartUpload(ctx, repository.multipartPointer(upload), uploadResp.UploadID)
+		return nil, err
+	}
+	return upload, nil
+}
+
+// UploadMultipartPart upload one part, upload the same part number again replace the old content
+func (repository *WorkRepository) UploadMultipartPart(ctx context.Context, upload *models.MultipartUpload, partNumber int, size int64, reader io.Reader) (*models.MultipartUploadPart, error) {
+	if partNumber < 1 || partNumber > MaxMultipartPartNumber {
+		return nil, fmt.Errorf("part number must between 1 and %d %w", MaxMultipartPartNumber, ErrInvalidPart)
+	}
+
+	hashReader := hash.NewHashingReader(reader, hash.Md5)
+	partResp, err := repository.adapter.UploadPart(ctx, repository.multipartPointer(upload), size, hashReader, upload.UploadID, partNumber)
+	if err != nil {
+		return nil, err
+	}
+	return repository.repo.MultipartUploadRepo().SavePart(ctx, &models.MultipartUploadPart{
+		MultipartUploadID: upload.ID,
+		PartNumber:        partNumber,
+		ETag:              partResp.ETag,
+		Size:              hashReader.CopiedSize,
+		CheckSum:          hashReader.Md5.Sum(nil),
+		CreatedAt:         time.Now(),
+	})
+}
+
+// CompleteMultipartUpload assemble parts and move content to content address, return blob of the content.
+// upload is removed only after blob registered, so complete can be retried if any step fails
+func (repository *WorkRepository) CompleteMultipartUpload(ctx context.Context, upload *models.MultipartUpload, properties models.Property) (_ *models.Blob, err error) {
+	parts, err := repository.repo.MultipartUploadRepo().ListParts(ctx, upload.ID)
+	if err != nil {
+		return nil, err
+	}
+	err = validateParts(parts)
+	if err != nil {
+		return nil, err
+	}
+
+	completion := &block.MultipartUploadCompletion{}
+	var size int64
+	for _, part := range parts {
+		completion.Part = append(completion.Part, block.MultipartPart{ETag: part.ETag, PartNumber: part.PartNumber})
+		size += part.Size
+	}
+	stagingPointer := repository.multipartPointer(upload)
+	//parts were assembled already if previous complete failed after storage upload completed
+	assembled, err := repository.adapter.Exists(ctx, stagingPointer)
+	if err != nil {
+		return nil, err
+	}
+	if !assembled {
+		_, err = repository.adapter.CompleteMultiPartUpload(ctx, stagingPointer, upload.UploadID, completion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reader, err := repository.adapter.Get(ctx, stagingPointer, size)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close() //nolint
+
+	var blob *models.Blob
+	if repository.repoModel.ChunkedStorage {
+		blob, err = repository.writeChunkedBlob(ctx, reader, properties)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		//hash is unknown until all content was read
+		hashReader := hash.NewHashingReader(reader, hash.Md5)
+		_, err = io.Copy(io.Discard, hashReader)
+		if err != nil {
+			return nil, err
+		}
+		checkSum := hash.Hash(hashReader.Md5.Sum(nil))
+		err = repository.copyToContentAddress(ctx, stagingPointer, checkSum)
+		if err != nil {
+			return nil, err
+		}
+		blob, err = models.NewBlob(properties, repository.repoModel.ID, checkSum, hashReader.CopiedSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = repository.registerBlob(ctx, blob)
+	if err != nil {
+		return nil, err
+	}
+
+	if removeErr := repository.adapter.Remove(ctx, stagingPointer); removeErr != nil {
+		workRepoLog.Warnf("remove staging key %s failed %v", stagingPointer.Identifier, removeErr)
+	}
+	if _, deleteErr := repository.repo.MultipartUploadRepo().Delete(ctx, models.NewDeleteMultipartUploadParams().SetID(upload.ID)); deleteErr != nil {
+		workRepoLog.Warnf("delete multipart upload %s failed %v", upload.ID, deleteErr)
+	}
+	return blob, nil
+}
+
+// AbortMultipartUpload cancel upload and remove uploaded parts, or the assembled staging key if complete failed halfway
+func (repository *WorkRepository) AbortMultipartUpload(ctx context.Context, upload *models.MultipartUpload) error {
+	stagingPointer := repository.multipartPointer(upload)
+	assembled, err := repository.adapter.Exists(ctx, stagingPointer)
+	if err != nil {
+		return err
+	}
+	if assembled {
+		err = repository.adapter.Remove(ctx, stagingPointer)
+	} else {
+		err = repository.adapter.AbortMultiPartUpload(ctx, stagingPointer, upload.UploadID)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = repository.repo.MultipartUploadRepo().Delete(ctx, models.NewDeleteMultipartUploadParams().SetID(upload.ID))
+	return err
+}
+
+// validateParts check parts are continuous from 1 and all parts except the last one are large enough
+func validateParts(parts []*models.MultipartUploadPart) error {
+	if len(parts) == 0 {
+		return fmt.Errorf("no part uploaded %w", ErrInvalidPart)
+	}
+	for index, part := range parts {
+		if part.PartNumber != index+1 {
+			return fmt.Errorf("part %d missing %w", index+1, ErrInvalidPart)
+		}
+		if index < len(parts)-1 && part.Size < MinMultipartPartSize {
+			return fmt.Errorf("part %d size %d smaller than %d %w", part.PartNumber, part.Size, MinMultipartPartSize, ErrInvalidPart)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package versionmgr
+
+import (
+	"testing"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParts(t *testing.T) {
+	part := func(partNumber int, size int64) *models.MultipartUploadPart {
+		return &models.MultipartUploadPart{PartNumber: partNumber, Size: size}
+	}
+
+	require.ErrorIs(t, validateParts(nil), ErrInvalidPart)
+	require.NoError(t, validateParts([]*models.MultipartUploadPart{part(1, 0)}))
+	require.NoError(t, validateParts([]*models.MultipartUploadPart{part(1, MinMultipartPartSize), part(2, 1)}))
+	require.ErrorIs(t, validateParts([]*models.MultipartUploadPart{part(1, MinMultipartPartSize), part(3, 1)}), ErrInvalidPart)
+	require.ErrorIs(t, validateParts([]*models.MultipartUploadPart{part(2, MinMultipartPartSize)}), ErrInvalidPart)
+	require.ErrorIs(t, validateParts([]*models.MultipartUploadPart{part(1, MinMultipartPartSize-1), part(2, 1)}), ErrInvalidPart)
+}
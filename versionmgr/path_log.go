@@ -0,0 +1,181 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/GitDataAI/jiaozifs/versionmgr/merkletrie"
+)
+
+var _ CommitIter = (*pathCommitIter)(nil)
+
+// pathCommitIter walk commits which changed the path, errors of tree lookup in filter are returned by Next
+type pathCommitIter struct {
+	ctx          context.Context
+	fileTreeRepo models.IFileTreeRepo
+	detector     *RenameDetector
+	// paths path of file in each visited commit, differ from the origin path after a rename is followed
+	paths   map[string]string
+	entries map[string]hash.Hash
+	iter    CommitIter
+	err     error
+}
+
+// NewPathCommitIter returns a CommitIter that walks the commit history from the passed commit in Breadth-first order and
+// only returns commits whose tree entry of path differs from every parent, a missing entry is treated as empty hash.
+// if detector is not nil, renames of path are followed and the history of the source file is returned too.
+func NewPathCommitIter(ctx context.Context, fileTreeRepo models.IFileTreeRepo, from *WrapCommitNode, path string, detector *RenameDetector) CommitIter {
+	pathIter := &pathCommitIter{
+		ctx:          ctx,
+		fileTreeRepo: fileTreeRepo,
+		detector:     detector,
+		paths:        map[string]string{from.Hash().Hex(): CleanPath(path)},
+		entries:      map[string]hash.Hash{},
+	}
+	isValid := CommitFilter(pathIter.changed)
+	pathIter.iter = NewFilterCommitIter(ctx, from, &isValid, nil)
+	return pathIter
+}
+
+// PathLog returns commits reachable from commit which changed the path, renames are followed if follow is true
+func (repository *WorkRepository) PathLog(ctx context.Context, commit *models.Commit, path string, follow bool) CommitIter {
+	var detector *RenameDetector
+	if follow {
+		detector = repository.RenameDetector()
+	}
+	commitNode := NewWrapCommitNode(repository.repo.CommitRepo(repository.repoModel.ID), commit)
+	return NewPathCommitIter(ctx, repository.repo.FileTreeRepo(repository.repoModel.ID), commitNode, path, detector)
+}
+
+func (w *pathCommitIter) Next() (*WrapCommitNode, error) {
+	commit, err := w.iter.Next()
+	if w.err != nil {
+		return nil, w.err
+	}
+	return commit, err
+}
+
+func (w *pathCommitIter) ForEach(cb func(*WrapCommitNode) error) error {
+	err := w.iter.ForEach(cb)
+	if w.err != nil {
+		return w.err
+	}
+	return err
+}
+
+// changed check whether commit changed the path, parents reached by this commit are assigned with the path in them
+func (w *pathCommitIter) changed(commit *WrapCommitNode) bool {
+	if w.err != nil {
+		return false
+	}
+	changed, err := w.checkChanged(commit)
+	if err != nil {
+		w.err = err
+		return false
+	}
+	return changed
+}
+
+func (w *pathCommitIter) checkChanged(commit *WrapCommitNode) (bool, error) {
+	path := w.paths[commit.Hash().Hex()]
+	entryHash, err := w.entryHash(commit.TreeHash(), path)
+	if err != nil {
+		return false, err
+	}
+
+	parents, err := commit.Parents(w.ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(parents) == 0 {
+		return !entryHash.IsEmpty(), nil
+	}
+
+	changed := true
+	for _, parent := range parents {
+		parentPath := path
+		parentEntryHash, err := w.entryHash(parent.TreeHash(), path)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(parentEntryHash, entryHash) {
+			changed = false
+		} else if parentEntryHash.IsEmpty() && !entryHash.IsEmpty() && w.detector != nil {
+			renameFrom, err := w.renameSource(parent.TreeHash(), commit.TreeHash(), path)
+			if err != nil {
+				return false, err
+			}
+			if len(renameFrom) > 0 {
+				parentPath = renameFrom
+			}
+		}
+
+		if _, ok := w.paths[parent.Hash().Hex()]; !ok {
+			w.paths[parent.Hash().Hex()] = parentPath
+		}
+	}
+	return changed, nil
+}
+
+// entryHash return hash of entry in tree, empty hash if path not exist
+func (w *pathCommitIter) entryHash(treeHash hash.Hash, path string) (hash.Hash, error) {
+	key := treeHash.Hex() + "/" + path
+	if entryHash, ok := w.entries[key]; ok {
+		return entryHash, nil
+	}
+
+	entryHash, err := pathEntryHash(w.ctx, w.fileTreeRepo, treeHash, path)
+	if err != nil {
+		return nil, err
+	}
+	w.entries[key] = entryHash
+	return entryHash, nil
+}
+
+// pathEntryHash return hash of file or directory of path in tree, empty hash if path not exist
+func pathEntryHash(ctx context.Context, fileTreeRepo models.IFileTreeRepo, treeHash hash.Hash, path string) (hash.Hash, error) {
+	workTree, err := NewWorkTree(ctx, fileTreeRepo, models.NewRootTreeEntry(treeHash))
+	if err != nil {
+		return nil, err
+	}
+	existNodes, missingPath, err := workTree.findNodeByPath(ctx, path)
+	if err != nil {
+		if errors.Is(err, ErrBlobMustBeLeaf) {
+			return hash.Empty, nil
+		}
+		return nil, err
+	}
+	if len(missingPath) > 0 || len(existNodes) == 0 {
+		return hash.Empty, nil
+	}
+	return existNodes[len(existNodes)-1].Entry().Hash, nil
+}
+
+// renameSource find the path which was renamed or copied to path between two trees, return empty string if path is a new file
+func (w *pathCommitIter) renameSource(fromTreeHash hash.Hash, toTreeHash hash.Hash, path string) (string, error) {
+	workTree, err := NewWorkTree(w.ctx, w.fileTreeRepo, models.NewRootTreeEntry(fromTreeHash))
+	if err != nil {
+		return "", err
+	}
+	changes, err := workTree.Diff(w.ctx, toTreeHash, "")
+	if err != nil {
+		return "", err
+	}
+	changes, err = w.detector.Detect(w.ctx, changes)
+	if err != nil {
+		return "", err
+	}
+	for _, change := range changes.Changes() {
+		action, err := change.Action()
+		if err != nil {
+			return "", err
+		}
+		if (action == merkletrie.Rename || action == merkletrie.Copy) && change.To().String() == path {
+			return change.From().String(), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,77 @@
+package versionmgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GitDataAI/jiaozifs/block/mem"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPathLog
+//
+// example
+//
+//	C1(add a, b) --- C2(modify b) --- C3(modify a) --- C4(rename a to e) --- C5(modify e)
+func TestPathLog(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	adapter := mem.New(ctx)
+	repo := models.NewRepo(db)
+
+	user, err := makeUser(ctx, repo.UserRepo(), "admin")
+	require.NoError(t, err)
+
+	project, err := makeRepository(ctx, repo, user, "testproject")
+	require.NoError(t, err)
+
+	workRepo := NewWorkRepositoryFromAdapter(ctx, user, project, repo, adapter)
+
+	commit1, err := addChangesToWip(ctx, workRepo, "main", "add a and b", `
+1|dir/a.txt	|a1
+1|b.txt	|b1
+`)
+	require.NoError(t, err)
+
+	_, err = addChangesToWip(ctx, workRepo, "main", "modify b", `
+3|b.txt	|b2
+`)
+	require.NoError(t, err)
+
+	commit3, err := addChangesToWip(ctx, workRepo, "main", "modify a", `
+3|dir/a.txt	|a2
+`)
+	require.NoError(t, err)
+
+	commit4, err := addChangesToWip(ctx, workRepo, "main", "rename a", `
+2|dir/a.txt	|a2
+1|e.txt	|a2
+`)
+	require.NoError(t, err)
+
+	commit5, err := addChangesToWip(ctx, workRepo, "main", "modify e", `
+3|e.txt	|e1
+`)
+	require.NoError(t, err)
+
+	collect := func(path string, follow bool) []string {
+		var messages []string
+		err := workRepo.PathLog(ctx, commit5, path, follow).ForEach(func(commit *WrapCommitNode) error {
+			messages = append(messages, commit.Commit().Message)
+			return nil
+		})
+		require.NoError(t, err)
+		return messages
+	}
+
+	require.Equal(t, []string{commit3.Message, commit1.Message}, collect("dir/a.txt", false))
+	require.Equal(t, []string{commit4.Message, commit3.Message, commit1.Message}, collect("dir", false))
+	require.Equal(t, []string{"modify b", commit1.Message}, collect("b.txt", false))
+	require.Equal(t, []string{commit5.Message, commit4.Message}, collect("e.txt", false))
+	require.Equal(t, []string{commit5.Message, commit4.Message, commit3.Message, commit1.Message}, collect("e.txt", true))
+	require.Empty(t, collect("not_exist.txt", false))
+}
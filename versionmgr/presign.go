@@ -0,0 +1,120 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/block"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+)
+
+var ErrContentMismatch = errors.New("content mismatch")
+
+func (repository *WorkRepository) stagingPointer(key string) block.ObjectPointer {
+	return block.ObjectPointer{
+		StorageNamespace: utils.StringValue(repository.repoModel.StorageNamespace),
+		IdentifierType:   block.IdentifierTypeRelative,
+		Identifier:       key,
+	}
+}
+
+// PresignBlobURL return a short-lived url to read content of blob from storage directly,
+// chunked blob is assembled by daemon and can not be pre-signed
+func (repository *WorkRepository) PresignBlobURL(ctx context.Context, blob *models.Blob) (string, time.Time, error) {
+	if len(blob.Chunks) > 0 {
+		return "", time.Time{}, fmt.Errorf("pre-sign chunked blob %w", block.ErrOperationNotSupported)
+	}
+	return repository.adapter.GetPreSignedURL(ctx, repository.contentPointer(blob.CheckSum), block.PreSignModeRead)
+}
+
+// PresignUpload return a new staging key and a short-lived url to upload content to it, content should be linked by
+// LinkStagingObject after uploaded. staging key is recorded so that it is removed by CleanupStagingObjects if never linked
+func (repository *WorkRepository) PresignUpload(ctx context.Context) (string, string, time.Time, error) {
+	stagingPointer := repository.stagingPointer(stagingPrefix + uuid.NewString())
+	url, expiresAt, err := repository.adapter.GetPreSignedURL(ctx, stagingPointer, block.PreSignModeWrite)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+
+	_, err = repository.repo.StagingObjectRepo().Insert(ctx, &models.StagingObject{
+		RepositoryID: repository.repoModel.ID,
+		Key:          stagingPointer.Identifier,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	return stagingPointer.Identifier, url, expiresAt, nil
+}
+
+// LinkStagingObject verify content uploaded to staging key match size and checksum, then move it to content address.
+// staging key is removed whether link success or not, client should request a new one to retry
+func (repository *WorkRepository) LinkStagingObject(ctx context.Context, key string, checkSum hash.Hash, size int64, properties models.Property) (_ *models.Blob, err error) {
+	stagingObject, err := repository.repo.StagingObjectRepo().Get(ctx, models.NewGetStagingObjectParams().SetRepositoryID(repository.repoModel.ID).SetKey(key))
+	if err != nil {
+		return nil, err
+	}
+	stagingPointer := repository.stagingPointer(stagingObject.Key)
+
+	reader, err := repository.adapter.Get(ctx, stagingPointer, size)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close() //nolint
+
+	defer func() {
+		if removeErr := repository.adapter.Remove(ctx, stagingPointer); removeErr != nil {
+			workRepoLog.Warnf("remove staging key %s failed %v", stagingPointer.Identifier, removeErr)
+			return
+		}
+		if _, deleteErr := repository.repo.StagingObjectRepo().Delete(ctx, models.NewDeleteStagingObjectParams().SetID(stagingObject.ID)); deleteErr != nil {
+			workRepoLog.Warnf("delete staging object record %s failed %v", stagingObject.ID, deleteErr)
+		}
+	}()
+
+	var blob *models.Blob
+	if repository.repoModel.ChunkedStorage {
+		//chunks of mismatch content are left to gc
+		blob, err = repository.writeChunkedBlob(ctx, reader, properties)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		hashReader := hash.NewHashingReader(reader, hash.Md5)
+		_, err = io.Copy(io.Discard, hashReader)
+		if err != nil {
+			return nil, err
+		}
+		blob, err = models.NewBlob(properties, repository.repoModel.ID, hash.Hash(hashReader.Md5.Sum(nil)), hashReader.CopiedSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if blob.Size != size {
+		return nil, fmt.Errorf("expect size %d but got %d %w", size, blob.Size, ErrContentMismatch)
+	}
+	if !bytes.Equal(blob.CheckSum, checkSum) {
+		return nil, fmt.Errorf("expect checksum %s but got %s %w", checkSum.Hex(), blob.CheckSum.Hex(), ErrContentMismatch)
+	}
+
+	if len(blob.Chunks) == 0 {
+		err = repository.copyToContentAddress(ctx, stagingPointer, blob.CheckSum)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = repository.registerBlob(ctx, blob)
+	if err != nil {
+		return nil, err
+	}
+	return blob, nil
+}
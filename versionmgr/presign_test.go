@@ -0,0 +1,84 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/block"
+	"github.com/GitDataAI/jiaozifs/block/local"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkStagingObject(t *testing.T) {
+	ctx := context.Background()
+	adapter, err := local.NewAdapter(t.TempDir())
+	require.NoError(t, err)
+
+	repoModel := &models.Repository{
+		ID:               uuid.New(),
+		StorageNamespace: utils.String("local://presign"),
+	}
+	stagingObjects := &memStagingObjectRepo{objects: map[uuid.UUID]*models.StagingObject{}}
+	fileTrees := &memFileTreeRepo{trees: map[string]*models.FileTree{}}
+	repository := NewWorkRepositoryFromAdapter(ctx, nil, repoModel, &stagingRepo{stagingObjectRepo: stagingObjects, fileTreeRepo: fileTrees}, adapter)
+
+	data := []byte("content uploaded by pre-signed url")
+	sum := md5.Sum(data)
+	checkSum := hash.Hash(sum[:])
+
+	//simulate upload of client by pre-signed url
+	stage := func(t *testing.T) string {
+		key := stagingPrefix + uuid.NewString()
+		err := adapter.Put(ctx, repository.stagingPointer(key), int64(len(data)), bytes.NewReader(data), block.PutOpts{})
+		require.NoError(t, err)
+		_, err = stagingObjects.Insert(ctx, &models.StagingObject{RepositoryID: repoModel.ID, Key: key, CreatedAt: time.Now()})
+		require.NoError(t, err)
+		return key
+	}
+
+	t.Run("presign not supported", func(t *testing.T) {
+		_, _, _, err := repository.PresignUpload(ctx)
+		require.ErrorIs(t, err, block.ErrOperationNotSupported)
+		_, _, err = repository.PresignBlobURL(ctx, &models.Blob{Chunks: []models.Chunk{{Hash: checkSum, Size: 1}}})
+		require.ErrorIs(t, err, block.ErrOperationNotSupported)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		key := stage(t)
+		_, err := repository.LinkStagingObject(ctx, key, checkSum, int64(len(data))+1, models.DefaultLeafProperty())
+		require.ErrorIs(t, err, ErrContentMismatch)
+		require.Len(t, stagingObjects.objects, 0)
+
+		key = stage(t)
+		_, err = repository.LinkStagingObject(ctx, key, hash.Hash(make([]byte, md5.Size)), int64(len(data)), models.DefaultLeafProperty())
+		require.ErrorIs(t, err, ErrContentMismatch)
+	})
+
+	t.Run("link", func(t *testing.T) {
+		key := stage(t)
+		blob, err := repository.LinkStagingObject(ctx, key, checkSum, int64(len(data)), models.DefaultLeafProperty())
+		require.NoError(t, err)
+		require.Equal(t, checkSum, blob.CheckSum)
+		require.Len(t, stagingObjects.objects, 0)
+		require.Contains(t, fileTrees.trees, blob.Hash.Hex())
+
+		exist, err := adapter.Exists(ctx, repository.stagingPointer(key))
+		require.NoError(t, err)
+		require.False(t, exist)
+
+		reader, err := repository.ReadBlob(ctx, blob, nil)
+		require.NoError(t, err)
+		defer reader.Close() //nolint
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, data, content)
+	})
+}
@@ -0,0 +1,93 @@
+package versionmgr
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+)
+
+var ErrRefNotFound = errors.New("ref not found")
+
+// RefPath ref and the path in ref
+type RefPath struct {
+	Name    string
+	RefType WorkRepoState
+	Path    string
+}
+
+// ResolveRefPath split fullPath which start with ref name into ref and path in ref.
+// branch name may contain one slash so branch of two segments is tried first, then branch, tag and commit hash of one segment
+func ResolveRefPath(ctx context.Context, repo models.IRepo, repositoryID uuid.UUID, fullPath string) (*RefPath, error) {
+	segments := strings.SplitN(fullPath, "/", 3)
+	if len(segments) == 3 {
+		name := segments[0] + "/" + segments[1]
+		_, err := repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetRepositoryID(repositoryID).SetName(name))
+		if err == nil {
+			return &RefPath{Name: name, RefType: InBranch, Path: segments[2]}, nil
+		}
+		if !errors.Is(err, models.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	name, path, _ := strings.Cut(fullPath, "/")
+	_, err := repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetRepositoryID(repositoryID).SetName(name))
+	if err == nil {
+		return &RefPath{Name: name, RefType: InBranch, Path: path}, nil
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return nil, err
+	}
+
+	_, err = repo.TagRepo().Get(ctx, models.NewGetTagParams().SetRepositoryID(repositoryID).SetName(name))
+	if err == nil {
+		return &RefPath{Name: name, RefType: InTag, Path: path}, nil
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return nil, err
+	}
+
+	commitHash, err := hash.FromHex(name)
+	if err == nil && !commitHash.IsEmpty() {
+		_, err = repo.CommitRepo(repositoryID).Commit(ctx, commitHash)
+		if err == nil {
+			return &RefPath{Name: name, RefType: InCommit, Path: path}, nil
+		}
+		if !errors.Is(err, models.ErrNotFound) {
+			return nil, err
+		}
+	}
+	return nil, ErrRefNotFound
+}
+
+// CheckOutWipOrRef checkout wip of operator if ref is a branch and wip exist, otherwise checkout ref,
+// so that operator can see uncommitted changes of self
+func (repository *WorkRepository) CheckOutWipOrRef(ctx context.Context, refType WorkRepoState, refName string) error {
+	if refType == InBranch {
+		err := repository.CheckOut(ctx, InWip, refName)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, models.ErrNotFound) {
+			return err
+		}
+	}
+	return repository.CheckOut(ctx, refType, refName)
+}
+
+// CheckOutOrCreateWip checkout wip of operator in branch, wip is created if not exist
+func (repository *WorkRepository) CheckOutOrCreateWip(ctx context.Context, branchName string) error {
+	err := repository.CheckOut(ctx, InBranch, branchName)
+	if err != nil {
+		return err
+	}
+	_, _, err = repository.GetOrCreateWip(ctx)
+	if err != nil {
+		return err
+	}
+	return repository.CheckOut(ctx, InWip, branchName)
+}
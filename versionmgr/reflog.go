@@ -0,0 +1,169 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidReflogEntry reflog entry could not be used to restore branch
+var ErrInvalidReflogEntry = errors.New("invalid reflog entry")
+
+// recordReflog append movement of branch head to reflog, call it in the transaction which moves the head
+func (repository *WorkRepository) recordReflog(ctx context.Context, repo models.IRepo, branch *models.Branch, oldHash, newHash hash.Hash, operation models.ReflogOperation, msg string) error {
+	_, err := repo.BranchReflogRepo().Insert(ctx, &models.BranchReflog{
+		RepositoryID: repository.repoModel.ID,
+		BranchID:     branch.ID,
+		BranchName:   branch.Name,
+		OldHash:      oldHash,
+		NewHash:      newHash,
+		Operation:    operation,
+		Message:      msg,
+		OperatorID:   repository.operator.ID,
+		CreatedAt:    time.Now(),
+	})
+	return err
+}
+
+// moveBranchHead update head of current branch and record it in reflog, call it in transaction
+func (repository *WorkRepository) moveBranchHead(ctx context.Context, repo models.IRepo, newHash hash.Hash, operation models.ReflogOperation, msg string) error {
+	err := repo.BranchRepo().UpdateByID(ctx, models.NewUpdateBranchParams(repository.branch.ID).SetCommitHash(newHash))
+	if err != nil {
+		return err
+	}
+	return repository.recordReflog(ctx, repo, repository.branch, repository.branch.CommitHash, newHash, operation, msg)
+}
+
+// Reflog list head movements of branch from newest to oldest, entries of deleted branch are also returned
+func (repository *WorkRepository) Reflog(ctx context.Context, branchName string, after *time.Time, amount int) ([]*models.BranchReflog, bool, error) {
+	params := models.NewListBranchReflogParams().
+		SetRepositoryID(repository.repoModel.ID).
+		SetBranchName(branchName).
+		SetAmount(amount)
+	if after != nil {
+		params.SetAfter(*after)
+	}
+	return repository.repo.BranchReflogRepo().List(ctx, params)
+}
+
+// RestoreBranch move current branch to head recorded after the reflog entry. if force is false, ErrNotFastForward is
+// returned when current head is not ancestor of the restored head. head is checked and moved in one transaction,
+// models.ErrBranchHeadChanged is returned if branch is moved by others meanwhile
+func (repository *WorkRepository) RestoreBranch(ctx context.Context, reflogID uuid.UUID, force bool) (*models.BranchReflog, error) {
+	if repository.state != InBranch {
+		return nil, errors.New("must restore on branch")
+	}
+
+	entry, err := repository.repo.BranchReflogRepo().Get(ctx, models.NewGetBranchReflogParams().SetID(reflogID).SetRepositoryID(repository.repoModel.ID))
+	if err != nil {
+		return nil, err
+	}
+	if entry.BranchName != repository.branch.Name {
+		return nil, fmt.Errorf("reflog entry %s belong to branch %s %w", entry.ID, entry.BranchName, ErrInvalidReflogEntry)
+	}
+	if entry.NewHash.IsEmpty() {
+		return nil, fmt.Errorf("reflog entry %s has no commit to restore %w", entry.ID, ErrInvalidReflogEntry)
+	}
+
+	var commit *models.Commit
+	var branch *models.Branch
+	err = repository.repo.Transaction(ctx, func(repo models.IRepo) error {
+		commitRepo := repo.CommitRepo(repository.repoModel.ID)
+		commit, err = commitRepo.Commit(ctx, entry.NewHash)
+		if err != nil {
+			return err
+		}
+
+		branch, err = repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetID(repository.branch.ID))
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(branch.CommitHash, commit.Hash) {
+			return nil
+		}
+
+		if !force && !branch.CommitHash.IsEmpty() {
+			head, err := commitRepo.Commit(ctx, branch.CommitHash)
+			if err != nil {
+				return err
+			}
+			isAncestor, err := NewWrapCommitNode(commitRepo, head).IsAncestor(ctx, NewWrapCommitNode(commitRepo, commit))
+			if err != nil {
+				return err
+			}
+			if !isAncestor {
+				return fmt.Errorf("branch %s at %s is not ancestor of %s %w", branch.Name, head.Hash.Hex(), commit.Hash.Hex(), ErrNotFastForward)
+			}
+		}
+
+		err = repo.BranchRepo().UpdateByID(ctx, models.NewUpdateBranchParams(branch.ID).SetCommitHash(commit.Hash).SetExpectCommitHash(branch.CommitHash))
+		if err != nil {
+			return err
+		}
+		return repository.recordReflog(ctx, repo, branch, branch.CommitHash, commit.Hash, models.ReflogOperationRestore, fmt.Sprintf("restore to reflog %s", entry.ID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	repository.branch.CommitHash = commit.Hash
+	repository.commit = commit
+	repository.headTree = &commit.TreeHash
+	return entry, nil
+}
+
+// UndeleteBranch recreate deleted branch at the head it had when it was deleted last time
+func (repository *WorkRepository) UndeleteBranch(ctx context.Context, branchName string) (*models.Branch, error) {
+	_, err := repository.repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetName(branchName).SetRepositoryID(repository.repoModel.ID))
+	if err == nil {
+		return nil, fmt.Errorf("%s already exit", branchName)
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return nil, err
+	}
+
+	deletions, _, err := repository.repo.BranchReflogRepo().List(ctx, models.NewListBranchReflogParams().
+		SetRepositoryID(repository.repoModel.ID).
+		SetBranchName(branchName).
+		SetOperation(models.ReflogOperationDelete).
+		SetAmount(1))
+	if err != nil {
+		return nil, err
+	}
+	if len(deletions) == 0 {
+		return nil, fmt.Errorf("deleted branch %s %w", branchName, models.ErrNotFound)
+	}
+	//make sure head of deleted branch still exist, same as restore
+	if !deletions[0].OldHash.IsEmpty() {
+		_, err = repository.repo.CommitRepo(repository.repoModel.ID).Commit(ctx, deletions[0].OldHash)
+		if err != nil {
+			return nil, fmt.Errorf("head %s of deleted branch %s %w", deletions[0].OldHash.Hex(), branchName, err)
+		}
+	}
+
+	var newBranch *models.Branch
+	err = repository.repo.Transaction(ctx, func(repo models.IRepo) error {
+		var err error
+		newBranch, err = repo.BranchRepo().Insert(ctx, &models.Branch{
+			RepositoryID: repository.repoModel.ID,
+			CommitHash:   deletions[0].OldHash,
+			Name:         branchName,
+			CreatorID:    repository.operator.ID,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return repository.recordReflog(ctx, repo, newBranch, hash.Empty, newBranch.CommitHash, models.ReflogOperationUndelete, fmt.Sprintf("undelete from reflog %s", deletions[0].ID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBranch, nil
+}
@@ -0,0 +1,119 @@
+package versionmgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GitDataAI/jiaozifs/block/mem"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBranchReflog(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	adapter := mem.New(ctx)
+	repo := models.NewRepo(db)
+
+	user, err := makeUser(ctx, repo.UserRepo(), "admin")
+	require.NoError(t, err)
+
+	project, err := makeRepository(ctx, repo, user, "testproject")
+	require.NoError(t, err)
+
+	workRepo := NewWorkRepositoryFromAdapter(ctx, user, project, repo, adapter)
+
+	commit1, err := addChangesToWip(ctx, workRepo, "main", "c1", `
+1|a.txt	|a1
+`)
+	require.NoError(t, err)
+
+	require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+	_, err = workRepo.CreateBranch(ctx, "feat")
+	require.NoError(t, err)
+
+	featCommit, err := addChangesToWip(ctx, workRepo, "feat", "f1", `
+1|f.txt	|f1
+`)
+	require.NoError(t, err)
+
+	require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+	ffCommit, err := workRepo.FastForwardMerge(ctx, featCommit.Hash)
+	require.NoError(t, err)
+
+	operations := func(branchName string) []models.ReflogOperation {
+		reflogs, _, err := workRepo.Reflog(ctx, branchName, nil, 100)
+		require.NoError(t, err)
+		var ops []models.ReflogOperation
+		for _, reflog := range reflogs {
+			ops = append(ops, reflog.Operation)
+		}
+		return ops
+	}
+
+	require.Equal(t, []models.ReflogOperation{models.ReflogOperationFastForward, models.ReflogOperationCommit}, operations("main"))
+	require.Equal(t, []models.ReflogOperation{models.ReflogOperationCommit, models.ReflogOperationCreate}, operations("feat"))
+
+	reflogs, _, err := workRepo.Reflog(ctx, "main", nil, 100)
+	require.NoError(t, err)
+	require.Equal(t, commit1.Hash.Hex(), reflogs[0].OldHash.Hex())
+	require.Equal(t, ffCommit.Hash.Hex(), reflogs[0].NewHash.Hex())
+	require.Equal(t, user.ID, reflogs[0].OperatorID)
+
+	t.Run("restore", func(t *testing.T) {
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		//move back to c1 is not fast-forward
+		_, err = workRepo.RestoreBranch(ctx, reflogs[1].ID, false)
+		require.ErrorIs(t, err, ErrNotFastForward)
+
+		_, err = workRepo.RestoreBranch(ctx, reflogs[1].ID, true)
+		require.NoError(t, err)
+		require.Equal(t, commit1.Hash.Hex(), workRepo.CurBranch().CommitHash.Hex())
+
+		//move forward again is fast-forward
+		_, err = workRepo.RestoreBranch(ctx, reflogs[0].ID, false)
+		require.NoError(t, err)
+		require.Equal(t, featCommit.Hash.Hex(), workRepo.CurBranch().CommitHash.Hex())
+		require.Equal(t, models.ReflogOperationRestore, operations("main")[0])
+
+		featReflogs, _, err := workRepo.Reflog(ctx, "feat", nil, 100)
+		require.NoError(t, err)
+		_, err = workRepo.RestoreBranch(ctx, featReflogs[0].ID, true)
+		require.ErrorIs(t, err, ErrInvalidReflogEntry)
+	})
+
+	t.Run("undelete", func(t *testing.T) {
+		_, err = workRepo.UndeleteBranch(ctx, "feat")
+		require.Error(t, err)
+
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "feat"))
+		require.NoError(t, workRepo.DeleteBranch(ctx))
+		require.Equal(t, models.ReflogOperationDelete, operations("feat")[0])
+
+		branch, err := workRepo.UndeleteBranch(ctx, "feat")
+		require.NoError(t, err)
+		require.Equal(t, featCommit.Hash.Hex(), branch.CommitHash.Hex())
+		require.Equal(t, models.ReflogOperationUndelete, operations("feat")[0])
+
+		_, err = workRepo.UndeleteBranch(ctx, "never")
+		require.ErrorIs(t, err, models.ErrNotFound)
+
+		//head of deleted branch is gone
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		_, err = workRepo.CreateBranch(ctx, "tmp")
+		require.NoError(t, err)
+		tmpCommit, err := addChangesToWip(ctx, workRepo, "tmp", "t1", `
+1|t.txt	|t1
+`)
+		require.NoError(t, err)
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "tmp"))
+		require.NoError(t, workRepo.DeleteBranch(ctx))
+		_, err = repo.CommitRepo(project.ID).Delete(ctx, models.NewDeleteParams().SetHash(tmpCommit.Hash))
+		require.NoError(t, err)
+		_, err = workRepo.UndeleteBranch(ctx, "tmp")
+		require.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
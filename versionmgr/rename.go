@@ -0,0 +1,463 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/GitDataAI/jiaozifs/versionmgr/merkletrie"
+	"github.com/GitDataAI/jiaozifs/versionmgr/merkletrie/noder"
+)
+
+const (
+	// DefaultRenameThreshold files with similarity not less than this percentage are treated as renamed
+	DefaultRenameThreshold = 50
+	// MaxRenameSimilaritySize files larger than this size are only detected by exact match
+	MaxRenameSimilaritySize = 1 << 20
+	// DefaultRenameMaxPairs only exact match is detected when deleted files multiply inserted files exceed this, like
+	// renameLimit of git
+	DefaultRenameMaxPairs = 100 * 100
+	// DefaultRenameMaxBytes only exact match is detected when content to compare exceed this size
+	DefaultRenameMaxBytes = 32 << 20
+)
+
+// errRenameBudgetExceeded content to compare is too large, fall back to exact match
+var errRenameBudgetExceeded = errors.New("rename detection budget exceeded")
+
+var _ IChange = (*RenameChange)(nil)
+
+// RenameChange file was moved or copied to a new path, the content may also be modified
+type RenameChange struct {
+	from   noder.Path
+	to     noder.Path
+	isCopy bool
+}
+
+// NewRenameChange create a rename change, if isCopy is true, the source file was kept
+func NewRenameChange(from, to noder.Path, isCopy bool) *RenameChange {
+	return &RenameChange{from: from, to: to, isCopy: isCopy}
+}
+
+// Action return Rename or Copy
+func (c *RenameChange) Action() (merkletrie.Action, error) {
+	if c.isCopy {
+		return merkletrie.Copy, nil
+	}
+	return merkletrie.Rename, nil
+}
+
+// From return source file
+func (c *RenameChange) From() noder.Path {
+	return c.from
+}
+
+// To return destination file
+func (c *RenameChange) To() noder.Path {
+	return c.to
+}
+
+// Path return path of destination file
+func (c *RenameChange) Path() string {
+	return c.to.String()
+}
+
+func (c *RenameChange) String() string {
+	action, _ := c.Action()
+	return fmt.Sprintf("<%s %s -> %s>", action, c.from.String(), c.to.String())
+}
+
+// RenameDetector find renamed or copied files in changes, a deleted file and an inserted file with the same content
+// is a rename, if the deleted file was already renamed or the source file was modified, it is a copy.
+// files not match exactly are compared by lines if ReadContent was set.
+type RenameDetector struct {
+	// ReadContent read content of blob for similarity detection, return ErrNotTextMergeable to skip this file
+	ReadContent func(ctx context.Context, blobHash hash.Hash) ([]byte, error)
+	// Threshold min percentage of similarity, use DefaultRenameThreshold if zero
+	Threshold int
+	// MaxPairs max deleted files multiply inserted files compared by similarity, use DefaultRenameMaxPairs if zero
+	MaxPairs int
+	// MaxBytes max total size of content compared by similarity, use DefaultRenameMaxBytes if zero
+	MaxBytes int
+}
+
+// Detect replace delete/insert changes with rename/copy changes, nil detector do nothing
+func (detector *RenameDetector) Detect(ctx context.Context, changes *Changes) (*Changes, error) {
+	if detector == nil {
+		return changes, nil
+	}
+
+	var deletes, inserts, result []IChange
+	modifyByHash := make(map[string]IChange)
+	for _, change := range changes.Changes() {
+		action, err := change.Action()
+		if err != nil {
+			return nil, err
+		}
+		switch action {
+		case merkletrie.Delete:
+			deletes = append(deletes, change)
+		case merkletrie.Insert:
+			inserts = append(inserts, change)
+		default:
+			if action == merkletrie.Modify && change.From() != nil {
+				modifyByHash[string(change.From().Hash())] = change
+			}
+			result = append(result, change)
+		}
+	}
+	if len(inserts) == 0 {
+		return changes, nil
+	}
+
+	deleteUsed := make([]bool, len(deletes))
+	insertUsed := make([]bool, len(inserts))
+
+	//exact match
+	deleteByHash := make(map[string][]int)
+	for index, change := range deletes {
+		key := string(change.From().Hash())
+		deleteByHash[key] = append(deleteByHash[key], index)
+	}
+	for index, change := range inserts {
+		key := string(change.To().Hash())
+		candidates := deleteByHash[key]
+		if len(candidates) > 0 {
+			deleteIndex := candidates[0]
+			isCopy := deleteUsed[deleteIndex]
+			for _, candidate := range candidates {
+				if !deleteUsed[candidate] {
+					deleteIndex, isCopy = candidate, false
+					break
+				}
+			}
+			deleteUsed[deleteIndex] = true
+			insertUsed[index] = true
+			result = append(result, NewRenameChange(deletes[deleteIndex].From(), change.To(), isCopy))
+			continue
+		}
+		if modify, ok := modifyByHash[key]; ok {
+			insertUsed[index] = true
+			result = append(result, NewRenameChange(modify.From(), change.To(), true))
+		}
+	}
+
+	//similarity match
+	if detector.ReadContent != nil {
+		renames, err := detector.detectSimilar(ctx, deletes, deleteUsed, inserts, insertUsed)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, renames...)
+	}
+
+	for index, change := range deletes {
+		if !deleteUsed[index] {
+			result = append(result, change)
+		}
+	}
+	for index, change := range inserts {
+		if !insertUsed[index] {
+			result = append(result, change)
+		}
+	}
+	return NewChanges(result), nil
+}
+
+func (detector *RenameDetector) detectSimilar(ctx context.Context, deletes []IChange, deleteUsed []bool, inserts []IChange, insertUsed []bool) ([]IChange, error) {
+	var deleteIndexes, insertIndexes []int
+	for index := range deletes {
+		if !deleteUsed[index] {
+			deleteIndexes = append(deleteIndexes, index)
+		}
+	}
+	for index := range inserts {
+		if !insertUsed[index] {
+			insertIndexes = append(insertIndexes, index)
+		}
+	}
+	maxPairs := detector.MaxPairs
+	if maxPairs <= 0 {
+		maxPairs = DefaultRenameMaxPairs
+	}
+	if len(deleteIndexes) == 0 || len(insertIndexes) == 0 || len(deleteIndexes)*len(insertIndexes) > maxPairs {
+		return nil, nil
+	}
+
+	threshold := detector.Threshold
+	if threshold <= 0 {
+		threshold = DefaultRenameThreshold
+	}
+	maxBytes := detector.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultRenameMaxBytes
+	}
+
+	//each file is read and split once, content is dropped after its lines are counted
+	readBytes := 0
+	lineSets := make(map[string]*lineSet)
+	loadLineSet := func(blobHash hash.Hash) (*lineSet, error) {
+		if set, ok := lineSets[string(blobHash)]; ok {
+			return set, nil
+		}
+		content, err := detector.ReadContent(ctx, blobHash)
+		if err != nil {
+			if errors.Is(err, ErrNotTextMergeable) {
+				lineSets[string(blobHash)] = nil
+				return nil, nil
+			}
+			return nil, err
+		}
+		readBytes += len(content)
+		if readBytes > maxBytes {
+			return nil, errRenameBudgetExceeded
+		}
+		//empty files are always similar, skip them
+		var set *lineSet
+		if len(content) > 0 {
+			set = newLineSet(content)
+		}
+		lineSets[string(blobHash)] = set
+		return set, nil
+	}
+	loadLineSets := func(changes []IChange, indexes []int, isDelete bool) ([]*lineSet, error) {
+		sets := make([]*lineSet, len(indexes))
+		for i, index := range indexes {
+			node := changes[index].To()
+			if isDelete {
+				node = changes[index].From()
+			}
+			set, err := loadLineSet(node.Hash())
+			if err != nil {
+				return nil, err
+			}
+			sets[i] = set
+		}
+		return sets, nil
+	}
+	deleteSets, err := loadLineSets(deletes, deleteIndexes, true)
+	if err != nil {
+		if errors.Is(err, errRenameBudgetExceeded) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	insertSets, err := loadLineSets(inserts, insertIndexes, false)
+	if err != nil {
+		if errors.Is(err, errRenameBudgetExceeded) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	type candidate struct {
+		deleteIndex int
+		insertIndex int
+		score       int
+	}
+	var candidates []candidate
+	for i, deleteIndex := range deleteIndexes {
+		if deleteSets[i] == nil {
+			continue
+		}
+		for j, insertIndex := range insertIndexes {
+			if insertSets[j] == nil {
+				continue
+			}
+			//shared lines are not more than lines of the smaller file
+			deleteLines, insertLines := deleteSets[i].total, insertSets[j].total
+			if min(deleteLines, insertLines)*200/(deleteLines+insertLines) < threshold {
+				continue
+			}
+			score := deleteSets[i].similarity(insertSets[j])
+			if score >= threshold {
+				candidates = append(candidates, candidate{deleteIndex: deleteIndex, insertIndex: insertIndex, score: score})
+			}
+		}
+	}
+
+	//most similar files first
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return strings.Compare(inserts[candidates[i].insertIndex].Path(), inserts[candidates[j].insertIndex].Path()) < 0
+	})
+
+	var renames []IChange
+	for _, c := range candidates {
+		if deleteUsed[c.deleteIndex] || insertUsed[c.insertIndex] {
+			continue
+		}
+		deleteUsed[c.deleteIndex] = true
+		insertUsed[c.insertIndex] = true
+		renames = append(renames, NewRenameChange(deletes[c.deleteIndex].From(), inserts[c.insertIndex].To(), false))
+	}
+	return renames, nil
+}
+
+// lineSet count of each line hash in content
+type lineSet struct {
+	counts map[uint64]int
+	total  int
+}
+
+func newLineSet(content []byte) *lineSet {
+	set := &lineSet{counts: make(map[uint64]int)}
+	for _, line := range splitLines(content) {
+		hasher := fnv.New64a()
+		_, _ = hasher.Write(line)
+		set.counts[hasher.Sum64()]++
+		set.total++
+	}
+	return set
+}
+
+// similarity return percentage of lines shared by two sets
+func (set *lineSet) similarity(other *lineSet) int {
+	if set.total+other.total == 0 {
+		return 100
+	}
+	small, large := set, other
+	if len(small.counts) > len(large.counts) {
+		small, large = large, small
+	}
+	common := 0
+	for line, count := range small.counts {
+		common += min(count, large.counts[line])
+	}
+	return common * 200 / (set.total + other.total)
+}
+
+// similarity return percentage of lines shared by two content
+func similarity(a, b []byte) int {
+	return newLineSet(a).similarity(newLineSet(b))
+}
+
+// FollowRenames expand renames and copies of both side into insert, delete and modify changes. if one side rename a file
+// and the other side modify it, the modification is moved to the new path, so that it can be merged with the renamed file
+func FollowRenames(ctx context.Context, fileTreeRepo models.IFileTreeRepo, left *Changes, right *Changes) (*Changes, *Changes, error) {
+	leftChanges, rightChanges, err := followRenames(ctx, fileTreeRepo, left.Changes(), right.Changes())
+	if err != nil {
+		return nil, nil, err
+	}
+	rightChanges, leftChanges, err = followRenames(ctx, fileTreeRepo, rightChanges, leftChanges)
+	if err != nil {
+		return nil, nil, err
+	}
+	return NewChanges(leftChanges), NewChanges(rightChanges), nil
+}
+
+// detectAndFollowRenames detect renames in both side and expand them for merge
+func detectAndFollowRenames(ctx context.Context, fileTreeRepo models.IFileTreeRepo, detector *RenameDetector, left *Changes, right *Changes) (*Changes, *Changes, error) {
+	left, err := detector.Detect(ctx, left)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err = detector.Detect(ctx, right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return FollowRenames(ctx, fileTreeRepo, left, right)
+}
+
+// followRenames expand renames in this side and move modifications of other side
+func followRenames(ctx context.Context, fileTreeRepo models.IFileTreeRepo, this []IChange, other []IChange) ([]IChange, []IChange, error) {
+	otherByPath := make(map[string]int, len(other))
+	for index, change := range other {
+		otherByPath[change.Path()] = index
+	}
+
+	moved := make(map[int]bool)
+	var thisResult, movedChanges []IChange
+	for _, change := range this {
+		action, err := change.Action()
+		if err != nil {
+			return nil, nil, err
+		}
+		if action == merkletrie.Copy {
+			thisResult = append(thisResult, &Change{merkletrie.NewInsert(change.To())})
+			continue
+		}
+		if action != merkletrie.Rename {
+			thisResult = append(thisResult, change)
+			continue
+		}
+
+		thisResult = append(thisResult, &Change{merkletrie.NewDelete(change.From())})
+
+		otherIndex, ok := otherByPath[change.From().String()]
+		_, toPathChanged := otherByPath[change.To().String()]
+		follow := ok && !toPathChanged && !moved[otherIndex]
+		if follow {
+			otherAction, err := other[otherIndex].Action()
+			if err != nil {
+				return nil, nil, err
+			}
+			follow = otherAction == merkletrie.Modify
+		}
+		if !follow {
+			thisResult = append(thisResult, &Change{merkletrie.NewInsert(change.To())})
+			continue
+		}
+
+		//both side modify content from the same base
+		basePath, err := pathWithHash(ctx, fileTreeRepo, change.To(), change.From().Hash())
+		if err != nil {
+			return nil, nil, err
+		}
+		otherPath, err := pathWithHash(ctx, fileTreeRepo, change.To(), other[otherIndex].To().Hash())
+		if err != nil {
+			return nil, nil, err
+		}
+		moved[otherIndex] = true
+		movedChanges = append(movedChanges, &Change{merkletrie.NewModify(basePath, otherPath)})
+		if !bytes.Equal(change.From().Hash(), change.To().Hash()) {
+			thisResult = append(thisResult, &Change{merkletrie.NewModify(basePath, change.To())})
+		}
+	}
+
+	otherResult := make([]IChange, 0, len(other))
+	for index, change := range other {
+		if !moved[index] {
+			otherResult = append(otherResult, change)
+		}
+	}
+	return thisResult, append(otherResult, movedChanges...), nil
+}
+
+// pathWithHash copy path and replace the last node with blob of blobHash, keep the name of last node
+func pathWithHash(ctx context.Context, fileTreeRepo models.IFileTreeRepo, path noder.Path, blobHash hash.Hash) (noder.Path, error) {
+	node, err := NewTreeNode(ctx, models.TreeEntry{Name: path.Name(), IsDir: false, Hash: blobHash}, fileTreeRepo)
+	if err != nil {
+		return nil, err
+	}
+	newPath := make(noder.Path, len(path))
+	copy(newPath, path)
+	newPath[len(newPath)-1] = node
+	return newPath, nil
+}
+
+// RenameDetector create a rename detector, small text blobs in this repository are compared by lines
+func (repository *WorkRepository) RenameDetector() *RenameDetector {
+	fileTreeRepo := repository.repo.FileTreeRepo(repository.repoModel.ID)
+	return &RenameDetector{
+		Threshold: DefaultRenameThreshold,
+		ReadContent: func(ctx context.Context, blobHash hash.Hash) ([]byte, error) {
+			blob, err := fileTreeRepo.Blob(ctx, blobHash)
+			if err != nil {
+				return nil, err
+			}
+			if blob.Size > MaxRenameSimilaritySize {
+				return nil, fmt.Errorf("blob %s too large %w", blobHash.Hex(), ErrNotTextMergeable)
+			}
+			content, _, err := repository.readTextBlob(ctx, fileTreeRepo, blobHash)
+			return content, err
+		},
+	}
+}
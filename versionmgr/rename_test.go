@@ -0,0 +1,142 @@
+package versionmgr
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/GitDataAI/jiaozifs/versionmgr/merkletrie"
+	"github.com/stretchr/testify/require"
+)
+
+func changeStrings(t *testing.T, changes *Changes) []string {
+	var result []string
+	for _, change := range changes.Changes() {
+		action, err := change.Action()
+		require.NoError(t, err)
+		if action == merkletrie.Rename || action == merkletrie.Copy {
+			result = append(result, fmt.Sprintf("%s|%s->%s", action, change.From().String(), change.To().String()))
+			continue
+		}
+		result = append(result, fmt.Sprintf("%s|%s", action, change.Path()))
+	}
+	return result
+}
+
+func TestRenameDetector(t *testing.T) {
+	ctx := context.Background()
+	testData := `
+2|a/x.dat|h1
+1|b/x.dat|h1
+1|c/x.dat|h1
+2|d.txt|h2
+1|e.txt|h3
+2|f.txt|h4
+1|g.bin|h5
+`
+	contents := map[string]string{
+		"h2": "a\nb\nc\nd\n",
+		"h3": "a\nb\nc\ne\n",
+		"h4": "x\ny\nz\n",
+	}
+	readContent := func(_ context.Context, blobHash hash.Hash) ([]byte, error) {
+		content, ok := contents[string(blobHash)]
+		if !ok {
+			return nil, ErrNotTextMergeable
+		}
+		return []byte(content), nil
+	}
+
+	t.Run("exact", func(t *testing.T) {
+		changes, err := makeMockChanges(testData)
+		require.NoError(t, err)
+		detector := &RenameDetector{}
+		result, err := detector.Detect(ctx, changes)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"Rename|a/x.dat->b/x.dat",
+			"Copy|a/x.dat->c/x.dat",
+			"Delete|d.txt",
+			"Insert|e.txt",
+			"Delete|f.txt",
+			"Insert|g.bin",
+		}, changeStrings(t, result))
+	})
+
+	t.Run("similarity", func(t *testing.T) {
+		changes, err := makeMockChanges(testData)
+		require.NoError(t, err)
+		detector := &RenameDetector{ReadContent: readContent}
+		result, err := detector.Detect(ctx, changes)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"Rename|a/x.dat->b/x.dat",
+			"Copy|a/x.dat->c/x.dat",
+			"Rename|d.txt->e.txt",
+			"Delete|f.txt",
+			"Insert|g.bin",
+		}, changeStrings(t, result))
+
+		detector.Threshold = 80
+		changes.Reset()
+		result, err = detector.Detect(ctx, changes)
+		require.NoError(t, err)
+		require.Contains(t, changeStrings(t, result), "Insert|e.txt")
+	})
+
+	t.Run("over budget", func(t *testing.T) {
+		changes, err := makeMockChanges(testData)
+		require.NoError(t, err)
+		//fall back to exact match
+		detector := &RenameDetector{ReadContent: readContent, MaxPairs: 1}
+		result, err := detector.Detect(ctx, changes)
+		require.NoError(t, err)
+		require.Contains(t, changeStrings(t, result), "Insert|e.txt")
+
+		changes.Reset()
+		detector = &RenameDetector{ReadContent: readContent, MaxBytes: 4}
+		result, err = detector.Detect(ctx, changes)
+		require.NoError(t, err)
+		require.Contains(t, changeStrings(t, result), "Insert|e.txt")
+	})
+
+	t.Run("nil detector", func(t *testing.T) {
+		changes, err := makeMockChanges(testData)
+		require.NoError(t, err)
+		var detector *RenameDetector
+		result, err := detector.Detect(ctx, changes)
+		require.NoError(t, err)
+		require.Equal(t, changes, result)
+	})
+}
+
+func TestSimilarity(t *testing.T) {
+	require.Equal(t, 100, similarity([]byte("a\nb\n"), []byte("b\na\n")))
+	require.Equal(t, 50, similarity([]byte("a\nb\n"), []byte("a\nc\n")))
+	require.Equal(t, 0, similarity([]byte("a\n"), []byte("b\n")))
+}
+
+func TestFollowRenames(t *testing.T) {
+	ctx := context.Background()
+	left, err := makeMockChanges(`
+2|a.txt|h1
+1|b.txt|h1
+1|c.txt|h2
+`)
+	require.NoError(t, err)
+	right, err := makeMockChanges(`
+2|a.txt|h1
+`)
+	require.NoError(t, err)
+
+	left, err = (&RenameDetector{}).Detect(ctx, left)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Rename|a.txt->b.txt", "Insert|c.txt"}, changeStrings(t, left))
+
+	//rename and delete the same file, keep the renamed file
+	left, right, err = FollowRenames(ctx, nil, left, right)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Delete|a.txt", "Insert|b.txt", "Insert|c.txt"}, changeStrings(t, left))
+	require.Equal(t, []string{"Delete|a.txt"}, changeStrings(t, right))
+}
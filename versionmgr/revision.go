@@ -0,0 +1,308 @@
+package versionmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+)
+
+const (
+	// HEADRevision refer to default branch of repository
+	HEADRevision = "HEAD"
+	// MinAbbrevHashLength abbreviated commit hash must have at least this number of hex chars
+	MinAbbrevHashLength = 4
+)
+
+var (
+	ErrInvalidRevision   = errors.New("invalid revision")
+	ErrAmbiguousRevision = errors.New("ambiguous abbreviated commit hash")
+	ErrEmptyRevision     = errors.New("branch has no commit")
+)
+
+// revisionTimeLayouts layouts accepted in @{time}, a date means the beginning of the day in UTC
+var revisionTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// RevisionResolver resolve git style revision expressions to commit, supported syntax:
+//
+//	<branch>, <tag>, <commit hash> or the abbreviated hash, HEAD for default branch of repository
+//	<branch>@{<time>}	the commit of branch at the time, found by committer time along the first parents
+//	<rev>~<n>	the nth generation ancestor following the first parents, <rev>~ is <rev>~1
+//	<rev>^<n>	the nth parent, <rev>^ is <rev>^1, <rev>^0 is the commit itself
+//
+// operators can be chained, for example main~3, v1.0^2, main@{2024-05-01}~1
+type RevisionResolver struct {
+	repo      models.IRepo
+	repoModel *models.Repository
+}
+
+func NewRevisionResolver(repo models.IRepo, repoModel *models.Repository) *RevisionResolver {
+	return &RevisionResolver{repo: repo, repoModel: repoModel}
+}
+
+// IsRevisionExpression check whether name use ancestry or time operators, plain names of branch, tag and commit are not expression
+func IsRevisionExpression(name string) bool {
+	return strings.ContainsAny(name, "~^") || strings.Contains(name, "@{")
+}
+
+// Resolve resolve revision expression to commit, refType restrict the kind of the leading name, branch only match branch,
+// tag only match tag, commit or empty match branch, tag and commit hash in order. a plain branch without commit returns
+// nil commit and nil error.
+func (resolver *RevisionResolver) Resolve(ctx context.Context, refType WorkRepoState, expr string) (*models.Commit, error) {
+	expr = strings.TrimSpace(expr)
+	name, operators := expr, ""
+	if idx := strings.IndexAny(expr, "~^@"); idx >= 0 {
+		name, operators = expr[:idx], expr[idx:]
+	}
+	if len(name) == 0 {
+		return nil, fmt.Errorf("revision %s miss ref name %w", expr, ErrInvalidRevision)
+	}
+
+	commit, branch, err := resolver.resolveName(ctx, refType, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(operators, "@{") {
+		end := strings.Index(operators, "}")
+		if end < 0 {
+			return nil, fmt.Errorf("revision %s miss } %w", expr, ErrInvalidRevision)
+		}
+		if branch == nil {
+			return nil, fmt.Errorf("revision %s time travel only support branch %w", expr, ErrInvalidRevision)
+		}
+		at, err := parseRevisionTime(operators[2:end])
+		if err != nil {
+			return nil, fmt.Errorf("revision %s %w", expr, err)
+		}
+		if commit == nil {
+			return nil, fmt.Errorf("revision %s %w", expr, ErrEmptyRevision)
+		}
+		commit, err = resolver.commitAtTime(ctx, commit, at)
+		if err != nil {
+			return nil, err
+		}
+		operators = operators[end+1:]
+	}
+
+	for len(operators) > 0 {
+		op := operators[0]
+		if op != '~' && op != '^' {
+			return nil, fmt.Errorf("revision %s unexpected %s %w", expr, operators, ErrInvalidRevision)
+		}
+		digits := 1
+		for digits < len(operators) && operators[digits] >= '0' && operators[digits] <= '9' {
+			digits++
+		}
+		n := 1
+		if digits > 1 {
+			n, err = strconv.Atoi(operators[1:digits])
+			if err != nil {
+				return nil, fmt.Errorf("revision %s %w", expr, ErrInvalidRevision)
+			}
+		}
+		operators = operators[digits:]
+
+		if commit == nil {
+			return nil, fmt.Errorf("revision %s %w", expr, ErrEmptyRevision)
+		}
+		if op == '~' {
+			for i := 0; i < n; i++ {
+				commit, err = resolver.parent(ctx, commit, 1)
+				if err != nil {
+					return nil, fmt.Errorf("revision %s %w", expr, err)
+				}
+			}
+		} else if n > 0 {
+			commit, err = resolver.parent(ctx, commit, n)
+			if err != nil {
+				return nil, fmt.Errorf("revision %s %w", expr, err)
+			}
+		}
+	}
+	return commit, nil
+}
+
+// ResolveBaseHead resolve base and head of compare expression, A..B compare A with B, A...B compare the merge base of
+// A and B with B
+func (resolver *RevisionResolver) ResolveBaseHead(ctx context.Context, basehead string) (*models.Commit, *models.Commit, error) {
+	mergeBase := true
+	base, head, found := strings.Cut(basehead, "...")
+	if !found {
+		mergeBase = false
+		base, head, found = strings.Cut(basehead, "..")
+	}
+	if !found || len(base) == 0 || len(head) == 0 {
+		return nil, nil, fmt.Errorf("basehead %s must be base...head or base..head %w", basehead, ErrInvalidRevision)
+	}
+
+	baseCommit, err := resolver.Resolve(ctx, InCommit, base)
+	if err != nil {
+		return nil, nil, err
+	}
+	headCommit, err := resolver.Resolve(ctx, InCommit, head)
+	if err != nil {
+		return nil, nil, err
+	}
+	if baseCommit == nil || headCommit == nil {
+		return nil, nil, fmt.Errorf("basehead %s %w", basehead, ErrEmptyRevision)
+	}
+	if !mergeBase {
+		return baseCommit, headCommit, nil
+	}
+
+	commitRepo := resolver.repo.CommitRepo(resolver.repoModel.ID)
+	bases, err := NewWrapCommitNode(commitRepo, baseCommit).MergeBase(ctx, NewWrapCommitNode(commitRepo, headCommit))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(bases) == 0 {
+		return nil, nil, fmt.Errorf("%s and %s have no merge base %w", base, head, models.ErrNotFound)
+	}
+	return bases[0].Commit(), headCommit, nil
+}
+
+// resolveName find commit of branch, tag or commit hash, branch is also returned if name is a branch
+func (resolver *RevisionResolver) resolveName(ctx context.Context, refType WorkRepoState, name string) (*models.Commit, *models.Branch, error) {
+	commitRepo := resolver.repo.CommitRepo(resolver.repoModel.ID)
+	if refType != InTag {
+		branchName := name
+		if name == HEADRevision {
+			branchName = resolver.repoModel.HEAD
+		}
+		branch, err := resolver.repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetRepositoryID(resolver.repoModel.ID).SetName(branchName))
+		if err == nil {
+			if branch.CommitHash.IsEmpty() {
+				return nil, branch, nil
+			}
+			commit, err := commitRepo.Commit(ctx, branch.CommitHash)
+			return commit, branch, err
+		}
+		if !errors.Is(err, models.ErrNotFound) {
+			return nil, nil, err
+		}
+		if refType == InBranch {
+			return nil, nil, fmt.Errorf("branch %s %w", name, models.ErrNotFound)
+		}
+	}
+
+	if refType != InBranch {
+		tag, err := resolver.repo.TagRepo().Get(ctx, models.NewGetTagParams().SetRepositoryID(resolver.repoModel.ID).SetName(name))
+		if err == nil {
+			commit, err := commitRepo.Commit(ctx, tag.Target)
+			return commit, nil, err
+		}
+		if !errors.Is(err, models.ErrNotFound) {
+			return nil, nil, err
+		}
+		if refType == InTag {
+			return nil, nil, fmt.Errorf("tag %s %w", name, models.ErrNotFound)
+		}
+	}
+
+	commit, err := resolver.resolveHash(ctx, name)
+	return commit, nil, err
+}
+
+// resolveHash find commit by full or abbreviated hash
+func (resolver *RevisionResolver) resolveHash(ctx context.Context, name string) (*models.Commit, error) {
+	prefix := strings.ToLower(name)
+	if _, err := hash.FromHex(prefix + strings.Repeat("0", len(prefix)%2)); err != nil || len(prefix) < MinAbbrevHashLength {
+		return nil, fmt.Errorf("%s is not a ref or commit hash of at least %d hex chars %w", name, MinAbbrevHashLength, ErrInvalidRevision)
+	}
+
+	commitRepo := resolver.repo.CommitRepo(resolver.repoModel.ID)
+	if commitHash, err := hash.FromHex(prefix); err == nil {
+		//try full hash first which is indexed
+		commit, err := commitRepo.Commit(ctx, commitHash)
+		if err == nil {
+			return commit, nil
+		}
+		if !errors.Is(err, models.ErrNotFound) {
+			return nil, err
+		}
+	}
+	commits, err := commitRepo.ListByPrefix(ctx, prefix, 2)
+	if err != nil {
+		return nil, err
+	}
+	switch len(commits) {
+	case 0:
+		return nil, fmt.Errorf("ref %s %w", name, models.ErrNotFound)
+	case 1:
+		return commits[0], nil
+	}
+	return nil, fmt.Errorf("%s %w", name, ErrAmbiguousRevision)
+}
+
+// parent return the nth parent of commit, start from 1
+func (resolver *RevisionResolver) parent(ctx context.Context, commit *models.Commit, n int) (*models.Commit, error) {
+	if n > len(commit.ParentHashes) {
+		return nil, fmt.Errorf("commit %s has no parent %d %w", commit.Hash.Hex(), n, models.ErrNotFound)
+	}
+	return resolver.repo.CommitRepo(resolver.repoModel.ID).Commit(ctx, commit.ParentHashes[n-1])
+}
+
+// commitAtTime walk first parents from commit and return the first commit committed not after the time
+func (resolver *RevisionResolver) commitAtTime(ctx context.Context, commit *models.Commit, at time.Time) (*models.Commit, error) {
+	for commit.Committer.When.After(at) {
+		if len(commit.ParentHashes) == 0 {
+			return nil, fmt.Errorf("no commit before %s %w", at.Format(time.RFC3339), models.ErrNotFound)
+		}
+		var err error
+		commit, err = resolver.parent(ctx, commit, 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return commit, nil
+}
+
+// parseRevisionTime parse time in @{time}, unix seconds is also accepted
+func parseRevisionTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	for _, layout := range revisionTimeLayouts {
+		if at, err := time.Parse(layout, value); err == nil {
+			return at, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unsupported time %s %w", value, ErrInvalidRevision)
+}
+
+// ResolveRevision resolve revision expression in this repository, see RevisionResolver
+func (repository *WorkRepository) ResolveRevision(ctx context.Context, refType WorkRepoState, expr string) (*models.Commit, error) {
+	return NewRevisionResolver(repository.repo, repository.repoModel).Resolve(ctx, refType, expr)
+}
+
+// CheckOutRevision check out ref for reading, branch and tag expressions like main~1 are resolved and checked out as
+// commit, plain names are checked out as CheckOut does
+func (repository *WorkRepository) CheckOutRevision(ctx context.Context, refType WorkRepoState, refName string) error {
+	if (refType != InBranch && refType != InTag) || !IsRevisionExpression(refName) {
+		return repository.CheckOut(ctx, refType, refName)
+	}
+
+	commit, err := repository.ResolveRevision(ctx, refType, refName)
+	if err != nil {
+		return err
+	}
+	treeHash := hash.Empty
+	if commit != nil {
+		treeHash = commit.TreeHash
+	}
+	repository.setCurState(InCommit, nil, nil, nil, commit)
+	repository.headTree = &treeHash
+	return nil
+}
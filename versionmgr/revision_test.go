@@ -0,0 +1,148 @@
+package versionmgr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/block/mem"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRevisionTime(t *testing.T) {
+	at, err := parseRevisionTime("2024-05-01")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), at)
+
+	at, err = parseRevisionTime("2024-05-01T10:20:30+08:00")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 5, 1, 2, 20, 30, 0, time.UTC).Unix(), at.Unix())
+
+	at, err = parseRevisionTime("1714521600")
+	require.NoError(t, err)
+	require.Equal(t, int64(1714521600), at.Unix())
+
+	_, err = parseRevisionTime("yesterday")
+	require.ErrorIs(t, err, ErrInvalidRevision)
+
+	require.True(t, IsRevisionExpression("main~3"))
+	require.True(t, IsRevisionExpression("v1.0^2"))
+	require.True(t, IsRevisionExpression("main@{2024-05-01}"))
+	require.False(t, IsRevisionExpression("feat/a"))
+}
+
+// TestRevisionResolver
+//
+// example
+//
+//	C1 --- C2 --- C3 --- M    main
+//	         \         /
+//	          F1 -----      feat
+func TestRevisionResolver(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	adapter := mem.New(ctx)
+	repo := models.NewRepo(db)
+
+	user, err := makeUser(ctx, repo.UserRepo(), "admin")
+	require.NoError(t, err)
+
+	project, err := makeRepository(ctx, repo, user, "testproject")
+	require.NoError(t, err)
+
+	workRepo := NewWorkRepositoryFromAdapter(ctx, user, project, repo, adapter)
+
+	commit1, err := addChangesToWip(ctx, workRepo, "main", "c1", `
+1|a.txt	|a1
+`)
+	require.NoError(t, err)
+
+	commit2, err := addChangesToWip(ctx, workRepo, "main", "c2", `
+1|b.txt	|b1
+`)
+	require.NoError(t, err)
+
+	require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+	_, err = workRepo.CreateBranch(ctx, "feat")
+	require.NoError(t, err)
+	featCommit, err := addChangesToWip(ctx, workRepo, "feat", "f1", `
+1|f.txt	|f1
+`)
+	require.NoError(t, err)
+
+	commit3, err := addChangesToWip(ctx, workRepo, "main", "c3", `
+1|c.txt	|c1
+`)
+	require.NoError(t, err)
+
+	require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+	mergeCommit, err := workRepo.Merge(ctx, featCommit.Hash, "merge feat", LeastHashResolve)
+	require.NoError(t, err)
+
+	require.NoError(t, workRepo.CheckOut(ctx, InCommit, commit1.Hash.Hex()))
+	_, err = workRepo.CreateTag(ctx, "v1.0", nil)
+	require.NoError(t, err)
+
+	resolver := NewRevisionResolver(repo, project)
+	resolve := func(refType WorkRepoState, expr string) string {
+		commit, err := resolver.Resolve(ctx, refType, expr)
+		require.NoError(t, err, expr)
+		return commit.Hash.Hex()
+	}
+
+	require.Equal(t, mergeCommit.Hash.Hex(), resolve(InBranch, "main"))
+	require.Equal(t, mergeCommit.Hash.Hex(), resolve(InCommit, "HEAD"))
+	require.Equal(t, commit1.Hash.Hex(), resolve(InTag, "v1.0"))
+	require.Equal(t, commit3.Hash.Hex(), resolve(InCommit, commit3.Hash.Hex()))
+	require.Equal(t, commit3.Hash.Hex(), resolve(InCommit, commit3.Hash.Hex()[:8]))
+	require.Equal(t, mergeCommit.ParentHashes[0].Hex(), resolve(InBranch, "main^"))
+	require.Equal(t, mergeCommit.ParentHashes[1].Hex(), resolve(InBranch, "main^2"))
+	require.Equal(t, mergeCommit.Hash.Hex(), resolve(InBranch, "main^0"))
+	require.Equal(t, commit2.Hash.Hex(), resolve(InBranch, "feat~1"))
+	require.Equal(t, commit1.Hash.Hex(), resolve(InCommit, "feat~~"))
+	require.Equal(t, commit1.Hash.Hex(), resolve(InCommit, "feat^~1"))
+	require.Equal(t, commit2.Hash.Hex(), resolve(InBranch, "feat@{"+commit2.Committer.When.Format(time.RFC3339Nano)+"}"))
+	require.Equal(t, commit1.Hash.Hex(), resolve(InBranch, "feat@{"+commit2.Committer.When.Format(time.RFC3339Nano)+"}~1"))
+
+	_, err = resolver.Resolve(ctx, InBranch, "v1.0")
+	require.ErrorIs(t, err, models.ErrNotFound)
+	_, err = resolver.Resolve(ctx, InCommit, "v1.0^")
+	require.ErrorIs(t, err, models.ErrNotFound)
+	_, err = resolver.Resolve(ctx, InCommit, "v1.0@{2024-05-01}")
+	require.ErrorIs(t, err, ErrInvalidRevision)
+	_, err = resolver.Resolve(ctx, InCommit, "main~x")
+	require.ErrorIs(t, err, ErrInvalidRevision)
+	_, err = resolver.Resolve(ctx, InCommit, "xyz")
+	require.ErrorIs(t, err, ErrInvalidRevision)
+	_, err = resolver.Resolve(ctx, InBranch, "feat@{2000-01-01}")
+	require.ErrorIs(t, err, models.ErrNotFound)
+
+	t.Run("base head", func(t *testing.T) {
+		base, head, err := resolver.ResolveBaseHead(ctx, "v1.0..feat")
+		require.NoError(t, err)
+		require.Equal(t, commit1.Hash.Hex(), base.Hash.Hex())
+		require.Equal(t, featCommit.Hash.Hex(), head.Hash.Hex())
+
+		base, head, err = resolver.ResolveBaseHead(ctx, "main^2...feat")
+		require.NoError(t, err)
+		require.Equal(t, commit2.Hash.Hex(), base.Hash.Hex())
+		require.Equal(t, featCommit.Hash.Hex(), head.Hash.Hex())
+
+		_, _, err = resolver.ResolveBaseHead(ctx, "main")
+		require.ErrorIs(t, err, ErrInvalidRevision)
+	})
+
+	t.Run("checkout expression", func(t *testing.T) {
+		require.NoError(t, workRepo.CheckOutRevision(ctx, InBranch, "main~1"))
+		require.Equal(t, InCommit, workRepo.state)
+		require.Equal(t, mergeCommit.ParentHashes[0].Hex(), workRepo.commit.Hash.Hex())
+
+		//expression point to commit which can not be written as branch
+		require.ErrorIs(t, workRepo.CheckOut(ctx, InBranch, "main~1"), ErrInvalidRevision)
+		require.ErrorIs(t, workRepo.CheckOut(ctx, InTag, "v1.0^"), ErrInvalidRevision)
+	})
+}
@@ -0,0 +1,246 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/block"
+	"github.com/GitDataAI/jiaozifs/block/params"
+	"github.com/GitDataAI/jiaozifs/config"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultStreamThreshold body with known length smaller than this size is hashed in memory and put to content
+	// address directly, others are streamed to a staging key
+	DefaultStreamThreshold = 32 << 20
+	// StreamPartSize size of each part when stream body to staging key by multipart upload
+	StreamPartSize = 16 << 20
+
+	// DefaultStagingTTL staging records older than this are treated as left by interrupted uploads
+	DefaultStagingTTL = 24 * time.Hour
+
+	stagingPrefix = "_staging/"
+)
+
+// SetStreamThreshold set size threshold of streaming upload, zero or negative value means DefaultStreamThreshold
+func (repository *WorkRepository) SetStreamThreshold(threshold int64) *WorkRepository {
+	repository.streamThreshold = threshold
+	return repository
+}
+
+func (repository *WorkRepository) getStreamThreshold() int64 {
+	if repository.streamThreshold <= 0 {
+		return DefaultStreamThreshold
+	}
+	return repository.streamThreshold
+}
+
+// writeSmallBlob hash content in memory and put it to content address
+func (repository *WorkRepository) writeSmallBlob(ctx context.Context, body io.Reader, contentLength int64, properties models.Property) (*models.Blob, error) {
+	data, err := io.ReadAll(io.LimitReader(body, contentLength+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != contentLength {
+		return nil, fmt.Errorf("expect %d bytes but got %d %w", contentLength, len(data), io.ErrUnexpectedEOF)
+	}
+
+	hasher := hash.NewHasher(hash.Md5)
+	_, err = hasher.Write(data)
+	if err != nil {
+		return nil, err
+	}
+	checkSum := hash.Hash(hasher.Md5.Sum(nil))
+
+	err = repository.adapter.Put(ctx, repository.contentPointer(checkSum), contentLength, bytes.NewReader(data), block.PutOpts{})
+	if err != nil {
+		return nil, err
+	}
+	return models.NewBlob(properties, repository.repoModel.ID, checkSum, contentLength)
+}
+
+// writeStreamBlob upload content to a staging key by multipart while hashing, then copy it to content address.
+// staging key is recorded in database before upload, so it can be removed by CleanupStagingObjects if daemon crashed.
+// content must match contentLength unless it is unknown(-1)
+func (repository *WorkRepository) writeStreamBlob(ctx context.Context, body io.Reader, contentLength int64, properties models.Property) (_ *models.Blob, err error) {
+	stagingPointer := block.ObjectPointer{
+		StorageNamespace: utils.StringValue(repository.repoModel.StorageNamespace),
+		IdentifierType:   block.IdentifierTypeRelative,
+		Identifier:       stagingPrefix + uuid.NewString(),
+	}
+	uploadResp, err := repository.adapter.CreateMultiPartUpload(ctx, stagingPointer, nil, block.CreateMultiPartUploadOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	stagingObject, err := repository.repo.StagingObjectRepo().Insert(ctx, &models.StagingObject{
+		RepositoryID: repository.repoModel.ID,
+		Key:          stagingPointer.Identifier,
+		UploadID:     uploadResp.UploadID,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		_ = repository.adapter.AbortMultiPartUpload(ctx, stagingPointer, uploadResp.UploadID)
+		return nil, err
+	}
+
+	completed := false
+	defer func() {
+		//staging object is useless whether upload success or not, keep the record if fail to clean it
+		if completed {
+			if removeErr := repository.adapter.Remove(ctx, stagingPointer); removeErr != nil {
+				workRepoLog.Warnf("remove staging key %s failed %v", stagingPointer.Identifier, removeErr)
+				return
+			}
+		} else {
+			if abortErr := repository.adapter.AbortMultiPartUpload(ctx, stagingPointer, uploadResp.UploadID); abortErr != nil {
+				workRepoLog.Warnf("abort multipart upload of staging key %s failed %v", stagingPointer.Identifier, abortErr)
+				return
+			}
+		}
+		if _, deleteErr := repository.repo.StagingObjectRepo().Delete(ctx, models.NewDeleteStagingObjectParams().SetID(stagingObject.ID)); deleteErr != nil {
+			workRepoLog.Warnf("delete staging object record %s failed %v", stagingObject.ID, deleteErr)
+		}
+	}()
+
+	if contentLength >= 0 {
+		//read one more byte to find out body longer than expect
+		body = io.LimitReader(body, contentLength+1)
+	}
+	hashReader := hash.NewHashingReader(body, hash.Md5)
+	buf := make([]byte, StreamPartSize)
+	var parts []block.MultipartPart
+	for partNumber := 1; ; partNumber++ {
+		n, readErr := io.ReadFull(hashReader, buf)
+		if readErr != nil && !errors.Is(readErr, io.EOF) && !errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return nil, readErr
+		}
+		//at least one part is required to complete upload
+		if n > 0 || len(parts) == 0 {
+			partResp, err := repository.adapter.UploadPart(ctx, stagingPointer, int64(n), bytes.NewReader(buf[:n]), uploadResp.UploadID, partNumber)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, block.MultipartPart{ETag: partResp.ETag, PartNumber: partNumber})
+		}
+		if readErr != nil {
+			break
+		}
+	}
+
+	if contentLength >= 0 && hashReader.CopiedSize != contentLength {
+		return nil, fmt.Errorf("expect %d bytes but got %d %w", contentLength, hashReader.CopiedSize, io.ErrUnexpectedEOF)
+	}
+
+	_, err = repository.adapter.CompleteMultiPartUpload(ctx, stagingPointer, uploadResp.UploadID, &block.MultipartUploadCompletion{Part: parts})
+	if err != nil {
+		return nil, err
+	}
+	completed = true
+
+	checkSum := hash.Hash(hashReader.Md5.Sum(nil))
+	err = repository.copyToContentAddress(ctx, stagingPointer, checkSum)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewBlob(properties, repository.repoModel.ID, checkSum, hashReader.CopiedSize)
+}
+
+// copyToContentAddress copy staging object to content address, skip if content already exit
+func (repository *WorkRepository) copyToContentAddress(ctx context.Context, stagingPointer block.ObjectPointer, checkSum hash.Hash) error {
+	contentPointer := repository.contentPointer(checkSum)
+	exist, err := repository.adapter.Exists(ctx, contentPointer)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return nil
+	}
+	return repository.adapter.Copy(ctx, stagingPointer, contentPointer)
+}
+
+// CleanupStagingObjects remove staging keys left by interrupted uploads, it should be called at startup of daemon.
+// only records older than staging ttl are removed, so uploads running on other daemons share the same database and
+// pre-signed uploads waiting for link are kept. ttl of repository is never shorter than its pre-signed url expiry
+func CleanupStagingObjects(ctx context.Context, repo models.IRepo, publicAdapterConfig params.AdapterConfig, uploadConfig *config.UploadConfig) error {
+	ttl := uploadConfig.StagingTTL
+	if ttl <= 0 {
+		ttl = DefaultStagingTTL
+	}
+	now := time.Now()
+	stagingObjects, err := repo.StagingObjectRepo().List(ctx, models.NewListStagingObjectParams().SetBefore(now.Add(-ttl)))
+	if err != nil {
+		return err
+	}
+
+	workRepos := make(map[uuid.UUID]*WorkRepository)
+	expiries := make(map[uuid.UUID]time.Duration)
+	for _, stagingObject := range stagingObjects {
+		workRepo, ok := workRepos[stagingObject.RepositoryID]
+		if !ok {
+			repository, err := repo.RepositoryRepo().Get(ctx, models.NewGetRepoParams().SetID(stagingObject.RepositoryID))
+			if err != nil && !errors.Is(err, models.ErrNotFound) {
+				return err
+			}
+			if repository != nil {
+				adapterConfig, err := adapterConfigOfRepository(repository, publicAdapterConfig)
+				if err != nil {
+					workRepoLog.Warnf("read storage config of repository %s failed %v", repository.ID, err)
+					continue
+				}
+				expiry, err := preSignedExpiry(adapterConfig)
+				if err != nil {
+					workRepoLog.Warnf("read pre-signed expiry of repository %s failed %v", repository.ID, err)
+					continue
+				}
+				workRepo, err = NewWorkRepositoryFromConfig(ctx, nil, repository, repo, publicAdapterConfig)
+				if err != nil {
+					workRepoLog.Warnf("create adapter of repository %s failed %v", repository.ID, err)
+					continue
+				}
+				expiries[stagingObject.RepositoryID] = expiry
+			}
+			workRepos[stagingObject.RepositoryID] = workRepo
+		}
+
+		//pre-signed url may still be used to upload
+		if stagingObject.CreatedAt.After(now.Add(-expiries[stagingObject.RepositoryID])) {
+			continue
+		}
+
+		//storage of deleted repository was removed together
+		if workRepo != nil {
+			stagingPointer := block.ObjectPointer{
+				StorageNamespace: utils.StringValue(workRepo.repoModel.StorageNamespace),
+				IdentifierType:   block.IdentifierTypeRelative,
+				Identifier:       stagingObject.Key,
+			}
+			//upload may be interrupted before or after complete
+			if len(stagingObject.UploadID) > 0 {
+				_ = workRepo.adapter.AbortMultiPartUpload(ctx, stagingPointer, stagingObject.UploadID)
+			}
+			exist, err := workRepo.adapter.Exists(ctx, stagingPointer)
+			if err == nil && exist {
+				err = workRepo.adapter.Remove(ctx, stagingPointer)
+			}
+			if err != nil {
+				workRepoLog.Warnf("remove staging key %s of repository %s failed %v", stagingObject.Key, stagingObject.RepositoryID, err)
+				continue
+			}
+		}
+
+		_, err = repo.StagingObjectRepo().Delete(ctx, models.NewDeleteStagingObjectParams().SetID(stagingObject.ID))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/GitDataAI/jiaozifs/block/local"
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type memStagingObjectRepo struct {
+	objects map[uuid.UUID]*models.StagingObject
+}
+
+func (repo *memStagingObjectRepo) Insert(_ context.Context, stagingObject *models.StagingObject) (*models.StagingObject, error) {
+	stagingObject.ID = uuid.New()
+	repo.objects[stagingObject.ID] = stagingObject
+	return stagingObject, nil
+}
+
+func (repo *memStagingObjectRepo) Get(_ context.Context, _ *models.GetStagingObjectParams) (*models.StagingObject, error) {
+	for _, object := range repo.objects {
+		return object, nil
+	}
+	return nil, models.ErrNotFound
+}
+
+func (repo *memStagingObjectRepo) List(_ context.Context, _ *models.ListStagingObjectParams) ([]*models.StagingObject, error) {
+	var objects []*models.StagingObject
+	for _, object := range repo.objects {
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// Delete remove all records, params can not be read outside models and uploads in test are serial
+func (repo *memStagingObjectRepo) Delete(_ context.Context, _ *models.DeleteStagingObjectParams) (int64, error) {
+	count := int64(len(repo.objects))
+	repo.objects = map[uuid.UUID]*models.StagingObject{}
+	return count, nil
+}
+
+type memFileTreeRepo struct {
+	models.IFileTreeRepo
+	trees map[string]*models.FileTree
+}
+
+func (repo *memFileTreeRepo) LockContents(_ context.Context, _ bool) error {
+	return nil
+}
+
+func (repo *memFileTreeRepo) Insert(_ context.Context, tree *models.FileTree) (*models.FileTree, error) {
+	repo.trees[tree.Hash.Hex()] = tree
+	return tree, nil
+}
+
+type stagingRepo struct {
+	models.IRepo
+	stagingObjectRepo *memStagingObjectRepo
+	fileTreeRepo      *memFileTreeRepo
+}
+
+func (repo *stagingRepo) Transaction(_ context.Context, fn func(repo models.IRepo) error, _ ...models.TxOption) error {
+	return fn(repo)
+}
+
+func (repo *stagingRepo) StagingObjectRepo() models.IStagingObjectRepo {
+	return repo.stagingObjectRepo
+}
+
+func (repo *stagingRepo) FileTreeRepo(_ uuid.UUID) models.IFileTreeRepo {
+	if repo.fileTreeRepo == nil {
+		repo.fileTreeRepo = &memFileTreeRepo{trees: map[string]*models.FileTree{}}
+	}
+	return repo.fileTreeRepo
+}
+
+func TestWriteBlobStream(t *testing.T) {
+	ctx := context.Background()
+	adapter, err := local.NewAdapter(t.TempDir())
+	require.NoError(t, err)
+
+	repoModel := &models.Repository{
+		ID:               uuid.New(),
+		StorageNamespace: utils.String("local://stream"),
+	}
+	stagingObjects := &memStagingObjectRepo{objects: map[uuid.UUID]*models.StagingObject{}}
+	repository := NewWorkRepositoryFromAdapter(ctx, nil, repoModel, &stagingRepo{stagingObjectRepo: stagingObjects}, adapter)
+
+	writeAndRead := func(t *testing.T, data []byte, contentLength int64) *models.Blob {
+		blob, err := repository.writeBlobContent(ctx, bytes.NewReader(data), contentLength, models.DefaultLeafProperty())
+		require.NoError(t, err)
+		require.Equal(t, int64(len(data)), blob.Size)
+
+		reader, err := repository.ReadBlob(ctx, blob, nil)
+		require.NoError(t, err)
+		defer reader.Close() //nolint
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, data, content)
+		return blob
+	}
+
+	data := make([]byte, 1024)
+	_, err = rand.New(rand.NewSource(1)).Read(data)
+	require.NoError(t, err)
+
+	t.Run("small", func(t *testing.T) {
+		writeAndRead(t, data, int64(len(data)))
+		writeAndRead(t, data, -1)
+		writeAndRead(t, []byte{}, 0)
+	})
+
+	t.Run("small with wrong length", func(t *testing.T) {
+		_, err := repository.writeBlobContent(ctx, bytes.NewReader(data), int64(len(data))+1, models.DefaultLeafProperty())
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		repository.SetStreamThreshold(100)
+		defer repository.SetStreamThreshold(0)
+
+		smallBlob := writeAndRead(t, data, int64(len(data)))
+		streamBlob := writeAndRead(t, data, -1)
+		require.Equal(t, smallBlob.CheckSum, streamBlob.CheckSum)
+		require.Len(t, stagingObjects.objects, 0)
+
+		exist, err := adapter.Exists(ctx, repository.contentPointer(streamBlob.CheckSum))
+		require.NoError(t, err)
+		require.True(t, exist)
+	})
+
+	t.Run("stream with wrong length", func(t *testing.T) {
+		repository.SetStreamThreshold(100)
+		defer repository.SetStreamThreshold(0)
+
+		_, err := repository.writeBlobContent(ctx, bytes.NewReader(data), int64(len(data))+1, models.DefaultLeafProperty())
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		_, err = repository.writeBlobContent(ctx, bytes.NewReader(data), int64(len(data))-1, models.DefaultLeafProperty())
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		require.Len(t, stagingObjects.objects, 0)
+	})
+}
@@ -0,0 +1,97 @@
+package versionmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/GitDataAI/jiaozifs/versionmgr/tablediff"
+)
+
+// DiffTable compare table file(csv, tsv, parquet) of path between current head and toCommitID,
+// return schema changes and row changes, if file not exit in one side, it is treated as empty table
+func (repository *WorkRepository) DiffTable(ctx context.Context, toCommitID hash.Hash, path string, opts tablediff.Options) (*tablediff.TableDiff, error) {
+	format, err := tablediff.FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	workTree, err := repository.RootTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	toCommit, err := repository.repo.CommitRepo(repository.repoModel.ID).Commit(ctx, toCommitID)
+	if err != nil {
+		return nil, err
+	}
+	toWorkTree, err := NewWorkTree(ctx, repository.repo.FileTreeRepo(repository.repoModel.ID), models.NewRootTreeEntry(toCommit.TreeHash))
+	if err != nil {
+		return nil, err
+	}
+
+	baseBlob, err := findTableBlob(ctx, workTree, path)
+	if err != nil {
+		return nil, err
+	}
+	toBlob, err := findTableBlob(ctx, toWorkTree, path)
+	if err != nil {
+		return nil, err
+	}
+	if baseBlob == nil && toBlob == nil {
+		return nil, ErrPathNotFound
+	}
+	//both sides are kept in memory together
+	var totalSize int64
+	for _, blob := range []*models.Blob{baseBlob, toBlob} {
+		if blob != nil {
+			totalSize += blob.Size
+		}
+	}
+	if totalSize > tablediff.MaxTableSize {
+		return nil, fmt.Errorf("file %s too large to compare %w", path, tablediff.ErrInvalidTable)
+	}
+
+	baseTable, err := repository.readTable(ctx, baseBlob, format)
+	if err != nil {
+		return nil, err
+	}
+	toTable, err := repository.readTable(ctx, toBlob, format)
+	if err != nil {
+		return nil, err
+	}
+	return tablediff.Diff(baseTable, toTable, opts)
+}
+
+// findTableBlob find blob of path in work tree, return nil if path not exit
+func findTableBlob(ctx context.Context, workTree *WorkTree, path string) (*models.Blob, error) {
+	blob, _, err := workTree.FindBlob(ctx, path)
+	if err != nil {
+		if errors.Is(err, ErrPathNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return blob, nil
+}
+
+// readTable read table from blob, return nil if blob is nil
+func (repository *WorkRepository) readTable(ctx context.Context, blob *models.Blob, format tablediff.Format) (*tablediff.Table, error) {
+	if blob == nil {
+		return nil, nil
+	}
+
+	reader, err := repository.ReadBlob(ctx, blob, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close() //nolint
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return tablediff.ReadTable(format, data)
+}
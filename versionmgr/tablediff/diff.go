@@ -0,0 +1,223 @@
+package tablediff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultSampleSize number of changed rows returned if not specific
+const DefaultSampleSize = 20
+
+type RowChangeKind string
+
+const (
+	RowAdded    RowChangeKind = "added"
+	RowRemoved  RowChangeKind = "removed"
+	RowModified RowChangeKind = "modified"
+)
+
+// ColumnTypeChange column exit in both table but type changed
+type ColumnTypeChange struct {
+	Name     string
+	FromType string
+	ToType   string
+}
+
+// RowChange changed row, Base is nil for added row and To is nil for removed row
+type RowChange struct {
+	Kind RowChangeKind
+	Key  string
+	Base map[string]string
+	To   map[string]string
+}
+
+// TableDiff schema changes and row changes between two tables
+type TableDiff struct {
+	AddedColumns   []Column
+	RemovedColumns []Column
+	RetypedColumns []ColumnTypeChange
+
+	BaseRows     int
+	ToRows       int
+	RowsAdded    int
+	RowsRemoved  int
+	RowsModified int
+	Samples      []RowChange
+}
+
+// Options used to compare tables
+type Options struct {
+	// PrimaryKey column used to match rows between tables, if empty rows are compared by content
+	// and modified rows are reported as removed and added
+	PrimaryKey string
+	// SampleSize max number of changed rows in result
+	SampleSize int
+}
+
+// Diff compare two tables, nil table means empty table, for example the file was added or deleted.
+// values are compared on columns exit in both tables only.
+func Diff(base, to *Table, opts Options) (*TableDiff, error) {
+	if base == nil {
+		base = &Table{}
+	}
+	if to == nil {
+		to = &Table{}
+	}
+	if opts.SampleSize <= 0 {
+		opts.SampleSize = DefaultSampleSize
+	}
+
+	result := &TableDiff{
+		BaseRows: len(base.Rows),
+		ToRows:   len(to.Rows),
+	}
+
+	//schema changes
+	var commonColumns []string
+	for _, column := range to.Columns {
+		baseIndex := base.ColumnIndex(column.Name)
+		if baseIndex == -1 {
+			result.AddedColumns = append(result.AddedColumns, column)
+			continue
+		}
+		commonColumns = append(commonColumns, column.Name)
+		if baseType := base.Columns[baseIndex].Type; baseType != column.Type {
+			result.RetypedColumns = append(result.RetypedColumns, ColumnTypeChange{
+				Name:     column.Name,
+				FromType: baseType,
+				ToType:   column.Type,
+			})
+		}
+	}
+	for _, column := range base.Columns {
+		if to.ColumnIndex(column.Name) == -1 {
+			result.RemovedColumns = append(result.RemovedColumns, column)
+		}
+	}
+
+	if len(opts.PrimaryKey) > 0 {
+		return result, diffByKey(result, base, to, commonColumns, opts)
+	}
+	diffByContent(result, base, to, commonColumns, opts)
+	return result, nil
+}
+
+func diffByKey(result *TableDiff, base, to *Table, commonColumns []string, opts Options) error {
+	//empty table has no columns, all rows are added or removed
+	baseKeyIndex, toKeyIndex := base.ColumnIndex(opts.PrimaryKey), to.ColumnIndex(opts.PrimaryKey)
+	if (baseKeyIndex == -1 && len(base.Columns) > 0) || (toKeyIndex == -1 && len(to.Columns) > 0) {
+		return fmt.Errorf("primary key %s not found %w", opts.PrimaryKey, ErrInvalidTable)
+	}
+
+	baseRows, err := indexRows(base, baseKeyIndex)
+	if err != nil {
+		return err
+	}
+	toRows, err := indexRows(to, toKeyIndex)
+	if err != nil {
+		return err
+	}
+
+	baseIndexes := columnIndexes(base, commonColumns)
+	toIndexes := columnIndexes(to, commonColumns)
+	for _, row := range to.Rows {
+		key := row[toKeyIndex]
+		baseRow, ok := baseRows[key]
+		if !ok {
+			result.RowsAdded++
+			result.addSample(opts, RowChange{Kind: RowAdded, Key: key, To: rowToMap(to, row)})
+			continue
+		}
+		if !sameValues(baseRow, baseIndexes, row, toIndexes) {
+			result.RowsModified++
+			result.addSample(opts, RowChange{Kind: RowModified, Key: key, Base: rowToMap(base, baseRow), To: rowToMap(to, row)})
+		}
+	}
+	for _, row := range base.Rows {
+		key := row[baseKeyIndex]
+		if _, ok := toRows[key]; !ok {
+			result.RowsRemoved++
+			result.addSample(opts, RowChange{Kind: RowRemoved, Key: key, Base: rowToMap(base, row)})
+		}
+	}
+	return nil
+}
+
+func diffByContent(result *TableDiff, base, to *Table, commonColumns []string, opts Options) {
+	baseIndexes := columnIndexes(base, commonColumns)
+	toIndexes := columnIndexes(to, commonColumns)
+
+	//rows are compared as multiset
+	baseCount := make(map[string]int, len(base.Rows))
+	for _, row := range base.Rows {
+		baseCount[rowContent(row, baseIndexes)]++
+	}
+	for _, row := range to.Rows {
+		content := rowContent(row, toIndexes)
+		if baseCount[content] > 0 {
+			baseCount[content]--
+			continue
+		}
+		result.RowsAdded++
+		result.addSample(opts, RowChange{Kind: RowAdded, To: rowToMap(to, row)})
+	}
+	for _, row := range base.Rows {
+		content := rowContent(row, baseIndexes)
+		if baseCount[content] > 0 {
+			baseCount[content]--
+			result.RowsRemoved++
+			result.addSample(opts, RowChange{Kind: RowRemoved, Base: rowToMap(base, row)})
+		}
+	}
+}
+
+func (result *TableDiff) addSample(opts Options, change RowChange) {
+	if len(result.Samples) < opts.SampleSize {
+		result.Samples = append(result.Samples, change)
+	}
+}
+
+func indexRows(table *Table, keyIndex int) (map[string][]string, error) {
+	rows := make(map[string][]string, len(table.Rows))
+	for _, row := range table.Rows {
+		key := row[keyIndex]
+		if _, ok := rows[key]; ok {
+			return nil, fmt.Errorf("duplicate primary key %s %w", key, ErrInvalidTable)
+		}
+		rows[key] = row
+	}
+	return rows, nil
+}
+
+func columnIndexes(table *Table, columns []string) []int {
+	indexes := make([]int, len(columns))
+	for i, name := range columns {
+		indexes[i] = table.ColumnIndex(name)
+	}
+	return indexes
+}
+
+func sameValues(a []string, aIndexes []int, b []string, bIndexes []int) bool {
+	for i := range aIndexes {
+		if a[aIndexes[i]] != b[bIndexes[i]] {
+			return false
+		}
+	}
+	return true
+}
+
+func rowContent(row []string, indexes []int) string {
+	values := make([]string, len(indexes))
+	for i, index := range indexes {
+		values[i] = row[index]
+	}
+	return strings.Join(values, "\x1f")
+}
+
+func rowToMap(table *Table, row []string) map[string]string {
+	values := make(map[string]string, len(table.Columns))
+	for index, column := range table.Columns {
+		values[column.Name] = row[index]
+	}
+	return values
+}
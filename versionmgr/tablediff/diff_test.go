@@ -0,0 +1,128 @@
+package tablediff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+func mustReadCSV(t *testing.T, content string) *Table {
+	table, err := ReadCSV(strings.NewReader(content), ',')
+	require.NoError(t, err)
+	return table
+}
+
+func TestReadCSV(t *testing.T) {
+	table := mustReadCSV(t, "id,price,label,ok\n1,1.5,cat,true\n2,,dog,false\n")
+	require.Equal(t, []Column{{"id", "integer"}, {"price", "float"}, {"label", "string"}, {"ok", "boolean"}}, table.Columns)
+	require.Len(t, table.Rows, 2)
+	require.Equal(t, []string{"2", "", "dog", "false"}, table.Rows[1])
+
+	table, err := ReadCSV(strings.NewReader("a\tb\n1\tx\n"), '\t')
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "x"}, table.Rows[0])
+
+	table = mustReadCSV(t, "")
+	require.Empty(t, table.Columns)
+
+	//short rows of wide table are padded, cells are limited
+	wide := strings.Repeat("c,", 1<<16) + "\n" + strings.Repeat("1\n", maxCells>>16)
+	_, err = ReadCSV(strings.NewReader(wide), ',')
+	require.ErrorIs(t, err, ErrInvalidTable)
+}
+
+func TestReadParquet(t *testing.T) {
+	type row struct {
+		ID    int64   `parquet:"name=id, type=INT64"`
+		Label *string `parquet:"name=label, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	}
+
+	buf := bytes.NewBuffer(nil)
+	pw, err := writer.NewParquetWriterFromWriter(buf, new(row), 1)
+	require.NoError(t, err)
+	cat := "cat"
+	require.NoError(t, pw.Write(row{ID: 1, Label: &cat}))
+	require.NoError(t, pw.Write(row{ID: 2}))
+	require.NoError(t, pw.WriteStop())
+
+	table, err := ReadTable(ParquetFormat, buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, []Column{{"id", "INT64"}, {"label", "UTF8"}}, table.Columns)
+	require.Equal(t, [][]string{{"1", "cat"}, {"2", ""}}, table.Rows)
+
+	_, err = ReadTable(ParquetFormat, []byte("not parquet"))
+	require.ErrorIs(t, err, ErrInvalidTable)
+
+	//corrupted footer must not panic or allocate rows claimed by metadata
+	data := buf.Bytes()
+	footerStart := len(data) - 8 - int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	for index := footerStart; index < len(data); index++ {
+		corrupted := bytes.Clone(data)
+		corrupted[index] ^= 0xff
+		require.NotPanics(t, func() {
+			_, _ = ReadTable(ParquetFormat, corrupted)
+		})
+	}
+}
+
+func TestFormatFromPath(t *testing.T) {
+	format, err := FormatFromPath("a/b.CSV")
+	require.NoError(t, err)
+	require.Equal(t, CSVFormat, format)
+
+	_, err = FormatFromPath("a/b.txt")
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestDiff(t *testing.T) {
+	base := mustReadCSV(t, "id,label,weight\n1,cat,4\n2,dog,10\n3,bird,1\n")
+	to := mustReadCSV(t, "id,label,color\n1,cat,black\n2,wolf,grey\n4,fish,red\n")
+
+	t.Run("by primary key", func(t *testing.T) {
+		result, err := Diff(base, to, Options{PrimaryKey: "id"})
+		require.NoError(t, err)
+		require.Equal(t, []Column{{"color", "string"}}, result.AddedColumns)
+		require.Equal(t, []Column{{"weight", "integer"}}, result.RemovedColumns)
+		require.Empty(t, result.RetypedColumns)
+		require.Equal(t, 1, result.RowsAdded)
+		require.Equal(t, 1, result.RowsRemoved)
+		require.Equal(t, 1, result.RowsModified)
+		require.Equal(t, []RowChange{
+			{Kind: RowModified, Key: "2", Base: map[string]string{"id": "2", "label": "dog", "weight": "10"}, To: map[string]string{"id": "2", "label": "wolf", "color": "grey"}},
+			{Kind: RowAdded, Key: "4", To: map[string]string{"id": "4", "label": "fish", "color": "red"}},
+			{Kind: RowRemoved, Key: "3", Base: map[string]string{"id": "3", "label": "bird", "weight": "1"}},
+		}, result.Samples)
+	})
+	t.Run("by content", func(t *testing.T) {
+		result, err := Diff(base, to, Options{SampleSize: 1})
+		require.NoError(t, err)
+		require.Equal(t, 2, result.RowsAdded)
+		require.Equal(t, 2, result.RowsRemoved)
+		require.Equal(t, 0, result.RowsModified)
+		require.Len(t, result.Samples, 1)
+	})
+	t.Run("retyped", func(t *testing.T) {
+		result, err := Diff(base, mustReadCSV(t, "id,label,weight\n1,cat,4.5\n"), Options{PrimaryKey: "id"})
+		require.NoError(t, err)
+		require.Equal(t, []ColumnTypeChange{{Name: "weight", FromType: "integer", ToType: "float"}}, result.RetypedColumns)
+		require.Equal(t, 1, result.RowsModified)
+		require.Equal(t, 2, result.RowsRemoved)
+	})
+	t.Run("new file", func(t *testing.T) {
+		result, err := Diff(nil, to, Options{PrimaryKey: "id"})
+		require.NoError(t, err)
+		require.Len(t, result.AddedColumns, 3)
+		require.Equal(t, 3, result.RowsAdded)
+	})
+	t.Run("invalid primary key", func(t *testing.T) {
+		_, err := Diff(base, to, Options{PrimaryKey: "name"})
+		require.ErrorIs(t, err, ErrInvalidTable)
+
+		_, err = Diff(base, mustReadCSV(t, "id\n1\n1\n"), Options{PrimaryKey: "id"})
+		require.ErrorIs(t, err, ErrInvalidTable)
+	})
+}
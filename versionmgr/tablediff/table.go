@@ -0,0 +1,237 @@
+package tablediff
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+// MaxTableSize tables of one diff are all kept in memory, diff is refused if their total size exceed this size
+const MaxTableSize = 64 << 20
+
+const (
+	// maxCells max cells read from one table, each cell take at least a string header in memory
+	maxCells = MaxTableSize / 16
+	// maxCellsPerByte parquet encode repeated values compactly, but row count far beyond the size of file is
+	// treated as invalid metadata
+	maxCellsPerByte = 64
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported table format")
+	ErrInvalidTable      = errors.New("invalid table")
+)
+
+// Format of table file
+type Format string
+
+const (
+	CSVFormat     Format = "csv"
+	TSVFormat     Format = "tsv"
+	ParquetFormat Format = "parquet"
+)
+
+// FormatFromPath detect table format from file extension
+func FormatFromPath(fullPath string) (Format, error) {
+	switch strings.ToLower(path.Ext(fullPath)) {
+	case ".csv":
+		return CSVFormat, nil
+	case ".tsv":
+		return TSVFormat, nil
+	case ".parquet":
+		return ParquetFormat, nil
+	default:
+		return "", fmt.Errorf("file %s %w", fullPath, ErrUnsupportedFormat)
+	}
+}
+
+// Column name and type of table column, types of csv/tsv column are inferred from values
+type Column struct {
+	Name string
+	Type string
+}
+
+// Table all values are converted to string, empty string means null
+type Table struct {
+	Columns []Column
+	Rows    [][]string
+}
+
+// ColumnIndex return index of column, -1 if not found
+func (table *Table) ColumnIndex(name string) int {
+	for index, column := range table.Columns {
+		if column.Name == name {
+			return index
+		}
+	}
+	return -1
+}
+
+// ReadTable read table with specific format
+func ReadTable(format Format, data []byte) (*Table, error) {
+	switch format {
+	case CSVFormat:
+		return ReadCSV(bytes.NewReader(data), ',')
+	case TSVFormat:
+		return ReadCSV(bytes.NewReader(data), '\t')
+	case ParquetFormat:
+		return ReadParquet(data)
+	default:
+		return nil, fmt.Errorf("format %s %w", format, ErrUnsupportedFormat)
+	}
+}
+
+// ReadCSV read csv with separator, first line is header
+func ReadCSV(r io.Reader, separator rune) (*Table, error) {
+	csvReader := csv.NewReader(r)
+	csvReader.Comma = separator
+	csvReader.FieldsPerRecord = -1
+
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return &Table{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read header %v %w", err, ErrInvalidTable)
+	}
+
+	table := &Table{Columns: make([]Column, len(header))}
+	for index, name := range header {
+		table.Columns[index] = Column{Name: name}
+	}
+	numCells := len(header)
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read record %v %w", err, ErrInvalidTable)
+		}
+		//short records are padded to width of header, so every row take cells of all columns
+		numCells += max(len(header), 1)
+		if numCells > maxCells {
+			return nil, fmt.Errorf("table has more than %d cells %w", maxCells, ErrInvalidTable)
+		}
+		row := make([]string, len(header))
+		copy(row, record)
+		table.Rows = append(table.Rows, row)
+	}
+
+	for index := range table.Columns {
+		table.Columns[index].Type = inferType(table.Rows, index)
+	}
+	return table, nil
+}
+
+// inferType guess type of column from values, null values are ignored
+func inferType(rows [][]string, index int) string {
+	isInt, isFloat, isBool, hasValue := true, true, true, false
+	for _, row := range rows {
+		value := row[index]
+		if len(value) == 0 {
+			continue
+		}
+		hasValue = true
+		if isInt {
+			_, err := strconv.ParseInt(value, 10, 64)
+			isInt = err == nil
+		}
+		if isFloat {
+			_, err := strconv.ParseFloat(value, 64)
+			isFloat = err == nil
+		}
+		if isBool {
+			_, err := strconv.ParseBool(value)
+			isBool = err == nil
+		}
+	}
+	switch {
+	case !hasValue:
+		return "string"
+	case isInt:
+		return "integer"
+	case isFloat:
+		return "float"
+	case isBool:
+		return "boolean"
+	default:
+		return "string"
+	}
+}
+
+// ReadParquet read parquet file, only flat schema is supported
+func ReadParquet(data []byte) (_ *Table, err error) {
+	//parquet-go panic on some malformed files
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("read parquet %v %w", r, ErrInvalidTable)
+		}
+	}()
+
+	parquetReader, err := reader.NewParquetColumnReader(buffer.NewBufferFileFromBytes(data), 1)
+	if err != nil {
+		return nil, fmt.Errorf("read parquet %v %w", err, ErrInvalidTable)
+	}
+	defer parquetReader.ReadStop()
+
+	numRows := parquetReader.GetNumRows()
+	schemaElements := parquetReader.SchemaHandler.SchemaElements
+	if len(schemaElements) == 0 {
+		return &Table{}, nil
+	}
+	if numRows < 0 || numRows > maxCells {
+		return nil, fmt.Errorf("parquet has %d rows %w", numRows, ErrInvalidTable)
+	}
+	numCells := numRows * int64(len(schemaElements)-1)
+	if numCells > maxCells || numCells > int64(len(data))*maxCellsPerByte {
+		return nil, fmt.Errorf("parquet has %d rows of %d columns in %d bytes %w", numRows, len(schemaElements)-1, len(data), ErrInvalidTable)
+	}
+
+	table := &Table{
+		Rows: make([][]string, numRows),
+	}
+	if len(schemaElements)-1 != int(schemaElements[0].GetNumChildren()) {
+		return nil, fmt.Errorf("nested parquet schema %w", ErrInvalidTable)
+	}
+	for index, element := range schemaElements[1:] {
+		name := parquetReader.SchemaHandler.GetExName(index + 1)
+		if element.GetNumChildren() > 0 || element.GetRepetitionType().String() == "REPEATED" {
+			return nil, fmt.Errorf("nested parquet column %s %w", name, ErrInvalidTable)
+		}
+
+		columnType := element.GetType().String()
+		if element.IsSetConvertedType() {
+			columnType = element.GetConvertedType().String()
+		}
+		table.Columns = append(table.Columns, Column{Name: name, Type: columnType})
+
+		if numRows == 0 {
+			continue
+		}
+		values, _, _, err := parquetReader.ReadColumnByIndex(int64(index), numRows)
+		if err != nil {
+			return nil, fmt.Errorf("read column %s %v %w", name, err, ErrInvalidTable)
+		}
+		if int64(len(values)) != numRows {
+			return nil, fmt.Errorf("column %s expect %d values but got %d %w", name, numRows, len(values), ErrInvalidTable)
+		}
+		for rowIndex, value := range values {
+			if table.Rows[rowIndex] == nil {
+				table.Rows[rowIndex] = make([]string, len(schemaElements)-1)
+			}
+			if value != nil {
+				table.Rows[rowIndex][index] = fmt.Sprint(value)
+			}
+		}
+	}
+	return table, nil
+}
@@ -0,0 +1,458 @@
+package versionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/utils/hash"
+	"github.com/GitDataAI/jiaozifs/versionmgr/merkletrie"
+)
+
+// MaxTextMergeSize files larger than this size are never merged by line, resolve them with other resolver
+const MaxTextMergeSize = 64 << 20
+
+var ErrNotTextMergeable = errors.New("change can not be merged as text")
+
+const (
+	conflictMarkerLeft  = "<<<<<<< left\n"
+	conflictMarkerBase  = "||||||| base\n"
+	conflictMarkerSep   = "=======\n"
+	conflictMarkerRight = ">>>>>>> right\n"
+)
+
+// TextConflict record a hunk which both side modify the same lines of base,
+// line numbers start from 1, a zero count hunk means lines inserted before the start line
+type TextConflict struct {
+	BaseStart  int      `json:"base_start"`
+	LeftStart  int      `json:"left_start"`
+	RightStart int      `json:"right_start"`
+	Base       []string `json:"base"`
+	Left       []string `json:"left"`
+	Right      []string `json:"right"`
+}
+
+// TextMergeResult result of a three-way line merge, if Conflicts is not empty, Merged contains diff3 style conflict markers
+type TextMergeResult struct {
+	Merged    []byte
+	Conflicts []TextConflict
+}
+
+// HasConflict check whether any hunk changed by both side
+func (result *TextMergeResult) HasConflict() bool {
+	return len(result.Conflicts) > 0
+}
+
+// TextMergeConflictError returned when a three-way merge find overlap hunks
+type TextMergeConflictError struct {
+	Path      string
+	Conflicts []TextConflict
+}
+
+func (e *TextMergeConflictError) Error() string {
+	return fmt.Sprintf("path %s has %d conflict hunks: %s", e.Path, len(e.Conflicts), ErrConflict)
+}
+
+func (e *TextMergeConflictError) Unwrap() error {
+	return ErrConflict
+}
+
+// MergeText merge left and right which both derived from base line by line, like diff3.
+// hunks only changed by one side are applied automatically, hunks changed by both side are
+// accepted only if both side make the same change, otherwise a conflict is recorded.
+func MergeText(base, left, right []byte) *TextMergeResult {
+	return mergeText(base, left, right, false)
+}
+
+// UnionMergeText merge like MergeText, but never conflict, lines of both side are kept for hunks changed by both side,
+// left lines first. this is useful for files which only append lines
+func UnionMergeText(base, left, right []byte) *TextMergeResult {
+	return mergeText(base, left, right, true)
+}
+
+func mergeText(base, left, right []byte, union bool) *TextMergeResult {
+	baseLines, leftLines, rightLines := splitLines(base), splitLines(left), splitLines(right)
+	ids := make(map[string]int)
+	baseIDs, leftIDs, rightIDs := lineIDs(ids, baseLines), lineIDs(ids, leftLines), lineIDs(ids, rightLines)
+
+	leftMatch := matchLines(baseIDs, leftIDs)
+	rightMatch := matchLines(baseIDs, rightIDs)
+
+	result := &TextMergeResult{}
+	buf := bytes.NewBuffer(nil)
+	o, a, b := 0, 0, 0
+	for {
+		//stable lines, unchanged by both side
+		j := 0
+		for o+j < len(baseIDs) && leftMatch[o+j] == a+j && rightMatch[o+j] == b+j {
+			j++
+		}
+		if j > 0 {
+			writeLines(buf, baseLines[o:o+j])
+			o, a, b = o+j, a+j, b+j
+			continue
+		}
+
+		//find next line in base which both side keep
+		nextO := o
+		for nextO < len(baseIDs) && (leftMatch[nextO] == -1 || rightMatch[nextO] == -1) {
+			nextO++
+		}
+		nextA, nextB := len(leftIDs), len(rightIDs)
+		if nextO < len(baseIDs) {
+			nextA, nextB = leftMatch[nextO], rightMatch[nextO]
+		}
+
+		if o == nextO && a == nextA && b == nextB {
+			break
+		}
+		result.mergeHunk(buf,
+			baseLines[o:nextO], leftLines[a:nextA], rightLines[b:nextB],
+			baseIDs[o:nextO], leftIDs[a:nextA], rightIDs[b:nextB],
+			o, a, b, union)
+		o, a, b = nextO, nextA, nextB
+	}
+	result.Merged = buf.Bytes()
+	return result
+}
+
+func (result *TextMergeResult) mergeHunk(buf *bytes.Buffer, base, left, right [][]byte, baseIDs, leftIDs, rightIDs []int, o, a, b int, union bool) {
+	switch {
+	case equalIDs(baseIDs, leftIDs):
+		writeLines(buf, right)
+	case equalIDs(baseIDs, rightIDs), equalIDs(leftIDs, rightIDs):
+		writeLines(buf, left)
+	case union:
+		writeMarkedLines(buf, left)
+		writeLines(buf, right)
+	default:
+		result.Conflicts = append(result.Conflicts, TextConflict{
+			BaseStart:  o + 1,
+			LeftStart:  a + 1,
+			RightStart: b + 1,
+			Base:       linesToStrings(base),
+			Left:       linesToStrings(left),
+			Right:      linesToStrings(right),
+		})
+		buf.WriteString(conflictMarkerLeft)
+		writeMarkedLines(buf, left)
+		buf.WriteString(conflictMarkerBase)
+		writeMarkedLines(buf, base)
+		buf.WriteString(conflictMarkerSep)
+		writeMarkedLines(buf, right)
+		buf.WriteString(conflictMarkerRight)
+	}
+}
+
+// IsBinary check whether content looks like binary data, same as git, look for NUL in the first 8000 bytes
+func IsBinary(content []byte) bool {
+	if len(content) > 8000 {
+		content = content[:8000]
+	}
+	return bytes.IndexByte(content, 0) != -1
+}
+
+// TextMergeResolver try to merge both side of conflict line by line, if both side modify the same hunk or the file
+// is not a text file, use fallback resolver to resolve this conflict. if fallback is nil, return error.
+// paths marked as binary in attributes file are never merged by line.
+func TextMergeResolver(ctx context.Context, repository *WorkRepository, fallback ConflictResolver) ConflictResolver {
+	return func(left IChange, right IChange) (IChange, error) {
+		merged, err := repository.mergeTextChange(ctx, left, right, false)
+		if err == nil {
+			return merged, nil
+		}
+		if fallback != nil && (errors.Is(err, ErrConflict) || errors.Is(err, ErrNotTextMergeable)) {
+			return fallback(left, right)
+		}
+		return nil, err
+	}
+}
+
+// mergeTextChange merge both side of conflict line by line, binary attribute is read from attributes file of current
+// branch, which is the merge target, same as AttributesResolver and GetMergeState
+func (repository *WorkRepository) mergeTextChange(ctx context.Context, left IChange, right IChange, union bool) (IChange, error) {
+	attributes, err := repository.Attributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if attributes.MergeStrategy(left.Path()) == MergeStrategyBinary {
+		return nil, fmt.Errorf("path %s marked as binary %w", left.Path(), ErrNotTextMergeable)
+	}
+
+	fileTreeRepo := repository.repo.FileTreeRepo(repository.repoModel.ID)
+	result, leftBlob, err := repository.mergeTextContent(ctx, fileTreeRepo, left, right, union)
+	if err != nil {
+		return nil, err
+	}
+	if result.HasConflict() {
+		return nil, &TextMergeConflictError{Path: left.Path(), Conflicts: result.Conflicts}
+	}
+
+	blob, err := repository.WriteBlob(ctx, bytes.NewReader(result.Merged), int64(len(result.Merged)), leftBlob.Properties)
+	if err != nil {
+		return nil, err
+	}
+	_, err = fileTreeRepo.Insert(ctx, blob.FileTree())
+	if err != nil {
+		return nil, err
+	}
+
+	toPath, err := pathWithHash(ctx, fileTreeRepo, left.To(), blob.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return &Change{Change: merkletrie.Change{From: left.From(), To: toPath}}, nil
+}
+
+// mergeTextContent read content of both side and base, merge them line by line, only modify-modify and insert-insert changes can be merged
+func (repository *WorkRepository) mergeTextContent(ctx context.Context, fileTreeRepo models.IFileTreeRepo, left IChange, right IChange, union bool) (*TextMergeResult, *models.Blob, error) {
+	leftAction, err := left.Action()
+	if err != nil {
+		return nil, nil, err
+	}
+	rightAction, err := right.Action()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var baseHash hash.Hash
+	switch {
+	case leftAction == merkletrie.Modify && rightAction == merkletrie.Modify:
+		baseHash = left.From().Hash()
+	case leftAction == merkletrie.Insert && rightAction == merkletrie.Insert:
+		baseHash = hash.Empty
+	default:
+		return nil, nil, fmt.Errorf("path %s %w", left.Path(), ErrNotTextMergeable)
+	}
+
+	base, _, err := repository.readTextBlob(ctx, fileTreeRepo, baseHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	leftContent, leftBlob, err := repository.readTextBlob(ctx, fileTreeRepo, left.To().Hash())
+	if err != nil {
+		return nil, nil, err
+	}
+	rightContent, _, err := repository.readTextBlob(ctx, fileTreeRepo, right.To().Hash())
+	if err != nil {
+		return nil, nil, err
+	}
+	return mergeText(base, leftContent, rightContent, union), leftBlob, nil
+}
+
+func (repository *WorkRepository) readTextBlob(ctx context.Context, fileTreeRepo models.IFileTreeRepo, blobHash hash.Hash) ([]byte, *models.Blob, error) {
+	if blobHash.IsEmpty() {
+		return nil, nil, nil
+	}
+	blob, err := fileTreeRepo.Blob(ctx, blobHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if blob.Size > MaxTextMergeSize {
+		return nil, nil, fmt.Errorf("blob %s too large %w", blobHash.Hex(), ErrNotTextMergeable)
+	}
+
+	reader, err := repository.ReadBlob(ctx, blob, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer reader.Close() //nolint
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	if IsBinary(content) {
+		return nil, nil, fmt.Errorf("blob %s is binary %w", blobHash.Hex(), ErrNotTextMergeable)
+	}
+	return content, blob, nil
+}
+
+// splitLines split content into lines, each line keep its line ending
+func splitLines(content []byte) [][]byte {
+	lines := make([][]byte, 0)
+	for len(content) > 0 {
+		idx := bytes.IndexByte(content, '\n')
+		if idx == -1 {
+			lines = append(lines, content)
+			break
+		}
+		lines = append(lines, content[:idx+1])
+		content = content[idx+1:]
+	}
+	return lines
+}
+
+func lineIDs(ids map[string]int, lines [][]byte) []int {
+	result := make([]int, len(lines))
+	for i, line := range lines {
+		id, ok := ids[string(line)]
+		if !ok {
+			id = len(ids)
+			ids[string(line)] = id
+		}
+		result[i] = id
+	}
+	return result
+}
+
+func equalIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func writeLines(buf *bytes.Buffer, lines [][]byte) {
+	for _, line := range lines {
+		buf.Write(line)
+	}
+}
+
+// writeMarkedLines write lines inside conflict markers, make sure marker always start at a new line
+func writeMarkedLines(buf *bytes.Buffer, lines [][]byte) {
+	writeLines(buf, lines)
+	if len(lines) > 0 && !bytes.HasSuffix(lines[len(lines)-1], []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+}
+
+func linesToStrings(lines [][]byte) []string {
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = string(line)
+	}
+	return result
+}
+
+// matchLines compute the longest common subsequence of a and b with myers algorithm,
+// return an array which map index of a to matched index of b, -1 if line was not matched
+func matchLines(a, b []int) []int {
+	match := make([]int, len(a))
+	for i := range match {
+		match[i] = -1
+	}
+	lineDiff{a: a, b: b, match: match}.compare(0, len(a), 0, len(b))
+	return match
+}
+
+type lineDiff struct {
+	a, b  []int
+	match []int
+}
+
+func (d lineDiff) compare(aLo, aHi, bLo, bHi int) {
+	//trim common prefix and suffix
+	for aLo < aHi && bLo < bHi && d.a[aLo] == d.b[bLo] {
+		d.match[aLo] = bLo
+		aLo++
+		bLo++
+	}
+	for aLo < aHi && bLo < bHi && d.a[aHi-1] == d.b[bHi-1] {
+		d.match[aHi-1] = bHi - 1
+		aHi--
+		bHi--
+	}
+	if aLo == aHi || bLo == bHi {
+		return
+	}
+
+	x, y, ok := bisect(d.a[aLo:aHi], d.b[bLo:bHi])
+	if !ok {
+		return
+	}
+	d.compare(aLo, aLo+x, bLo, bLo+y)
+	d.compare(aLo+x, aHi, bLo+y, bHi)
+}
+
+// bisect find the middle snake of the two sequence in linear space, port from diff-match-patch
+func bisect(a, b []int) (int, int, bool) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	vOffset := maxD
+	vLength := 2*maxD + 2
+	v1 := make([]int, vLength)
+	v2 := make([]int, vLength)
+	for i := range v1 {
+		v1[i] = -1
+		v2[i] = -1
+	}
+	v1[vOffset+1] = 0
+	v2[vOffset+1] = 0
+
+	delta := n - m
+	// If the total number of lines is odd, then the front path will collide with the reverse path.
+	front := delta%2 != 0
+	k1start, k1end, k2start, k2end := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k1 := -d + k1start; k1 <= d-k1end; k1 += 2 {
+			k1Offset := vOffset + k1
+			var x1 int
+			if k1 == -d || (k1 != d && v1[k1Offset-1] < v1[k1Offset+1]) {
+				x1 = v1[k1Offset+1]
+			} else {
+				x1 = v1[k1Offset-1] + 1
+			}
+			y1 := x1 - k1
+			for x1 < n && y1 < m && a[x1] == b[y1] {
+				x1++
+				y1++
+			}
+			v1[k1Offset] = x1
+			if x1 > n {
+				k1end += 2
+			} else if y1 > m {
+				k1start += 2
+			} else if front {
+				k2Offset := vOffset + delta - k1
+				if k2Offset >= 0 && k2Offset < vLength && v2[k2Offset] != -1 {
+					// Mirror x2 onto top-left coordinate system.
+					x2 := n - v2[k2Offset]
+					if x1 >= x2 {
+						return x1, y1, true
+					}
+				}
+			}
+		}
+
+		for k2 := -d + k2start; k2 <= d-k2end; k2 += 2 {
+			k2Offset := vOffset + k2
+			var x2 int
+			if k2 == -d || (k2 != d && v2[k2Offset-1] < v2[k2Offset+1]) {
+				x2 = v2[k2Offset+1]
+			} else {
+				x2 = v2[k2Offset-1] + 1
+			}
+			y2 := x2 - k2
+			for x2 < n && y2 < m && a[n-x2-1] == b[m-y2-1] {
+				x2++
+				y2++
+			}
+			v2[k2Offset] = x2
+			if x2 > n {
+				k2end += 2
+			} else if y2 > m {
+				k2start += 2
+			} else if !front {
+				k1Offset := vOffset + delta - k2
+				if k1Offset >= 0 && k1Offset < vLength && v1[k1Offset] != -1 {
+					x1 := v1[k1Offset]
+					y1 := vOffset + x1 - k1Offset
+					// Mirror x2 onto top-left coordinate system.
+					if x1 >= n-x2 {
+						return x1, y1, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
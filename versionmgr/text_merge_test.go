@@ -0,0 +1,155 @@
+package versionmgr
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeText(t *testing.T) {
+	base := "id,label\n1,cat\n2,dog\n3,bird\n4,fish\n"
+	t.Run("no change", func(t *testing.T) {
+		result := MergeText([]byte(base), []byte(base), []byte(base))
+		require.False(t, result.HasConflict())
+		require.Equal(t, base, string(result.Merged))
+	})
+	t.Run("one side change", func(t *testing.T) {
+		left := "id,label\n1,cat\n2,wolf\n3,bird\n4,fish\n"
+		result := MergeText([]byte(base), []byte(left), []byte(base))
+		require.False(t, result.HasConflict())
+		require.Equal(t, left, string(result.Merged))
+
+		result = MergeText([]byte(base), []byte(base), []byte(left))
+		require.False(t, result.HasConflict())
+		require.Equal(t, left, string(result.Merged))
+	})
+	t.Run("different lines", func(t *testing.T) {
+		left := "id,label\n1,tiger\n2,dog\n3,bird\n4,fish\n"
+		right := "id,label\n1,cat\n2,dog\n3,bird\n4,shark\n5,ant\n"
+		result := MergeText([]byte(base), []byte(left), []byte(right))
+		require.False(t, result.HasConflict())
+		require.Equal(t, "id,label\n1,tiger\n2,dog\n3,bird\n4,shark\n5,ant\n", string(result.Merged))
+	})
+	t.Run("delete and append", func(t *testing.T) {
+		left := "id,label\n1,cat\n3,bird\n4,fish\n"
+		right := "id,label\n1,cat\n2,dog\n3,bird\n4,fish\n5,ant\n"
+		result := MergeText([]byte(base), []byte(left), []byte(right))
+		require.False(t, result.HasConflict())
+		require.Equal(t, "id,label\n1,cat\n3,bird\n4,fish\n5,ant\n", string(result.Merged))
+	})
+	t.Run("same change", func(t *testing.T) {
+		left := "id,label\n1,cat\n2,wolf\n3,bird\n4,fish\n"
+		result := MergeText([]byte(base), []byte(left), []byte(left))
+		require.False(t, result.HasConflict())
+		require.Equal(t, left, string(result.Merged))
+	})
+	t.Run("overlap", func(t *testing.T) {
+		left := "id,label\n1,cat\n2,wolf\n3,bird\n4,fish\n"
+		right := "id,label\n1,cat\n2,puppy\n3,bird\n4,fish\n"
+		result := MergeText([]byte(base), []byte(left), []byte(right))
+		require.True(t, result.HasConflict())
+		require.Len(t, result.Conflicts, 1)
+		conflict := result.Conflicts[0]
+		require.Equal(t, 3, conflict.BaseStart)
+		require.Equal(t, []string{"2,dog\n"}, conflict.Base)
+		require.Equal(t, []string{"2,wolf\n"}, conflict.Left)
+		require.Equal(t, []string{"2,puppy\n"}, conflict.Right)
+		require.Equal(t, "id,label\n1,cat\n<<<<<<< left\n2,wolf\n||||||| base\n2,dog\n=======\n2,puppy\n>>>>>>> right\n3,bird\n4,fish\n", string(result.Merged))
+	})
+	t.Run("both append", func(t *testing.T) {
+		left := base + "5,ant\n"
+		right := base + "5,bee\n"
+		result := MergeText([]byte(base), []byte(left), []byte(right))
+		require.True(t, result.HasConflict())
+		require.Equal(t, 6, result.Conflicts[0].BaseStart)
+		require.Empty(t, result.Conflicts[0].Base)
+	})
+	t.Run("empty base", func(t *testing.T) {
+		result := MergeText(nil, []byte("a\n"), nil)
+		require.False(t, result.HasConflict())
+		require.Equal(t, "a\n", string(result.Merged))
+
+		result = MergeText(nil, []byte("a\n"), []byte("a\n"))
+		require.False(t, result.HasConflict())
+		require.Equal(t, "a\n", string(result.Merged))
+
+		result = MergeText(nil, []byte("a\n"), []byte("b\n"))
+		require.True(t, result.HasConflict())
+	})
+	t.Run("no trailing newline", func(t *testing.T) {
+		left := "a\nb\nc"
+		right := "a\nd\nc"
+		result := MergeText([]byte("a\nb\nc"), []byte(left), []byte(right))
+		require.False(t, result.HasConflict())
+		require.Equal(t, "a\nd\nc", string(result.Merged))
+
+		result = MergeText([]byte("a\nb"), []byte("a\nc"), []byte("a\nd"))
+		require.True(t, result.HasConflict())
+		require.Equal(t, "a\n<<<<<<< left\nc\n||||||| base\nb\n=======\nd\n>>>>>>> right\n", string(result.Merged))
+	})
+}
+
+func TestUnionMergeText(t *testing.T) {
+	base := "id,label\n1,cat\n"
+	result := UnionMergeText([]byte(base), []byte(base+"2,dog\n"), []byte(base+"2,bird\n"))
+	require.False(t, result.HasConflict())
+	require.Equal(t, "id,label\n1,cat\n2,dog\n2,bird\n", string(result.Merged))
+
+	result = UnionMergeText([]byte("a\nb"), []byte("a\nc"), []byte("a\nd"))
+	require.False(t, result.HasConflict())
+	require.Equal(t, "a\nc\nd", string(result.Merged))
+}
+
+func TestMatchLines(t *testing.T) {
+	lcsLen := func(a, b []int) int {
+		dp := make([][]int, len(a)+1)
+		for i := range dp {
+			dp[i] = make([]int, len(b)+1)
+		}
+		for i := len(a) - 1; i >= 0; i-- {
+			for j := len(b) - 1; j >= 0; j-- {
+				if a[i] == b[j] {
+					dp[i][j] = dp[i+1][j+1] + 1
+				} else if dp[i+1][j] > dp[i][j+1] {
+					dp[i][j] = dp[i+1][j]
+				} else {
+					dp[i][j] = dp[i][j+1]
+				}
+			}
+		}
+		return dp[0][0]
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a := make([]int, r.Intn(40))
+		for j := range a {
+			a[j] = r.Intn(5)
+		}
+		b := make([]int, r.Intn(40))
+		for j := range b {
+			b[j] = r.Intn(5)
+		}
+
+		match := matchLines(a, b)
+		matched, last := 0, -1
+		for index, m := range match {
+			if m == -1 {
+				continue
+			}
+			require.Greater(t, m, last)
+			require.Equal(t, a[index], b[m])
+			last = m
+			matched++
+		}
+		require.Equal(t, lcsLen(a, b), matched, "a %v b %v", a, b)
+	}
+}
+
+func TestIsBinary(t *testing.T) {
+	require.False(t, IsBinary([]byte("a,b,c\n")))
+	require.True(t, IsBinary([]byte("a\x00b")))
+	require.False(t, IsBinary([]byte(strings.Repeat("a", 9000)+"\x00")))
+}
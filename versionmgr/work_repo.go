@@ -21,6 +21,7 @@ import (
 	"github.com/GitDataAI/jiaozifs/utils/hash"
 	"github.com/GitDataAI/jiaozifs/utils/httputil"
 	"github.com/GitDataAI/jiaozifs/utils/pathutil"
+	"github.com/ipfs/go-cid"
 	logging "github.com/ipfs/go-log/v2"
 )
 
@@ -47,6 +48,11 @@ type WorkRepository struct {
 	branch   *models.Branch
 	tag      *models.Tag
 	commit   *models.Commit
+
+	attributes     *Attributes
+	attributesTree hash.Hash
+
+	streamThreshold int64
 }
 
 func NewWorkRepositoryFromConfig(ctx context.Context, operator *models.User, repoModel *models.Repository, repo models.IRepo, publicAdapterConfig params.AdapterConfig) (*WorkRepository, error) {
@@ -71,46 +77,82 @@ func NewWorkRepositoryFromAdapter(_ context.Context, operator *models.User, repo
 	}
 }
 
-// WriteBlob write blob content to storage
+// WriteBlob write blob content to storage, content is split into chunks if repository enable chunked storage.
+// body with unknown length(-1) or larger than stream threshold is streamed to storage without local temp file.
+// blob is registered once content written, so gc treat its content as referenced
 func (repository *WorkRepository) WriteBlob(ctx context.Context, body io.Reader, contentLength int64, properties models.Property) (*models.Blob, error) {
-	// handle the upload itself
-	hashReader := hash.NewHashingReader(body, hash.Md5)
-	tempf, err := os.CreateTemp("", "*")
+	blob, err := repository.writeBlobContent(ctx, body, contentLength, properties)
 	if err != nil {
 		return nil, err
 	}
-	_, err = io.Copy(tempf, hashReader)
+	err = repository.registerBlob(ctx, blob)
 	if err != nil {
 		return nil, err
 	}
+	return blob, nil
+}
 
-	checkSum := hash.Hash(hashReader.Md5.Sum(nil))
-	_, err = tempf.Seek(0, io.SeekStart)
-	if err != nil {
-		return nil, err
+func (repository *WorkRepository) writeBlobContent(ctx context.Context, body io.Reader, contentLength int64, properties models.Property) (*models.Blob, error) {
+	if repository.repoModel.ChunkedStorage {
+		return repository.writeChunkedBlob(ctx, body, properties)
 	}
 
-	defer func() {
-		name := tempf.Name()
-		_ = tempf.Close()
-		_ = os.RemoveAll(name)
-	}()
+	threshold := repository.getStreamThreshold()
+	if contentLength < 0 {
+		//read head of body to find out whether it is small
+		head, err := io.ReadAll(io.LimitReader(body, threshold))
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(head)) < threshold {
+			return repository.writeSmallBlob(ctx, bytes.NewReader(head), int64(len(head)), properties)
+		}
+		return repository.writeStreamBlob(ctx, io.MultiReader(bytes.NewReader(head), body), -1, properties)
+	}
 
-	address := pathutil.PathOfHash(checkSum)
-	err = repository.adapter.Put(ctx, block.ObjectPointer{
-		StorageNamespace: utils.StringValue(repository.repoModel.StorageNamespace),
-		IdentifierType:   block.IdentifierTypeRelative,
-		Identifier:       address,
-	}, contentLength, tempf, block.PutOpts{})
-	if err != nil {
-		return nil, err
+	if contentLength < threshold {
+		return repository.writeSmallBlob(ctx, body, contentLength, properties)
 	}
+	return repository.writeStreamBlob(ctx, body, contentLength, properties)
+}
 
-	return models.NewBlob(properties, repository.repoModel.ID, checkSum, hashReader.CopiedSize)
+// registerBlob insert blob after its content written. writing skip content which already exist, gc may remove it
+// before blob inserted, so content is checked again under shared contents lock which gc hold while removing content
+func (repository *WorkRepository) registerBlob(ctx context.Context, blob *models.Blob) error {
+	return repository.repo.Transaction(ctx, func(repo models.IRepo) error {
+		fileTreeRepo := repo.FileTreeRepo(repository.repoModel.ID)
+		err := fileTreeRepo.LockContents(ctx, false)
+		if err != nil {
+			return err
+		}
+		for _, content := range blobContents(blob.FileTree()) {
+			exist, err := repository.adapter.Exists(ctx, repository.contentPointer(content.Hash))
+			if err != nil {
+				return err
+			}
+			if !exist {
+				return fmt.Errorf("content %s of blob %s was removed by gc, upload again %w", content.Hash.Hex(), blob.Hash.Hex(), ErrContentRemoved)
+			}
+		}
+		_, err = fileTreeRepo.Insert(ctx, blob.FileTree())
+		return err
+	})
 }
 
-// ReadBlob read blob content with range
+// ReadBlob read blob content with range, chunks of chunked blob are reassembled
 func (repository *WorkRepository) ReadBlob(ctx context.Context, blob *models.Blob, rangeSpec *string) (io.ReadCloser, error) {
+	if len(blob.Chunks) > 0 {
+		start, end := int64(0), blob.Size-1
+		if rangeSpec != nil {
+			rng, err := httputil.ParseRange(*rangeSpec, blob.Size)
+			if err != nil {
+				return nil, err
+			}
+			start, end = rng.StartOffset, rng.EndOffset
+		}
+		return newChunkedReader(ctx, repository, blob.Chunks, start, end), nil
+	}
+
 	address := pathutil.PathOfHash(blob.CheckSum)
 	pointer := block.ObjectPointer{
 		StorageNamespace: utils.StringValue(repository.repoModel.StorageNamespace),
@@ -170,9 +212,13 @@ func (repository *WorkRepository) rootTree(ctx context.Context, repo models.IRep
 	return NewWorkTree(ctx, repo.FileTreeRepo(repository.repoModel.ID), models.NewRootTreeEntry(*repository.headTree))
 }
 
+// CheckOut switch to branch, tag, commit or wip of branch, revision expressions are rejected for branch and tag,
+// use CheckOutRevision to read the commit they point to
 func (repository *WorkRepository) CheckOut(ctx context.Context, refType WorkRepoState, refName string) error {
 	treeHash := hash.Empty
-	if refType == InWip {
+	if (refType == InBranch || refType == InTag) && IsRevisionExpression(refName) {
+		return fmt.Errorf("%s is not a %s name %w", refName, refType, ErrInvalidRevision)
+	} else if refType == InWip {
 		ref, err := repository.repo.BranchRepo().Get(ctx, models.NewGetBranchParams().SetRepositoryID(repository.repoModel.ID).SetName(refName))
 		if err != nil {
 			return fmt.Errorf("unable to get branch %s of repository %s: %w", refName, repository.repoModel.Name, err)
@@ -198,17 +244,14 @@ func (repository *WorkRepository) CheckOut(ctx context.Context, refType WorkRepo
 		}
 		repository.setCurState(InBranch, nil, branch, nil, commit)
 	} else if refType == InCommit {
-		commitHash, err := hash.FromHex(refName)
-		if err != nil {
-			return err
-		}
-
-		if !commitHash.IsEmpty() {
-			commit, err := repository.repo.CommitRepo(repository.repoModel.ID).Commit(ctx, commitHash)
+		if len(refName) > 0 {
+			commit, err := repository.ResolveRevision(ctx, InCommit, refName)
 			if err != nil {
 				return err
 			}
-			treeHash = commit.TreeHash
+			if commit != nil {
+				treeHash = commit.TreeHash
+			}
 			repository.setCurState(InCommit, nil, nil, nil, commit)
 		}
 	} else if refType == InTag {
@@ -341,7 +384,7 @@ func (repository *WorkRepository) DeleteWip(ctx context.Context) error {
 
 // CommitChanges append a new commit to current headTree, read changes from wip, than create a new commit with parent point to current headTree,
 // and replace tree hash with wip's currentTreeHash.
-func (repository *WorkRepository) CommitChanges(ctx context.Context, msg string) (*models.Commit, error) {
+func (repository *WorkRepository) CommitChanges(ctx context.Context, msg string, opts ...CommitOption) (*models.Commit, error) {
 	if !(repository.state == InWip) {
 		return nil, errors.New("must commit changes on branch")
 	}
@@ -364,7 +407,7 @@ func (repository *WorkRepository) CommitChanges(ctx context.Context, msg string)
 	var commit *models.Commit
 	err = repository.repo.Transaction(ctx, func(repo models.IRepo) error {
 		var err error
-		commit, err = repository.commitChangeRoot(ctx, repo, author, repository.wip.CurrentTree, msg)
+		commit, err = repository.commitChangeRoot(ctx, repo, author, repository.wip.CurrentTree, msg, models.ReflogOperationCommit, opts...)
 		if err != nil {
 			return err
 		}
@@ -418,7 +461,7 @@ func (repository *WorkRepository) ChangeAndCommit(ctx context.Context, msg strin
 		if err != nil {
 			return err
 		}
-		commit, err = repository.commitChangeRoot(ctx, repo, author, workTree.Root().Hash(), msg)
+		commit, err = repository.commitChangeRoot(ctx, repo, author, workTree.Root().Hash(), msg, models.ReflogOperationCommit)
 		if err != nil {
 			return err
 		}
@@ -453,7 +496,19 @@ func (repository *WorkRepository) changeInWip(ctx context.Context, repo models.I
 	return workTree, changFn(workTree)
 }
 
-func (repository *WorkRepository) commitChangeRoot(ctx context.Context, repo models.IRepo, author models.Signature, root hash.Hash, msg string) (*models.Commit, error) {
+// CommitOption set optional fields of commit before its hash is calculated
+type CommitOption func(commit *models.Commit)
+
+// WithMetadata attach key values to new commit, metadata is part of commit hash
+func WithMetadata(metadata map[string]string) CommitOption {
+	return func(commit *models.Commit) {
+		if len(metadata) > 0 {
+			commit.Metadata = metadata
+		}
+	}
+}
+
+func (repository *WorkRepository) commitChangeRoot(ctx context.Context, repo models.IRepo, author models.Signature, root hash.Hash, msg string, operation models.ReflogOperation, opts ...CommitOption) (*models.Commit, error) {
 	parentHash := make([]hash.Hash, 0) //avoid nil parent
 	if !repository.branch.CommitHash.IsEmpty() {
 		parentHash = []hash.Hash{repository.branch.CommitHash}
@@ -475,6 +530,9 @@ func (repository *WorkRepository) commitChangeRoot(ctx context.Context, repo mod
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
+	for _, opt := range opts {
+		opt(commit)
+	}
 	commitHash, err := commit.GetHash()
 	if err != nil {
 		return nil, err
@@ -487,7 +545,7 @@ func (repository *WorkRepository) commitChangeRoot(ctx context.Context, repo mod
 	}
 
 	// Update branch
-	err = repo.BranchRepo().UpdateByID(ctx, models.NewUpdateBranchParams(repository.branch.ID).SetCommitHash(commitHash))
+	err = repository.moveBranchHead(ctx, repo, commitHash, operation, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -518,11 +576,25 @@ func (repository *WorkRepository) CreateBranch(ctx context.Context, branchName s
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
-	return repository.repo.BranchRepo().Insert(ctx, newBranch)
+	err = repository.repo.Transaction(ctx, func(repo models.IRepo) error {
+		_, err := repo.BranchRepo().Insert(ctx, newBranch)
+		if err != nil {
+			return err
+		}
+		return repository.recordReflog(ctx, repo, newBranch, hash.Empty, commitHash, models.ReflogOperationCreate, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBranch, nil
 }
 
 // DeleteBranch delete branch also delete wip belong this branch
 func (repository *WorkRepository) DeleteBranch(ctx context.Context) error {
+	if repository.state != InBranch {
+		return fmt.Errorf("working repo not in branch state")
+	}
+
 	return repository.repo.Transaction(ctx, func(repo models.IRepo) error {
 		deleteBranchParams := models.NewDeleteBranchParams().
 			SetRepositoryID(repository.repoModel.ID).
@@ -538,7 +610,7 @@ func (repository *WorkRepository) DeleteBranch(ctx context.Context) error {
 			return err
 		}
 
-		return err
+		return repository.recordReflog(ctx, repo, repository.branch, repository.branch.CommitHash, hash.Empty, models.ReflogOperationDelete, "")
 	})
 }
 
@@ -643,7 +715,11 @@ func (repository *WorkRepository) DiffCommit(ctx context.Context, toCommitID has
 		return nil, err
 	}
 
-	return workTree.Diff(ctx, toCommit.TreeHash, pathPrefix)
+	changes, err := workTree.Diff(ctx, toCommit.TreeHash, pathPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return repository.RenameDetector().Detect(ctx, changes)
 }
 
 func (repository *WorkRepository) GetCommitChanges(ctx context.Context, pathPrefix string) (*Changes, error) {
@@ -667,7 +743,11 @@ func (repository *WorkRepository) GetCommitChanges(ctx context.Context, pathPref
 	if err != nil {
 		return nil, err
 	}
-	return workTree.Diff(ctx, repository.commit.TreeHash, pathPrefix)
+	changes, err := workTree.Diff(ctx, repository.commit.TreeHash, pathPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return repository.RenameDetector().Detect(ctx, changes)
 }
 
 func (repository *WorkRepository) GetMergeState(ctx context.Context, toMergeCommitHash hash.Hash) ([]*ChangePair, error) {
@@ -722,6 +802,18 @@ func (repository *WorkRepository) GetMergeState(ctx context.Context, toMergeComm
 		return nil, err
 	}
 
+	baseDiff, mergeDiff, err = detectAndFollowRenames(ctx, repository.repo.FileTreeRepo(repository.repoModel.ID), repository.RenameDetector(), baseDiff, mergeDiff)
+	if err != nil {
+		return nil, err
+	}
+
+	//merge strategy was decided by attributes file of merge target which is the current branch, same as Merge
+	attributes, err := repository.Attributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fileTreeRepo := repository.repo.FileTreeRepo(repository.repoModel.ID)
 	changePairs := make([]*ChangePair, 0)
 	iter := NewChangesPairIter(baseDiff, mergeDiff)
 	for iter.Has() {
@@ -729,13 +821,40 @@ func (repository *WorkRepository) GetMergeState(ctx context.Context, toMergeComm
 		if err != nil {
 			return nil, err
 		}
+		changePair.MergeStrategy = attributes.MergeStrategy(changePair.Path())
+		if changePair.IsConflict {
+			changePair.IsConflict, err = repository.isConflictWithStrategy(ctx, fileTreeRepo, changePair)
+			if err != nil {
+				return nil, err
+			}
+		}
 		changePairs = append(changePairs, changePair)
 	}
 	return changePairs, nil
 }
 
+// isConflictWithStrategy check whether conflict can be resolved automatically by merge strategy, merge try to merge
+// text files line by line if no strategy specified, so these paths are conflict only if text merge fails
+func (repository *WorkRepository) isConflictWithStrategy(ctx context.Context, fileTreeRepo models.IFileTreeRepo, changePair *ChangePair) (bool, error) {
+	switch changePair.MergeStrategy {
+	case MergeStrategyOurs, MergeStrategyTheirs:
+		return false, nil
+	case MergeStrategyBinary:
+		return true, nil
+	default:
+		result, _, err := repository.mergeTextContent(ctx, fileTreeRepo, changePair.Left, changePair.Right, changePair.MergeStrategy == MergeStrategyUnion)
+		if err != nil {
+			if errors.Is(err, ErrNotTextMergeable) {
+				return true, nil
+			}
+			return false, err
+		}
+		return result.HasConflict(), nil
+	}
+}
+
 // Merge implement merge like git, docs https://en.wikipedia.org/wiki/Merge_(version_control)
-func (repository *WorkRepository) Merge(ctx context.Context, toMergeCommitHash hash.Hash, msg string, resolver ConflictResolver) (*models.Commit, error) {
+func (repository *WorkRepository) Merge(ctx context.Context, toMergeCommitHash hash.Hash, msg string, resolver ConflictResolver, opts ...CommitOption) (*models.Commit, error) {
 	if repository.state != InBranch {
 		return nil, errors.New("must merge on branch")
 	}
@@ -758,6 +877,12 @@ func (repository *WorkRepository) Merge(ctx context.Context, toMergeCommitHash h
 		}
 	}
 
+	attributes, err := repository.Attributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolver = AttributesResolver(ctx, repository, attributes, resolver)
+
 	var newCommit *models.Commit
 	err = repository.repo.Transaction(ctx, func(repo models.IRepo) error {
 		commitRepo := repo.CommitRepo(repository.repoModel.ID)
@@ -766,13 +891,16 @@ func (repository *WorkRepository) Merge(ctx context.Context, toMergeCommitHash h
 		if err != nil {
 			return err
 		}
-		newCommit, err = merge(ctx, commitRepo, fileTreeRepo, repository.repoModel, repository.operator, bestAncestor, sourceCommit, targetCommit, msg, resolver)
+		newCommit, err = merge(ctx, commitRepo, fileTreeRepo, repository.repoModel, repository.operator, bestAncestor, sourceCommit, targetCommit, msg, resolver, repository.RenameDetector(), opts...)
 		if err != nil {
 			return err
 		}
 
-		updateParams := models.NewUpdateBranchParams(repository.branch.ID).SetCommitHash(newCommit.Hash)
-		return repo.BranchRepo().UpdateByID(ctx, updateParams)
+		if bytes.Equal(repository.branch.CommitHash, newCommit.Hash) {
+			//already merged, head not moved
+			return nil
+		}
+		return repository.moveBranchHead(ctx, repo, newCommit.Hash, models.ReflogOperationMerge, msg)
 	})
 	if err != nil {
 		return nil, err
@@ -787,10 +915,12 @@ const (
 	CarArchiveType ArchiveType = "car"
 )
 
-func (repository *WorkRepository) Archive(ctx context.Context, archiveType ArchiveType) (io.ReadCloser, int64, error) {
+// Archive pack files of current ref into a temporary file, the file is removed when reader closed.
+// root cid of unixfs dag is returned for car archive, cid.Undef for others
+func (repository *WorkRepository) Archive(ctx context.Context, archiveType ArchiveType) (io.ReadCloser, int64, cid.Cid, error) {
 	rootTree, err := repository.RootTree(ctx)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, cid.Undef, err
 	}
 
 	wk := NewFileWalk(rootTree.object, rootTree.root)
@@ -801,8 +931,15 @@ func (repository *WorkRepository) Archive(ctx context.Context, archiveType Archi
 	archiver := NewRepoArchiver(repository.repoModel.Name, wk, reader)
 	tmpDir, err := os.MkdirTemp(os.TempDir(), "*") //todo file cache for archive
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, cid.Undef, err
+	}
+	removeTmp := func() {
+		if removeErr := os.RemoveAll(tmpDir); removeErr != nil {
+			workRepoLog.Warnf("remove archive temp dir %s failed %v", tmpDir, removeErr)
+		}
 	}
+
+	rootCid := cid.Undef
 	var tmpFile string
 	switch archiveType {
 	case ZipArchiveType:
@@ -810,22 +947,36 @@ func (repository *WorkRepository) Archive(ctx context.Context, archiveType Archi
 		err = archiver.ArchiveZip(ctx, tmpFile)
 	case CarArchiveType:
 		tmpFile = path.Join(tmpDir, hash.Hash(rootTree.root.Hash()).Hex()+".car")
-		err = archiver.ArchiveZip(ctx, tmpFile)
+		rootCid, err = archiver.ArchiveCar(ctx, tmpFile)
 	default:
-		return nil, 0, fmt.Errorf("unexpect archive type %s", archiveType)
+		err = fmt.Errorf("unexpect archive type %s", archiveType)
 	}
 	if err != nil {
-		return nil, 0, err
+		removeTmp()
+		return nil, 0, cid.Undef, err
 	}
 	st, err := os.Stat(tmpFile)
 	if err != nil {
-		return nil, 0, err
+		removeTmp()
+		return nil, 0, cid.Undef, err
 	}
 	fs, err := os.Open(tmpFile)
 	if err != nil {
-		return nil, 0, err
+		removeTmp()
+		return nil, 0, cid.Undef, err
 	}
-	return fs, st.Size(), nil
+	return &archiveFile{File: fs, remove: removeTmp}, st.Size(), rootCid, nil
+}
+
+// archiveFile remove temporary archive after closed
+type archiveFile struct {
+	*os.File
+	remove func()
+}
+
+func (f *archiveFile) Close() error {
+	defer f.remove()
+	return f.File.Close()
 }
 
 func (repository *WorkRepository) setCurState(state WorkRepoState, wip *models.WorkingInProcess, branch *models.Branch, tag *models.Tag, commit *models.Commit) {
@@ -836,6 +987,11 @@ func (repository *WorkRepository) setCurState(state WorkRepoState, wip *models.W
 	repository.commit = commit
 }
 
+// RepositoryModel return model of repository
+func (repository *WorkRepository) RepositoryModel() *models.Repository {
+	return repository.repoModel
+}
+
 // CurWip return current wip if in wip, else return nil
 func (repository *WorkRepository) CurWip() *models.WorkingInProcess {
 	return repository.wip
@@ -897,7 +1053,7 @@ func findBestAncestor(ctx context.Context,
 			return nil, err
 		}
 
-		virtualCommit, err := merge(ctx, commitRepo, fileTreeRepo, repoModel, merger, subBestAncestor, bestAncestor[0].Commit(), bestAncestor[1].Commit(), "virtual commit", ForbidResolver)
+		virtualCommit, err := merge(ctx, commitRepo, fileTreeRepo, repoModel, merger, subBestAncestor, bestAncestor[0].Commit(), bestAncestor[1].Commit(), "virtual commit", ForbidResolver, &RenameDetector{})
 		if err != nil {
 			return nil, err
 		}
@@ -918,7 +1074,9 @@ func merge(ctx context.Context,
 	sourceCommit *models.Commit,
 	targetCommit *models.Commit,
 	msg string,
-	resolver ConflictResolver) (*models.Commit, error) {
+	resolver ConflictResolver,
+	detector *RenameDetector,
+	opts ...CommitOption) (*models.Commit, error) {
 	if sourceCommit == nil && targetCommit == nil {
 		return nil, errors.New("cannot find nil commit")
 	}
@@ -961,6 +1119,52 @@ func merge(ctx context.Context,
 		}
 	}
 
+	baseWorkTree, err := mergeTree(ctx, fileTreeRepo, bestAncestor, sourceCommit, targetCommit, resolver, detector)
+	if err != nil {
+		return nil, err
+	}
+
+	author := models.Signature{
+		Name:  merger.Name,
+		Email: merger.Email,
+		When:  time.Now(),
+	}
+
+	mergeCommit := &models.Commit{
+		Author:       author,
+		RepositoryID: repoModel.ID,
+		Committer:    author,
+		MergeTag:     "",
+		Message:      msg,
+		TreeHash:     baseWorkTree.Root().Hash(),
+		ParentHashes: []hash.Hash{sourceCommit.Hash, targetCommit.Hash},
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	for _, opt := range opts {
+		opt(mergeCommit)
+	}
+	hash, err := mergeCommit.GetHash()
+	if err != nil {
+		return nil, err
+	}
+	mergeCommit.Hash = hash
+
+	mergeCommit, err = commitRepo.Insert(ctx, mergeCommit)
+	if err != nil {
+		return nil, err
+	}
+	return mergeCommit, nil
+}
+
+// mergeTree three-way merge trees of source and target commit with tree of best ancestor as base, return the merged tree
+func mergeTree(ctx context.Context,
+	fileTreeRepo models.IFileTreeRepo,
+	bestAncestor *models.Commit,
+	sourceCommit *models.Commit,
+	targetCommit *models.Commit,
+	resolver ConflictResolver,
+	detector *RenameDetector) (*WorkTree, error) {
 	ancestorWorkTree, err := NewWorkTree(ctx, fileTreeRepo, models.NewRootTreeEntry(bestAncestor.TreeHash))
 	if err != nil {
 		return nil, err
@@ -976,6 +1180,11 @@ func merge(ctx context.Context,
 		return nil, err
 	}
 
+	sourceDiff, targetDiff, err = detectAndFollowRenames(ctx, fileTreeRepo, detector, sourceDiff, targetDiff)
+	if err != nil {
+		return nil, err
+	}
+
 	//merge diff
 	baseWorkTree, err := NewWorkTree(ctx, fileTreeRepo, models.NewRootTreeEntry(bestAncestor.TreeHash))
 	if err != nil {
@@ -994,35 +1203,7 @@ func merge(ctx context.Context,
 			return nil, err
 		}
 	}
-
-	author := models.Signature{
-		Name:  merger.Name,
-		Email: merger.Email,
-		When:  time.Now(),
-	}
-
-	mergeCommit := &models.Commit{
-		Author:       author,
-		RepositoryID: repoModel.ID,
-		Committer:    author,
-		MergeTag:     "",
-		Message:      msg,
-		TreeHash:     baseWorkTree.Root().Hash(),
-		ParentHashes: []hash.Hash{sourceCommit.Hash, targetCommit.Hash},
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
-	}
-	hash, err := mergeCommit.GetHash()
-	if err != nil {
-		return nil, err
-	}
-	mergeCommit.Hash = hash
-
-	mergeCommit, err = commitRepo.Insert(ctx, mergeCommit)
-	if err != nil {
-		return nil, err
-	}
-	return mergeCommit, nil
+	return baseWorkTree, nil
 }
 
 func treeHashFromCommit(commit *models.Commit) hash.Hash {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -531,6 +532,79 @@ func TestWorkRepositoryMergeState(t *testing.T) {
 	})
 }
 
+func TestWorkRepositoryGetMergeStateTextMerge(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+	repo := models.NewRepo(db)
+
+	user, err := makeUser(ctx, repo.UserRepo(), "admin")
+	require.NoError(t, err)
+
+	project, err := makeRepository(ctx, repo, user, t.Name())
+	require.NoError(t, err)
+
+	workRepo := NewWorkRepositoryFromAdapter(ctx, user, project, repo, mem.New(ctx))
+	writeFile := func(branchName, fullPath, content string) *models.Commit {
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, branchName))
+		_, _, err := workRepo.GetOrCreateWip(ctx)
+		require.NoError(t, err)
+		require.NoError(t, workRepo.CheckOut(ctx, InWip, branchName))
+		commit, err := workRepo.ChangeAndCommit(ctx, "update "+fullPath, func(workTree *WorkTree) error {
+			blob, err := workRepo.WriteBlob(ctx, strings.NewReader(content), int64(len(content)), models.DefaultLeafProperty())
+			if err != nil {
+				return err
+			}
+			_, _, err = workTree.FindBlob(ctx, fullPath)
+			if errors.Is(err, ErrPathNotFound) {
+				return workTree.AddLeaf(ctx, fullPath, blob)
+			}
+			return workTree.ReplaceLeaf(ctx, fullPath, blob)
+		})
+		require.NoError(t, err)
+		return commit
+	}
+
+	writeFile("main", "a.txt", "1\n2\n3\n4\n5\n")
+	writeFile("main", "b.txt", "1\n2\n3\n")
+	require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+	_, err = workRepo.CreateBranch(ctx, "feat")
+	require.NoError(t, err)
+
+	writeFile("feat", "a.txt", "1\nfeat\n3\n4\n5\n")
+	featCommit := writeFile("feat", "b.txt", "1\nfeat\n3\n")
+	writeFile("main", "a.txt", "1\n2\n3\n4\nmain\n")
+	writeFile("main", "b.txt", "1\nmain\n3\n")
+
+	require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+	changes, err := workRepo.GetMergeState(ctx, featCommit.Hash)
+	require.NoError(t, err)
+	conflicts := make(map[string]bool)
+	for _, change := range changes {
+		conflicts[change.Path()] = change.IsConflict
+	}
+	//a.txt is merged line by line, b.txt modify the same line
+	require.Equal(t, map[string]bool{"a.txt": false, "b.txt": true}, conflicts)
+
+	mergeState := func(sourceHash hash.Hash) map[string]bool {
+		require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+		changes, err := workRepo.GetMergeState(ctx, sourceHash)
+		require.NoError(t, err)
+		conflicts := make(map[string]bool)
+		for _, change := range changes {
+			conflicts[change.Path()] = change.IsConflict
+		}
+		return conflicts
+	}
+
+	//attributes of source branch are ignored, same as merge
+	featCommit = writeFile("feat", AttributesFileName, "a.txt binary\nb.txt merge=ours\n")
+	require.Equal(t, map[string]bool{"a.txt": false, "b.txt": true, AttributesFileName: false}, mergeState(featCommit.Hash))
+
+	writeFile("main", AttributesFileName, "a.txt binary\nb.txt merge=ours\n")
+	require.Equal(t, map[string]bool{"a.txt": true, "b.txt": false, AttributesFileName: false}, mergeState(featCommit.Hash))
+}
+
 func TestWorkRepositoryCreateTag(t *testing.T) {
 	ctx := context.Background()
 	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
@@ -571,6 +645,61 @@ func TestWorkRepositoryCreateTag(t *testing.T) {
 	_, err = workRepo.CreateTag(ctx, "v0.0.1", nil)
 	require.Error(t, err)
 }
+func TestWorkRepositoryCommitMetadata(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	adapter := mem.New(ctx)
+	repo := models.NewRepo(db)
+
+	user, err := makeUser(ctx, repo.UserRepo(), "admin")
+	require.NoError(t, err)
+
+	project, err := makeRepository(ctx, repo, user, "testproject")
+	require.NoError(t, err)
+
+	workRepo := NewWorkRepositoryFromAdapter(ctx, user, project, repo, adapter)
+	_, err = addChangesToWip(ctx, workRepo, "main", "init", `
+1|a.txt	|a1
+`)
+	require.NoError(t, err)
+
+	require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+	_, err = workRepo.CreateBranch(ctx, "feat")
+	require.NoError(t, err)
+
+	require.NoError(t, workRepo.CheckOut(ctx, InBranch, "feat"))
+	_, _, err = workRepo.GetOrCreateWip(ctx)
+	require.NoError(t, err)
+	require.NoError(t, workRepo.CheckOut(ctx, InWip, "feat"))
+	require.NoError(t, workRepo.ChangeInWip(ctx, func(workTree *WorkTree) error {
+		return appendChangeToWorkTree(ctx, workRepo, workTree, `
+1|b.txt	|b1
+`)
+	}))
+	commit, err := workRepo.CommitChanges(ctx, "produce data", WithMetadata(map[string]string{"pipeline_run_id": "42"}))
+	require.NoError(t, err)
+
+	savedCommit, err := repo.CommitRepo(project.ID).Commit(ctx, commit.Hash)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"pipeline_run_id": "42"}, savedCommit.Metadata)
+	expectHash, err := savedCommit.GetHash()
+	require.NoError(t, err)
+	require.Equal(t, expectHash.Hex(), commit.Hash.Hex())
+
+	_, err = addChangesToWip(ctx, workRepo, "main", "diverge", `
+1|c.txt	|c1
+`)
+	require.NoError(t, err)
+
+	require.NoError(t, workRepo.CheckOut(ctx, InBranch, "main"))
+	mergeCommit, err := workRepo.Merge(ctx, commit.Hash, "merge feat", LeastHashResolve, WithMetadata(map[string]string{"source_uri": "s3://bucket/data"}))
+	require.NoError(t, err)
+	require.Equal(t, 2, mergeCommit.NumParents())
+	require.Equal(t, map[string]string{"source_uri": "s3://bucket/data"}, mergeCommit.Metadata)
+}
+
 func TestWorkRepository_Archive(t *testing.T) {
 	ctx := context.Background()
 	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
@@ -586,7 +715,7 @@ func TestWorkRepository_Archive(t *testing.T) {
 	adapter := mem.New(ctx)
 	workRepo := NewWorkRepositoryFromAdapter(ctx, user, project, repo, adapter)
 
-	reader, _, err := workRepo.Archive(ctx, ZipArchiveType)
+	reader, _, _, err := workRepo.Archive(ctx, ZipArchiveType)
 	defer reader.Close() //nolint
 	require.NoError(t, err)
 
@@ -601,14 +730,15 @@ func TestWorkRepository_Archive(t *testing.T) {
 
 	{
 		//unexpect type
-		_, _, err = workRepo.Archive(ctx, "gz")
+		_, _, _, err = workRepo.Archive(ctx, "gz")
 		require.Error(t, err)
 	}
 
 	{
 		//test zip
-		reader, _, err := workRepo.Archive(ctx, ZipArchiveType)
+		reader, _, rootCid, err := workRepo.Archive(ctx, ZipArchiveType)
 		require.NoError(t, err)
+		require.False(t, rootCid.Defined())
 		defer reader.Close() //nolint
 		_, err = io.ReadAll(reader)
 		require.NoError(t, err)
@@ -616,10 +746,22 @@ func TestWorkRepository_Archive(t *testing.T) {
 
 	{
 		//test car
-		reader, _, err := workRepo.Archive(ctx, CarArchiveType)
+		reader, _, rootCid, err := workRepo.Archive(ctx, CarArchiveType)
 		require.NoError(t, err)
 		defer reader.Close() //nolint
-		_, err = io.ReadAll(reader)
+		require.True(t, rootCid.Defined())
+
+		//import car back to wip
+		err = workRepo.CheckOut(ctx, InWip, "main")
+		require.NoError(t, err)
+		result, err := workRepo.ImportCar(ctx, reader, DefaultMaxCarSize, "imported", false)
+		require.NoError(t, err)
+		require.Equal(t, rootCid, result.RootCid)
+		require.Equal(t, 1, result.FileCount)
+
+		workTree, err := workRepo.RootTree(ctx)
+		require.NoError(t, err)
+		_, _, err = workTree.FindBlob(ctx, path.Join("imported", project.Name, "a.txt"))
 		require.NoError(t, err)
 	}
 }
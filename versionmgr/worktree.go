@@ -34,6 +34,8 @@ type FullTreeEntry struct {
 	IsDir bool      `json:"is_dir"`
 	Hash  hash.Hash `json:"hash"`
 	Size  int64     `json:"size"`
+	// CheckSum md5 of file content, empty for directory
+	CheckSum hash.Hash `json:"checksum,omitempty"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
@@ -497,6 +499,7 @@ func (workTree *WorkTree) getFullEntry(ctx context.Context, treeEntries []models
 				return nil, err
 			}
 			fe.Size = blob.Size
+			fe.CheckSum = blob.CheckSum
 			fe.CreatedAt = blob.CreatedAt
 			fe.UpdatedAt = blob.UpdatedAt
 			entries = append(entries, fe)
@@ -543,7 +546,22 @@ func (workTree *WorkTree) ApplyOneChange(ctx context.Context, change IChange) er
 		if err != nil {
 			return err
 		}
-		return workTree.ReplaceLeaf(ctx, change.To().String(), blob)
+		err = workTree.ReplaceLeaf(ctx, change.To().String(), blob)
+		if errors.Is(err, ErrPathNotFound) {
+			//modification was moved to the new path of renamed file, see FollowRenames
+			return workTree.AddLeaf(ctx, change.To().String(), blob)
+		}
+		return err
+	case merkletrie.Rename, merkletrie.Copy:
+		blob, err := workTree.object.Blob(ctx, change.To().Hash())
+		if err != nil {
+			return err
+		}
+		err = workTree.AddLeaf(ctx, change.To().String(), blob)
+		if err != nil || action == merkletrie.Copy {
+			return err
+		}
+		return workTree.RemoveEntry(ctx, change.From().String())
 	}
 	return fmt.Errorf("unexpect change action: %s", action)
 }
@@ -0,0 +1,239 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/config"
+	"github.com/GitDataAI/jiaozifs/models"
+	"go.uber.org/fx"
+)
+
+const (
+	// DefaultDeliveryInterval interval to poll pending deliveries if not config
+	DefaultDeliveryInterval = 5 * time.Second
+	// DefaultDeliveryTimeout timeout of one request to webhook if not config
+	DefaultDeliveryTimeout = 10 * time.Second
+	// DefaultMaxAttempts delivery is marked failed after this number of attempts if not config
+	DefaultMaxAttempts = 8
+	// DefaultBackoff wait time before the first retry, doubled for each retry
+	DefaultBackoff = 30 * time.Second
+	// maxBackoff upper limit of wait time between retries
+	maxBackoff = 6 * time.Hour
+
+	deliveryBatchSize = 100
+)
+
+// ErrForbiddenAddress webhook url resolved to an address which is not public
+var ErrForbiddenAddress = errors.New("address of webhook is not public")
+
+// Deliverer post pending deliveries to webhooks, failed deliveries are retried with exponential backoff
+type Deliverer struct {
+	repo        models.IRepo
+	client      *http.Client
+	timeout     time.Duration
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewDeliverer create deliverer, webhooks are not allowed to reach private network unless allowPrivateNetwork is true
+func NewDeliverer(repo models.IRepo, timeout time.Duration, maxAttempts int, backoff time.Duration, allowPrivateNetwork bool) *Deliverer {
+	if timeout <= 0 {
+		timeout = DefaultDeliveryTimeout
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	if backoff <= 0 {
+		backoff = DefaultBackoff
+	}
+	return &Deliverer{
+		repo:        repo,
+		client:      newDeliveryClient(timeout, allowPrivateNetwork),
+		timeout:     timeout,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+// newDeliveryClient create http client which never follow redirects. address is checked when connecting instead of
+// when resolving url, so dns record changed after check can not lead request to private network
+func newDeliveryClient(timeout time.Duration, allowPrivateNetwork bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivateNetwork {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			return checkPublicAddress(address)
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	//connection to proxy would pass the check of address
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// carrierGradeNAT shared address space, not covered by net.IP.IsPrivate
+var carrierGradeNAT = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// checkPublicAddress reject loopback, private, link-local, multicast and unspecified addresses
+func checkPublicAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid ip %s %w", host, ErrForbiddenAddress)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || carrierGradeNAT.Contains(ip) {
+		return fmt.Errorf("ip %s %w", host, ErrForbiddenAddress)
+	}
+	return nil
+}
+
+// DeliverDue claim deliveries due now and post them, return number of deliveries attempted. claimed deliveries are
+// leased until all of them could be attempted, so that other daemons sharing the database skip them
+func (d *Deliverer) DeliverDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	deliveries, err := d.repo.WebhookRepo().ClaimDueDeliveries(ctx, now, now.Add(d.timeout*deliveryBatchSize), deliveryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		err = d.deliver(ctx, delivery)
+		if err != nil {
+			log.Errorf("update delivery %s failed %v", delivery.ID, err)
+		}
+	}
+	return len(deliveries), nil
+}
+
+func (d *Deliverer) deliver(ctx context.Context, delivery *models.WebhookDelivery) error {
+	attempts := delivery.Attempts + 1
+	hook, err := d.repo.WebhookRepo().Get(ctx, models.NewGetWebhookParams().SetID(delivery.WebhookID))
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return d.repo.WebhookRepo().UpdateDelivery(ctx, models.NewUpdateDeliveryParams(delivery.ID, models.DeliveryStateFailed, attempts, time.Now()).SetError("webhook not found"))
+		}
+		return err
+	}
+
+	code, err := d.post(ctx, hook, delivery)
+	if err == nil && code >= 200 && code < 300 {
+		return d.repo.WebhookRepo().UpdateDelivery(ctx, models.NewUpdateDeliveryParams(delivery.ID, models.DeliveryStateSuccess, attempts, time.Now()).SetResponseCode(code))
+	}
+
+	state := models.DeliveryStatePending
+	if attempts >= d.maxAttempts {
+		state = models.DeliveryStateFailed
+	}
+	params := models.NewUpdateDeliveryParams(delivery.ID, state, attempts, time.Now().Add(Backoff(d.backoff, attempts)))
+	if err != nil {
+		params.SetError(err.Error())
+	} else {
+		params.SetResponseCode(code).SetError(fmt.Sprintf("unexpected response code %d", code))
+	}
+	return d.repo.WebhookRepo().UpdateDelivery(ctx, params)
+}
+
+func (d *Deliverer) post(ctx context.Context, hook *models.Webhook, delivery *models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close() //nolint
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
+
+// Backoff return wait time before next attempt, doubled for each failed attempt
+func Backoff(base time.Duration, attempts int) time.Duration {
+	wait := base
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return wait
+}
+
+// RunWebhookDelivery deliver pending events to webhooks in background of daemon, events are persisted in database
+// so that they are not lost while daemon restart
+func RunWebhookDelivery(lc fx.Lifecycle, webhookConfig *config.WebhookConfig, repo models.IRepo) {
+	if !webhookConfig.Enable {
+		return
+	}
+
+	interval := webhookConfig.Interval
+	if interval <= 0 {
+		interval = DefaultDeliveryInterval
+	}
+	deliverer := NewDeliverer(repo, webhookConfig.Timeout, webhookConfig.MaxAttempts, webhookConfig.Backoff, webhookConfig.AllowPrivateNetwork)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+
+					//drain due deliveries before wait for next tick
+					for {
+						count, err := deliverer.DeliverDue(ctx)
+						if err != nil {
+							if ctx.Err() == nil {
+								log.Errorf("deliver webhook events failed %v", err)
+							}
+							break
+						}
+						if count < deliveryBatchSize {
+							break
+						}
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			<-done
+			return nil
+		},
+	})
+}
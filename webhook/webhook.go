@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/google/uuid"
+	logging "github.com/ipfs/go-log/v2"
+)
+
+var log = logging.Logger("webhook")
+
+const (
+	// EventHeader name of event in request header
+	EventHeader = "X-Jiaozifs-Event"
+	// DeliveryHeader id of delivery in request header, same in retries
+	DeliveryHeader = "X-Jiaozifs-Delivery"
+	// SignatureHeader hmac sha256 of request body signed by secret of webhook, format is sha256=<hex>
+	SignatureHeader = "X-Jiaozifs-Signature-256"
+)
+
+const (
+	EventCommit             = "commit"
+	EventBranchCreate       = "branch_create"
+	EventBranchDelete       = "branch_delete"
+	EventBranchRestore      = "branch_restore"
+	EventTagCreate          = "tag_create"
+	EventTagDelete          = "tag_delete"
+	EventMergeRequestOpen   = "merge_request_open"
+	EventMergeRequestUpdate = "merge_request_update"
+	EventMergeRequestMerge  = "merge_request_merge"
+	EventMemberChange       = "member_change"
+)
+
+// Events all events could be subscribed by webhook
+var Events = []string{
+	EventCommit,
+	EventBranchCreate,
+	EventBranchDelete,
+	EventBranchRestore,
+	EventTagCreate,
+	EventTagDelete,
+	EventMergeRequestOpen,
+	EventMergeRequestUpdate,
+	EventMergeRequestMerge,
+	EventMemberChange,
+}
+
+// ValidEvent check whether event could be subscribed
+func ValidEvent(event string) bool {
+	for _, e := range Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Payload body posted to webhook
+type Payload struct {
+	Event        string    `json:"event"`
+	RepositoryID uuid.UUID `json:"repository_id"`
+	Repository   string    `json:"repository"`
+	Sender       string    `json:"sender"`
+	Timestamp    int64     `json:"timestamp"`
+	Data         any       `json:"data"`
+}
+
+// Sign return signature of body in format sha256=<hex>
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Emit enqueue delivery of event to active webhooks of repository which subscribe the event, deliveries are posted by
+// background worker of daemon. call it in the transaction of the operation, so event is queued only if operation is committed
+func Emit(ctx context.Context, repo models.IRepo, repository *models.Repository, sender string, event string, data any) error {
+	hooks, err := repo.WebhookRepo().List(ctx, repository.ID)
+	if err != nil {
+		return err
+	}
+
+	var body []byte
+	for _, hook := range hooks {
+		if !hook.Subscribe(event) {
+			continue
+		}
+
+		if body == nil {
+			body, err = json.Marshal(Payload{
+				Event:        event,
+				RepositoryID: repository.ID,
+				Repository:   repository.Name,
+				Sender:       sender,
+				Timestamp:    time.Now().UnixMilli(),
+				Data:         data,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = repo.WebhookRepo().InsertDelivery(ctx, &models.WebhookDelivery{
+			WebhookID:     hook.ID,
+			Event:         event,
+			Payload:       body,
+			State:         models.DeliveryStatePending,
+			NextAttemptAt: time.Now(),
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CommitData data of commit event
+type CommitData struct {
+	Branch  string `json:"branch"`
+	Commit  string `json:"commit"`
+	Message string `json:"message"`
+	Author  string `json:"author"`
+}
+
+// RefData data of branch and tag events
+type RefData struct {
+	Ref    string `json:"ref"`
+	Commit string `json:"commit"`
+}
+
+// MergeRequestData data of merge request events
+type MergeRequestData struct {
+	Sequence     uint64 `json:"sequence"`
+	Title        string `json:"title"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+	State        int    `json:"state"`
+	// MergeCommit commit created by merging, only set in merge event
+	MergeCommit string `json:"merge_commit,omitempty"`
+}
+
+// MemberData data of member change event
+type MemberData struct {
+	// Action one of invite, update, revoke
+	Action  string    `json:"action"`
+	UserID  uuid.UUID `json:"user_id"`
+	GroupID uuid.UUID `json:"group_id"`
+}
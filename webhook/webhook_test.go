@@ -0,0 +1,145 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GitDataAI/jiaozifs/models"
+	"github.com/GitDataAI/jiaozifs/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	require.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", Sign("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
+
+func TestBackoff(t *testing.T) {
+	require.Equal(t, time.Second, Backoff(time.Second, 1))
+	require.Equal(t, 4*time.Second, Backoff(time.Second, 3))
+	require.Equal(t, maxBackoff, Backoff(time.Hour, 10))
+}
+
+func TestValidEvent(t *testing.T) {
+	require.True(t, ValidEvent(EventCommit))
+	require.False(t, ValidEvent("push"))
+}
+
+func TestCheckPublicAddress(t *testing.T) {
+	for _, address := range []string{"127.0.0.1:80", "[::1]:80", "10.0.0.1:80", "192.168.1.1:443", "169.254.169.254:80", "100.64.0.1:80", "0.0.0.0:80", "[fe80::1]:80"} {
+		require.ErrorIs(t, checkPublicAddress(address), ErrForbiddenAddress, address)
+	}
+	for _, address := range []string{"8.8.8.8:443", "[2001:4860:4860::8888]:443"} {
+		require.NoError(t, checkPublicAddress(address), address)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	_, err := newDeliveryClient(time.Second, false).Get(server.URL)
+	require.ErrorIs(t, err, ErrForbiddenAddress)
+
+	resp, err := newDeliveryClient(time.Second, true).Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+}
+
+func TestDeliveryClientNotFollowRedirect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest", http.StatusFound)
+	}))
+	defer server.Close()
+
+	resp, err := newDeliveryClient(time.Second, true).Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+}
+
+func TestDeliverDue(t *testing.T) {
+	ctx := context.Background()
+	closeDB, _, db := testhelper.SetupDatabase(ctx, t)
+	defer closeDB()
+
+	repo := models.NewRepo(db)
+	repository := &models.Repository{ID: uuid.New(), Name: "testrepo"}
+
+	statusCode := http.StatusOK
+	var received []*http.Request
+	var receivedBody [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = append(received, r)
+		receivedBody = append(receivedBody, body)
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	hook, err := repo.WebhookRepo().Insert(ctx, &models.Webhook{
+		RepositoryID: repository.ID,
+		URL:          server.URL,
+		Secret:       "secret",
+		Events:       []string{EventBranchCreate},
+		Active:       true,
+		CreatorID:    uuid.New(),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	})
+	require.NoError(t, err)
+
+	deliverer := NewDeliverer(repo, time.Second, 2, time.Millisecond, true)
+
+	t.Run("not subscribed event", func(t *testing.T) {
+		require.NoError(t, Emit(ctx, repo, repository, "admin", EventTagCreate, RefData{Ref: "v1"}))
+		count, err := deliverer.DeliverDue(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+	})
+
+	t.Run("deliver success", func(t *testing.T) {
+		require.NoError(t, Emit(ctx, repo, repository, "admin", EventBranchCreate, RefData{Ref: "feat"}))
+		count, err := deliverer.DeliverDue(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		require.Len(t, received, 1)
+		require.Equal(t, EventBranchCreate, received[0].Header.Get(EventHeader))
+		require.Equal(t, Sign("secret", receivedBody[0]), received[0].Header.Get(SignatureHeader))
+
+		payload := Payload{}
+		require.NoError(t, json.Unmarshal(receivedBody[0], &payload))
+		require.Equal(t, "testrepo", payload.Repository)
+		require.Equal(t, "admin", payload.Sender)
+
+		deliveries, err := repo.WebhookRepo().ListDeliveries(ctx, hook.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		require.Equal(t, models.DeliveryStateSuccess, deliveries[0].State)
+		require.Equal(t, http.StatusOK, *deliveries[0].ResponseCode)
+	})
+
+	t.Run("retry until failed", func(t *testing.T) {
+		statusCode = http.StatusInternalServerError
+		require.NoError(t, Emit(ctx, repo, repository, "admin", EventBranchCreate, RefData{Ref: "feat2"}))
+
+		for i := 0; i < 2; i++ {
+			time.Sleep(10 * time.Millisecond)
+			count, err := deliverer.DeliverDue(ctx)
+			require.NoError(t, err)
+			require.Equal(t, 1, count)
+		}
+
+		deliveries, err := repo.WebhookRepo().ListDeliveries(ctx, hook.ID, 1)
+		require.NoError(t, err)
+		require.Equal(t, models.DeliveryStateFailed, deliveries[0].State)
+		require.Equal(t, 2, deliveries[0].Attempts)
+		require.Equal(t, http.StatusInternalServerError, *deliveries[0].ResponseCode)
+	})
+}